@@ -0,0 +1,132 @@
+package libasciidoc_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("backends", func() {
+
+	// a backend which renders the attributes describing the backend
+	renderer.RegisterConverter("test", renderer.NewConverter(func(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+		for _, k := range []string{"backend", "backend-test", "basebackend", "basebackend-testing", "outfilesuffix"} {
+			if v, found := ctx.Document.Attributes[k]; found {
+				if _, err := io.WriteString(output, k+"="+v.(string)+"\n"); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return ctx.Document.Attributes, nil
+	}, "testing", ".tst"))
+
+	convert := func(source string, options ...renderer.Option) (string, map[string]interface{}, error) {
+		result := bytes.NewBuffer(nil)
+		metadata, err := libasciidoc.Convert(context.Background(), "", strings.NewReader(source), result, options...)
+		return result.String(), metadata, err
+	}
+
+	It("should list the registered backends", func() {
+		Expect(renderer.Backends()).To(ContainElement("html5"))
+		Expect(renderer.Backends()).To(ContainElement("test"))
+	})
+
+	It("should render with the backend given in the options", func() {
+		output, _, err := convert("some content", renderer.Backend("test"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal(`backend=test
+backend-test=
+basebackend=testing
+basebackend-testing=
+outfilesuffix=.tst
+`))
+	})
+
+	It("should render with the backend given in the document attributes", func() {
+		source := `:backend: test
+
+some content`
+		output, _, err := convert(source)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(HavePrefix("backend=test\n"))
+	})
+
+	It("should render with the backend given in the options rather than in the document attributes", func() {
+		source := `:backend: html5
+
+some content`
+		output, _, err := convert(source, renderer.Backend("test"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(HavePrefix("backend=test\n"))
+	})
+
+	It("should retain the outfilesuffix given in the document attributes", func() {
+		source := `:outfilesuffix: .out
+
+some content`
+		output, _, err := convert(source, renderer.Backend("test"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(HaveSuffix("outfilesuffix=.out\n"))
+	})
+
+	It("should set the backend attributes with the built-in backends", func() {
+		_, metadata, err := convert("some content")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metadata).To(HaveKeyWithValue("backend", "html5"))
+		Expect(metadata).To(HaveKeyWithValue("backend-html5", ""))
+		Expect(metadata).To(HaveKeyWithValue("basebackend", "html"))
+		Expect(metadata).To(HaveKeyWithValue("basebackend-html", ""))
+		Expect(metadata).To(HaveKeyWithValue("outfilesuffix", ".html"))
+	})
+
+	It("should use the outfilesuffix in the cross references to other documents", func() {
+		source := `:outfilesuffix: .htm
+
+see xref:other.adoc[the other document]`
+		output, _, err := convert(source, renderer.IncludeHeaderFooter(false))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(ContainSubstring(`<a href="other.htm">the other document</a>`))
+	})
+
+	It("should substitute the backend attributes in the document", func() {
+		source := `{backend} {basebackend} {outfilesuffix}`
+		output, _, err := convert(source, renderer.IncludeHeaderFooter(false))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal(`<div class="paragraph">
+<p>html5 html .html</p>
+</div>`))
+	})
+
+	It("should substitute the backend attributes declared in the document", func() {
+		source := `:backend: docbook5
+:outfilesuffix: .dbk
+
+{backend} {basebackend} {outfilesuffix}`
+		output, _, err := convert(source, renderer.IncludeHeaderFooter(false))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal(`<simpara>docbook5 docbook .dbk</simpara>`))
+	})
+
+	It("should substitute the backend given in the options rather than in the document attributes", func() {
+		source := `:backend: docbook5
+
+{backend}`
+		output, _, err := convert(source, renderer.Backend("html5"), renderer.IncludeHeaderFooter(false))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output).To(Equal(`<div class="paragraph">
+<p>html5</p>
+</div>`))
+	})
+
+	It("should fail with an unknown backend", func() {
+		_, _, err := convert("some content", renderer.Backend("unknown"))
+		Expect(err).To(MatchError("unsupported backend: 'unknown'"))
+	})
+})
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/jsonast"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewASTCmd returns the `ast` command, to export the documents in JSON and to render the exported documents
+func NewASTCmd() *cobra.Command {
+	astCmd := &cobra.Command{
+		Use:   "ast",
+		Short: "Export documents in JSON, or render documents exported in JSON",
+	}
+	astCmd.AddCommand(newASTExportCmd(), newASTRenderCmd())
+	return astCmd
+}
+
+func newASTExportCmd() *cobra.Command {
+	var outputName string
+	var safeMode string
+	var attributes []string
+	exportCmd := &cobra.Command{
+		Use:   "export [flags] FILE",
+		Short: "Export the documents in JSON",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mode, err := types.ParseSafeMode(safeMode)
+			if err != nil {
+				return err
+			}
+			options := []renderer.Option{renderer.SafeMode(mode), renderer.Attributes(parseAttributes(attributes))}
+			for _, source := range args {
+				f, err := os.Open(source)
+				if err != nil {
+					return errors.Wrapf(err, "error opening %s", source)
+				}
+				defer f.Close()
+				doc, err := libasciidoc.ParseDocument(context.Background(), source, f, options...)
+				if err != nil {
+					return errors.Wrapf(err, "error while parsing %s", source)
+				}
+				// the document is encoded before the output file is created, so that no file is left behind
+				// if a problem occurred
+				result := bytes.NewBuffer(nil)
+				if err := jsonast.Encode(result, doc); err != nil {
+					return err
+				}
+				out, close := getOut(cmd, source, outputName, ".json")
+				if out == nil {
+					continue
+				}
+				defer close()
+				if _, err := result.WriteTo(out); err != nil {
+					return errors.Wrapf(err, "error writing the output of %s", source)
+				}
+			}
+			return nil
+		},
+	}
+	flags := exportCmd.Flags()
+	flags.StringVarP(&outputName, "out-file", "o", "", "output file (default: based on path of input file); use - to output to STDOUT")
+	flags.StringVarP(&safeMode, "safe-mode", "S", "unsafe", "safe mode which restricts the access to the files [unsafe|safe|server|secure]")
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "document attribute to set, as 'name' or 'name=value', which takes precedence over the attributes of the document")
+	return exportCmd
+}
+
+func newASTRenderCmd() *cobra.Command {
+	var noHeaderFooter bool
+	var outputName string
+	var backend string
+	renderCmd := &cobra.Command{
+		Use:   "render [flags] FILE",
+		Short: "Render the documents exported in JSON",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, source := range args {
+				f, err := os.Open(source)
+				if err != nil {
+					return errors.Wrapf(err, "error opening %s", source)
+				}
+				defer f.Close()
+				stat, err := f.Stat()
+				if err != nil {
+					return errors.Wrapf(err, "error opening %s", source)
+				}
+				doc, err := jsonast.Decode(f)
+				if err != nil {
+					return errors.Wrapf(err, "error while decoding %s", source)
+				}
+				// use the file mtime as the `last updated` value
+				options := []renderer.Option{renderer.IncludeHeaderFooter(!noHeaderFooter), renderer.LastUpdated(stat.ModTime())}
+				if backend != "" {
+					options = append(options, renderer.Backend(backend))
+				}
+				// the document is rendered before the output file is created, since the extension of the file
+				// depends on the backend, which may be set in the document itself
+				result := bytes.NewBuffer(nil)
+				metadata, err := libasciidoc.ConvertDocument(context.Background(), source, doc, result, options...)
+				if err != nil {
+					return err
+				}
+				out, close := getOut(cmd, source, outputName, outputExtension(metadata))
+				if out == nil {
+					continue
+				}
+				defer close()
+				if _, err := result.WriteTo(out); err != nil {
+					return errors.Wrapf(err, "error writing the output of %s", source)
+				}
+			}
+			return nil
+		},
+	}
+	flags := renderCmd.Flags()
+	flags.BoolVarP(&noHeaderFooter, "no-header-footer", "s", false, "do not render header/footer (default: false)")
+	flags.StringVarP(&outputName, "out-file", "o", "", "output file (default: based on path of input file); use - to output to STDOUT")
+	flags.StringVarP(&backend, "backend", "b", "", "backend to render the document with ["+strings.Join(renderer.Backends(), "|")+"] (default: the 'backend' document attribute, or html5)")
+	return renderCmd
+}
@@ -0,0 +1,110 @@
+package main_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ast cmd", func() {
+
+	It("export and render a document", func() {
+		// given
+		astCmd := main.NewASTCmd()
+		buf := new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{"export", "-o", "-", "test/test.adoc"})
+		// when
+		err := astCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix("{\n  \"version\": 1,\n"))
+		Expect(buf.String()).To(ContainSubstring(`"type": "delimitedBlock"`))
+
+		// given
+		f, err := ioutil.TempFile("", "libasciidoc-*.json")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.Remove(f.Name())
+		_, err = f.Write(buf.Bytes())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+		astCmd = main.NewASTCmd()
+		buf = new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{"render", "-s", "-o", "-", f.Name()})
+		// when
+		err = astCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`<div class="listingblock">`))
+	})
+
+	It("fail to render an invalid document", func() {
+		// given
+		astCmd := main.NewASTCmd()
+		buf := new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{"render", "-o", "-", "test/test.adoc"})
+		// when
+		err := astCmd.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fail to export an unknown file", func() {
+		// given
+		astCmd := main.NewASTCmd()
+		buf := new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{"export", "-o", "-", "test/unknown.adoc"})
+		// when
+		err := astCmd.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("export with the safe mode and the attributes", func() {
+		// given
+		dir, err := ioutil.TempDir("", "libasciidoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		source := filepath.Join(dir, "doc.adoc")
+		err = ioutil.WriteFile(source, []byte("include::chapter.adoc[]\n\n{product}"), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		err = ioutil.WriteFile(filepath.Join(dir, "chapter.adoc"), []byte("included content"), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		astCmd := main.NewASTCmd()
+		buf := new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{"export", "-S", "secure", "-a", "product=libasciidoc", "-o", "-", source})
+		// when
+		err = astCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`"libasciidoc"`))
+		Expect(buf.String()).ToNot(ContainSubstring("included content"))
+	})
+
+	It("fail to export an unknown file without creating the output file", func() {
+		// given
+		dir, err := ioutil.TempDir("", "libasciidoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		output := filepath.Join(dir, "unknown.json")
+		astCmd := main.NewASTCmd()
+		buf := new(bytes.Buffer)
+		astCmd.SetOutput(buf)
+		astCmd.SetArgs([]string{"export", "-o", output, "test/unknown.adoc"})
+		// when
+		err = astCmd.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(output).ToNot(BeAnExistingFile())
+	})
+})
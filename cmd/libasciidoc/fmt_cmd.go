@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/formatter"
+
+	"github.com/pkg/errors"
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/spf13/cobra"
+)
+
+// NewFmtCmd returns the `fmt` command, to normalize the formatting of the documents
+func NewFmtCmd() *cobra.Command {
+	var check bool
+	var diff bool
+	var sentencePerLine bool
+	fmtCmd := &cobra.Command{
+		Use:   "fmt [flags] FILE",
+		Short: "Format the documents (the files are rewritten, unless the --check or --diff flag is set)",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			unformatted := 0
+			for _, source := range args {
+				stat, err := os.Stat(source)
+				if err != nil {
+					return errors.Wrapf(err, "error opening %s", source)
+				}
+				content, err := ioutil.ReadFile(source)
+				if err != nil {
+					return errors.Wrapf(err, "error opening %s", source)
+				}
+				formatted := bytes.NewBuffer(nil)
+				if err := formatter.Format(source, bytes.NewReader(content), formatted, formatter.SentencePerLine(sentencePerLine)); err != nil {
+					return err
+				}
+				if bytes.Equal(content, formatted.Bytes()) {
+					continue
+				}
+				unformatted++
+				switch {
+				case diff:
+					fmt.Fprint(cmd.OutOrStdout(), unifiedDiff(source, string(content), formatted.String()))
+				case check:
+					fmt.Fprintln(cmd.OutOrStdout(), source)
+				default:
+					if err := ioutil.WriteFile(source, formatted.Bytes(), stat.Mode()); err != nil {
+						return errors.Wrapf(err, "error writing %s", source)
+					}
+				}
+			}
+			if check && unformatted > 0 {
+				// no need to print the usage: the command was valid
+				cmd.SilenceUsage = true
+				return errors.Errorf("%d file(s) not formatted", unformatted)
+			}
+			return nil
+		},
+	}
+	flags := fmtCmd.Flags()
+	flags.BoolVar(&check, "check", false, "list the files whose formatting differs, and fail if there is any (default: false)")
+	flags.BoolVar(&diff, "diff", false, "display the formatting changes instead of rewriting the files (default: false)")
+	flags.BoolVar(&sentencePerLine, "sentence-per-line", false, "write each sentence of the paragraphs on its own line (default: false)")
+	return fmtCmd
+}
+
+// the number of unchanged lines displayed around the changes
+const diffContext = 3
+
+type diffLine struct {
+	op   diffmatchpatch.Operation
+	text string
+}
+
+// unifiedDiff returns the changes between the original and the formatted contents of the given file, in the unified format
+func unifiedDiff(filename, original, formatted string) string {
+	dmp := diffmatchpatch.New()
+	a, b, lines := dmp.DiffLinesToChars(original, formatted)
+	diffLines := []diffLine{}
+	for _, d := range dmp.DiffCharsToLines(dmp.DiffMain(a, b, false), lines) {
+		for _, l := range strings.SplitAfter(d.Text, "\n") {
+			if l != "" {
+				diffLines = append(diffLines, diffLine{op: d.Type, text: l})
+			}
+		}
+	}
+	result := strings.Builder{}
+	result.WriteString("--- " + filename + "\n")
+	result.WriteString("+++ " + filename + " (formatted)\n")
+	originalLine, formattedLine := 1, 1 // the line numbers at the current position
+	for i := 0; i < len(diffLines); {
+		if diffLines[i].op == diffmatchpatch.DiffEqual {
+			originalLine++
+			formattedLine++
+			i++
+			continue
+		}
+		// a hunk starts with the unchanged lines before the change, and ends when the next change is too far away
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+		for start < i && diffLines[start].op != diffmatchpatch.DiffEqual {
+			start++
+		}
+		end := i
+		for equals := 0; end < len(diffLines) && equals <= 2*diffContext; end++ {
+			if diffLines[end].op == diffmatchpatch.DiffEqual {
+				equals++
+			} else {
+				equals = 0
+			}
+		}
+		// trim the trailing unchanged lines to the context size
+		trailing := 0
+		for end > i && diffLines[end-1].op == diffmatchpatch.DiffEqual {
+			end--
+			trailing++
+		}
+		if trailing > diffContext {
+			trailing = diffContext
+		}
+		end += trailing
+		hunk := strings.Builder{}
+		originalStart, formattedStart := originalLine-(i-start), formattedLine-(i-start)
+		originalCount, formattedCount := 0, 0
+		for _, l := range diffLines[start:end] {
+			switch l.op {
+			case diffmatchpatch.DiffEqual:
+				hunk.WriteString(" ")
+				originalCount++
+				formattedCount++
+			case diffmatchpatch.DiffDelete:
+				hunk.WriteString("-")
+				originalCount++
+			case diffmatchpatch.DiffInsert:
+				hunk.WriteString("+")
+				formattedCount++
+			}
+			hunk.WriteString(l.text)
+			if !strings.HasSuffix(l.text, "\n") {
+				hunk.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		fmt.Fprintf(&result, "@@ -%s +%s @@\n", hunkRange(originalStart, originalCount), hunkRange(formattedStart, formattedCount))
+		result.WriteString(hunk.String())
+		// move to the end of the hunk
+		originalLine += originalCount - (i - start)
+		formattedLine += formattedCount - (i - start)
+		i = end
+	}
+	return result.String()
+}
+
+// hunkRange returns the range of a hunk, ie, its start line and its number of lines
+func hunkRange(start, count int) string {
+	if count == 0 {
+		// an empty range starts at the line before
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
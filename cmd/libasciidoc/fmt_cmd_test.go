@@ -0,0 +1,134 @@
+package main_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("fmt cmd", func() {
+
+	source := `- item 1   
+- item 2
+
+|===
+|a|b
+|ccc|d
+|===
+`
+	expected := `* item 1
+* item 2
+
+|===
+| a   | b
+| ccc | d
+|===
+`
+
+	var filename string
+
+	BeforeEach(func() {
+		f, err := ioutil.TempFile("", "libasciidoc-*.adoc")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = f.WriteString(source)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+		filename = f.Name()
+	})
+
+	AfterEach(func() {
+		os.Remove(filename)
+	})
+
+	It("format a document", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{filename})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		content, err := ioutil.ReadFile(filename)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal(expected))
+	})
+
+	It("check an unformatted document", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"--check", filename})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix(filename + "\n"))
+		content, err := ioutil.ReadFile(filename)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal(source)) // file is unchanged
+	})
+
+	It("check a formatted document", func() {
+		// given
+		Expect(ioutil.WriteFile(filename, []byte(expected), 0644)).To(Succeed())
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"--check", filename})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(BeEmpty())
+	})
+
+	It("display the diff of an unformatted document", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"--diff", filename})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`--- ` + filename + `
++++ ` + filename + ` (formatted)
+@@ -1,7 +1,7 @@
+-- item 1   
+-- item 2
++* item 1
++* item 2
+ 
+ |===
+-|a|b
+-|ccc|d
++| a   | b
++| ccc | d
+ |===
+`))
+		content, err := ioutil.ReadFile(filename)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal(source)) // file is unchanged
+	})
+
+	It("fail to format an unknown file", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"test/unknown.adoc"})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -13,6 +13,8 @@ func main() {
 	rootCmd := NewRootCmd()
 	versionCmd := NewVersionCmd()
 	rootCmd.AddCommand(versionCmd)
+	rootCmd.AddCommand(NewASTCmd())
+	rootCmd.AddCommand(NewFmtCmd())
 	rootCmd.SetHelpCommand(helpCommand)
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"strings"
 
@@ -12,7 +14,9 @@ import (
 	"github.com/bytesparadise/libasciidoc"
 	logsupport "github.com/bytesparadise/libasciidoc/pkg/log"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -23,10 +27,16 @@ func NewRootCmd() *cobra.Command {
 	var noHeaderFooter bool
 	var outputName string
 	var logLevel string
+	var backend string
+	var textWidth int
+	var latexPreamble string
+	var templateDir string
+	var safeMode string
+	var attributes []string
 
 	rootCmd := &cobra.Command{
 		Use:   "libasciidoc [flags] FILE",
-		Short: `libasciidoc is a tool to convert from Asciidoc to HTML`,
+		Short: `libasciidoc is a tool to convert from Asciidoc to HTML5, DocBook5, manpage, Markdown, text, EPUB3, LaTeX or reveal.js (see --backend)`,
 		Args:  cobra.ArbitraryArgs,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			lvl, err := log.ParseLevel(logLevel)
@@ -43,15 +53,39 @@ func NewRootCmd() *cobra.Command {
 			if len(args) == 0 {
 				return helpCommand.RunE(cmd, args)
 			}
+			mode, err := types.ParseSafeMode(safeMode)
+			if err != nil {
+				return err
+			}
+			options := []renderer.Option{renderer.IncludeHeaderFooter(!noHeaderFooter), renderer.TextWidth(textWidth), renderer.SafeMode(mode), renderer.Attributes(parseAttributes(attributes))}
+			if backend != "" {
+				options = append(options, renderer.Backend(backend))
+			}
+			if templateDir != "" {
+				options = append(options, renderer.TemplateDir(templateDir))
+			}
+			if latexPreamble != "" {
+				preamble, err := ioutil.ReadFile(latexPreamble)
+				if err != nil {
+					return errors.Wrapf(err, "unable to read the LaTeX preamble template")
+				}
+				options = append(options, renderer.LaTeXPreamble(string(preamble)))
+			}
 			for _, source := range args {
-				out, close := getOut(cmd, source, outputName)
+				path, _ := filepath.Abs(source)
+				log.Debugf("Starting to process file %v", path)
+				// the document is rendered before the output file is created, since the extension of the file
+				// depends on the backend, which may be set in the document itself
+				result := bytes.NewBuffer(nil)
+				metadata, err := libasciidoc.ConvertFile(context.Background(), source, result, options...)
+				if err != nil {
+					return err
+				}
+				out, close := getOut(cmd, source, outputName, outputExtension(metadata))
 				if out != nil {
 					defer close()
-					path, _ := filepath.Abs(source)
-					log.Debugf("Starting to process file %v", path)
-					_, err := libasciidoc.ConvertFileToHTML(context.Background(), source, out, renderer.IncludeHeaderFooter(!noHeaderFooter))
-					if err != nil {
-						return err
+					if _, err := result.WriteTo(out); err != nil {
+						return errors.Wrapf(err, "error writing the output of %s", source)
 					}
 				}
 			}
@@ -61,10 +95,29 @@ func NewRootCmd() *cobra.Command {
 	flags := rootCmd.Flags()
 	flags.BoolVarP(&noHeaderFooter, "no-header-footer", "s", false, "do not render header/footer (default: false)")
 	flags.StringVarP(&outputName, "out-file", "o", "", "output file (default: based on path of input file); use - to output to STDOUT")
+	flags.StringVarP(&backend, "backend", "b", "", "backend to render the document with ["+strings.Join(renderer.Backends(), "|")+"] (default: the 'backend' document attribute, or html5)")
+	flags.IntVar(&textWidth, "text-width", renderer.DefaultTextWidth, "maximum number of characters per line with the text backend")
+	flags.StringVar(&latexPreamble, "latex-preamble", "", "file containing the template of the preamble with the latex backend")
+	flags.StringVarP(&templateDir, "template-dir", "T", "", "directory of the templates which override the default templates of the html5 backend")
+	flags.StringVarP(&safeMode, "safe-mode", "S", "unsafe", "safe mode which restricts the access to the files [unsafe|safe|server|secure]")
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "document attribute to set, as 'name' or 'name=value', which takes precedence over the attributes of the document")
 	rootCmd.PersistentFlags().StringVar(&logLevel, "log", "warning", "log level to set [debug|info|warning|error|fatal|panic]")
 	return rootCmd
 }
 
+// parseAttributes returns the document attributes given as `name` or `name=value`
+func parseAttributes(values []string) types.DocumentAttributes {
+	result := types.DocumentAttributes{}
+	for _, v := range values {
+		if i := strings.Index(v, "="); i >= 0 {
+			result[v[:i]] = v[i+1:]
+		} else {
+			result[v] = ""
+		}
+	}
+	return result
+}
+
 type closeFunc func() error
 
 func defaultCloseFunc() closeFunc {
@@ -77,7 +130,16 @@ func newCloseFileFunc(c io.Closer) closeFunc {
 	}
 }
 
-func getOut(cmd *cobra.Command, source, outputName string) (io.Writer, closeFunc) {
+// outputExtension returns the extension of the output file, given the metadata of the rendered document, ie, its
+// `outfilesuffix` attribute (which depends on the backend used to render the document), or `.html` if it is not set
+func outputExtension(metadata map[string]interface{}) string {
+	if suffix, ok := metadata[types.AttrOutFileSuffix].(string); ok && suffix != "" {
+		return suffix
+	}
+	return ".html"
+}
+
+func getOut(cmd *cobra.Command, source, outputName, extension string) (io.Writer, closeFunc) {
 	if outputName == "-" {
 		// outfile is STDOUT
 		return cmd.OutOrStdout(), defaultCloseFunc()
@@ -91,7 +153,7 @@ func getOut(cmd *cobra.Command, source, outputName string) (io.Writer, closeFunc
 	} else if source != "" {
 		// outfile is based on source
 		path, _ := filepath.Abs(source)
-		outname := strings.TrimSuffix(path, filepath.Ext(path)) + ".html"
+		outname := strings.TrimSuffix(path, filepath.Ext(path)) + extension
 		outfile, err := os.Create(outname)
 		if err != nil {
 			log.Warnf("Cannot create output file - %v, skipping", outname)
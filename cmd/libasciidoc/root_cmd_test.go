@@ -3,6 +3,8 @@ package main_test
 import (
 	"bytes"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
 
@@ -68,6 +70,238 @@ var _ = Describe("root cmd", func() {
 		Expect(buf.String()).ToNot(ContainSubstring(`<div id="footer">`))
 	})
 
+	It("render with docbook5 backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "docbook5", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`<article xmlns="http://docbook.org/ns/docbook"`))
+	})
+
+	It("render with manpage backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "manpage", "-o", "-", "test/manpage.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`.TH "GIT\-FOO" "1"`))
+	})
+
+	It("render with markdown backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "markdown", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).ToNot(BeEmpty())
+		Expect(buf.String()).ToNot(ContainSubstring(`<div class="paragraph">`))
+	})
+
+	It("render with text backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "text", "--text-width", "20", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix("    multiple"))
+	})
+
+	It("render with epub3 backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "epub3", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix("PK"))
+		Expect(buf.String()).To(ContainSubstring("mimetypeapplication/epub+zip"))
+	})
+
+	It("render with latex backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "latex", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix(`\documentclass{article}`))
+		Expect(buf.String()).To(ContainSubstring(`\begin{document}`))
+	})
+
+	It("render with revealjs backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "revealjs", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix("<!DOCTYPE html>"))
+		Expect(buf.String()).To(ContainSubstring(`<div class="reveal">`))
+	})
+
+	It("render with latex backend and custom preamble", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "latex", "--latex-preamble", "test/preamble.tex", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(HavePrefix("\\documentclass{article}\n% custom preamble\n\\begin{document}"))
+	})
+
+	It("render with template overrides", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "--template-dir", "test/templates", "-o", "-", "test/admonition.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(ContainSubstring(`<div class="custom-admonition">this is a note</div>`))
+	})
+
+	It("fail to render with unknown latex preamble", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "latex", "--latex-preamble", "test/unknown.tex", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fail to render with unknown backend", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-b", "unknown", "-o", "-", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("render with secure safe mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"--safe-mode", "secure", "-s", "-o", "-", "test/admonition.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).ToNot(BeEmpty())
+	})
+
+	It("fail to render with unknown safe mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-S", "paranoid", "-o", "-", "test/admonition.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(MatchError("unknown safe mode: 'paranoid'"))
+	})
+
+	It("render with attributes", func() {
+		// given
+		f, err := ioutil.TempFile("", "libasciidoc-*.adoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.Remove(f.Name())
+		_, err = f.WriteString(":product: foo\n\n{product} {version}{empty}")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-a", "product=libasciidoc", "--attribute", "version=1.0", "-a", "empty", "-s", "-o", "-", f.Name()})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`<div class="paragraph">
+<p>libasciidoc 1.0</p>
+</div>`))
+	})
+
+	It("render with the backend and the output file suffix given in the document", func() {
+		// given
+		dir, err := ioutil.TempDir("", "libasciidoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		source := filepath.Join(dir, "doc.adoc")
+		err = ioutil.WriteFile(source, []byte(":backend: docbook5\n\nsome content"), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{source})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		content, err := ioutil.ReadFile(filepath.Join(dir, "doc.xml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring(`<article xmlns="http://docbook.org/ns/docbook"`))
+		Expect(filepath.Join(dir, "doc.html")).ToNot(BeAnExistingFile())
+	})
+
+	It("render with the output file suffix given in the document", func() {
+		// given
+		dir, err := ioutil.TempDir("", "libasciidoc")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		source := filepath.Join(dir, "doc.adoc")
+		err = ioutil.WriteFile(source, []byte(":outfilesuffix: .htm\n\nsome content"), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{source})
+		// when
+		err = root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(filepath.Join(dir, "doc.htm")).To(BeAnExistingFile())
+	})
+
 	It("render multiple files", func() {
 		// given
 		root := main.NewRootCmd()
@@ -1,5 +1,6 @@
 // Package libasciidoc is an open source Go library that converts Asciidoc
-// content into HTML.
+// content into HTML5, DocBook5, manpage, Markdown, plain text, EPUB3, LaTeX or reveal.js slides
+// (see the `renderer.Backend` option).
 package libasciidoc
 
 import (
@@ -9,8 +10,18 @@ import (
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/processor"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	docbookrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/docbook5"
+	epub3renderer "github.com/bytesparadise/libasciidoc/pkg/renderer/epub3"
 	htmlrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	latexrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/latex"
+	manpagerenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/manpage"
+	markdownrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/markdown"
+	revealjsrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/revealjs"
+	textrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/text"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/vfs"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -25,47 +36,220 @@ var (
 	BuildTime = ""
 )
 
+func init() {
+	renderer.RegisterConverter("html5", renderer.NewConverter(htmlrenderer.Render, "html", ".html"))
+	renderer.RegisterConverter("docbook5", renderer.NewConverter(docbookrenderer.Render, "docbook", ".xml"))
+	renderer.RegisterConverter("manpage", renderer.NewConverter(manpagerenderer.Render, "manpage", ".man"))
+	renderer.RegisterConverter("markdown", renderer.NewConverter(markdownrenderer.Render, "markdown", ".md"))
+	renderer.RegisterConverter("text", renderer.NewConverter(textrenderer.Render, "text", ".txt"))
+	renderer.RegisterConverter("epub3", renderer.NewConverter(epub3renderer.Render, "html", ".epub"))
+	renderer.RegisterConverter("latex", renderer.NewConverter(latexrenderer.Render, "latex", ".tex"))
+	renderer.RegisterConverter("revealjs", renderer.NewConverter(revealjsrenderer.Render, "html", ".html"))
+}
+
 // ConvertFileToHTML converts the content of the given filename into an HTML document.
 // The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
 // as the result of the function call.
 func ConvertFileToHTML(ctx context.Context, filename string, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
-	file, err := os.Open(filename)
+	return ConvertFile(ctx, filename, output, append(options, renderer.Backend("html5"))...)
+}
+
+// ConvertToHTML converts the content of the given reader `r` into a full HTML document, written in the given writer `output`.
+// Returns an error if a problem occurred
+func ConvertToHTML(ctx context.Context, filename string, r io.Reader, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
+	return Convert(ctx, filename, r, output, append(options, renderer.Backend("html5"))...)
+}
+
+// ConvertFile converts the content of the given filename into a document using the backend specified in the options
+// (`html5` by default, `docbook5`, `manpage`, `markdown`, `text`, `epub3`, `latex` or `revealjs`).
+// The file is read from the file system given by the `renderer.FileSystem` option (the local filesystem by default).
+// The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
+// as the result of the function call.
+func ConvertFile(ctx context.Context, filename string, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
+	fs := renderer.Wrap(ctx, types.Document{}, options...).FileSystem()
+	file, err := fs.Open(filename)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error opening %s", filename)
 	}
 	defer file.Close()
-	// use the file mtime as the `last updated` value
-	stat, err := os.Stat(filename)
-	if err != nil {
-		return nil, errors.Wrapf(err, "error opening %s", filename)
+	if fs == vfs.OS {
+		// use the file mtime as the `last updated` value
+		stat, err := os.Stat(filename)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error opening %s", filename)
+		}
+		options = append(options, renderer.LastUpdated(stat.ModTime()))
 	}
-	options = append(options, renderer.LastUpdated(stat.ModTime()))
-	return ConvertToHTML(ctx, filename, file, output, options...)
+	return Convert(ctx, filename, file, output, options...)
 }
 
-// ConvertToHTML converts the content of the given reader `r` into a full HTML document, written in the given writer `output`.
+// Convert converts the content of the given reader `r` into a document using the backend specified in the options
+// (`html5` by default, `docbook5`, `manpage`, `markdown`, `text`, `epub3`, `latex` or `revealjs`), written in the given writer `output`.
 // Returns an error if a problem occurred
-func ConvertToHTML(ctx context.Context, filename string, r io.Reader, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
+func Convert(ctx context.Context, filename string, r io.Reader, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
 	start := time.Now()
 	defer func() {
 		duration := time.Since(start)
-		log.Debugf("rendered the HTML output in %v", duration)
+		log.Debugf("rendered the output in %v", duration)
 	}()
 	log.Debugf("parsing the asciidoc source...")
-	doc, err := parser.ParseDocument(filename, r) //, parser.Debug(true))
+	parserOpts := parserOptions(ctx, filename, options...)
+	draftDoc, err := parser.ParseDraftDocument(filename, r, parserOpts...) //, parser.Debug(true))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while parsing the document")
+	}
+	draftCtx := draftContext(ctx, filename, draftDoc, options...)
+	// the block processors are applied before the document attribute substitutions, so that they receive the source lines
+	draftDoc, err = processor.ProcessDraftBlocks(draftCtx, draftDoc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering the document")
+	}
+	// the attributes of the backend are set before the document attribute substitutions are applied,
+	// so that they can be used in the document (eg: `{backend}` or `{outfilesuffix}`)
+	backend := draftCtx.Backend()
+	if converter, found := renderer.LookupConverter(backend); found {
+		options = append(options, renderer.Attributes(backendAttributes(backend, converter)))
+		parserOpts = append(parserOpts,
+			parser.Attributes(renderer.Wrap(ctx, types.Document{}, options...).Attributes()),
+			parser.DefaultAttributes(types.DocumentAttributes{
+				types.AttrOutFileSuffix: converter.OutFileSuffix(),
+			}))
+	}
+	doc, err := parser.ProcessDraftDocument(draftDoc, parserOpts...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while parsing the document")
 	}
+	// the blocks were already processed in the draft document
+	return convertDocument(ctx, filename, doc, output, false, options...)
+}
+
+// ParseDocument parses the content of the given reader `r` into a document, with the same restrictions (eg: the safe mode)
+// and the same attributes as when converting the content with the given options, but without rendering it.
+// Returns an error if a problem occurred
+func ParseDocument(ctx context.Context, filename string, r io.Reader, options ...renderer.Option) (types.Document, error) {
+	return parser.ParseDocument(filename, r, parserOptions(ctx, filename, options...)...)
+}
+
+// parserOptions returns the options to parse a document with, given the renderer options
+func parserOptions(ctx context.Context, filename string, options ...renderer.Option) []parser.Option {
+	// the files to include are read from the same file systems as the files to embed in the output,
+	// with the same restrictions
+	rendererCtx := renderer.Wrap(ctx, types.Document{}, append([]renderer.Option{renderer.Filename(filename)}, options...)...)
+	result := []parser.Option{
+		parser.FileSystem(rendererCtx.FileSystem()),
+		parser.SafeMode(rendererCtx.SafeMode()),
+		parser.BaseDir(rendererCtx.BaseDir()),
+		parser.Attributes(rendererCtx.Attributes()),
+	}
+	if fs, found := rendererCtx.URIFileSystem(); found {
+		result = append(result, parser.URIFileSystem(fs))
+	}
+	return result
+}
+
+// draftContext returns the renderer context of the given draft document, with the attributes declared in its
+// front-matter and in its header (except the ones which are locked in the safe mode), so that the backend
+// to render the document with (eg: given by the `backend` attribute) is known before the document is processed
+func draftContext(ctx context.Context, filename string, draftDoc types.DraftDocument, options ...renderer.Option) *renderer.Context {
+	attrs := types.DocumentAttributes{}
+	for k, v := range draftDoc.FrontMatter.Content {
+		attrs[k] = v
+	}
+	for k, v := range draftDoc.DocumentAttributes() {
+		attrs[k] = v
+	}
+	rendererCtx := renderer.Wrap(ctx, types.Document{Attributes: attrs}, append([]renderer.Option{renderer.Filename(filename)}, options...)...)
+	renderer.LockAttributes(rendererCtx)
+	return rendererCtx
+}
+
+// ConvertDocument renders the given (parsed or decoded) document using the backend specified in the options
+// (`html5` by default, `docbook5`, `manpage`, `markdown`, `text`, `epub3`, `latex` or `revealjs`), written in the given writer `output`.
+// If the backend is not specified in the options, the `backend` document attribute is used instead. Other backends can be
+// registered with the `renderer.RegisterConverter` function.
+// The block, macro and tree processors defined with the `renderer.DefineBlockProcessor`,
+// `renderer.DefineInlineMacroProcessor`, `renderer.DefineBlockMacroProcessor` and `renderer.DefineTreeProcessor`
+// options are applied on the document (in this order) before it is rendered.
+// The `filename` is used to resolve the paths of the images and files which are relative to the document.
+// Returns an error if a problem occurred
+func ConvertDocument(ctx context.Context, filename string, doc types.Document, output io.Writer, options ...renderer.Option) (map[string]interface{}, error) {
+	return convertDocument(ctx, filename, doc, output, true, options...)
+}
+
+// convertDocument renders the given document, after applying the block processors on the document if `processBlocks` is `true`
+func convertDocument(ctx context.Context, filename string, doc types.Document, output io.Writer, processBlocks bool, options ...renderer.Option) (map[string]interface{}, error) {
+	// the name of the file is also used to resolve the paths of the images to embed
+	options = append([]renderer.Option{renderer.Filename(filename)}, options...)
+	if doc.Attributes == nil {
+		doc.Attributes = types.DocumentAttributes{}
+	}
 	rendererCtx := renderer.Wrap(ctx, doc, options...)
+	// remove the attributes which cannot be set by the document in the safe mode, and set the ones given in the options
+	renderer.LockAttributes(rendererCtx)
+	// replace the blocks which are handled by a block processor
+	if processBlocks {
+		if err := processor.ProcessBlocks(rendererCtx); err != nil {
+			return nil, errors.Wrapf(err, "error while rendering the document")
+		}
+	}
+	// replace the user macros which are handled by a macro processor
+	if err := processor.ProcessMacros(rendererCtx); err != nil {
+		return nil, errors.Wrapf(err, "error while rendering the document")
+	}
+	// apply the tree processors on the parsed document
+	if err := renderer.ProcessTree(rendererCtx); err != nil {
+		return nil, errors.Wrapf(err, "error while rendering the document")
+	}
 	// insert tables of contents, preamble and process file inclusions
-	err = renderer.Prerender(rendererCtx)
+	err := renderer.Prerender(rendererCtx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering the document")
 	}
-	metadata, err := htmlrenderer.Render(rendererCtx, output)
+	backend := rendererCtx.Backend()
+	converter, found := renderer.LookupConverter(backend)
+	if !found {
+		return nil, errors.Errorf("unsupported backend: '%s'", backend)
+	}
+	setBackendAttributes(rendererCtx.Document.Attributes, backend, converter)
+	metadata, err := converter.Convert(rendererCtx, output)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while rendering the document")
 	}
+	// also, return the backend and the suffix of the output file, which may have been set in the document
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	for _, k := range []string{types.AttrBackend, types.AttrOutFileSuffix} {
+		if _, found := metadata[k]; !found {
+			metadata[k] = rendererCtx.Document.Attributes[k]
+		}
+	}
 	log.Debugf("Done processing document")
 	return metadata, nil
 }
+
+// setBackendAttributes sets the document attributes which describe the backend used to render the document
+// (eg: `backend`, `backend-html5`, `basebackend`, `basebackend-html` and `outfilesuffix`).
+// The `outfilesuffix` attribute is not overridden if it was set in the document
+func setBackendAttributes(attrs types.DocumentAttributes, backend string, converter renderer.Converter) {
+	for k, v := range backendAttributes(backend, converter) {
+		attrs[k] = v
+	}
+	if _, found := attrs[types.AttrOutFileSuffix]; !found {
+		attrs[types.AttrOutFileSuffix] = converter.OutFileSuffix()
+	}
+}
+
+// backendAttributes returns the document attributes which describe the given backend
+// (eg: `backend`, `backend-html5`, `basebackend` and `basebackend-html`)
+func backendAttributes(backend string, converter renderer.Converter) types.DocumentAttributes {
+	attrs := types.DocumentAttributes{
+		types.AttrBackend:                 backend,
+		types.AttrBackend + "-" + backend: "",
+	}
+	if basebackend := converter.BaseBackend(); basebackend != "" {
+		attrs[types.AttrBaseBackend] = basebackend
+		attrs[types.AttrBaseBackend+"-"+basebackend] = ""
+	}
+	return attrs
+}
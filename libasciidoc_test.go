@@ -1,6 +1,17 @@
 package libasciidoc_test
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/vfs"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo"
@@ -181,6 +192,171 @@ a paragraph with _italic content_`
 		})
 	})
 
+	Context("tree processors", func() {
+
+		It("should render the sections generated by a tree processor", func() {
+			source := `:product: libasciidoc
+
+== Section A
+
+some content`
+			// a processor which appends a section whose title is given by the `product` document attribute
+			appendSection := renderer.DefineTreeProcessor(func(ctx *renderer.Context, doc types.Document) (types.Document, error) {
+				title := []interface{}{
+					types.StringElement{Content: "About " + doc.Attributes.GetAsStringWithDefault("product", "")},
+				}
+				doc.Elements = append(doc.Elements, types.Section{
+					Level: 1,
+					Attributes: types.ElementAttributes{
+						types.AttrID: "_about",
+					},
+					Title:    title,
+					Elements: []interface{}{},
+				})
+				doc.ElementReferences["_about"] = title
+				return doc, nil
+			})
+			expected := `<div class="sect1">
+<h2 id="_section_a">Section A</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>some content</p>
+</div>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_about">About libasciidoc</h2>
+<div class="sectionbody">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected, appendSection))
+		})
+	})
+
+	Context("file systems", func() {
+
+		It("should read the document, the files to include and the images from the given file system", func() {
+			fs := vfs.MemFS{
+				"docs/index.adoc":     []byte(":data-uri:\n\ninclude::chapter.adoc[]\n\nimage::images/dot.svg[]"),
+				"docs/chapter.adoc":   []byte("content of chapter"),
+				"docs/images/dot.svg": []byte("<svg></svg>"),
+			}
+			output := bytes.NewBuffer(nil)
+			_, err := libasciidoc.ConvertFile(context.Background(), "docs/index.adoc", output, renderer.FileSystem(fs))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.String()).To(Equal(`<div class="paragraph">
+<p>content of chapter</p>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="data:image/svg+xml;base64,PHN2Zz48L3N2Zz4=" alt="dot">
+</div>
+</div>`))
+		})
+	})
+
+	Context("circular file inclusions", func() {
+
+		It("should not include a file which includes itself", func() {
+			fs := vfs.MemFS{
+				"docs/index.adoc":   []byte("include::chapter.adoc[]"),
+				"docs/chapter.adoc": []byte("content of chapter\n\ninclude::chapter.adoc[]"),
+			}
+			output := bytes.NewBuffer(nil)
+			_, err := libasciidoc.ConvertFile(context.Background(), "docs/index.adoc", output, renderer.FileSystem(fs))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.String()).To(Equal(`<div class="paragraph">
+<p>content of chapter</p>
+</div>
+<div class="paragraph">
+<p>Unresolved directive in docs/chapter.adoc - include::chapter.adoc[]</p>
+</div>`))
+		})
+	})
+
+	Context("URI inclusions", func() {
+
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, "remote content")
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		allowURIRead := renderer.Attributes(types.DocumentAttributes{
+			types.AttrAllowURIRead: "",
+		})
+
+		It("should include the content at a URI with the given file system", func() {
+			source := "include::" + server.URL + "/remote.adoc[]"
+			expected := `<div class="paragraph">
+<p>remote content</p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected, allowURIRead, renderer.URIFileSystem(vfs.NewHTTPFS(server.Client(), 1024))))
+		})
+
+		It("should include the content at a URI in server mode", func() {
+			source := "include::" + server.URL + "/remote.adoc[]"
+			expected := `<div class="paragraph">
+<p>remote content</p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected, allowURIRead, renderer.SafeMode(types.SafeModeServer)))
+		})
+
+		It("should ignore the allow-uri-read attribute declared in the document in server mode", func() {
+			source := ":allow-uri-read:\n\ninclude::" + server.URL + "/remote.adoc[]"
+			expected := `<div class="paragraph">
+<p>Unresolved directive in test.adoc - include::` + server.URL + `/remote.adoc[]</p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected, renderer.SafeMode(types.SafeModeServer)))
+		})
+
+		It("should not include the content at a URI in secure mode", func() {
+			source := "include::" + server.URL + "/remote.adoc[]"
+			expected := `<div class="paragraph">
+<p><a href="` + server.URL + `/remote.adoc" class="bare">` + server.URL + `/remote.adoc</a></p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected, allowURIRead, renderer.SafeMode(types.SafeModeSecure)))
+		})
+	})
+
+	Context("safe modes", func() {
+
+		It("should render the file inclusions as links in secure mode", func() {
+			source := "include::test/includes/grandchild-include.adoc[]"
+			expected := `<div class="paragraph">
+<p><a href="test/includes/grandchild-include.adoc" class="bare">test/includes/grandchild-include.adoc</a></p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected, WithFilename("foo.adoc"), renderer.SafeMode(types.SafeModeSecure)))
+		})
+
+		It("should not embed the images outside of the base directory in safe mode", func() {
+			source := ":data-uri:\n\nimage::../test/images/square.svg[]"
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="../test/images/square.svg" alt="square">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected, WithFilename("tmp/foo.adoc"), renderer.SafeMode(types.SafeModeSafe)))
+		})
+
+		It("should not let the document set the backend in server mode", func() {
+			source := ":backend: docbook5\n\nsome content"
+			expected := `<div class="paragraph">
+<p>some content</p>
+</div>`
+			output := bytes.NewBuffer(nil)
+			_, err := libasciidoc.Convert(context.Background(), "foo.adoc", strings.NewReader(source), output, renderer.SafeMode(types.SafeModeServer))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.String()).To(Equal(expected))
+		})
+	})
+
 	Context("complete Document ", func() {
 
 		It("using existing file", func() {
@@ -0,0 +1,89 @@
+// Package formatter normalizes the formatting of AsciiDoc documents.
+//
+// The source is parsed in a `types.DraftDocument`, which retains the attribute declarations, the comments,
+// the blank lines and the file inclusions (which are not expanded), then rewritten with the AsciiDoc renderer
+// after the following changes:
+//
+// - the markers of the list items are normalized according to their actual level (eg: `*`, `**`, etc.
+// for the unordered lists, `.`, `..`, etc. for the ordered lists with the default numbering styles)
+// - the cells of the tables are aligned
+// - the trailing whitespaces are removed
+// - optionally, each sentence of the paragraphs is written on its own line
+//
+// The attribute lists are also normalized by the AsciiDoc renderer (eg: `[source,go]` or `[quote, author, title]`).
+package formatter
+
+import (
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/asciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Option an option to configure the formatter
+type Option func(f *formatter)
+
+// SentencePerLine option to write each sentence of the paragraphs on its own line
+func SentencePerLine(value bool) Option {
+	return func(f *formatter) {
+		f.sentencePerLine = value
+	}
+}
+
+type formatter struct {
+	sentencePerLine bool
+}
+
+// Format parses the document read from the given source and writes its formatted content in the given output
+func Format(filename string, source io.Reader, output io.Writer, options ...Option) error {
+	f := &formatter{}
+	for _, option := range options {
+		option(f)
+	}
+	doc, err := parser.ParseRawDocument(filename, source)
+	if err != nil {
+		return errors.Wrapf(err, "unable to format '%s'", filename)
+	}
+	doc.Blocks = normalizeListItems(doc.Blocks)
+	if f.sentencePerLine && !hasHardBreaks(doc.Blocks) {
+		doc.Blocks = splitSentences(doc.Blocks)
+	}
+	doc.Blocks, err = alignTables(doc.Blocks)
+	if err != nil {
+		return errors.Wrapf(err, "unable to format '%s'", filename)
+	}
+	result := strings.Builder{}
+	if err := asciidoc.RenderDraft(doc, &result); err != nil {
+		return errors.Wrapf(err, "unable to format '%s'", filename)
+	}
+	return errors.Wrapf(trimTrailingSpaces(result.String(), output), "unable to format '%s'", filename)
+}
+
+// hasHardBreaks returns true if the `hardbreaks` attribute is declared in the given blocks, in which case
+// the line breaks in the paragraphs are significant
+func hasHardBreaks(blocks []interface{}) bool {
+	for _, block := range blocks {
+		if d, ok := block.(types.DocumentAttributeDeclaration); ok && d.Name == types.DocumentAttrHardBreaks {
+			return true
+		}
+	}
+	return false
+}
+
+// trimTrailingSpaces writes the given content in the output, without the trailing whitespaces on each line
+func trimTrailingSpaces(content string, output io.Writer) error {
+	log.Debug("removing trailing whitespaces")
+	if content == "" {
+		return nil
+	}
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	_, err := io.WriteString(output, strings.Join(lines, "\n")+"\n")
+	return err
+}
@@ -0,0 +1,110 @@
+package formatter_test
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/formatter"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("formatter", func() {
+
+	It("remove trailing whitespaces", func() {
+		source := `= Title  
+:toc:   
+
+a paragraph   
+on two lines	`
+		expected := `= Title
+:toc:
+
+a paragraph
+on two lines
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("normalize attribute lists", func() {
+		source := `[source, go]
+----
+func main() {}
+----
+
+[quote,john doe ,  quote title]
+a quote
+
+[cols="1,1" , options="header"]
+|===
+| a | b
+|===`
+		expected := `[source,go]
+----
+func main() {}
+----
+
+[quote, john doe, quote title]
+a quote
+
+[cols="1,1",options=header]
+|===
+| a | b
+|===
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("retain escaped quoted texts", func() {
+		source := `\*not bold* and \__not italic__ and *bold \*text*`
+		expected := `\*not bold* and \__not italic__ and *bold \*text*
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("retain comments and blank lines", func() {
+		source := `// a comment
+
+
+a paragraph`
+		expected := `// a comment
+
+
+a paragraph
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("retain file inclusions", func() {
+		source := `= Title
+
+include::chapter-a.adoc[leveloffset=+1]
+
+----
+include::hello_world.go.txt[lines=1..2]
+----
+
+include::doesnotexist.adoc[]`
+		expected := `= Title
+
+include::chapter-a.adoc[leveloffset=+1]
+
+----
+include::hello_world.go.txt[lines=1..2]
+----
+
+include::doesnotexist.adoc[]
+`
+		// the files exist in the directory of the document, but they must not be included
+		result := strings.Builder{}
+		err := formatter.Format("../../test/includes/test.adoc", strings.NewReader(source), &result)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.String()).To(Equal(expected))
+	})
+})
+
+func format(source string, options ...formatter.Option) (string, error) {
+	result := strings.Builder{}
+	err := formatter.Format("test.adoc", strings.NewReader(source), &result, options...)
+	return result.String(), err
+}
@@ -0,0 +1,119 @@
+package formatter
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// normalizeListItems sets the level and the bullet style of each list item of the given draft blocks, so that
+// the markers of the items are consistent with their actual level in the document.
+// The lookup of the level follows the same rules as the parser when it rearranges the list items, so that
+// the normalized items are still grouped in the same lists.
+func normalizeListItems(blocks []interface{}) []interface{} {
+	result := make([]interface{}, len(blocks))
+	lists := []interface{}{} // the key of the list at each depth: a bullet style, a numbering style or a labeled list level
+	blankline := false
+	for i, block := range blocks {
+		switch b := block.(type) {
+		case types.OrderedListItem:
+			if blankline && len(b.Attributes) > 0 {
+				lists = []interface{}{}
+			}
+			lists, b.Level = lookupListLevel(lists, b.NumberingStyle, func(int) interface{} {
+				return b.NumberingStyle
+			})
+			result[i] = b
+			blankline = false
+		case types.UnorderedListItem:
+			if blankline && len(b.Attributes) > 0 {
+				lists = []interface{}{}
+			}
+			lists, b.Level = lookupListLevel(lists, b.BulletStyle, func(int) interface{} {
+				// the bullet style of a nested list is forced by the style of its parent list
+				if len(lists) > 0 {
+					if parent, ok := lists[len(lists)-1].(types.BulletStyle); ok {
+						return b.BulletStyle.NextLevel(parent)
+					}
+				}
+				return b.BulletStyle
+			})
+			b.BulletStyle = bulletStyle(b.Level)
+			result[i] = b
+			blankline = false
+		case types.LabeledListItem:
+			if blankline && len(b.Attributes) > 0 {
+				lists = []interface{}{}
+			}
+			lists, b.Level = lookupListLevel(lists, labeledListLevel(b.Level), func(level int) interface{} {
+				return labeledListLevel(level)
+			})
+			result[i] = b
+			blankline = false
+		case types.ContinuedListItemElement:
+			if depth := len(lists) + b.Offset; depth >= 0 && depth < len(lists) {
+				lists = lists[:depth]
+			}
+			result[i] = b
+			blankline = false
+		case types.BlankLine:
+			result[i] = b
+			blankline = true
+		case types.DelimitedBlock:
+			b.Elements = normalizeListItems(b.Elements)
+			result[i] = b
+			lists = []interface{}{}
+			blankline = false
+		default:
+			result[i] = b
+			lists = []interface{}{}
+			blankline = false
+		}
+	}
+	return result
+}
+
+// labeledListLevel the key of a labeled list, to distinguish from the other keys
+type labeledListLevel int
+
+// lookupListLevel looks-up the list with the given key in the current lists. If found, the deeper lists are pruned,
+// otherwise a new list is appended, with the key returned by `newKey`. Returns the updated lists along with the level
+// of the list item, ie, the number of lists of the same type, up to the matching (or new) list.
+func lookupListLevel(lists []interface{}, key interface{}, newKey func(level int) interface{}) ([]interface{}, int) {
+	level := 0
+	for i, k := range lists {
+		if !sameListType(k, key) {
+			continue
+		}
+		level++
+		if k == key {
+			return lists[:i+1], level
+		}
+	}
+	level++
+	log.Debugf("new list with key '%v' at level %d", key, level)
+	return append(lists, newKey(level)), level
+}
+
+func sameListType(k1, k2 interface{}) bool {
+	switch k1.(type) {
+	case types.NumberingStyle:
+		_, ok := k2.(types.NumberingStyle)
+		return ok
+	case types.BulletStyle:
+		_, ok := k2.(types.BulletStyle)
+		return ok
+	case labeledListLevel:
+		_, ok := k2.(labeledListLevel)
+		return ok
+	default:
+		return false
+	}
+}
+
+// the bullet styles of the unordered list items, by level
+var bulletStyles = []types.BulletStyle{types.OneAsterisk, types.TwoAsterisks, types.ThreeAsterisks, types.FourAsterisks, types.FiveAsterisks, types.Dash}
+
+// bulletStyle returns the bullet style for the given level (starting at 1)
+func bulletStyle(level int) types.BulletStyle {
+	return bulletStyles[(level-1)%len(bulletStyles)]
+}
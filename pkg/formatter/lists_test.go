@@ -0,0 +1,112 @@
+package formatter_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("list markers", func() {
+
+	It("normalize unordered list markers", func() {
+		source := `- item 1
+* item 1.1
+** item 1.1.1
+- item 2`
+		expected := `* item 1
+** item 1.1
+*** item 1.1.1
+* item 2
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("normalize unordered list markers in mixed lists", func() {
+		source := `. item 1
+- item 1.1
+.. item 1.1.1
+*** item 1.1.1.1`
+		expected := `. item 1
+* item 1.1
+.. item 1.1.1
+** item 1.1.1.1
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("normalize ordered list markers", func() {
+		source := `1. item 1
+... item 1.1
+a. item 1.2
+2. item 2`
+		expected := `. item 1
+i) item 1.1
+a. item 1.2
+. item 2
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("normalize labeled list markers", func() {
+		source := `term 1:::
+term 2::::`
+		expected := `term 1::
+term 2:::
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("restart lists after a block", func() {
+		source := `** item 1
+
+a paragraph
+
+** item 2
+
+[[other]]
+** item 3`
+		expected := `* item 1
+
+a paragraph
+
+* item 2
+
+[[other]]
+* item 3
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("normalize list markers after a list continuation", func() {
+		source := `* item 1
+** item 1.1
+
++
+----
+a listing attached to item 1
+----
+*** item 1.2
+* item 2`
+		expected := `* item 1
+** item 1.1
+
++
+----
+a listing attached to item 1
+----
+** item 1.2
+* item 2
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("normalize list markers in a delimited block", func() {
+		source := `====
+- item 1
+====`
+		expected := `====
+* item 1
+====
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+})
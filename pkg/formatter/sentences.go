@@ -0,0 +1,171 @@
+package formatter
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// splitSentences rewrites the lines of the paragraphs in the given draft blocks, with a sentence per line
+func splitSentences(blocks []interface{}) []interface{} {
+	result := make([]interface{}, len(blocks))
+	for i, block := range blocks {
+		switch b := block.(type) {
+		case types.Paragraph:
+			result[i] = splitParagraphSentences(b)
+		case types.OrderedListItem:
+			b.Elements = splitSentences(b.Elements)
+			result[i] = b
+		case types.UnorderedListItem:
+			b.Elements = splitSentences(b.Elements)
+			result[i] = b
+		case types.LabeledListItem:
+			b.Elements = splitSentences(b.Elements)
+			result[i] = b
+		case types.ContinuedListItemElement:
+			b.Element = splitSentences([]interface{}{b.Element})[0]
+			result[i] = b
+		case types.DelimitedBlock:
+			switch b.Kind {
+			case types.Example, types.Quote, types.Sidebar:
+				b.Elements = splitSentences(b.Elements)
+			}
+			result[i] = b
+		default:
+			result[i] = b
+		}
+	}
+	return result
+}
+
+// splitParagraphSentences joins the lines of the given paragraph (except when they end with a hard line break)
+// and splits them again at the end of each sentence. Verses, literal paragraphs and paragraphs with hard breaks are
+// left as-is, since their line breaks are significant.
+func splitParagraphSentences(p types.Paragraph) types.Paragraph {
+	if p.Attributes.Has(types.AttrKind) || p.Attributes.Has(types.AttrHardBreaks) {
+		return p
+	}
+	for _, line := range p.Lines {
+		for _, element := range line {
+			if _, ok := element.(types.SingleLineComment); ok {
+				return p
+			}
+		}
+	}
+	lines := [][]interface{}{}
+	current := []interface{}{}
+	for i, line := range p.Lines {
+		for _, element := range line {
+			current = appendInlineElement(current, element)
+		}
+		if i == len(p.Lines)-1 || endsWithLineBreak(line) {
+			lines = append(lines, splitLine(current)...)
+			current = []interface{}{}
+			continue
+		}
+		// join with the next line
+		current = appendInlineElement(current, types.StringElement{Content: " "})
+	}
+	p.Lines = lines
+	return p
+}
+
+// appendInlineElement appends the given element to the given line, merging the consecutive strings
+func appendInlineElement(line []interface{}, element interface{}) []interface{} {
+	if s, ok := element.(types.StringElement); ok && len(line) > 0 {
+		if last, ok := line[len(line)-1].(types.StringElement); ok {
+			if s.Content == " " {
+				// do not accumulate the spaces when joining the lines
+				last.Content = strings.TrimRight(last.Content, " ")
+			}
+			line[len(line)-1] = types.StringElement{Content: last.Content + s.Content}
+			return line
+		}
+	}
+	return append(line, element)
+}
+
+func endsWithLineBreak(line []interface{}) bool {
+	if len(line) == 0 {
+		return false
+	}
+	_, ok := line[len(line)-1].(types.LineBreak)
+	return ok
+}
+
+// splitLine splits the given line at the end of each sentence within its strings
+func splitLine(line []interface{}) [][]interface{} {
+	result := [][]interface{}{}
+	current := []interface{}{}
+	for _, element := range line {
+		s, ok := element.(types.StringElement)
+		if !ok {
+			current = append(current, element)
+			continue
+		}
+		content := s.Content
+		for {
+			// the punctuation may immediately follow another element (eg: a link)
+			end, next := sentenceEnd(content, len(current) > 0)
+			if end < 0 {
+				break
+			}
+			current = append(current, types.StringElement{Content: content[:end]})
+			result = append(result, current)
+			current = []interface{}{}
+			content = content[next:]
+		}
+		if content != "" {
+			current = append(current, types.StringElement{Content: content})
+		}
+	}
+	if len(current) > 0 {
+		result = append(result, current)
+	}
+	return result
+}
+
+// the common abbreviations which are followed by a `.` and usually by a capitalized word (eg: `Dr. Who`),
+// and which do not end a sentence
+var abbreviations = map[string]bool{
+	"mr": true, "mrs": true, "ms": true, "mx": true, "dr": true, "prof": true, "sr": true, "jr": true, "st": true,
+	"mt": true, "rev": true, "hon": true, "gen": true, "col": true, "capt": true, "lt": true, "sgt": true,
+	"no": true, "fig": true, "figs": true, "vol": true, "ch": true, "sec": true, "eq": true, "ref": true,
+	"vs": true, "cf": true, "approx": true,
+}
+
+// sentenceEnd returns the end of the first sentence in the given content (ie, after its final punctuation) along
+// with the start of the next sentence, or `-1, -1` if the content does not contain the end of a sentence followed
+// by another one. The `afterElement` flag indicates if the content follows another element on the same line.
+// A sentence ends with a `.`, `?` or `!` followed by a space and a capitalized word (eg: `The` or `I`), unless the last word of the
+// sentence is an abbreviation (eg: `e.g.`, `Dr.` or an initial). Also, the content is not split if the next sentence
+// could be parsed as a labeled list item.
+func sentenceEnd(content string, afterElement bool) (int, int) {
+	for i := 0; i < len(content)-1; i++ {
+		if !strings.ContainsRune(".?!", rune(content[i])) || content[i+1] != ' ' {
+			continue
+		}
+		next := i + 1
+		for next < len(content) && content[next] == ' ' {
+			next++
+		}
+		first, size := utf8.DecodeRuneInString(content[next:])
+		second, _ := utf8.DecodeRuneInString(content[next+size:])
+		if !unicode.IsUpper(first) || !(unicode.IsLower(second) || second == ' ' || second == '\'') {
+			continue
+		}
+		word := strings.TrimLeftFunc(content[strings.LastIndex(content[:i], " ")+1:i], func(r rune) bool {
+			return !unicode.IsLetter(r)
+		})
+		if (i > 0 || !afterElement) && (len(word) < 2 || strings.Contains(word, ".") || (content[i] == '.' && abbreviations[strings.ToLower(word)])) {
+			continue
+		}
+		if strings.Contains(content[next:], "::") || strings.Contains(content[next:], ";;") {
+			continue
+		}
+		return i + 1, next
+	}
+	return -1, -1
+}
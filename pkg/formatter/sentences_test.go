@@ -0,0 +1,113 @@
+package formatter_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/formatter"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("sentence per line", func() {
+
+	It("split sentences", func() {
+		source := `A first sentence.  A second sentence
+on two lines! Is it a *third* one? I think so.`
+		expected := `A first sentence.
+A second sentence on two lines!
+Is it a *third* one?
+I think so.
+`
+		Expect(format(source, formatter.SentencePerLine(true))).To(Equal(expected))
+	})
+
+	It("split sentences after an element", func() {
+		source := `A https://example.com[link]. Another sentence.`
+		expected := `A https://example.com[link].
+Another sentence.
+`
+		Expect(format(source, formatter.SentencePerLine(true))).To(Equal(expected))
+	})
+
+	It("do not split sentences after abbreviations and initials", func() {
+		source := `Written by J. Doe, e.g. Foo and Bar. The end.`
+		expected := `Written by J. Doe, e.g. Foo and Bar.
+The end.
+`
+		Expect(format(source, formatter.SentencePerLine(true))).To(Equal(expected))
+	})
+
+	It("do not split sentences after common abbreviations", func() {
+		source := `Starring Dr. Who and Mrs. Hudson (see Fig. A and prof. Moriarty). The end.`
+		expected := `Starring Dr. Who and Mrs. Hudson (see Fig. A and prof. Moriarty).
+The end.
+`
+		Expect(format(source, formatter.SentencePerLine(true))).To(Equal(expected))
+	})
+
+	It("do not split sentences which could be parsed as a labeled list item", func() {
+		source := `A first sentence. Term:: a description.`
+		expected := `A first sentence. Term:: a description.
+`
+		Expect(format(source, formatter.SentencePerLine(true))).To(Equal(expected))
+	})
+
+	It("retain hard line breaks", func() {
+		source := `A first line +
+on two lines. Second sentence.`
+		expected := `A first line +
+on two lines.
+Second sentence.
+`
+		Expect(format(source, formatter.SentencePerLine(true))).To(Equal(expected))
+	})
+
+	It("split sentences in list items and delimited blocks", func() {
+		source := `* An item. With two sentences.
+
+****
+A sidebar. With two sentences.
+****`
+		expected := `* An item.
+With two sentences.
+
+****
+A sidebar.
+With two sentences.
+****
+`
+		Expect(format(source, formatter.SentencePerLine(true))).To(Equal(expected))
+	})
+
+	It("do not split sentences in verses and paragraphs with hard breaks", func() {
+		source := `[verse]
+A verse. On a single line.
+
+[%hardbreaks]
+A paragraph. On a single line.`
+		expected := `[verse]
+A verse. On a single line.
+
+[%hardbreaks]
+A paragraph. On a single line.
+`
+		Expect(format(source, formatter.SentencePerLine(true))).To(Equal(expected))
+	})
+
+	It("do not split sentences when hard breaks are enabled in the document", func() {
+		source := `:hardbreaks:
+
+A paragraph. On a single line.`
+		expected := `:hardbreaks:
+
+A paragraph. On a single line.
+`
+		Expect(format(source, formatter.SentencePerLine(true))).To(Equal(expected))
+	})
+
+	It("do not split sentences by default", func() {
+		source := `A first sentence. A second sentence.`
+		expected := `A first sentence. A second sentence.
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+})
@@ -0,0 +1,123 @@
+package formatter
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/asciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// alignTables pads the cells of the tables in the given draft blocks, so that the cells of each column
+// have the same width
+func alignTables(blocks []interface{}) ([]interface{}, error) {
+	result := make([]interface{}, len(blocks))
+	for i, block := range blocks {
+		switch b := block.(type) {
+		case types.Table:
+			t, err := alignTable(b)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = t
+		case types.ContinuedListItemElement:
+			elements, err := alignTables([]interface{}{b.Element})
+			if err != nil {
+				return nil, err
+			}
+			b.Element = elements[0]
+			result[i] = b
+		case types.DelimitedBlock:
+			switch b.Kind {
+			case types.Example, types.Quote, types.Sidebar:
+				elements, err := alignTables(b.Elements)
+				if err != nil {
+					return nil, err
+				}
+				b.Elements = elements
+			}
+			result[i] = b
+		default:
+			result[i] = b
+		}
+	}
+	return result, nil
+}
+
+func alignTable(t types.Table) (types.Table, error) {
+	lines := make([]types.TableLine, 0, len(t.Lines)+1)
+	if len(t.Header.Cells) > 0 {
+		lines = append(lines, t.Header)
+	}
+	lines = append(lines, t.Lines...)
+	// compute the width of each column, without the trailing spaces of the cells
+	widths := []int{}
+	cellWidths := make([][]int, len(lines))
+	for i, line := range lines {
+		cellWidths[i] = make([]int, len(line.Cells))
+		for j, cell := range line.Cells {
+			w, err := cellWidth(cell)
+			if err != nil {
+				return types.Table{}, errors.Wrap(err, "unable to align table")
+			}
+			cellWidths[i][j] = w
+			if j == len(widths) {
+				widths = append(widths, 0)
+			}
+			if w > widths[j] {
+				widths[j] = w
+			}
+		}
+	}
+	// pad the cells (except the last one on each line), with an extra space before the next cell separator
+	for i, line := range lines {
+		cells := make([][]interface{}, len(line.Cells))
+		for j, cell := range line.Cells {
+			cells[j] = trimCell(cell)
+			if j < len(line.Cells)-1 {
+				cells[j] = appendInlineElement(cells[j], types.StringElement{Content: strings.Repeat(" ", widths[j]-cellWidths[i][j]+1)})
+			}
+		}
+		lines[i] = types.TableLine{Cells: cells}
+	}
+	if len(t.Header.Cells) > 0 {
+		t.Header = lines[0]
+		lines = lines[1:]
+	}
+	t.Lines = lines
+	return t, nil
+}
+
+// trimCell returns a copy of the given cell, without its trailing spaces
+func trimCell(cell []interface{}) []interface{} {
+	result := make([]interface{}, len(cell))
+	copy(result, cell)
+	if len(result) == 0 {
+		return result
+	}
+	if s, ok := result[len(result)-1].(types.StringElement); ok {
+		if content := strings.TrimRight(s.Content, " "); content != "" {
+			result[len(result)-1] = types.StringElement{Content: content}
+		} else {
+			result = result[:len(result)-1]
+		}
+	}
+	return result
+}
+
+// cellWidth returns the number of characters of the given cell once rendered in AsciiDoc, without its trailing spaces
+func cellWidth(cell []interface{}) (int, error) {
+	result := strings.Builder{}
+	err := asciidoc.RenderDraft(types.DraftDocument{
+		Blocks: []interface{}{
+			types.Paragraph{
+				Lines: [][]interface{}{trimCell(cell)},
+			},
+		},
+	}, &result)
+	if err != nil {
+		return 0, err
+	}
+	return utf8.RuneCountInString(strings.TrimRight(result.String(), "\n")), nil
+}
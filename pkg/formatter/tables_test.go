@@ -0,0 +1,73 @@
+package formatter_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tables", func() {
+
+	It("align the cells of a table with header", func() {
+		source := `|===
+|Column 1|Column 2|C3
+
+|*a*|b|c
+|a longer cell  |d|e
+|===`
+		expected := `|===
+| Column 1      | Column 2 | C3
+
+| *a*           | b        | c
+| a longer cell | d        | e
+|===
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("align the cells of a table in a delimited block", func() {
+		source := `****
+|===
+|a|b
+|ccc|d
+|===
+****`
+		expected := `****
+|===
+| a   | b
+| ccc | d
+|===
+****
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("align the cells of a table attached to a list item", func() {
+		source := `* item
++
+|===
+|a|b
+|ccc|d
+|===`
+		expected := `* item
++
+|===
+| a   | b
+| ccc | d
+|===
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("align the cells with non-ASCII characters", func() {
+		source := `|===
+|é|b
+|ab|c
+|===`
+		expected := `|===
+| é  | b
+| ab | c
+|===
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+})
@@ -0,0 +1,620 @@
+package jsonast
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// decoder decodes the fields of a JSON object. The first error which occurs is retained
+// (and the subsequent calls return zero values), so that it can be checked once all fields were decoded
+type decoder struct {
+	obj map[string]interface{}
+	err error
+}
+
+func newDecoder(obj map[string]interface{}) *decoder {
+	return &decoder{
+		obj: obj,
+	}
+}
+
+func (d *decoder) fail(key, expected string) {
+	if d.err == nil {
+		d.err = errors.Errorf("invalid '%s' field in '%v' object: expected %s but got %T", key, d.obj["type"], expected, d.obj[key])
+	}
+}
+
+func (d *decoder) string(key string) string {
+	v, found := d.obj[key]
+	if !found || d.err != nil {
+		return ""
+	}
+	s, ok := v.(string)
+	if !ok {
+		d.fail(key, "a string")
+	}
+	return s
+}
+
+func (d *decoder) bool(key string) bool {
+	v, found := d.obj[key]
+	if !found || d.err != nil {
+		return false
+	}
+	b, ok := v.(bool)
+	if !ok {
+		d.fail(key, "a boolean")
+	}
+	return b
+}
+
+func (d *decoder) int(key string) int {
+	v, found := d.obj[key]
+	if !found || d.err != nil {
+		return 0
+	}
+	n, ok := v.(json.Number)
+	if !ok {
+		d.fail(key, "a number")
+		return 0
+	}
+	i, err := n.Int64()
+	if err != nil {
+		d.fail(key, "an integer")
+	}
+	return int(i)
+}
+
+func (d *decoder) value(key string) interface{} {
+	v, found := d.obj[key]
+	if !found || d.err != nil {
+		return nil
+	}
+	result, err := decodeValue(v)
+	if err != nil {
+		d.err = errors.Wrapf(err, "invalid '%s' field in '%v' object", key, d.obj["type"])
+	}
+	return result
+}
+
+func (d *decoder) array(key string) []interface{} {
+	v, found := d.obj[key]
+	if !found || d.err != nil {
+		return nil
+	}
+	a, ok := v.([]interface{})
+	if !ok {
+		d.fail(key, "an array")
+	}
+	return a
+}
+
+func (d *decoder) object(key string) map[string]interface{} {
+	v, found := d.obj[key]
+	if !found || d.err != nil {
+		return nil
+	}
+	o, ok := v.(map[string]interface{})
+	if !ok {
+		d.fail(key, "an object")
+	}
+	return o
+}
+
+// elements decodes the array of elements of the given field, or returns `nil` if the field is missing
+func (d *decoder) elements(key string) []interface{} {
+	a := d.array(key)
+	if a == nil {
+		return nil
+	}
+	result, err := decodeElements(a)
+	if err != nil && d.err == nil {
+		d.err = errors.Wrapf(err, "invalid '%s' field in '%v' object", key, d.obj["type"])
+	}
+	return result
+}
+
+// lines decodes the array of lines of elements of the given field, or returns `nil` if the field is missing
+func (d *decoder) lines(key string) [][]interface{} {
+	a := d.array(key)
+	if a == nil {
+		return nil
+	}
+	result := make([][]interface{}, len(a))
+	for i, line := range a {
+		l, ok := line.([]interface{})
+		if !ok {
+			d.fail(key, "an array of arrays")
+			return nil
+		}
+		var err error
+		if result[i], err = decodeElements(l); err != nil && d.err == nil {
+			d.err = errors.Wrapf(err, "invalid '%s' field in '%v' object", key, d.obj["type"])
+		}
+	}
+	return result
+}
+
+// strings decodes the array of strings of the given field, or returns `nil` if the field is missing
+func (d *decoder) strings(key string) []string {
+	a := d.array(key)
+	if a == nil {
+		return nil
+	}
+	result := make([]string, len(a))
+	for i, v := range a {
+		s, ok := v.(string)
+		if !ok {
+			d.fail(key, "an array of strings")
+			return nil
+		}
+		result[i] = s
+	}
+	return result
+}
+
+// entries decodes the entries of the object of the given field, or returns `nil` if the field is missing
+func (d *decoder) entries(key string) map[string]interface{} {
+	o := d.object(key)
+	if o == nil {
+		return nil
+	}
+	result, err := decodeEntries(o)
+	if err != nil && d.err == nil {
+		d.err = errors.Wrapf(err, "invalid '%s' field in '%v' object", key, d.obj["type"])
+	}
+	return result
+}
+
+func (d *decoder) attributes(key string) types.ElementAttributes {
+	if e := d.entries(key); e != nil {
+		return types.ElementAttributes(e)
+	}
+	return nil
+}
+
+func (d *decoder) location(key string) types.Location {
+	return types.Location{
+		Elements: d.elements(key),
+	}
+}
+
+func decodeDocument(v interface{}) (types.Document, error) {
+	obj, ok := v.(map[string]interface{})
+	if !ok || obj["type"] != documentType {
+		return types.Document{}, errors.Errorf("expected a '%s' object", documentType)
+	}
+	d := newDecoder(obj)
+	doc := types.Document{
+		Elements: d.elements("elements"),
+	}
+	if a := d.entries("attributes"); a != nil {
+		doc.Attributes = types.DocumentAttributes(a)
+	}
+	if r := d.entries("elementReferences"); r != nil {
+		doc.ElementReferences = types.ElementReferences(r)
+	}
+	if footnotes := d.array("footnotes"); footnotes != nil {
+		doc.Footnotes = make(types.Footnotes, len(footnotes))
+		for i, f := range footnotes {
+			doc.Footnotes[i], d.err = decodeFootnote(f, d.err)
+		}
+	}
+	if refs := d.object("footnoteReferences"); refs != nil {
+		doc.FootnoteReferences = make(types.FootnoteReferences, len(refs))
+		for k, f := range refs {
+			doc.FootnoteReferences[k], d.err = decodeFootnote(f, d.err)
+		}
+	}
+	return doc, d.err
+}
+
+// decodeFootnote decodes the given footnote, unless a previous error occurred
+func decodeFootnote(v interface{}, err error) (types.Footnote, error) {
+	if err != nil {
+		return types.Footnote{}, err
+	}
+	f, err := decodeValue(v)
+	if err != nil {
+		return types.Footnote{}, err
+	}
+	if f, ok := f.(types.Footnote); ok {
+		return f, nil
+	}
+	return types.Footnote{}, errors.Errorf("expected a '%s' object but got %T", footnoteType, f)
+}
+
+func decodeElements(values []interface{}) ([]interface{}, error) {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		e, err := decodeValue(v)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = e
+	}
+	return result, nil
+}
+
+func decodeEntries(entries map[string]interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(entries))
+	for k, v := range entries {
+		e, err := decodeValue(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to decode the '%s' entry", k)
+		}
+		result[k] = e
+	}
+	return result, nil
+}
+
+// decodeValue decodes the given element or attribute value
+func decodeValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case nil, string, bool:
+		return v, nil
+	case json.Number:
+		if !strings.ContainsAny(v.String(), ".eE") {
+			if i, err := v.Int64(); err == nil {
+				return int(i), nil
+			}
+		}
+		return v.Float64()
+	case []interface{}:
+		return decodeElements(v)
+	case map[string]interface{}:
+		t, ok := v["type"].(string)
+		if !ok {
+			return nil, errors.New("missing 'type' discriminator")
+		}
+		d := newDecoder(v)
+		element, err := decodeNode(t, d)
+		if err != nil {
+			return nil, err
+		}
+		if d.err != nil {
+			return nil, d.err
+		}
+		return element, nil
+	default:
+		return nil, errors.Errorf("unexpected value: %v (%T)", value, value)
+	}
+}
+
+// decodeNode decodes the fields of the JSON object with the given `type` discriminator
+func decodeNode(t string, d *decoder) (interface{}, error) { // nolint:gocyclo
+	switch t {
+	case attributesType:
+		return types.ElementAttributes(d.entries("entries")), nil
+	case mapType:
+		return d.entries("entries"), nil
+	case blockKindType:
+		return types.BlockKind(d.string("value")), nil
+	case admonitionKindType:
+		return types.AdmonitionKind(d.string("value")), nil
+	case numberingStyleType:
+		return types.NumberingStyle(d.string("value")), nil
+	case bulletStyleType:
+		return types.BulletStyle(d.string("value")), nil
+	case checkStyleType:
+		return types.UnorderedListItemCheckStyle(d.string("value")), nil
+	case stemNotationType:
+		return types.StemNotation(d.string("value")), nil
+	case macroKindType:
+		return types.MacroKind(d.string("value")), nil
+	case sectionType:
+		return types.Section{
+			Level:      d.int("level"),
+			Attributes: d.attributes("attributes"),
+			Title:      d.elements("title"),
+			Elements:   d.elements("elements"),
+		}, nil
+	case preambleType:
+		return types.Preamble{
+			Elements: d.elements("elements"),
+		}, nil
+	case frontMatterType:
+		return types.FrontMatter{
+			Content: d.entries("content"),
+		}, nil
+	case documentAuthorType:
+		return types.DocumentAuthor{
+			FullName: d.string("fullName"),
+			Email:    d.string("email"),
+		}, nil
+	case documentAuthorsType:
+		authors := d.elements("authors")
+		result := make([]types.DocumentAuthor, len(authors))
+		for i, a := range authors {
+			author, ok := a.(types.DocumentAuthor)
+			if !ok {
+				return nil, errors.Errorf("expected a '%s' object but got %T", documentAuthorType, a)
+			}
+			result[i] = author
+		}
+		return result, nil
+	case documentRevisionType:
+		return types.DocumentRevision{
+			Revnumber: d.string("revnumber"),
+			Revdate:   d.string("revdate"),
+			Revremark: d.string("revremark"),
+		}, nil
+	case documentAttributeDeclarationType:
+		return types.DocumentAttributeDeclaration{
+			Name:  d.string("name"),
+			Value: d.string("value"),
+		}, nil
+	case documentAttributeResetType:
+		return types.DocumentAttributeReset{
+			Name: d.string("name"),
+		}, nil
+	case documentAttributeSubstitutionType:
+		return types.DocumentAttributeSubstitution{
+			Name: d.string("name"),
+		}, nil
+	case tableOfContentsMacroType:
+		return types.TableOfContentsMacro{}, nil
+	case userMacroType:
+		return types.UserMacro{
+			Kind:       types.MacroKind(d.string("kind")),
+			Name:       d.string("name"),
+			Value:      d.string("value"),
+			Attributes: d.attributes("attributes"),
+			RawText:    d.string("rawText"),
+		}, nil
+	case orderedListType:
+		l := types.OrderedList{
+			Attributes: d.attributes("attributes"),
+		}
+		if items := d.elements("items"); items != nil {
+			l.Items = make([]types.OrderedListItem, len(items))
+			for i, item := range items {
+				var ok bool
+				if l.Items[i], ok = item.(types.OrderedListItem); !ok {
+					return nil, errors.Errorf("expected a '%s' object but got %T", orderedListItemType, item)
+				}
+			}
+		}
+		return l, nil
+	case orderedListItemType:
+		return types.OrderedListItem{
+			Attributes:     d.attributes("attributes"),
+			Level:          d.int("level"),
+			NumberingStyle: types.NumberingStyle(d.string("numberingStyle")),
+			Elements:       d.elements("elements"),
+		}, nil
+	case unorderedListType:
+		l := types.UnorderedList{
+			Attributes: d.attributes("attributes"),
+		}
+		if items := d.elements("items"); items != nil {
+			l.Items = make([]types.UnorderedListItem, len(items))
+			for i, item := range items {
+				var ok bool
+				if l.Items[i], ok = item.(types.UnorderedListItem); !ok {
+					return nil, errors.Errorf("expected a '%s' object but got %T", unorderedListItemType, item)
+				}
+			}
+		}
+		return l, nil
+	case unorderedListItemType:
+		return types.UnorderedListItem{
+			Level:       d.int("level"),
+			BulletStyle: types.BulletStyle(d.string("bulletStyle")),
+			CheckStyle:  types.UnorderedListItemCheckStyle(d.string("checkStyle")),
+			Attributes:  d.attributes("attributes"),
+			Elements:    d.elements("elements"),
+		}, nil
+	case labeledListType:
+		l := types.LabeledList{
+			Attributes: d.attributes("attributes"),
+		}
+		if items := d.elements("items"); items != nil {
+			l.Items = make([]types.LabeledListItem, len(items))
+			for i, item := range items {
+				var ok bool
+				if l.Items[i], ok = item.(types.LabeledListItem); !ok {
+					return nil, errors.Errorf("expected a '%s' object but got %T", labeledListItemType, item)
+				}
+			}
+		}
+		return l, nil
+	case labeledListItemType:
+		return types.LabeledListItem{
+			Term:       d.elements("term"),
+			Level:      d.int("level"),
+			Attributes: d.attributes("attributes"),
+			Elements:   d.elements("elements"),
+		}, nil
+	case continuedListItemElementType:
+		return types.ContinuedListItemElement{
+			Offset:  d.int("offset"),
+			Element: d.value("element"),
+		}, nil
+	case paragraphType:
+		return types.Paragraph{
+			Attributes: d.attributes("attributes"),
+			Lines:      d.lines("lines"),
+		}, nil
+	case internalCrossReferenceType:
+		return types.InternalCrossReference{
+			ID:    d.string("id"),
+			Label: d.string("label"),
+		}, nil
+	case externalCrossReferenceType:
+		return types.ExternalCrossReference{
+			Location: d.location("location"),
+			Label:    d.elements("label"),
+		}, nil
+	case imageBlockType:
+		return types.ImageBlock{
+			Location:   d.location("location"),
+			Attributes: d.attributes("attributes"),
+		}, nil
+	case inlineImageType:
+		return types.InlineImage{
+			Location:   d.location("location"),
+			Attributes: d.attributes("attributes"),
+		}, nil
+	case videoBlockType:
+		return types.VideoBlock{
+			Location:   d.location("location"),
+			Attributes: d.attributes("attributes"),
+		}, nil
+	case audioBlockType:
+		return types.AudioBlock{
+			Location:   d.location("location"),
+			Attributes: d.attributes("attributes"),
+		}, nil
+	case inlineLinkType:
+		return types.InlineLink{
+			Location:   d.location("location"),
+			Attributes: d.attributes("attributes"),
+		}, nil
+	case inlineIconType:
+		return types.InlineIcon{
+			Name:       d.string("name"),
+			Attributes: d.attributes("attributes"),
+		}, nil
+	case footnoteType:
+		return types.Footnote{
+			ID:       d.int("id"),
+			Ref:      d.string("ref"),
+			Elements: d.elements("elements"),
+		}, nil
+	case delimitedBlockType:
+		return types.DelimitedBlock{
+			Kind:       types.BlockKind(d.string("kind")),
+			Attributes: d.attributes("attributes"),
+			Elements:   d.elements("elements"),
+		}, nil
+	case tableType:
+		t := types.Table{
+			Attributes: d.attributes("attributes"),
+		}
+		if header, ok := d.value("header").(types.TableLine); ok {
+			t.Header = header
+		}
+		if lines := d.elements("lines"); lines != nil {
+			t.Lines = make([]types.TableLine, len(lines))
+			for i, line := range lines {
+				var ok bool
+				if t.Lines[i], ok = line.(types.TableLine); !ok {
+					return nil, errors.Errorf("expected a '%s' object but got %T", tableLineType, line)
+				}
+			}
+		}
+		return t, nil
+	case tableLineType:
+		return types.TableLine{
+			Cells: d.lines("cells"),
+		}, nil
+	case literalBlockType:
+		return types.LiteralBlock{
+			Attributes: d.attributes("attributes"),
+			Lines:      d.strings("lines"),
+		}, nil
+	case inlineStemType:
+		return types.InlineStem{
+			Notation: types.StemNotation(d.string("notation")),
+			Content:  d.string("content"),
+		}, nil
+	case stemBlockType:
+		return types.StemBlock{
+			Attributes: d.attributes("attributes"),
+			Notation:   types.StemNotation(d.string("notation")),
+			Lines:      d.strings("lines"),
+		}, nil
+	case blankLineType:
+		return types.BlankLine{}, nil
+	case singleLineCommentType:
+		return types.SingleLineComment{
+			Content: d.string("content"),
+		}, nil
+	case stringType:
+		return types.StringElement{
+			Content: d.string("content"),
+		}, nil
+	case lineBreakType:
+		return types.LineBreak{}, nil
+	case quotedTextType:
+		kind := d.string("kind")
+		for k, name := range quotedTextKinds {
+			if name == kind {
+				return types.QuotedText{
+					Kind:     k,
+					Elements: d.elements("elements"),
+				}, nil
+			}
+		}
+		return nil, errors.Errorf("unsupported kind of quoted text: '%s'", kind)
+	case passthroughType:
+		kind := d.string("kind")
+		for k, name := range passthroughKinds {
+			if name == kind {
+				return types.Passthrough{
+					Kind:     k,
+					Elements: d.elements("elements"),
+				}, nil
+			}
+		}
+		return nil, errors.Errorf("unsupported kind of passthrough: '%s'", kind)
+	case fileInclusionType:
+		return types.FileInclusion{
+			Attributes: d.attributes("attributes"),
+			Location:   d.location("location"),
+			RawText:    d.string("rawText"),
+		}, nil
+	case lineRangeType:
+		return types.LineRange{
+			StartLine: d.int("start"),
+			EndLine:   d.int("end"),
+		}, nil
+	case lineRangesType:
+		ranges := d.elements("ranges")
+		result := make(types.LineRanges, len(ranges))
+		for i, r := range ranges {
+			var ok bool
+			if result[i], ok = r.(types.LineRange); !ok {
+				return nil, errors.Errorf("expected a '%s' object but got %T", lineRangeType, r)
+			}
+		}
+		return result, nil
+	case tagRangeType:
+		return types.TagRange{
+			Name:     d.string("name"),
+			Included: d.bool("included"),
+		}, nil
+	case tagRangesType:
+		ranges := d.elements("ranges")
+		result := make(types.TagRanges, len(ranges))
+		for i, r := range ranges {
+			var ok bool
+			if result[i], ok = r.(types.TagRange); !ok {
+				return nil, errors.Errorf("expected a '%s' object but got %T", tagRangeType, r)
+			}
+		}
+		return result, nil
+	case includedFileLineType:
+		return types.IncludedFileLine(d.elements("elements")), nil
+	case includedFileStartTagType:
+		return types.IncludedFileStartTag{
+			Value: d.string("value"),
+		}, nil
+	case includedFileEndTagType:
+		return types.IncludedFileEndTag{
+			Value: d.string("value"),
+		}, nil
+	case concealedIndexTermType:
+		return types.ConceleadIndexTerm{
+			Term1: d.value("term1"),
+			Term2: d.value("term2"),
+			Term3: d.value("term3"),
+		}, nil
+	default:
+		return nil, errors.Errorf("unsupported type of element: '%s'", t)
+	}
+}
@@ -0,0 +1,555 @@
+package jsonast
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// the `type` discriminators of the elements
+const (
+	documentType                      = "document"
+	sectionType                       = "section"
+	preambleType                      = "preamble"
+	frontMatterType                   = "frontMatter"
+	documentAuthorType                = "documentAuthor"
+	documentAuthorsType               = "documentAuthors"
+	documentRevisionType              = "documentRevision"
+	documentAttributeDeclarationType  = "documentAttributeDeclaration"
+	documentAttributeResetType        = "documentAttributeReset"
+	documentAttributeSubstitutionType = "documentAttributeSubstitution"
+	tableOfContentsMacroType          = "tableOfContentsMacro"
+	userMacroType                     = "userMacro"
+	orderedListType                   = "orderedList"
+	orderedListItemType               = "orderedListItem"
+	unorderedListType                 = "unorderedList"
+	unorderedListItemType             = "unorderedListItem"
+	labeledListType                   = "labeledList"
+	labeledListItemType               = "labeledListItem"
+	continuedListItemElementType      = "continuedListItemElement"
+	paragraphType                     = "paragraph"
+	internalCrossReferenceType        = "internalCrossReference"
+	externalCrossReferenceType        = "externalCrossReference"
+	imageBlockType                    = "imageBlock"
+	inlineImageType                   = "inlineImage"
+	inlineIconType                    = "inlineIcon"
+	videoBlockType                    = "videoBlock"
+	audioBlockType                    = "audioBlock"
+	footnoteType                      = "footnote"
+	delimitedBlockType                = "delimitedBlock"
+	tableType                         = "table"
+	tableLineType                     = "tableLine"
+	literalBlockType                  = "literalBlock"
+	inlineStemType                    = "inlineStem"
+	stemBlockType                     = "stemBlock"
+	blankLineType                     = "blankLine"
+	singleLineCommentType             = "singleLineComment"
+	stringType                        = "string"
+	lineBreakType                     = "lineBreak"
+	quotedTextType                    = "quotedText"
+	passthroughType                   = "passthrough"
+	inlineLinkType                    = "inlineLink"
+	fileInclusionType                 = "fileInclusion"
+	lineRangeType                     = "lineRange"
+	lineRangesType                    = "lineRanges"
+	tagRangeType                      = "tagRange"
+	tagRangesType                     = "tagRanges"
+	includedFileLineType              = "includedFileLine"
+	includedFileStartTagType          = "includedFileStartTag"
+	includedFileEndTagType            = "includedFileEndTag"
+	concealedIndexTermType            = "concealedIndexTerm"
+	// nested maps in the attributes
+	attributesType = "attributes"
+	mapType        = "map"
+	// enumerations in the attributes
+	blockKindType      = "blockKind"
+	admonitionKindType = "admonitionKind"
+	numberingStyleType = "numberingStyle"
+	bulletStyleType    = "bulletStyle"
+	checkStyleType     = "checkStyle"
+	stemNotationType   = "stemNotation"
+	macroKindType      = "macroKind"
+)
+
+var quotedTextKinds = map[types.QuotedTextKind]string{
+	types.Bold:        "bold",
+	types.Italic:      "italic",
+	types.Monospace:   "monospace",
+	types.Subscript:   "subscript",
+	types.Superscript: "superscript",
+}
+
+var passthroughKinds = map[types.PassthroughKind]string{
+	types.SinglePlusPassthrough: "singlePlus",
+	types.TriplePlusPassthrough: "triplePlus",
+	types.PassthroughMacro:      "macro",
+}
+
+// node a JSON object representing an element, with its `type` discriminator
+type node map[string]interface{}
+
+func newNode(t string) node {
+	return node{"type": t}
+}
+
+// setElements sets the encoded elements under the given key, unless the elements are `nil`
+func (n node) setElements(key string, elements []interface{}) error {
+	if elements == nil {
+		return nil
+	}
+	e, err := encodeElements(elements)
+	if err != nil {
+		return err
+	}
+	n[key] = e
+	return nil
+}
+
+// setLines sets the encoded lines of elements under the given key, unless the lines are `nil`
+func (n node) setLines(key string, lines [][]interface{}) error {
+	if lines == nil {
+		return nil
+	}
+	result := make([]interface{}, len(lines))
+	for i, line := range lines {
+		e, err := encodeElements(line)
+		if err != nil {
+			return err
+		}
+		result[i] = e
+	}
+	n[key] = result
+	return nil
+}
+
+// setAttributes sets the encoded attributes under the given key, unless the attributes are `nil`
+func (n node) setAttributes(key string, attributes map[string]interface{}) error {
+	if attributes == nil {
+		return nil
+	}
+	a, err := encodeEntries(attributes)
+	if err != nil {
+		return err
+	}
+	n[key] = a
+	return nil
+}
+
+// setStrings sets the given strings under the given key, unless they are `nil`
+func (n node) setStrings(key string, values []string) {
+	if values != nil {
+		n[key] = values
+	}
+}
+
+func encodeDocument(doc types.Document) (node, error) {
+	n := newNode(documentType)
+	if err := n.setAttributes("attributes", doc.Attributes); err != nil {
+		return nil, err
+	}
+	if err := n.setElements("elements", doc.Elements); err != nil {
+		return nil, err
+	}
+	if err := n.setAttributes("elementReferences", doc.ElementReferences); err != nil {
+		return nil, err
+	}
+	if doc.Footnotes != nil {
+		footnotes := make([]interface{}, len(doc.Footnotes))
+		for i, f := range doc.Footnotes {
+			e, err := encodeValue(f)
+			if err != nil {
+				return nil, err
+			}
+			footnotes[i] = e
+		}
+		n["footnotes"] = footnotes
+	}
+	if doc.FootnoteReferences != nil {
+		refs := make(map[string]interface{}, len(doc.FootnoteReferences))
+		for k, f := range doc.FootnoteReferences {
+			e, err := encodeValue(f)
+			if err != nil {
+				return nil, err
+			}
+			refs[k] = e
+		}
+		n["footnoteReferences"] = refs
+	}
+	return n, nil
+}
+
+func encodeElements(elements []interface{}) ([]interface{}, error) {
+	result := make([]interface{}, len(elements))
+	for i, element := range elements {
+		e, err := encodeValue(element)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = e
+	}
+	return result, nil
+}
+
+func encodeEntries(entries map[string]interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(entries))
+	for k, v := range entries {
+		e, err := encodeValue(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to encode the '%s' entry", k)
+		}
+		result[k] = e
+	}
+	return result, nil
+}
+
+func encodeEnumeration(t string, value interface{}) node {
+	n := newNode(t)
+	n["value"] = fmt.Sprintf("%s", value)
+	return n
+}
+
+// encodeValue encodes the given element or attribute value
+func encodeValue(value interface{}) (interface{}, error) { // nolint:gocyclo
+	switch v := value.(type) {
+	case nil, string, bool, int, int64, float64:
+		return v, nil
+	case []interface{}:
+		return encodeElements(v)
+	case types.ElementAttributes:
+		n := newNode(attributesType)
+		return n, n.setAttributes("entries", v)
+	case map[string]interface{}:
+		n := newNode(mapType)
+		return n, n.setAttributes("entries", v)
+	case map[interface{}]interface{}:
+		// nested maps in the YAML front-matter
+		entries := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			entries[fmt.Sprintf("%v", k)] = e
+		}
+		n := newNode(mapType)
+		return n, n.setAttributes("entries", entries)
+	case types.BlockKind:
+		return encodeEnumeration(blockKindType, v), nil
+	case types.AdmonitionKind:
+		return encodeEnumeration(admonitionKindType, v), nil
+	case types.NumberingStyle:
+		return encodeEnumeration(numberingStyleType, v), nil
+	case types.BulletStyle:
+		return encodeEnumeration(bulletStyleType, v), nil
+	case types.UnorderedListItemCheckStyle:
+		return encodeEnumeration(checkStyleType, v), nil
+	case types.StemNotation:
+		return encodeEnumeration(stemNotationType, v), nil
+	case types.MacroKind:
+		return encodeEnumeration(macroKindType, v), nil
+	case types.Section:
+		n := newNode(sectionType)
+		n["level"] = v.Level
+		if err := n.setAttributes("attributes", v.Attributes); err != nil {
+			return nil, err
+		}
+		if err := n.setElements("title", v.Title); err != nil {
+			return nil, err
+		}
+		return n, n.setElements("elements", v.Elements)
+	case types.Preamble:
+		n := newNode(preambleType)
+		return n, n.setElements("elements", v.Elements)
+	case types.FrontMatter:
+		n := newNode(frontMatterType)
+		return n, n.setAttributes("content", v.Content)
+	case types.DocumentAuthor:
+		n := newNode(documentAuthorType)
+		n["fullName"] = v.FullName
+		n["email"] = v.Email
+		return n, nil
+	case []types.DocumentAuthor:
+		result := make([]interface{}, len(v))
+		for i, a := range v {
+			result[i], _ = encodeValue(a)
+		}
+		return node{"type": documentAuthorsType, "authors": result}, nil
+	case types.DocumentRevision:
+		n := newNode(documentRevisionType)
+		n["revnumber"] = v.Revnumber
+		n["revdate"] = v.Revdate
+		n["revremark"] = v.Revremark
+		return n, nil
+	case types.DocumentAttributeDeclaration:
+		n := newNode(documentAttributeDeclarationType)
+		n["name"] = v.Name
+		n["value"] = v.Value
+		return n, nil
+	case types.DocumentAttributeReset:
+		n := newNode(documentAttributeResetType)
+		n["name"] = v.Name
+		return n, nil
+	case types.DocumentAttributeSubstitution:
+		n := newNode(documentAttributeSubstitutionType)
+		n["name"] = v.Name
+		return n, nil
+	case types.TableOfContentsMacro:
+		return newNode(tableOfContentsMacroType), nil
+	case types.UserMacro:
+		n := newNode(userMacroType)
+		n["kind"] = string(v.Kind)
+		n["name"] = v.Name
+		n["value"] = v.Value
+		n["rawText"] = v.RawText
+		return n, n.setAttributes("attributes", v.Attributes)
+	case types.OrderedList:
+		n := newNode(orderedListType)
+		if err := n.setAttributes("attributes", v.Attributes); err != nil {
+			return nil, err
+		}
+		if v.Items != nil {
+			items := make([]interface{}, len(v.Items))
+			for i, item := range v.Items {
+				e, err := encodeValue(item)
+				if err != nil {
+					return nil, err
+				}
+				items[i] = e
+			}
+			n["items"] = items
+		}
+		return n, nil
+	case types.OrderedListItem:
+		n := newNode(orderedListItemType)
+		n["level"] = v.Level
+		n["numberingStyle"] = string(v.NumberingStyle)
+		if err := n.setAttributes("attributes", v.Attributes); err != nil {
+			return nil, err
+		}
+		return n, n.setElements("elements", v.Elements)
+	case types.UnorderedList:
+		n := newNode(unorderedListType)
+		if err := n.setAttributes("attributes", v.Attributes); err != nil {
+			return nil, err
+		}
+		if v.Items != nil {
+			items := make([]interface{}, len(v.Items))
+			for i, item := range v.Items {
+				e, err := encodeValue(item)
+				if err != nil {
+					return nil, err
+				}
+				items[i] = e
+			}
+			n["items"] = items
+		}
+		return n, nil
+	case types.UnorderedListItem:
+		n := newNode(unorderedListItemType)
+		n["level"] = v.Level
+		n["bulletStyle"] = string(v.BulletStyle)
+		n["checkStyle"] = string(v.CheckStyle)
+		if err := n.setAttributes("attributes", v.Attributes); err != nil {
+			return nil, err
+		}
+		return n, n.setElements("elements", v.Elements)
+	case types.LabeledList:
+		n := newNode(labeledListType)
+		if err := n.setAttributes("attributes", v.Attributes); err != nil {
+			return nil, err
+		}
+		if v.Items != nil {
+			items := make([]interface{}, len(v.Items))
+			for i, item := range v.Items {
+				e, err := encodeValue(item)
+				if err != nil {
+					return nil, err
+				}
+				items[i] = e
+			}
+			n["items"] = items
+		}
+		return n, nil
+	case types.LabeledListItem:
+		n := newNode(labeledListItemType)
+		n["level"] = v.Level
+		if err := n.setElements("term", v.Term); err != nil {
+			return nil, err
+		}
+		if err := n.setAttributes("attributes", v.Attributes); err != nil {
+			return nil, err
+		}
+		return n, n.setElements("elements", v.Elements)
+	case types.ContinuedListItemElement:
+		n := newNode(continuedListItemElementType)
+		n["offset"] = v.Offset
+		e, err := encodeValue(v.Element)
+		n["element"] = e
+		return n, err
+	case types.Paragraph:
+		n := newNode(paragraphType)
+		if err := n.setAttributes("attributes", v.Attributes); err != nil {
+			return nil, err
+		}
+		return n, n.setLines("lines", v.Lines)
+	case types.InternalCrossReference:
+		n := newNode(internalCrossReferenceType)
+		n["id"] = v.ID
+		n["label"] = v.Label
+		return n, nil
+	case types.ExternalCrossReference:
+		n := newNode(externalCrossReferenceType)
+		if err := n.setElements("location", v.Location.Elements); err != nil {
+			return nil, err
+		}
+		return n, n.setElements("label", v.Label)
+	case types.ImageBlock:
+		return encodeLocationElement(imageBlockType, v.Location, v.Attributes)
+	case types.InlineImage:
+		return encodeLocationElement(inlineImageType, v.Location, v.Attributes)
+	case types.VideoBlock:
+		return encodeLocationElement(videoBlockType, v.Location, v.Attributes)
+	case types.AudioBlock:
+		return encodeLocationElement(audioBlockType, v.Location, v.Attributes)
+	case types.InlineLink:
+		return encodeLocationElement(inlineLinkType, v.Location, v.Attributes)
+	case types.InlineIcon:
+		n := newNode(inlineIconType)
+		n["name"] = v.Name
+		return n, n.setAttributes("attributes", v.Attributes)
+	case types.Footnote:
+		n := newNode(footnoteType)
+		n["id"] = v.ID
+		n["ref"] = v.Ref
+		return n, n.setElements("elements", v.Elements)
+	case types.DelimitedBlock:
+		n := newNode(delimitedBlockType)
+		n["kind"] = string(v.Kind)
+		if err := n.setAttributes("attributes", v.Attributes); err != nil {
+			return nil, err
+		}
+		return n, n.setElements("elements", v.Elements)
+	case types.Table:
+		n := newNode(tableType)
+		if err := n.setAttributes("attributes", v.Attributes); err != nil {
+			return nil, err
+		}
+		header, err := encodeValue(v.Header)
+		if err != nil {
+			return nil, err
+		}
+		n["header"] = header
+		if v.Lines != nil {
+			lines := make([]interface{}, len(v.Lines))
+			for i, line := range v.Lines {
+				if lines[i], err = encodeValue(line); err != nil {
+					return nil, err
+				}
+			}
+			n["lines"] = lines
+		}
+		return n, nil
+	case types.TableLine:
+		n := newNode(tableLineType)
+		return n, n.setLines("cells", v.Cells)
+	case types.LiteralBlock:
+		n := newNode(literalBlockType)
+		n.setStrings("lines", v.Lines)
+		return n, n.setAttributes("attributes", v.Attributes)
+	case types.InlineStem:
+		n := newNode(inlineStemType)
+		n["notation"] = string(v.Notation)
+		n["content"] = v.Content
+		return n, nil
+	case types.StemBlock:
+		n := newNode(stemBlockType)
+		n["notation"] = string(v.Notation)
+		n.setStrings("lines", v.Lines)
+		return n, n.setAttributes("attributes", v.Attributes)
+	case types.BlankLine:
+		return newNode(blankLineType), nil
+	case types.SingleLineComment:
+		n := newNode(singleLineCommentType)
+		n["content"] = v.Content
+		return n, nil
+	case types.StringElement:
+		n := newNode(stringType)
+		n["content"] = v.Content
+		return n, nil
+	case types.LineBreak:
+		return newNode(lineBreakType), nil
+	case types.QuotedText:
+		kind, found := quotedTextKinds[v.Kind]
+		if !found {
+			return nil, errors.Errorf("unsupported kind of quoted text: %d", v.Kind)
+		}
+		n := newNode(quotedTextType)
+		n["kind"] = kind
+		return n, n.setElements("elements", v.Elements)
+	case types.Passthrough:
+		kind, found := passthroughKinds[v.Kind]
+		if !found {
+			return nil, errors.Errorf("unsupported kind of passthrough: %d", v.Kind)
+		}
+		n := newNode(passthroughType)
+		n["kind"] = kind
+		return n, n.setElements("elements", v.Elements)
+	case types.FileInclusion:
+		n := newNode(fileInclusionType)
+		n["rawText"] = v.RawText
+		if err := n.setElements("location", v.Location.Elements); err != nil {
+			return nil, err
+		}
+		return n, n.setAttributes("attributes", v.Attributes)
+	case types.LineRange:
+		n := newNode(lineRangeType)
+		n["start"] = v.StartLine
+		n["end"] = v.EndLine
+		return n, nil
+	case types.LineRanges:
+		ranges := make([]interface{}, len(v))
+		for i, r := range v {
+			ranges[i], _ = encodeValue(r)
+		}
+		return node{"type": lineRangesType, "ranges": ranges}, nil
+	case types.TagRange:
+		n := newNode(tagRangeType)
+		n["name"] = v.Name
+		n["included"] = v.Included
+		return n, nil
+	case types.TagRanges:
+		ranges := make([]interface{}, len(v))
+		for i, r := range v {
+			ranges[i], _ = encodeValue(r)
+		}
+		return node{"type": tagRangesType, "ranges": ranges}, nil
+	case types.IncludedFileLine:
+		n := newNode(includedFileLineType)
+		return n, n.setElements("elements", v)
+	case types.IncludedFileStartTag:
+		n := newNode(includedFileStartTagType)
+		n["value"] = v.Value
+		return n, nil
+	case types.IncludedFileEndTag:
+		n := newNode(includedFileEndTagType)
+		n["value"] = v.Value
+		return n, nil
+	case types.ConceleadIndexTerm:
+		n := newNode(concealedIndexTermType)
+		for key, term := range map[string]interface{}{"term1": v.Term1, "term2": v.Term2, "term3": v.Term3} {
+			e, err := encodeValue(term)
+			if err != nil {
+				return nil, err
+			}
+			n[key] = e
+		}
+		return n, nil
+	default:
+		return nil, errors.Errorf("unsupported type of element: %T", value)
+	}
+}
+
+func encodeLocationElement(t string, location types.Location, attributes types.ElementAttributes) (node, error) {
+	n := newNode(t)
+	if err := n.setElements("location", location.Elements); err != nil {
+		return nil, err
+	}
+	return n, n.setAttributes("attributes", attributes)
+}
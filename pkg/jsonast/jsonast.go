@@ -0,0 +1,119 @@
+// Package jsonast provides a stable, versioned JSON serialization of the documents and of their elements,
+// so that they can be processed by non-Go tools (and converted back into a `types.Document`, to be rendered).
+//
+// The encoded document is wrapped in an envelope which contains the version of the format:
+//
+//	{
+//	  "version": 1,
+//	  "document": {
+//	    "type": "document",
+//	    "attributes": {...},
+//	    "elements": [...],
+//	    "elementReferences": {...},
+//	    "footnotes": [...],
+//	    "footnoteReferences": {...}
+//	  }
+//	}
+//
+// Each element is a JSON object with an explicit `type` discriminator (eg: `section`, `paragraph`, `string`),
+// along with its own fields, in camel case (eg: `level`, `attributes`, `title`, `elements` for a section).
+// Slices and maps which are `nil` in the Go structure are omitted.
+//
+// The values of the element and document attributes are encoded as follows:
+//   - strings and booleans as JSON strings and booleans
+//   - integers and floats as JSON numbers (decoded as `int` when they have no fractional part)
+//   - slices as JSON arrays
+//   - elements as JSON objects, with their `type` discriminator
+//   - values of the enumerated types of the `types` package (eg: `types.BlockKind`) as JSON objects with
+//     the name of the enumeration as the `type` discriminator and the actual value in the `value` field,
+//     eg: `{"type": "blockKind", "value": "source"}`
+//   - nested maps as JSON objects with the `attributes` (for `types.ElementAttributes`) or `map` discriminator,
+//     and the entries in the `entries` field.
+//
+// Any change in the format which would break existing consumers requires a new version.
+package jsonast
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// Version the current version of the JSON serialization format
+const Version = 1
+
+// envelope the top-level JSON object, with the version of the format and the encoded document
+type envelope struct {
+	Version  int         `json:"version"`
+	Document interface{} `json:"document"`
+}
+
+// Encode writes the JSON serialization of the given document in the given writer
+func Encode(w io.Writer, doc types.Document) error {
+	d, err := encodeDocument(doc)
+	if err != nil {
+		return errors.Wrap(err, "unable to encode document")
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(envelope{
+		Version:  Version,
+		Document: d,
+	}); err != nil {
+		return errors.Wrap(err, "unable to encode document")
+	}
+	return nil
+}
+
+// Decode reads the JSON serialization of a document from the given reader.
+// Returns an error if the content is not valid or if its version is not supported
+func Decode(r io.Reader) (types.Document, error) {
+	e := envelope{}
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	if err := dec.Decode(&e); err != nil {
+		return types.Document{}, errors.Wrap(err, "unable to decode document")
+	}
+	if e.Version != Version {
+		return types.Document{}, errors.Errorf("unable to decode document: unsupported version: %d", e.Version)
+	}
+	doc, err := decodeDocument(e.Document)
+	if err != nil {
+		return types.Document{}, errors.Wrap(err, "unable to decode document")
+	}
+	return doc, nil
+}
+
+// MarshalElement returns the JSON serialization of the given element (eg: a `types.Section`)
+func MarshalElement(element interface{}) ([]byte, error) {
+	e, err := encodeValue(element)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to encode element")
+	}
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(e); err != nil {
+		return nil, errors.Wrap(err, "unable to encode element")
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// UnmarshalElement returns the element from its JSON serialization
+func UnmarshalElement(data []byte) (interface{}, error) {
+	var content interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&content); err != nil {
+		return nil, errors.Wrap(err, "unable to decode element")
+	}
+	element, err := decodeValue(content)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decode element")
+	}
+	return element, nil
+}
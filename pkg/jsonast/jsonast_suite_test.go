@@ -0,0 +1,15 @@
+package jsonast_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/testsupport"
+)
+
+func TestJSONAST(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "JSON AST Suite")
+}
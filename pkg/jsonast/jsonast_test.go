@@ -0,0 +1,248 @@
+package jsonast_test
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/jsonast"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("json ast", func() {
+
+	Context("encoding", func() {
+
+		It("document with a section", func() {
+			doc := types.Document{
+				Attributes: types.DocumentAttributes{
+					"toclevels": 2,
+				},
+				Elements: []interface{}{
+					types.Section{
+						Level: 1,
+						Attributes: types.ElementAttributes{
+							types.AttrID:   "_section_<a>",
+							types.AttrKind: types.Source,
+						},
+						Title: []interface{}{
+							types.QuotedText{
+								Kind: types.Bold,
+								Elements: []interface{}{
+									types.StringElement{Content: "section <a>"},
+								},
+							},
+						},
+						Elements: []interface{}{},
+					},
+				},
+			}
+			expected := `{
+  "version": 1,
+  "document": {
+    "attributes": {
+      "toclevels": 2
+    },
+    "elements": [
+      {
+        "attributes": {
+          "id": "_section_<a>",
+          "kind": {
+            "type": "blockKind",
+            "value": "source"
+          }
+        },
+        "elements": [],
+        "level": 1,
+        "title": [
+          {
+            "elements": [
+              {
+                "content": "section <a>",
+                "type": "string"
+              }
+            ],
+            "kind": "bold",
+            "type": "quotedText"
+          }
+        ],
+        "type": "section"
+      }
+    ],
+    "type": "document"
+  }
+}
+`
+			buf := bytes.NewBuffer(nil)
+			err := jsonast.Encode(buf, doc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(buf.String()).To(Equal(expected))
+		})
+
+		It("single element", func() {
+			result, err := jsonast.MarshalElement(types.InlineLink{
+				Location: types.Location{
+					Elements: []interface{}{
+						types.StringElement{Content: "https://example.com"},
+					},
+				},
+				Attributes: types.ElementAttributes{},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(result)).To(Equal(`{"attributes":{},"location":[{"content":"https://example.com","type":"string"}],"type":"inlineLink"}`))
+		})
+
+		It("unsupported element", func() {
+			_, err := jsonast.MarshalElement(struct{}{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("decoding", func() {
+
+		It("single element", func() {
+			result, err := jsonast.UnmarshalElement([]byte(`{"type":"orderedList","items":[{"type":"orderedListItem","level":1,"numberingStyle":"arabic","elements":[]}],"attributes":{"start":3,"numberingStyle":{"type":"numberingStyle","value":"arabic"}}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(types.OrderedList{
+				Attributes: types.ElementAttributes{
+					types.AttrStart:          3,
+					types.AttrNumberingStyle: types.Arabic,
+				},
+				Items: []types.OrderedListItem{
+					{
+						Level:          1,
+						NumberingStyle: types.Arabic,
+						Elements:       []interface{}{},
+					},
+				},
+			}))
+		})
+
+		It("unsupported version", func() {
+			_, err := jsonast.Decode(strings.NewReader(`{"version": 2, "document": {"type": "document"}}`))
+			Expect(err).To(MatchError("unable to decode document: unsupported version: 2"))
+		})
+
+		It("missing type discriminator", func() {
+			_, err := jsonast.UnmarshalElement([]byte(`{"content":"foo"}`))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("unknown type discriminator", func() {
+			_, err := jsonast.UnmarshalElement([]byte(`{"type":"unknown"}`))
+			Expect(err).To(MatchError("unable to decode element: unsupported type of element: 'unknown'"))
+		})
+
+		It("invalid field", func() {
+			_, err := jsonast.UnmarshalElement([]byte(`{"type":"section","level":"one"}`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	table.DescribeTable("round trip",
+		func(source string) {
+			doc, err := parser.ParseDocument("", strings.NewReader(source))
+			Expect(err).NotTo(HaveOccurred())
+			buf := bytes.NewBuffer(nil)
+			err = jsonast.Encode(buf, doc)
+			Expect(err).NotTo(HaveOccurred())
+			result, err := jsonast.Decode(buf)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(doc))
+		},
+		table.Entry("header and sections", `= Document Title
+John Doe <john@example.com>
+v1.0, 2019-05-06: remark
+:toc:
+
+preamble
+
+== Section A
+
+content
+
+=== Section A.1
+
+more content`),
+		table.Entry("paragraphs and inline elements", `[#id.role]
+.Title
+some *bold*, _italic_, `+"`mono`"+`, ~sub~ and ^sup^ content +
+with a https://example.com[link], an image:foo.png[alt,20] and icon:tip[], 
+a footnote:[a note], footnoteref:[ref, a note with a ref] footnoteref:[ref] and a +passthrough+ and pass:[macro],
+a <<ref,cross reference>>, a link:other.adoc[external cross reference] and stem:[sqrt(4) = 2]
+((indexterm)) (((term1, term2, term3)))`),
+		table.Entry("lists", `. item 1
+.. item 1.1
+. item 2
+
+* [x] checked
+** nested
+- dash
+
+term 1:: description
+term 2::: nested`),
+		table.Entry("delimited blocks", `[source,go]
+----
+package main
+----
+
+....
+literal
+....
+
+  literal with spaces
+
+[quote, author, title]
+____
+a quote
+____
+
+[verse, author, title]
+____
+a verse
+____
+
+****
+sidebar
+****
+
+====
+example
+====
+
+[stem]
+++++
+sqrt(4) = 2
+++++
+
+// single line comment
+
+////
+comment
+////`),
+		table.Entry("blocks with attributes and macros", `:imagesdir: images
+
+image::foo.png[alt, 100, 200]
+
+video::video.mp4[width=640, start=10]
+
+audio::audio.mp3[]
+
+[NOTE]
+a note
+
+TIP: a tip
+
+toc::[]
+
+|===
+| Header 1 | Header 2
+
+| cell 1 | cell 2
+|===`),
+	)
+})
@@ -0,0 +1,573 @@
+package mathml
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ------------------------------------------
+// AsciiMath symbols
+// ------------------------------------------
+
+type amKind int
+
+const (
+	amConst amKind = iota
+	amUnderOver
+	amUnary
+	amBinary
+	amLeftBracket
+	amRightBracket
+	amText
+	amSpace
+)
+
+type amSymbol struct {
+	input  string
+	tag    string
+	output string
+	kind   amKind
+	// build the node of a unary or binary operation
+	build func(args ...*node) *node
+}
+
+func accent(over string) func(args ...*node) *node {
+	return func(args ...*node) *node {
+		return newNode("mover", args[0], mo(over)).withAttr("accent", "true")
+	}
+}
+
+func under(u string) func(args ...*node) *node {
+	return func(args ...*node) *node {
+		return newNode("munder", args[0], mo(u))
+	}
+}
+
+func fenced(left, right string) func(args ...*node) *node {
+	return func(args ...*node) *node {
+		return newNode("mrow", mo(left), args[0], mo(right))
+	}
+}
+
+func variant(v string) func(args ...*node) *node {
+	return func(args ...*node) *node {
+		return newNode("mstyle", args[0]).withAttr("mathvariant", v)
+	}
+}
+
+var amSymbols = []amSymbol{
+	// greek letters
+	{input: "alpha", tag: "mi", output: "α"},
+	{input: "beta", tag: "mi", output: "β"},
+	{input: "chi", tag: "mi", output: "χ"},
+	{input: "delta", tag: "mi", output: "δ"},
+	{input: "Delta", tag: "mo", output: "Δ"},
+	{input: "epsilon", tag: "mi", output: "ε"},
+	{input: "varepsilon", tag: "mi", output: "ɛ"},
+	{input: "eta", tag: "mi", output: "η"},
+	{input: "gamma", tag: "mi", output: "γ"},
+	{input: "Gamma", tag: "mo", output: "Γ"},
+	{input: "iota", tag: "mi", output: "ι"},
+	{input: "kappa", tag: "mi", output: "κ"},
+	{input: "lambda", tag: "mi", output: "λ"},
+	{input: "Lambda", tag: "mo", output: "Λ"},
+	{input: "lamda", tag: "mi", output: "λ"},
+	{input: "Lamda", tag: "mo", output: "Λ"},
+	{input: "mu", tag: "mi", output: "μ"},
+	{input: "nu", tag: "mi", output: "ν"},
+	{input: "omega", tag: "mi", output: "ω"},
+	{input: "Omega", tag: "mo", output: "Ω"},
+	{input: "phi", tag: "mi", output: "ϕ"},
+	{input: "varphi", tag: "mi", output: "φ"},
+	{input: "Phi", tag: "mo", output: "Φ"},
+	{input: "pi", tag: "mi", output: "π"},
+	{input: "Pi", tag: "mo", output: "Π"},
+	{input: "psi", tag: "mi", output: "ψ"},
+	{input: "Psi", tag: "mi", output: "Ψ"},
+	{input: "rho", tag: "mi", output: "ρ"},
+	{input: "sigma", tag: "mi", output: "σ"},
+	{input: "Sigma", tag: "mo", output: "Σ"},
+	{input: "tau", tag: "mi", output: "τ"},
+	{input: "theta", tag: "mi", output: "θ"},
+	{input: "vartheta", tag: "mi", output: "ϑ"},
+	{input: "Theta", tag: "mo", output: "Θ"},
+	{input: "upsilon", tag: "mi", output: "υ"},
+	{input: "xi", tag: "mi", output: "ξ"},
+	{input: "Xi", tag: "mo", output: "Ξ"},
+	{input: "zeta", tag: "mi", output: "ζ"},
+	// binary operation symbols
+	{input: "+", tag: "mo", output: "+"},
+	{input: "-", tag: "mo", output: "-"},
+	{input: "*", tag: "mo", output: "⋅"},
+	{input: "cdot", tag: "mo", output: "⋅"},
+	{input: "**", tag: "mo", output: "∗"},
+	{input: "***", tag: "mo", output: "⋆"},
+	{input: "//", tag: "mo", output: "/"},
+	{input: "\\\\", tag: "mo", output: "\\"},
+	{input: "xx", tag: "mo", output: "×"},
+	{input: "-:", tag: "mo", output: "÷"},
+	{input: "divide", tag: "mo", output: "÷"},
+	{input: "@", tag: "mo", output: "∘"},
+	{input: "o+", tag: "mo", output: "⊕"},
+	{input: "ox", tag: "mo", output: "⊗"},
+	{input: "o.", tag: "mo", output: "⊙"},
+	{input: "sum", tag: "mo", output: "∑", kind: amUnderOver},
+	{input: "prod", tag: "mo", output: "∏", kind: amUnderOver},
+	{input: "^^", tag: "mo", output: "∧"},
+	{input: "^^^", tag: "mo", output: "⋀", kind: amUnderOver},
+	{input: "vv", tag: "mo", output: "∨"},
+	{input: "vvv", tag: "mo", output: "⋁", kind: amUnderOver},
+	{input: "nn", tag: "mo", output: "∩"},
+	{input: "nnn", tag: "mo", output: "⋂", kind: amUnderOver},
+	{input: "uu", tag: "mo", output: "∪"},
+	{input: "uuu", tag: "mo", output: "⋃", kind: amUnderOver},
+	// relation symbols
+	{input: "=", tag: "mo", output: "="},
+	{input: "!=", tag: "mo", output: "≠"},
+	{input: ":=", tag: "mo", output: ":="},
+	{input: "lt", tag: "mo", output: "<"},
+	{input: "<=", tag: "mo", output: "≤"},
+	{input: "lt=", tag: "mo", output: "≤"},
+	{input: "gt", tag: "mo", output: ">"},
+	{input: ">=", tag: "mo", output: "≥"},
+	{input: "gt=", tag: "mo", output: "≥"},
+	{input: "-<", tag: "mo", output: "≺"},
+	{input: ">-", tag: "mo", output: "≻"},
+	{input: "-<=", tag: "mo", output: "⪯"},
+	{input: ">-=", tag: "mo", output: "⪰"},
+	{input: "in", tag: "mo", output: "∈"},
+	{input: "!in", tag: "mo", output: "∉"},
+	{input: "sub", tag: "mo", output: "⊂"},
+	{input: "sup", tag: "mo", output: "⊃"},
+	{input: "sube", tag: "mo", output: "⊆"},
+	{input: "supe", tag: "mo", output: "⊇"},
+	{input: "-=", tag: "mo", output: "≡"},
+	{input: "~=", tag: "mo", output: "≅"},
+	{input: "~~", tag: "mo", output: "≈"},
+	{input: "prop", tag: "mo", output: "∝"},
+	// logical symbols
+	{input: "and", tag: "mtext", output: "and", kind: amSpace},
+	{input: "or", tag: "mtext", output: "or", kind: amSpace},
+	{input: "not", tag: "mo", output: "¬"},
+	{input: "=>", tag: "mo", output: "⇒"},
+	{input: "if", tag: "mtext", output: "if", kind: amSpace},
+	{input: "<=>", tag: "mo", output: "⇔"},
+	{input: "iff", tag: "mo", output: "⇔"},
+	{input: "AA", tag: "mo", output: "∀"},
+	{input: "EE", tag: "mo", output: "∃"},
+	{input: "_|_", tag: "mo", output: "⊥"},
+	{input: "TT", tag: "mo", output: "⊤"},
+	{input: "|--", tag: "mo", output: "⊢"},
+	{input: "|==", tag: "mo", output: "⊨"},
+	// miscellaneous symbols
+	{input: "int", tag: "mo", output: "∫"},
+	{input: "oint", tag: "mo", output: "∮"},
+	{input: "del", tag: "mo", output: "∂"},
+	{input: "grad", tag: "mo", output: "∇"},
+	{input: "+-", tag: "mo", output: "±"},
+	{input: "O/", tag: "mo", output: "∅"},
+	{input: "oo", tag: "mo", output: "∞"},
+	{input: "aleph", tag: "mo", output: "ℵ"},
+	{input: "/_", tag: "mo", output: "∠"},
+	{input: ":.", tag: "mo", output: "∴"},
+	{input: "...", tag: "mo", output: "..."},
+	{input: "cdots", tag: "mo", output: "⋯"},
+	{input: "vdots", tag: "mo", output: "⋮"},
+	{input: "ddots", tag: "mo", output: "⋱"},
+	{input: "diamond", tag: "mo", output: "⋄"},
+	{input: "square", tag: "mo", output: "□"},
+	{input: "|__", tag: "mo", output: "⌊"},
+	{input: "__|", tag: "mo", output: "⌋"},
+	{input: "|~", tag: "mo", output: "⌈"},
+	{input: "~|", tag: "mo", output: "⌉"},
+	{input: "CC", tag: "mo", output: "ℂ"},
+	{input: "NN", tag: "mo", output: "ℕ"},
+	{input: "QQ", tag: "mo", output: "ℚ"},
+	{input: "RR", tag: "mo", output: "ℝ"},
+	{input: "ZZ", tag: "mo", output: "ℤ"},
+	{input: "quad", tag: "mspace", output: "1em", kind: amSpace},
+	{input: "qquad", tag: "mspace", output: "2em", kind: amSpace},
+	// standard functions
+	{input: "lim", tag: "mo", output: "lim", kind: amUnderOver},
+	{input: "Lim", tag: "mo", output: "Lim", kind: amUnderOver},
+	{input: "min", tag: "mo", output: "min", kind: amUnderOver},
+	{input: "max", tag: "mo", output: "max", kind: amUnderOver},
+	{input: "sin", tag: "mi", output: "sin"},
+	{input: "cos", tag: "mi", output: "cos"},
+	{input: "tan", tag: "mi", output: "tan"},
+	{input: "sec", tag: "mi", output: "sec"},
+	{input: "csc", tag: "mi", output: "csc"},
+	{input: "cot", tag: "mi", output: "cot"},
+	{input: "sinh", tag: "mi", output: "sinh"},
+	{input: "cosh", tag: "mi", output: "cosh"},
+	{input: "tanh", tag: "mi", output: "tanh"},
+	{input: "arcsin", tag: "mi", output: "arcsin"},
+	{input: "arccos", tag: "mi", output: "arccos"},
+	{input: "arctan", tag: "mi", output: "arctan"},
+	{input: "exp", tag: "mi", output: "exp"},
+	{input: "log", tag: "mi", output: "log"},
+	{input: "ln", tag: "mi", output: "ln"},
+	{input: "det", tag: "mi", output: "det"},
+	{input: "dim", tag: "mi", output: "dim"},
+	{input: "mod", tag: "mi", output: "mod"},
+	{input: "gcd", tag: "mi", output: "gcd"},
+	{input: "lcm", tag: "mi", output: "lcm"},
+	{input: "lub", tag: "mi", output: "lub"},
+	{input: "glb", tag: "mi", output: "glb"},
+	// arrows
+	{input: "uarr", tag: "mo", output: "↑"},
+	{input: "darr", tag: "mo", output: "↓"},
+	{input: "rarr", tag: "mo", output: "→"},
+	{input: "->", tag: "mo", output: "→"},
+	{input: "to", tag: "mo", output: "→"},
+	{input: "|->", tag: "mo", output: "↦"},
+	{input: "larr", tag: "mo", output: "←"},
+	{input: "harr", tag: "mo", output: "↔"},
+	{input: "rArr", tag: "mo", output: "⇒"},
+	{input: "lArr", tag: "mo", output: "⇐"},
+	{input: "hArr", tag: "mo", output: "⇔"},
+	// brackets
+	{input: "(", tag: "mo", output: "(", kind: amLeftBracket},
+	{input: ")", tag: "mo", output: ")", kind: amRightBracket},
+	{input: "[", tag: "mo", output: "[", kind: amLeftBracket},
+	{input: "]", tag: "mo", output: "]", kind: amRightBracket},
+	{input: "{", tag: "mo", output: "{", kind: amLeftBracket},
+	{input: "}", tag: "mo", output: "}", kind: amRightBracket},
+	{input: "(:", tag: "mo", output: "⟨", kind: amLeftBracket},
+	{input: ":)", tag: "mo", output: "⟩", kind: amRightBracket},
+	{input: "<<", tag: "mo", output: "⟨", kind: amLeftBracket},
+	{input: ">>", tag: "mo", output: "⟩", kind: amRightBracket},
+	{input: "{:", tag: "mo", output: "", kind: amLeftBracket},
+	{input: ":}", tag: "mo", output: "", kind: amRightBracket},
+	// unary operations
+	{input: "sqrt", kind: amUnary, build: func(args ...*node) *node { return newNode("msqrt", args[0]) }},
+	{input: "hat", kind: amUnary, build: accent("^")},
+	{input: "bar", kind: amUnary, build: accent("¯")},
+	{input: "vec", kind: amUnary, build: accent("→")},
+	{input: "dot", kind: amUnary, build: accent(".")},
+	{input: "ddot", kind: amUnary, build: accent("..")},
+	{input: "tilde", kind: amUnary, build: accent("~")},
+	{input: "obrace", kind: amUnary, build: accent("⏞")},
+	{input: "ul", kind: amUnary, build: under("̲")},
+	{input: "underline", kind: amUnary, build: under("̲")},
+	{input: "ubrace", kind: amUnary, build: under("⏟")},
+	{input: "abs", kind: amUnary, build: fenced("|", "|")},
+	{input: "floor", kind: amUnary, build: fenced("⌊", "⌋")},
+	{input: "ceil", kind: amUnary, build: fenced("⌈", "⌉")},
+	{input: "norm", kind: amUnary, build: fenced("∥", "∥")},
+	{input: "bb", kind: amUnary, build: variant("bold")},
+	{input: "bbb", kind: amUnary, build: variant("double-struck")},
+	{input: "cc", kind: amUnary, build: variant("script")},
+	{input: "tt", kind: amUnary, build: variant("monospace")},
+	{input: "fr", kind: amUnary, build: variant("fraktur")},
+	{input: "sf", kind: amUnary, build: variant("sans-serif")},
+	{input: "cancel", kind: amUnary, build: func(args ...*node) *node {
+		return newNode("menclose", args[0]).withAttr("notation", "updiagonalstrike")
+	}},
+	{input: "text", kind: amText},
+	{input: "mbox", kind: amText},
+	// binary operations
+	{input: "frac", kind: amBinary, build: func(args ...*node) *node { return newNode("mfrac", args[0], args[1]) }},
+	{input: "root", kind: amBinary, build: func(args ...*node) *node { return newNode("mroot", args[1], args[0]) }},
+	{input: "stackrel", kind: amBinary, build: func(args ...*node) *node { return newNode("mover", args[1], args[0]) }},
+	{input: "overset", kind: amBinary, build: func(args ...*node) *node { return newNode("mover", args[1], args[0]) }},
+	{input: "underset", kind: amBinary, build: func(args ...*node) *node { return newNode("munder", args[1], args[0]) }},
+	{input: "color", kind: amBinary, build: func(args ...*node) *node {
+		return newNode("mstyle", args[1]).withAttr("mathcolor", textContent(args[0]))
+	}},
+}
+
+// textContent returns the concatenated text of the given node and its children
+func textContent(n *node) string {
+	result := strings.Builder{}
+	result.WriteString(n.text)
+	for _, c := range n.children {
+		result.WriteString(textContent(c))
+	}
+	return result.String()
+}
+
+// ------------------------------------------
+// AsciiMath parser
+// ------------------------------------------
+
+type amToken struct {
+	*amSymbol
+	text string // the actual input
+}
+
+type amParser struct {
+	input string
+	pos   int
+}
+
+func parseAsciiMath(expr string) ([]*node, error) {
+	p := &amParser{
+		input: expr,
+	}
+	result := []*node{}
+	for {
+		result = append(result, p.parseExpression()...)
+		t := p.next()
+		if t == nil {
+			break
+		}
+		// unmatched closing bracket
+		if t.output != "" {
+			result = append(result, mo(t.output))
+		}
+	}
+	return result, nil
+}
+
+func (p *amParser) skipSpaces() {
+	for p.pos < len(p.input) {
+		r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		p.pos += size
+	}
+}
+
+// peek returns the next token, without consuming it
+func (p *amParser) peek() *amToken {
+	pos := p.pos
+	defer func() {
+		p.pos = pos
+	}()
+	return p.next()
+}
+
+// next returns the next token, or nil if the end of input was reached
+func (p *amParser) next() *amToken {
+	p.skipSpaces()
+	if p.pos >= len(p.input) {
+		return nil
+	}
+	remaining := p.input[p.pos:]
+	// quoted text
+	if remaining[0] == '"' {
+		end := strings.IndexByte(remaining[1:], '"')
+		if end == -1 {
+			end = len(remaining) - 1
+		}
+		p.pos += end + 1
+		if p.pos < len(p.input) {
+			p.pos++ // closing quote
+		}
+		return &amToken{
+			amSymbol: &amSymbol{tag: "mtext", kind: amConst, output: remaining[1 : end+1]},
+			text:     remaining[:end+1],
+		}
+	}
+	// numbers
+	if remaining[0] >= '0' && remaining[0] <= '9' {
+		end := 0
+		for end < len(remaining) && (remaining[end] >= '0' && remaining[end] <= '9' || remaining[end] == '.' && end+1 < len(remaining) && remaining[end+1] >= '0' && remaining[end+1] <= '9') {
+			end++
+		}
+		p.pos += end
+		return &amToken{
+			amSymbol: &amSymbol{tag: "mn", kind: amConst, output: remaining[:end]},
+			text:     remaining[:end],
+		}
+	}
+	// symbols (longest match wins)
+	var match *amSymbol
+	for i, s := range amSymbols {
+		if strings.HasPrefix(remaining, s.input) && (match == nil || len(s.input) > len(match.input)) {
+			match = &amSymbols[i]
+		}
+	}
+	if match != nil {
+		p.pos += len(match.input)
+		return &amToken{
+			amSymbol: match,
+			text:     match.input,
+		}
+	}
+	// any other character
+	r, size := utf8.DecodeRuneInString(remaining)
+	p.pos += size
+	tag := "mo"
+	if unicode.IsLetter(r) {
+		tag = "mi"
+	}
+	return &amToken{
+		amSymbol: &amSymbol{tag: tag, kind: amConst, output: string(r)},
+		text:     string(r),
+	}
+}
+
+// parseExpression parses a sequence of intermediate expressions, until the end of the input
+// or a closing bracket (which is not consumed)
+func (p *amParser) parseExpression() []*node {
+	result := []*node{}
+	for {
+		t := p.peek()
+		if t == nil || t.kind == amRightBracket {
+			return result
+		}
+		n := p.parseIntermediate()
+		if t := p.peek(); t != nil && t.text == "/" {
+			p.next()
+			denominator := p.parseIntermediate()
+			n = newNode("mfrac", unwrapBrackets(n), unwrapBrackets(denominator))
+		}
+		result = append(result, n)
+	}
+}
+
+// parseIntermediate parses a simple expression, with its optional subscript and superscript
+func (p *amParser) parseIntermediate() *node {
+	underOver := false
+	if t := p.peek(); t != nil && t.kind == amUnderOver {
+		underOver = true
+	}
+	base := p.parseSimple()
+	var sub, sup *node
+	if t := p.peek(); t != nil && t.text == "_" {
+		p.next()
+		sub = unwrapBrackets(p.parseSimple())
+	}
+	if t := p.peek(); t != nil && t.text == "^" {
+		p.next()
+		sup = unwrapBrackets(p.parseSimple())
+	}
+	switch {
+	case sub != nil && sup != nil && underOver:
+		return newNode("munderover", base, sub, sup)
+	case sub != nil && sup != nil:
+		return newNode("msubsup", base, sub, sup)
+	case sub != nil && underOver:
+		return newNode("munder", base, sub)
+	case sub != nil:
+		return newNode("msub", base, sub)
+	case sup != nil && underOver:
+		return newNode("mover", base, sup)
+	case sup != nil:
+		return newNode("msup", base, sup)
+	default:
+		return base
+	}
+}
+
+// parseSimple parses a symbol, a bracketed expression or a unary/binary operation
+func (p *amParser) parseSimple() *node {
+	t := p.peek()
+	if t == nil || t.kind == amRightBracket {
+		return newNode("mrow")
+	}
+	p.next()
+	switch t.kind {
+	case amLeftBracket:
+		return p.parseBracketed(t)
+	case amText:
+		return mtext(p.readRawArgument())
+	case amUnary:
+		if next := p.peek(); next == nil || next.kind == amRightBracket {
+			return mo(t.text)
+		}
+		return t.build(unwrapBrackets(p.parseSimple()))
+	case amBinary:
+		arg1 := unwrapBrackets(p.parseSimple())
+		arg2 := unwrapBrackets(p.parseSimple())
+		return t.build(arg1, arg2)
+	case amSpace:
+		if t.tag == "mspace" {
+			return mspace(t.output)
+		}
+		return newNode("mrow", mspace("1ex"), mtext(t.output), mspace("1ex"))
+	default:
+		return newToken(t.tag, t.output)
+	}
+}
+
+func (p *amParser) parseBracketed(left *amToken) *node {
+	content := p.parseExpression()
+	right := p.peek()
+	if right != nil {
+		p.next() // consume the closing bracket
+	}
+	if m := toMatrix(content); m != nil {
+		content = []*node{m}
+	}
+	children := []*node{}
+	if left.output != "" {
+		children = append(children, mo(left.output))
+	}
+	children = append(children, content...)
+	if right != nil && right.output != "" {
+		children = append(children, mo(right.output))
+	}
+	result := newNode("mrow", children...)
+	result.bracketed = left.output != "" && right != nil && right.output != ""
+	return result
+}
+
+// readRawArgument reads the raw content of a bracketed argument, eg: the `...` in `text(...)`
+func (p *amParser) readRawArgument() string {
+	p.skipSpaces()
+	if p.pos >= len(p.input) {
+		return ""
+	}
+	closing := map[byte]byte{'(': ')', '[': ']', '{': '}'}[p.input[p.pos]]
+	if closing == 0 {
+		return ""
+	}
+	end := strings.IndexByte(p.input[p.pos+1:], closing)
+	if end == -1 {
+		end = len(p.input) - p.pos - 1
+	}
+	result := p.input[p.pos+1 : p.pos+1+end]
+	p.pos += end + 2
+	if p.pos > len(p.input) {
+		p.pos = len(p.input)
+	}
+	return result
+}
+
+// toMatrix returns an `<mtable>` if the given content is a sequence of comma-separated
+// bracketed rows with the same number of comma-separated cells, eg: `(1,2),(3,4)`.
+// Returns `nil` otherwise.
+func toMatrix(content []*node) *node {
+	if len(content) < 3 || len(content)%2 == 0 {
+		return nil
+	}
+	rows := []*node{}
+	columns := -1
+	for i, n := range content {
+		if i%2 == 1 {
+			if !n.isOperator(",") {
+				return nil
+			}
+			continue
+		}
+		if !n.bracketed {
+			return nil
+		}
+		cells := splitCells(n.children[1 : len(n.children)-1])
+		if columns != -1 && len(cells) != columns {
+			return nil
+		}
+		columns = len(cells)
+		rows = append(rows, newNode("mtr", cells...))
+	}
+	return newNode("mtable", rows...)
+}
+
+func splitCells(content []*node) []*node {
+	cells := []*node{}
+	cell := []*node{}
+	for _, n := range content {
+		if n.isOperator(",") {
+			cells = append(cells, newNode("mtd", mrow(cell...)))
+			cell = []*node{}
+			continue
+		}
+		cell = append(cell, n)
+	}
+	return append(cells, newNode("mtd", mrow(cell...)))
+}
@@ -0,0 +1,47 @@
+package mathml_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/mathml"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("asciimath to mathml",
+	func(expr, expected string) {
+		result, err := mathml.FromAsciiMath(expr, mathml.Inline)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(`<math xmlns="http://www.w3.org/1998/Math/MathML">` + expected + `</math>`))
+	},
+	Entry("single identifier", "x", `<mi>x</mi>`),
+	Entry("number", "3.14", `<mn>3.14</mn>`),
+	Entry("square root", "sqrt(4) = 2", `<msqrt><mn>4</mn></msqrt><mo>=</mo><mn>2</mn>`),
+	Entry("root", "root(3)(x)", `<mroot><mi>x</mi><mn>3</mn></mroot>`),
+	Entry("fraction with slash", "a/b", `<mfrac><mi>a</mi><mi>b</mi></mfrac>`),
+	Entry("fraction with brackets", "(a+1)/2", `<mfrac><mrow><mi>a</mi><mo>+</mo><mn>1</mn></mrow><mn>2</mn></mfrac>`),
+	Entry("frac operation", "frac(1)(2)", `<mfrac><mn>1</mn><mn>2</mn></mfrac>`),
+	Entry("subscript and superscript", "x_1^2", `<msubsup><mi>x</mi><mn>1</mn><mn>2</mn></msubsup>`),
+	Entry("sum with limits", "sum_(i=1)^n i",
+		`<munderover><mo>∑</mo><mrow><mi>i</mi><mo>=</mo><mn>1</mn></mrow><mi>n</mi></munderover><mi>i</mi>`),
+	Entry("greek letters", "alpha + Omega", `<mi>α</mi><mo>+</mo><mo>Ω</mo>`),
+	Entry("function", "sin x", `<mi>sin</mi><mi>x</mi>`),
+	Entry("text", `text(speed) = "distance"`, `<mtext>speed</mtext><mo>=</mo><mtext>distance</mtext>`),
+	Entry("accent", "vec v", `<mover accent="true"><mi>v</mi><mo>→</mo></mover>`),
+	Entry("absolute value", "abs(x)", `<mrow><mo>|</mo><mi>x</mi><mo>|</mo></mrow>`),
+	Entry("font", "bbb R", `<mstyle mathvariant="double-struck"><mi>R</mi></mstyle>`),
+	Entry("matrix", "[(a,b),(c,d)]",
+		`<mrow><mo>[</mo><mtable><mtr><mtd><mi>a</mi></mtd><mtd><mi>b</mi></mtd></mtr><mtr><mtd><mi>c</mi></mtd><mtd><mi>d</mi></mtd></mtr></mtable><mo>]</mo></mrow>`),
+	Entry("invisible brackets", "{:x:}", `<mrow><mi>x</mi></mrow>`),
+	Entry("unmatched closing bracket", "x)", `<mi>x</mi><mo>)</mo>`),
+	Entry("escaped characters", "a < b", `<mi>a</mi><mo>&lt;</mo><mi>b</mi>`),
+)
+
+var _ = Describe("asciimath display", func() {
+
+	It("block display", func() {
+		result, err := mathml.FromAsciiMath("x", mathml.Block)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(`<math xmlns="http://www.w3.org/1998/Math/MathML" display="block"><mi>x</mi></math>`))
+	})
+})
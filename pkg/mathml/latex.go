@@ -0,0 +1,778 @@
+package mathml
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// ------------------------------------------
+// LaTeX symbols
+// ------------------------------------------
+
+// latexSymbols the LaTeX commands which are rendered as a single `<mi>` or `<mo>` element
+var latexSymbols = map[string]*node{
+	// greek letters
+	"alpha":      mi("α"),
+	"beta":       mi("β"),
+	"gamma":      mi("γ"),
+	"delta":      mi("δ"),
+	"epsilon":    mi("ϵ"),
+	"varepsilon": mi("ε"),
+	"zeta":       mi("ζ"),
+	"eta":        mi("η"),
+	"theta":      mi("θ"),
+	"vartheta":   mi("ϑ"),
+	"iota":       mi("ι"),
+	"kappa":      mi("κ"),
+	"lambda":     mi("λ"),
+	"mu":         mi("μ"),
+	"nu":         mi("ν"),
+	"xi":         mi("ξ"),
+	"pi":         mi("π"),
+	"varpi":      mi("ϖ"),
+	"rho":        mi("ρ"),
+	"varrho":     mi("ϱ"),
+	"sigma":      mi("σ"),
+	"varsigma":   mi("ς"),
+	"tau":        mi("τ"),
+	"upsilon":    mi("υ"),
+	"phi":        mi("ϕ"),
+	"varphi":     mi("φ"),
+	"chi":        mi("χ"),
+	"psi":        mi("ψ"),
+	"omega":      mi("ω"),
+	"Gamma":      mi("Γ"),
+	"Delta":      mi("Δ"),
+	"Theta":      mi("Θ"),
+	"Lambda":     mi("Λ"),
+	"Xi":         mi("Ξ"),
+	"Pi":         mi("Π"),
+	"Sigma":      mi("Σ"),
+	"Upsilon":    mi("Υ"),
+	"Phi":        mi("Φ"),
+	"Psi":        mi("Ψ"),
+	"Omega":      mi("Ω"),
+	// binary operators
+	"pm":       mo("±"),
+	"mp":       mo("∓"),
+	"times":    mo("×"),
+	"div":      mo("÷"),
+	"cdot":     mo("⋅"),
+	"ast":      mo("∗"),
+	"star":     mo("⋆"),
+	"circ":     mo("∘"),
+	"bullet":   mo("∙"),
+	"oplus":    mo("⊕"),
+	"ominus":   mo("⊖"),
+	"otimes":   mo("⊗"),
+	"oslash":   mo("⊘"),
+	"odot":     mo("⊙"),
+	"cap":      mo("∩"),
+	"cup":      mo("∪"),
+	"wedge":    mo("∧"),
+	"land":     mo("∧"),
+	"vee":      mo("∨"),
+	"lor":      mo("∨"),
+	"setminus": mo("∖"),
+	// relations
+	"leq":       mo("≤"),
+	"le":        mo("≤"),
+	"geq":       mo("≥"),
+	"ge":        mo("≥"),
+	"neq":       mo("≠"),
+	"ne":        mo("≠"),
+	"equiv":     mo("≡"),
+	"approx":    mo("≈"),
+	"cong":      mo("≅"),
+	"sim":       mo("∼"),
+	"simeq":     mo("≃"),
+	"propto":    mo("∝"),
+	"ll":        mo("≪"),
+	"gg":        mo("≫"),
+	"prec":      mo("≺"),
+	"succ":      mo("≻"),
+	"in":        mo("∈"),
+	"notin":     mo("∉"),
+	"ni":        mo("∋"),
+	"subset":    mo("⊂"),
+	"supset":    mo("⊃"),
+	"subseteq":  mo("⊆"),
+	"supseteq":  mo("⊇"),
+	"mid":       mo("∣"),
+	"parallel":  mo("∥"),
+	"perp":      mo("⊥"),
+	"vdash":     mo("⊢"),
+	"models":    mo("⊨"),
+	"colon":     mo(":"),
+	"coloneqq":  mo("≔"),
+	"triangleq": mo("≜"),
+	// arrows
+	"to":              mo("→"),
+	"rightarrow":      mo("→"),
+	"leftarrow":       mo("←"),
+	"gets":            mo("←"),
+	"leftrightarrow":  mo("↔"),
+	"Rightarrow":      mo("⇒"),
+	"Leftarrow":       mo("⇐"),
+	"Leftrightarrow":  mo("⇔"),
+	"implies":         mo("⟹"),
+	"iff":             mo("⟺"),
+	"mapsto":          mo("↦"),
+	"uparrow":         mo("↑"),
+	"downarrow":       mo("↓"),
+	"longrightarrow":  mo("⟶"),
+	"longleftarrow":   mo("⟵"),
+	"hookrightarrow":  mo("↪"),
+	"rightharpoonup":  mo("⇀"),
+	"leftharpoonup":   mo("↼"),
+	"nearrow":         mo("↗"),
+	"searrow":         mo("↘"),
+	"Longrightarrow":  mo("⟹"),
+	"Longleftarrow":   mo("⟸"),
+	"longmapsto":      mo("⟼"),
+	"rightleftarrows": mo("⇄"),
+	// big operators
+	"sum":       mo("∑"),
+	"prod":      mo("∏"),
+	"coprod":    mo("∐"),
+	"int":       mo("∫"),
+	"iint":      mo("∬"),
+	"iiint":     mo("∭"),
+	"oint":      mo("∮"),
+	"bigcup":    mo("⋃"),
+	"bigcap":    mo("⋂"),
+	"bigvee":    mo("⋁"),
+	"bigwedge":  mo("⋀"),
+	"bigoplus":  mo("⨁"),
+	"bigotimes": mo("⨂"),
+	// miscellaneous symbols
+	"infty":      mi("∞"),
+	"partial":    mo("∂"),
+	"nabla":      mo("∇"),
+	"forall":     mo("∀"),
+	"exists":     mo("∃"),
+	"nexists":    mo("∄"),
+	"neg":        mo("¬"),
+	"lnot":       mo("¬"),
+	"emptyset":   mi("∅"),
+	"varnothing": mi("∅"),
+	"aleph":      mi("ℵ"),
+	"hbar":       mi("ℏ"),
+	"ell":        mi("ℓ"),
+	"Re":         mi("ℜ"),
+	"Im":         mi("ℑ"),
+	"angle":      mo("∠"),
+	"triangle":   mo("△"),
+	"top":        mo("⊤"),
+	"bot":        mo("⊥"),
+	"prime":      mo("′"),
+	"therefore":  mo("∴"),
+	"because":    mo("∵"),
+	"ldots":      mo("…"),
+	"dots":       mo("…"),
+	"cdots":      mo("⋯"),
+	"vdots":      mo("⋮"),
+	"ddots":      mo("⋱"),
+	"langle":     mo("⟨"),
+	"rangle":     mo("⟩"),
+	"lfloor":     mo("⌊"),
+	"rfloor":     mo("⌋"),
+	"lceil":      mo("⌈"),
+	"rceil":      mo("⌉"),
+	"lvert":      mo("|"),
+	"rvert":      mo("|"),
+	"vert":       mo("|"),
+	"lVert":      mo("∥"),
+	"rVert":      mo("∥"),
+	"Vert":       mo("∥"),
+	"{":          mo("{"),
+	"}":          mo("}"),
+	"|":          mo("∥"),
+	"%":          mo("%"),
+	"$":          mo("$"),
+	"#":          mo("#"),
+	"&":          mo("&"),
+	"_":          mo("_"),
+	"backslash":  mo("\\"),
+	// functions
+	"sin":    mi("sin"),
+	"cos":    mi("cos"),
+	"tan":    mi("tan"),
+	"sec":    mi("sec"),
+	"csc":    mi("csc"),
+	"cot":    mi("cot"),
+	"sinh":   mi("sinh"),
+	"cosh":   mi("cosh"),
+	"tanh":   mi("tanh"),
+	"arcsin": mi("arcsin"),
+	"arccos": mi("arccos"),
+	"arctan": mi("arctan"),
+	"exp":    mi("exp"),
+	"log":    mi("log"),
+	"ln":     mi("ln"),
+	"lg":     mi("lg"),
+	"det":    mi("det"),
+	"dim":    mi("dim"),
+	"ker":    mi("ker"),
+	"deg":    mi("deg"),
+	"gcd":    mi("gcd"),
+	"arg":    mi("arg"),
+	"Pr":     mi("Pr"),
+	"bmod":   mo("mod"),
+	"lim":    mo("lim"),
+	"limsup": mo("lim sup"),
+	"liminf": mo("lim inf"),
+	"max":    mo("max"),
+	"min":    mo("min"),
+	"sup":    mo("sup"),
+	"inf":    mo("inf"),
+}
+
+// latexUnderOver the commands whose subscripts and superscripts are rendered under and over the symbol
+var latexUnderOver = map[string]bool{
+	"sum":       true,
+	"prod":      true,
+	"coprod":    true,
+	"bigcup":    true,
+	"bigcap":    true,
+	"bigvee":    true,
+	"bigwedge":  true,
+	"bigoplus":  true,
+	"bigotimes": true,
+	"lim":       true,
+	"limsup":    true,
+	"liminf":    true,
+	"max":       true,
+	"min":       true,
+	"sup":       true,
+	"inf":       true,
+}
+
+var latexSpaces = map[string]string{
+	",":     "0.167em",
+	":":     "0.222em",
+	">":     "0.222em",
+	";":     "0.278em",
+	" ":     "0.333em",
+	"!":     "-0.167em",
+	"quad":  "1em",
+	"qquad": "2em",
+}
+
+var latexAccents = map[string]string{
+	"hat":       "^",
+	"widehat":   "^",
+	"bar":       "¯",
+	"overline":  "¯",
+	"vec":       "→",
+	"dot":       "˙",
+	"ddot":      "¨",
+	"tilde":     "~",
+	"widetilde": "~",
+	"overbrace": "⏞",
+}
+
+var latexUnderAccents = map[string]string{
+	"underline":  "_",
+	"underbrace": "⏟",
+}
+
+var latexFonts = map[string]string{
+	"mathbf":     "bold",
+	"boldsymbol": "bold-italic",
+	"mathit":     "italic",
+	"mathrm":     "normal",
+	"mathbb":     "double-struck",
+	"mathcal":    "script",
+	"mathscr":    "script",
+	"mathfrak":   "fraktur",
+	"mathsf":     "sans-serif",
+	"mathtt":     "monospace",
+}
+
+var latexTexts = map[string]string{
+	"text":   "",
+	"textrm": "",
+	"mbox":   "",
+	"textbf": "bold",
+	"textit": "italic",
+	"texttt": "monospace",
+}
+
+// latexMatrices the supported environments, with their opening and closing delimiters
+var latexMatrices = map[string][2]string{
+	"matrix":  {"", ""},
+	"pmatrix": {"(", ")"},
+	"bmatrix": {"[", "]"},
+	"Bmatrix": {"{", "}"},
+	"vmatrix": {"|", "|"},
+	"Vmatrix": {"∥", "∥"},
+	"cases":   {"{", ""},
+	"aligned": {"", ""},
+	"array":   {"", ""},
+}
+
+// ------------------------------------------
+// LaTeX parser
+// ------------------------------------------
+
+type latexTokenKind int
+
+const (
+	latexCommand latexTokenKind = iota
+	latexNumber
+	latexLetter
+	latexChar
+)
+
+type latexToken struct {
+	kind  latexTokenKind
+	value string
+}
+
+type latexParser struct {
+	input string
+	pos   int
+}
+
+func parseLaTeX(expr string) ([]*node, error) {
+	p := &latexParser{
+		input: expr,
+	}
+	result, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t != nil {
+		return nil, errors.Errorf("unexpected '%s' at position %d", t.value, p.pos)
+	}
+	return result, nil
+}
+
+func (p *latexParser) skipSpaces() {
+	for p.pos < len(p.input) {
+		r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		p.pos += size
+	}
+}
+
+// peek returns the next token, without consuming it
+func (p *latexParser) peek() *latexToken {
+	pos := p.pos
+	defer func() {
+		p.pos = pos
+	}()
+	return p.next()
+}
+
+// next returns the next token, or nil if the end of input was reached
+func (p *latexParser) next() *latexToken {
+	p.skipSpaces()
+	if p.pos >= len(p.input) {
+		return nil
+	}
+	remaining := p.input[p.pos:]
+	switch {
+	case remaining[0] == '\\' && len(remaining) > 1:
+		end := 1
+		for end < len(remaining) && isASCIILetter(remaining[end]) {
+			end++
+		}
+		if end == 1 {
+			// control symbol, eg: `\,` or `\{`
+			_, size := utf8.DecodeRuneInString(remaining[1:])
+			end = 1 + size
+		}
+		p.pos += end
+		return &latexToken{kind: latexCommand, value: remaining[1:end]}
+	case remaining[0] >= '0' && remaining[0] <= '9':
+		end := 0
+		for end < len(remaining) && (remaining[end] >= '0' && remaining[end] <= '9' || remaining[end] == '.' && end+1 < len(remaining) && remaining[end+1] >= '0' && remaining[end+1] <= '9') {
+			end++
+		}
+		p.pos += end
+		return &latexToken{kind: latexNumber, value: remaining[:end]}
+	}
+	r, size := utf8.DecodeRuneInString(remaining)
+	p.pos += size
+	if unicode.IsLetter(r) {
+		return &latexToken{kind: latexLetter, value: string(r)}
+	}
+	return &latexToken{kind: latexChar, value: string(r)}
+}
+
+func isASCIILetter(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
+
+// isTerminator returns true if the given token ends the current expression
+func isTerminator(t *latexToken) bool {
+	if t == nil {
+		return true
+	}
+	switch {
+	case t.kind == latexChar && (t.value == "}" || t.value == "&"):
+		return true
+	case t.kind == latexCommand && (t.value == "right" || t.value == "end" || t.value == "\\"):
+		return true
+	default:
+		return false
+	}
+}
+
+// parseExpression parses a sequence of atoms (with their optional subscripts and superscripts),
+// until the end of the input or a terminator (which is not consumed)
+func (p *latexParser) parseExpression() ([]*node, error) {
+	result := []*node{}
+	for {
+		if isTerminator(p.peek()) {
+			return result, nil
+		}
+		n, err := p.parseScripted()
+		if err != nil {
+			return nil, err
+		}
+		if n != nil {
+			result = append(result, n)
+		}
+	}
+}
+
+// parseScripted parses an atom and its optional subscript and superscript
+func (p *latexParser) parseScripted() (*node, error) {
+	underOver := false
+	if t := p.peek(); t != nil && t.kind == latexCommand && latexUnderOver[t.value] {
+		underOver = true
+	}
+	base, err := p.parseAtom()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t != nil && t.kind == latexCommand && (t.value == "limits" || t.value == "nolimits") {
+		p.next()
+		underOver = t.value == "limits"
+	}
+	var sub, sup *node
+	for {
+		t := p.peek()
+		if t == nil || t.kind != latexChar {
+			break
+		}
+		switch {
+		case t.value == "_" && sub == nil:
+			p.next()
+			if sub, err = p.parseArgument(); err != nil {
+				return nil, err
+			}
+		case t.value == "^" && sup == nil:
+			p.next()
+			if sup, err = p.parseArgument(); err != nil {
+				return nil, err
+			}
+		case t.value == "'" && sup == nil:
+			p.next()
+			primes := "′"
+			for t := p.peek(); t != nil && t.kind == latexChar && t.value == "'"; t = p.peek() {
+				p.next()
+				primes += "′"
+			}
+			sup = mo(primes)
+		default:
+			return scripted(base, sub, sup, underOver), nil
+		}
+	}
+	return scripted(base, sub, sup, underOver), nil
+}
+
+func scripted(base, sub, sup *node, underOver bool) *node {
+	switch {
+	case sub != nil && sup != nil && underOver:
+		return newNode("munderover", base, sub, sup)
+	case sub != nil && sup != nil:
+		return newNode("msubsup", base, sub, sup)
+	case sub != nil && underOver:
+		return newNode("munder", base, sub)
+	case sub != nil:
+		return newNode("msub", base, sub)
+	case sup != nil && underOver:
+		return newNode("mover", base, sup)
+	case sup != nil:
+		return newNode("msup", base, sup)
+	default:
+		return base
+	}
+}
+
+// parseArgument parses a mandatory argument: either a group in curly braces or a single atom
+func (p *latexParser) parseArgument() (*node, error) {
+	t := p.peek()
+	if t == nil || isTerminator(t) {
+		return nil, errors.Errorf("missing argument at position %d", p.pos)
+	}
+	return p.parseAtom()
+}
+
+// parseGroupContent parses the content of a group in curly braces, after the opening brace was consumed
+func (p *latexParser) parseGroupContent() (*node, error) {
+	content, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.next(); t == nil || t.kind != latexChar || t.value != "}" {
+		return nil, errors.Errorf("missing closing brace at position %d", p.pos)
+	}
+	return mrow(content...), nil
+}
+
+// readRawGroup reads the raw content of a group in curly braces (eg: for `\text{...}`)
+func (p *latexParser) readRawGroup() (string, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.input) || p.input[p.pos] != '{' {
+		return "", errors.Errorf("missing opening brace at position %d", p.pos)
+	}
+	depth := 0
+	for i := p.pos; i < len(p.input); i++ {
+		switch p.input[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				result := p.input[p.pos+1 : i]
+				p.pos = i + 1
+				return result, nil
+			}
+		}
+	}
+	return "", errors.Errorf("missing closing brace at position %d", p.pos)
+}
+
+// parseDelimiter parses the delimiter after a `\left` or `\right` command
+func (p *latexParser) parseDelimiter() (string, error) {
+	t := p.next()
+	switch {
+	case t == nil:
+		return "", errors.New("missing delimiter")
+	case t.kind == latexChar && t.value == ".":
+		return "", nil
+	case t.kind == latexChar:
+		return t.value, nil
+	case t.kind == latexCommand:
+		if s, ok := latexSymbols[t.value]; ok && s.name == "mo" {
+			return s.text, nil
+		}
+	}
+	return "", errors.Errorf("unsupported delimiter '%s'", t.value)
+}
+
+func (p *latexParser) parseAtom() (*node, error) {
+	t := p.next()
+	switch t.kind {
+	case latexNumber:
+		return mn(t.value), nil
+	case latexLetter:
+		return mi(t.value), nil
+	case latexChar:
+		switch t.value {
+		case "{":
+			result, err := p.parseGroupContent()
+			if err != nil {
+				return nil, err
+			}
+			return result, nil
+		case "^", "_":
+			return nil, errors.Errorf("unexpected '%s' at position %d", t.value, p.pos)
+		case "~":
+			return mspace("0.333em"), nil
+		case "'":
+			return mo("′"), nil
+		default:
+			return mo(t.value), nil
+		}
+	default:
+		return p.parseCommand(t.value)
+	}
+}
+
+func (p *latexParser) parseCommand(name string) (*node, error) {
+	if s, ok := latexSymbols[name]; ok {
+		return newToken(s.name, s.text), nil
+	}
+	if width, ok := latexSpaces[name]; ok {
+		return mspace(width), nil
+	}
+	if a, ok := latexAccents[name]; ok {
+		arg, err := p.parseArgument()
+		if err != nil {
+			return nil, err
+		}
+		return newNode("mover", arg, mo(a)).withAttr("accent", "true"), nil
+	}
+	if a, ok := latexUnderAccents[name]; ok {
+		arg, err := p.parseArgument()
+		if err != nil {
+			return nil, err
+		}
+		return newNode("munder", arg, mo(a)).withAttr("accentunder", "true"), nil
+	}
+	if v, ok := latexFonts[name]; ok {
+		arg, err := p.parseArgument()
+		if err != nil {
+			return nil, err
+		}
+		return newNode("mstyle", arg).withAttr("mathvariant", v), nil
+	}
+	if v, ok := latexTexts[name]; ok {
+		content, err := p.readRawGroup()
+		if err != nil {
+			return nil, err
+		}
+		result := mtext(content)
+		if v != "" {
+			result.withAttr("mathvariant", v)
+		}
+		return result, nil
+	}
+	switch name {
+	case "frac", "dfrac", "tfrac", "binom":
+		num, err := p.parseArgument()
+		if err != nil {
+			return nil, err
+		}
+		den, err := p.parseArgument()
+		if err != nil {
+			return nil, err
+		}
+		if name == "binom" {
+			return newNode("mrow", mo("("), newNode("mfrac", num, den).withAttr("linethickness", "0"), mo(")")), nil
+		}
+		return newNode("mfrac", num, den), nil
+	case "sqrt":
+		p.skipSpaces()
+		var index *node
+		if p.pos < len(p.input) && p.input[p.pos] == '[' {
+			end := strings.IndexByte(p.input[p.pos:], ']')
+			if end == -1 {
+				return nil, errors.Errorf("missing closing bracket at position %d", p.pos)
+			}
+			nodes, err := parseLaTeX(p.input[p.pos+1 : p.pos+end])
+			if err != nil {
+				return nil, err
+			}
+			index = mrow(nodes...)
+			p.pos += end + 1
+		}
+		arg, err := p.parseArgument()
+		if err != nil {
+			return nil, err
+		}
+		if index != nil {
+			return newNode("mroot", arg, index), nil
+		}
+		return newNode("msqrt", arg), nil
+	case "operatorname":
+		content, err := p.readRawGroup()
+		if err != nil {
+			return nil, err
+		}
+		return mi(content), nil
+	case "left":
+		left, err := p.parseDelimiter()
+		if err != nil {
+			return nil, err
+		}
+		content, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t == nil || t.kind != latexCommand || t.value != "right" {
+			return nil, errors.Errorf("missing \\right at position %d", p.pos)
+		}
+		right, err := p.parseDelimiter()
+		if err != nil {
+			return nil, err
+		}
+		return fence(left, right, content...), nil
+	case "begin":
+		return p.parseEnvironment()
+	}
+	return nil, errors.Errorf("unsupported command '\\%s'", name)
+}
+
+func fence(left, right string, content ...*node) *node {
+	children := []*node{}
+	if left != "" {
+		children = append(children, mo(left).withAttr("fence", "true"))
+	}
+	children = append(children, content...)
+	if right != "" {
+		children = append(children, mo(right).withAttr("fence", "true"))
+	}
+	return newNode("mrow", children...)
+}
+
+// parseEnvironment parses the content of a `\begin{...}...\end{...}` environment, after the `\begin` command was consumed
+func (p *latexParser) parseEnvironment() (*node, error) {
+	env, err := p.readRawGroup()
+	if err != nil {
+		return nil, err
+	}
+	delimiters, ok := latexMatrices[env]
+	if !ok {
+		return nil, errors.Errorf("unsupported environment '%s'", env)
+	}
+	if env == "array" {
+		// skip the column specification
+		if _, err := p.readRawGroup(); err != nil {
+			return nil, err
+		}
+	}
+	rows := []*node{}
+	cells := []*node{}
+	for {
+		content, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		cells = append(cells, newNode("mtd", mrow(content...)))
+		t := p.next()
+		switch {
+		case t == nil:
+			return nil, errors.Errorf("missing \\end{%s}", env)
+		case t.kind == latexChar && t.value == "&":
+			continue
+		case t.kind == latexCommand && t.value == "\\":
+			rows = append(rows, newNode("mtr", cells...))
+			cells = []*node{}
+			continue
+		case t.kind == latexCommand && t.value == "end":
+			end, err := p.readRawGroup()
+			if err != nil {
+				return nil, err
+			}
+			if end != env {
+				return nil, errors.Errorf("mismatch environment: '%s' vs '%s'", env, end)
+			}
+			// ignore empty last row (ie, when the last row ends with a `\\`)
+			if len(cells) > 1 || len(content) > 0 {
+				rows = append(rows, newNode("mtr", cells...))
+			}
+			table := newNode("mtable", rows...)
+			if env == "cases" || env == "aligned" {
+				table.withAttr("columnalign", "left")
+			}
+			if delimiters[0] == "" && delimiters[1] == "" {
+				return table, nil
+			}
+			return fence(delimiters[0], delimiters[1], table), nil
+		default:
+			return nil, errors.Errorf("unexpected '%s' at position %d", t.value, p.pos)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package mathml_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/mathml"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("latex to mathml",
+	func(expr, expected string) {
+		result, err := mathml.FromLaTeX(expr, mathml.Block)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(`<math xmlns="http://www.w3.org/1998/Math/MathML" display="block">` + expected + `</math>`))
+	},
+	Entry("fraction", `\frac{1}{2}`, `<mfrac><mn>1</mn><mn>2</mn></mfrac>`),
+	Entry("square root", `\sqrt{x}`, `<msqrt><mi>x</mi></msqrt>`),
+	Entry("nth root", `\sqrt[3]{x}`, `<mroot><mi>x</mi><mn>3</mn></mroot>`),
+	Entry("sum with limits", `\sum_{i=0}^{n} x_i^2`,
+		`<munderover><mo>∑</mo><mrow><mi>i</mi><mo>=</mo><mn>0</mn></mrow><mi>n</mi></munderover><msubsup><mi>x</mi><mi>i</mi><mn>2</mn></msubsup>`),
+	Entry("integral", `\int_0^1 f(x) \, dx`,
+		`<msubsup><mo>∫</mo><mn>0</mn><mn>1</mn></msubsup><mi>f</mi><mo>(</mo><mi>x</mi><mo>)</mo><mspace width="0.167em"/><mi>d</mi><mi>x</mi>`),
+	Entry("greek letters", `\alpha \leq \Omega`, `<mi>α</mi><mo>≤</mo><mi>Ω</mi>`),
+	Entry("prime", `f'(x)`, `<msup><mi>f</mi><mo>′</mo></msup><mo>(</mo><mi>x</mi><mo>)</mo>`),
+	Entry("left and right delimiters", `\left( \frac{a}{b} \right)`,
+		`<mrow><mo fence="true">(</mo><mfrac><mi>a</mi><mi>b</mi></mfrac><mo fence="true">)</mo></mrow>`),
+	Entry("matrix", `\begin{pmatrix} a & b \\ c & d \end{pmatrix}`,
+		`<mrow><mo fence="true">(</mo><mtable><mtr><mtd><mi>a</mi></mtd><mtd><mi>b</mi></mtd></mtr><mtr><mtd><mi>c</mi></mtd><mtd><mi>d</mi></mtd></mtr></mtable><mo fence="true">)</mo></mrow>`),
+	Entry("text", `\text{if } x > 0`, `<mtext>if </mtext><mi>x</mi><mo>&gt;</mo><mn>0</mn>`),
+	Entry("font", `\mathbf{v}`, `<mstyle mathvariant="bold"><mi>v</mi></mstyle>`),
+	Entry("accent", `\hat{x}`, `<mover accent="true"><mi>x</mi><mo>^</mo></mover>`),
+)
+
+var _ = DescribeTable("invalid latex",
+	func(expr, expectedErr string) {
+		_, err := mathml.FromLaTeX(expr, mathml.Inline)
+		Expect(err).To(MatchError(expectedErr))
+	},
+	Entry("unsupported command", `\foo{x}`, `unsupported command '\foo'`),
+	Entry("missing closing brace", `\frac{1}{2`, `missing closing brace at position 10`),
+	Entry("unexpected closing brace", `x}`, `unexpected '}' at position 1`),
+	Entry("unsupported environment", `\begin{align} x \end{align}`, `unsupported environment 'align'`),
+)
@@ -0,0 +1,160 @@
+// Package mathml converts AsciiMath and (a subset of) LaTeX math expressions into MathML markup
+package mathml
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Display the display mode of the `<math>` element
+type Display int
+
+const (
+	// Inline the math element is rendered inline, within the surrounding text
+	Inline Display = iota
+	// Block the math element is rendered as a standalone block
+	Block
+)
+
+// FromAsciiMath converts the given AsciiMath expression into a MathML `<math>` element
+func FromAsciiMath(expr string, display Display) (string, error) {
+	nodes, err := parseAsciiMath(expr)
+	if err != nil {
+		return "", err
+	}
+	return toMathML(nodes, display), nil
+}
+
+// FromLaTeX converts the given LaTeX expression into a MathML `<math>` element
+func FromLaTeX(expr string, display Display) (string, error) {
+	nodes, err := parseLaTeX(expr)
+	if err != nil {
+		return "", err
+	}
+	return toMathML(nodes, display), nil
+}
+
+func toMathML(nodes []*node, display Display) string {
+	result := &bytes.Buffer{}
+	result.WriteString(`<math xmlns="http://www.w3.org/1998/Math/MathML"`)
+	if display == Block {
+		result.WriteString(` display="block"`)
+	}
+	result.WriteString(">")
+	for _, n := range nodes {
+		n.writeTo(result)
+	}
+	result.WriteString("</math>")
+	return result.String()
+}
+
+// ------------------------------------------
+// MathML nodes
+// ------------------------------------------
+
+// node a MathML element, with either a text content (for token elements such as `<mi>`, `<mo>`, etc.)
+// or child elements (for layout elements such as `<mrow>`, `<mfrac>`, etc.)
+type node struct {
+	name     string
+	attrs    []attribute
+	text     string
+	children []*node
+	// bracketed true if the node is an mrow whose first and last children are the opening and closing brackets
+	bracketed bool
+}
+
+type attribute struct {
+	key   string
+	value string
+}
+
+func newToken(name, text string) *node {
+	return &node{
+		name: name,
+		text: text,
+	}
+}
+
+func newNode(name string, children ...*node) *node {
+	return &node{
+		name:     name,
+		children: children,
+	}
+}
+
+func (n *node) withAttr(key, value string) *node {
+	n.attrs = append(n.attrs, attribute{key: key, value: value})
+	return n
+}
+
+func mi(text string) *node {
+	return newToken("mi", text)
+}
+
+func mn(text string) *node {
+	return newToken("mn", text)
+}
+
+func mo(text string) *node {
+	return newToken("mo", text)
+}
+
+func mtext(text string) *node {
+	return newToken("mtext", text)
+}
+
+func mspace(width string) *node {
+	return newNode("mspace").withAttr("width", width)
+}
+
+// mrow wraps the given nodes in an `<mrow>` element, unless there is a single node
+func mrow(children ...*node) *node {
+	if len(children) == 1 {
+		return children[0]
+	}
+	return newNode("mrow", children...)
+}
+
+// unwrapBrackets returns the content of the given node without its surrounding brackets,
+// if it was bracketed (used for the arguments of fractions, roots, subscripts, etc.)
+func unwrapBrackets(n *node) *node {
+	if n == nil || !n.bracketed || len(n.children) < 2 {
+		return n
+	}
+	return mrow(n.children[1 : len(n.children)-1]...)
+}
+
+// isOperator returns true if the node is an `<mo>` with the given text
+func (n *node) isOperator(text string) bool {
+	return n != nil && n.name == "mo" && n.text == text
+}
+
+func (n *node) writeTo(result *bytes.Buffer) {
+	result.WriteString("<")
+	result.WriteString(n.name)
+	for _, attr := range n.attrs {
+		result.WriteString(" ")
+		result.WriteString(attr.key)
+		result.WriteString(`="`)
+		result.WriteString(escape(attr.value))
+		result.WriteString(`"`)
+	}
+	if n.text == "" && len(n.children) == 0 {
+		result.WriteString("/>")
+		return
+	}
+	result.WriteString(">")
+	result.WriteString(escape(n.text))
+	for _, c := range n.children {
+		c.writeTo(result)
+	}
+	result.WriteString("</")
+	result.WriteString(n.name)
+	result.WriteString(">")
+}
+
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+func escape(s string) string {
+	return escaper.Replace(s)
+}
@@ -0,0 +1,13 @@
+package mathml_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMathML(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "MathML Suite")
+}
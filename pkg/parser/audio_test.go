@@ -0,0 +1,32 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("audios", func() {
+
+	It("audio block with options", func() {
+		source := `audio::sounds/ocean.wav[start=5, opts="autoplay,nocontrols"]`
+		expected := types.DraftDocument{
+			Blocks: []interface{}{
+				types.AudioBlock{
+					Attributes: types.ElementAttributes{
+						types.AttrStart: "5",
+						types.AttrOpts:  "autoplay,nocontrols",
+					},
+					Location: types.Location{
+						Elements: []interface{}{
+							types.StringElement{Content: "sounds/ocean.wav"},
+						},
+					},
+				},
+			},
+		}
+		Expect(source).To(BecomeDraftDocument(expected))
+	})
+})
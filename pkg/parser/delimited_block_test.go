@@ -1382,6 +1382,33 @@ end
 			Expect(source).To(BecomeDocumentBlock(expected))
 		})
 
+		It("with spaces around the language", func() {
+			source := `[source , ruby ]
+----
+require 'sinatra'
+----`
+			expected := types.DelimitedBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrKind:     types.Source,
+					types.AttrLanguage: "ruby",
+				},
+				Kind: types.Source,
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "require 'sinatra'",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomeDocumentBlock(expected))
+		})
+
 		It("with title, source and languages attributes", func() {
 			source := `[source,ruby]
 .Source block title
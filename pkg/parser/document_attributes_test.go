@@ -1,6 +1,9 @@
 package parser_test
 
 import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -735,15 +738,17 @@ v1.0:`
 :_author: Xavier
 :Author: Xavier
 :0Author: Xavier
-:Auth0r: Xavier`
+:Auth0r: Xavier
+:author_name: Xavier`
 				expected := types.Document{
 					Attributes: types.DocumentAttributes{
-						"a":       "",
-						"author":  "Xavier",
-						"_author": "Xavier",
-						"Author":  "Xavier",
-						"0Author": "Xavier",
-						"Auth0r":  "Xavier",
+						"a":           "",
+						"author":      "Xavier",
+						"_author":     "Xavier",
+						"Author":      "Xavier",
+						"0Author":     "Xavier",
+						"Auth0r":      "Xavier",
+						"author_name": "Xavier",
 					},
 					ElementReferences:  types.ElementReferences{},
 					Footnotes:          types.Footnotes{},
@@ -1074,4 +1079,31 @@ a paragraph written by {author}.`
 			Expect(source).To(BecomeDocument(expected))
 		})
 	})
+
+	Context("attributes set in the options", func() {
+
+		It("should take precedence over the attributes declared in the document", func() {
+			source := `:product: foo
+
+{product}
+
+:product!:
+
+{product}`
+			doc, err := parser.ParseDocument("", strings.NewReader(source), parser.Attributes(types.DocumentAttributes{
+				"product": "libasciidoc",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(doc.Attributes).To(HaveKeyWithValue("product", "libasciidoc"))
+			paragraph := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: [][]interface{}{
+					{
+						types.StringElement{Content: "libasciidoc"},
+					},
+				},
+			}
+			Expect(doc.Elements).To(Equal([]interface{}{paragraph, paragraph}))
+		})
+	})
 })
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"io"
+	"path/filepath"
 
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/davecgh/go-spew/spew"
@@ -17,18 +18,54 @@ const LevelOffset ContextKey = "leveloffset"
 
 // ParseDraftDocument parses a document's content and applies the preprocessing directives (file inclusions)
 func ParseDraftDocument(filename string, r io.Reader, opts ...Option) (types.DraftDocument, error) {
+	// share the same file system for the URIs (and its cache) with all the files to include,
+	// and use the directory of the document as the base directory, unless other options were given
+	opts = append([]Option{URIFileSystem(uriFileSystem(opts...)), BaseDir(filepath.Dir(filename))}, opts...)
+	// also, use an absolute path for the base directory, since the current directory changes while the files are included
+	if dir, err := filepath.Abs(baseDir(opts...)); err == nil {
+		opts = append(opts, BaseDir(dir))
+	}
 	opts = append(opts, Entrypoint("AsciidocDocument"))
-	return parseDraftDocument(filename, r, []levelOffset{}, opts...)
+	return parseDraftDocument(filename, r, attributes(opts...), []levelOffset{}, newIncludeStack(filename, baseDir(opts...)), opts...)
+}
+
+// the key of the flag in the global store of the parser, which indicates that the escaped content is retained as-is
+const rawSourceKey = "rawSource"
+
+// ParseRawDocument parses a document's content without applying the preprocessing directives, so that
+// the file inclusions are retained in the resulting document (eg: to format the document).
+// Also, the escaped quoted texts are retained with their backslashes (eg: `\*not bold*`)
+func ParseRawDocument(filename string, r io.Reader, opts ...Option) (types.DraftDocument, error) {
+	d, err := ParseReader(filename, r, append(opts, GlobalStore(rawSourceKey, true), Entrypoint("AsciidocDocument"))...)
+	if err != nil {
+		return types.DraftDocument{}, err
+	}
+	return d.(types.DraftDocument), nil
+}
+
+// newEscapedQuotedText returns the content of the escaped quoted text, or its source when the
+// document is parsed with `ParseRawDocument`, so that the backslashes are not lost
+func (c *current) newEscapedQuotedText(backslashes string, punctuation string, content interface{}) (interface{}, error) {
+	if raw, ok := c.globalStore[rawSourceKey].(bool); ok && raw {
+		return types.NewStringElement(string(c.text))
+	}
+	return types.NewEscapedQuotedText(backslashes, punctuation, content)
 }
 
-func parseDraftDocument(filename string, r io.Reader, levelOffsets []levelOffset, opts ...Option) (types.DraftDocument, error) {
+// parseDraftDocument parses the document, given the attributes of the document which includes it (if applicable)
+func parseDraftDocument(filename string, r io.Reader, parentAttrs types.DocumentAttributes, levelOffsets []levelOffset, includes includeStack, opts ...Option) (types.DraftDocument, error) {
 	d, err := ParseReader(filename, r, opts...)
 	if err != nil {
 		return types.DraftDocument{}, err
 	}
 	doc := d.(types.DraftDocument)
+	// use a copy of the attributes, so that the attributes declared in an included file are
+	// not used in the rest of the document which includes it
 	attrs := types.DocumentAttributes{}
-	blocks, err := parseElements(filename, doc.Blocks, attrs, levelOffsets, opts...)
+	for k, v := range parentAttrs {
+		attrs[k] = v
+	}
+	blocks, err := parseElements(filename, doc.Blocks, attrs, levelOffsets, includes, opts...)
 	if err != nil {
 		return types.DraftDocument{}, err
 	}
@@ -41,23 +78,26 @@ func parseDraftDocument(filename string, r io.Reader, levelOffsets []levelOffset
 }
 
 // parseElements resolves the file inclusions if any is found in the given elements
-func parseElements(filename string, elements []interface{}, attrs types.DocumentAttributes, levelOffsets []levelOffset, opts ...Option) ([]interface{}, error) {
+func parseElements(filename string, elements []interface{}, attrs types.DocumentAttributes, levelOffsets []levelOffset, includes includeStack, opts ...Option) ([]interface{}, error) {
 	result := []interface{}{}
 	for _, e := range elements {
 		switch e := e.(type) {
 		case types.DocumentAttributeDeclaration:
-			attrs[e.Name] = e.Value
+			// the attributes set in the options take precedence over the ones declared in the document
+			if !attributes(opts...).Has(e.Name) {
+				attrs[e.Name] = e.Value
+			}
 			result = append(result, e)
 		case types.FileInclusion:
 			// read the file and include its content
-			embedded, err := parseFileToInclude(filename, e, attrs, levelOffsets, opts...)
+			embedded, err := parseFileToInclude(filename, e, attrs, levelOffsets, includes, opts...)
 			if err != nil {
 				// do not fail, but instead report the error in the console
 				log.Errorf("failed to include file '%s': %v", e.Location, err)
 			}
 			result = append(result, embedded.Blocks...)
 		case types.DelimitedBlock:
-			elmts, err := parseElements(filename, e.Elements, attrs, levelOffsets,
+			elmts, err := parseElements(filename, e.Elements, attrs, levelOffsets, includes,
 				// use a new var to avoid overridding the current one which needs to stay as-is for the rest of the doc parsing
 				append(opts, Entrypoint("AsciidocDocumentWithinDelimitedBlock"))...)
 			if err != nil {
@@ -6,12 +6,35 @@ import (
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 )
 
+// the key of the default attributes in the global store of the parser
+const defaultAttributesKey = "defaultAttributes"
+
+// DefaultAttributes creates an Option to set document attributes which can be overridden by the attributes declared
+// in the document (eg: the `outfilesuffix` attribute, which depends on the backend used to render the document)
+func DefaultAttributes(attrs types.DocumentAttributes) Option {
+	return GlobalStore(defaultAttributesKey, attrs)
+}
+
+// defaultAttributes returns the default attributes set in the given options, or an empty set of attributes if there is none
+func defaultAttributes(opts ...Option) types.DocumentAttributes {
+	if attrs, ok := newParser("", nil, opts...).cur.globalStore[defaultAttributesKey].(types.DocumentAttributes); ok {
+		return attrs
+	}
+	return types.DocumentAttributes{}
+}
+
 // ParseDocument parses the content of the reader identitied by the filename
 func ParseDocument(filename string, r io.Reader, opts ...Option) (types.Document, error) {
 	draftDoc, err := ParseDraftDocument(filename, r, opts...)
 	if err != nil {
 		return types.Document{}, err
 	}
+	return ProcessDraftDocument(draftDoc, opts...)
+}
+
+// ProcessDraftDocument applies the document attribute substitutions on the given draft document, then
+// rearranges its lists and sections
+func ProcessDraftDocument(draftDoc types.DraftDocument, opts ...Option) (types.Document, error) {
 	attrs := types.DocumentAttributes{}
 	// add all predefined attributes
 	for k, v := range Predefined {
@@ -20,6 +43,11 @@ func ParseDocument(filename string, r io.Reader, opts ...Option) (types.Document
 		}
 	}
 
+	// also, add the default attributes, which can be overridden by the document
+	for k, v := range defaultAttributes(opts...) {
+		attrs[k] = v
+	}
+
 	// also, add all front-matter key/values
 	for k, v := range draftDoc.FrontMatter.Content {
 		if v, ok := v.(string); ok {
@@ -33,8 +61,13 @@ func ParseDocument(filename string, r io.Reader, opts ...Option) (types.Document
 		attrs[k] = v
 	}
 
+	// and finally, the attributes set in the options, which take precedence over the ones declared in the document
+	for k, v := range attributes(opts...) {
+		attrs[k] = v
+	}
+
 	// apply document attribute substitutions and re-parse paragraphs that were affected
-	blocks, _, err := applyDocumentAttributeSubstitutions(draftDoc.Blocks, attrs)
+	blocks, _, err := applyDocumentAttributeSubstitutions(ignoreAttributeDeclarations(draftDoc.Blocks, attributes(opts...)), attrs)
 	if err != nil {
 		return types.Document{}, err
 	}
@@ -60,5 +93,35 @@ func ParseDocument(filename string, r io.Reader, opts ...Option) (types.Document
 	for k, v := range documentAttributes {
 		doc.Attributes[k] = v
 	}
+	for k, v := range attributes(opts...) {
+		doc.Attributes[k] = v
+	}
 	return doc, nil
 }
+
+// ignoreAttributeDeclarations removes the declarations and the resets of the given attributes from the given elements,
+// so that the attributes keep their values while the document attribute substitutions are applied
+func ignoreAttributeDeclarations(elements []interface{}, attrs types.DocumentAttributes) []interface{} {
+	if len(attrs) == 0 {
+		return elements
+	}
+	result := make([]interface{}, 0, len(elements))
+	for _, e := range elements {
+		switch e := e.(type) {
+		case types.DocumentAttributeDeclaration:
+			if attrs.Has(e.Name) {
+				continue
+			}
+		case types.DocumentAttributeReset:
+			if attrs.Has(e.Name) {
+				continue
+			}
+		case types.DelimitedBlock:
+			e.Elements = ignoreAttributeDeclarations(e.Elements, attrs)
+			result = append(result, e)
+			continue
+		}
+		result = append(result, e)
+	}
+	return result
+}
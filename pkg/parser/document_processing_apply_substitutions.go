@@ -48,9 +48,13 @@ func applyDocumentAttributeSubstitutions(element interface{}, attrs types.Docume
 			Content: "{" + e.Name + "}",
 		}, false, nil
 	case types.ImageBlock:
-		return e.ResolveLocation(attrs), false, nil
+		return e.ResolveLocation(attrs).ResolveCaption(attrs), false, nil
 	case types.InlineImage:
 		return e.ResolveLocation(attrs), false, nil
+	case types.VideoBlock:
+		return e.ResolveLocation(attrs), false, nil
+	case types.AudioBlock:
+		return e.ResolveLocation(attrs), false, nil
 	case types.ExternalCrossReference:
 		return e.ResolveLocation(attrs), false, nil
 	case types.Section:
@@ -302,4 +302,27 @@ a paragraph`
 		})
 	})
 
+	Context("attribute group", func() {
+
+		It("quoted values with spaces around the commas", func() {
+			source := `[cols="1,1" , options="header" ,role=foo]
+a paragraph`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{
+					"cols":         "1,1",
+					"options":      "header",
+					types.AttrRole: "foo",
+				},
+				Lines: [][]interface{}{
+					{
+						types.StringElement{
+							Content: "a paragraph",
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomeDocumentBlock(expected))
+		})
+	})
+
 })
@@ -3,6 +3,8 @@ package parser
 import (
 	"bufio"
 	"bytes"
+	"io"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -10,6 +12,7 @@ import (
 	"text/template"
 
 	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/vfs"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/pkg/errors"
 
@@ -26,6 +29,93 @@ func init() {
 	}
 }
 
+// the key of the file system in the global store of the parser
+const fileSystemKey = "fileSystem"
+
+// FileSystem creates an Option to set the file system from which the files to include are read
+// (default is the local filesystem)
+func FileSystem(fs vfs.FS) Option {
+	return GlobalStore(fileSystemKey, fs)
+}
+
+// the key of the file system for the URIs in the global store of the parser
+const uriFileSystemKey = "uriFileSystem"
+
+// URIFileSystem creates an Option to set the file system from which the content at the URIs to include is read,
+// when the `allow-uri-read` attribute is set with the `Attributes` option (default is a `vfs.HTTPFS` with the default timeout and maximum size)
+func URIFileSystem(fs vfs.FS) Option {
+	return GlobalStore(uriFileSystemKey, fs)
+}
+
+// uriFileSystem returns the file system for the URIs set in the given options, or a new `vfs.HTTPFS` if there is none
+func uriFileSystem(opts ...Option) vfs.FS {
+	if fs, ok := newParser("", nil, opts...).cur.globalStore[uriFileSystemKey].(vfs.FS); ok {
+		return fs
+	}
+	return vfs.NewHTTPFS(nil, 0)
+}
+
+// the keys of the safe mode and of the base directory in the global store of the parser
+const (
+	safeModeKey = "safeMode"
+	baseDirKey  = "baseDir"
+)
+
+// SafeMode creates an Option to set the safe mode which restricts the files to include (default is `types.SafeModeUnsafe`)
+func SafeMode(mode types.SafeMode) Option {
+	return GlobalStore(safeModeKey, mode)
+}
+
+// safeMode returns the safe mode set in the given options, or `types.SafeModeUnsafe` if there is none
+func safeMode(opts ...Option) types.SafeMode {
+	if mode, ok := newParser("", nil, opts...).cur.globalStore[safeModeKey].(types.SafeMode); ok {
+		return mode
+	}
+	return types.SafeModeUnsafe
+}
+
+// BaseDir creates an Option to set the directory which contains the files that can be included in the `safe` and
+// `server` safe modes (default is the directory of the document being parsed)
+func BaseDir(dir string) Option {
+	return GlobalStore(baseDirKey, dir)
+}
+
+// baseDir returns the base directory set in the given options, or the current directory if there is none
+func baseDir(opts ...Option) string {
+	if dir, ok := newParser("", nil, opts...).cur.globalStore[baseDirKey].(string); ok {
+		return dir
+	}
+	return "."
+}
+
+// the key of the attributes set by the caller in the global store of the parser
+const attributesKey = "attributes"
+
+// Attributes creates an Option to set document attributes which take precedence over the attributes declared
+// in the document (eg: the `allow-uri-read` attribute, which cannot be set by the document itself)
+func Attributes(attrs types.DocumentAttributes) Option {
+	return GlobalStore(attributesKey, attrs)
+}
+
+// attributes returns a copy of the attributes set in the given options, or an empty set of attributes if there is none
+func attributes(opts ...Option) types.DocumentAttributes {
+	result := types.DocumentAttributes{}
+	if attrs, ok := newParser("", nil, opts...).cur.globalStore[attributesKey].(types.DocumentAttributes); ok {
+		for k, v := range attrs {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// fileSystem returns the file system set in the given options, or the local filesystem if there is none
+func fileSystem(opts ...Option) vfs.FS {
+	if fs, ok := newParser("", nil, opts...).cur.globalStore[fileSystemKey].(vfs.FS); ok {
+		return fs
+	}
+	return vfs.OS
+}
+
 // levelOffset a func that applies a given offset to the sections of a child document to include in a parent doc (the caller)
 type levelOffset struct {
 	absolute bool
@@ -55,12 +145,152 @@ func absoluteOffset(offset int) levelOffset {
 	}
 }
 
-func parseFileToInclude(filename string, incl types.FileInclusion, attrs types.DocumentAttributes, levelOffsets []levelOffset, opts ...Option) (types.DraftDocument, error) {
+// DefaultMaxIncludeDepth the maximum depth of the nested file inclusions, unless the `max-include-depth`
+// document attribute is set
+const DefaultMaxIncludeDepth = 64
+
+// includeStack the files being included, from the document being parsed to the file which contains the
+// file inclusion being processed, so that the circular file inclusions can be detected
+type includeStack struct {
+	files []includedFile
+	// the maximum depth set with the `depth` attribute of the enclosing file inclusions, or -1 if there was none
+	limit int
+	// the absolute path of the base directory, to which the names of the local files are relative
+	baseDir string
+}
+
+// includedFile a file in the include stack
+type includedFile struct {
+	key  string // the absolute path or the URI of the file, to compare the files
+	name string // the name of the file in the messages
+}
+
+// newIncludeStack returns a stack which contains the document being parsed, given the absolute path of the base directory
+func newIncludeStack(filename, baseDir string) includeStack {
+	s := includeStack{
+		limit:   -1,
+		baseDir: baseDir,
+	}
+	if filename != "" {
+		s.files = []includedFile{s.file(filename)}
+	} else {
+		// a document without a name cannot be included, but it still counts in the depth of the inclusions
+		s.files = []includedFile{{}}
+	}
+	return s
+}
+
+// file returns the file at the given location, whose name is relative to the base directory
+func (s includeStack) file(location string) includedFile {
+	if isURI(location) {
+		return includedFile{key: location, name: location}
+	}
+	key, err := filepath.Abs(location)
+	if err != nil {
+		key = filepath.Clean(location)
+	}
+	name := location
+	if rel, err := filepath.Rel(s.baseDir, key); err == nil {
+		name = rel
+	}
+	return includedFile{key: key, name: name}
+}
+
+// depth returns the number of nested file inclusions (0 when the file inclusions of the document itself are processed)
+func (s includeStack) depth() int {
+	return len(s.files) - 1
+}
+
+// contains returns true if the given file is already being included
+func (s includeStack) contains(f includedFile) bool {
+	for _, included := range s.files {
+		if f.key != "" && included.key == f.key {
+			return true
+		}
+	}
+	return false
+}
+
+// chain returns the names of the files in the stack, followed by the given file (eg: `a.adoc -> b.adoc -> a.adoc`)
+func (s includeStack) chain(f includedFile) string {
+	names := make([]string, 0, len(s.files)+1)
+	for _, included := range s.files {
+		names = append(names, included.name)
+	}
+	return strings.Join(append(names, f.name), " -> ")
+}
+
+// maxDepth returns the maximum depth of the nested file inclusions, given the `max-include-depth` document attribute
+// and the `depth` attribute of the enclosing file inclusions
+func (s includeStack) maxDepth(attrs types.DocumentAttributes) int {
+	max := DefaultMaxIncludeDepth
+	if m, found := attrs.GetAsString(types.AttrMaxIncludeDepth); found {
+		if v, err := strconv.Atoi(m); err == nil && v >= 0 {
+			max = v
+		} else {
+			log.Warnf("invalid value of the '%s' document attribute: '%s'", types.AttrMaxIncludeDepth, m)
+		}
+	}
+	if s.limit >= 0 && s.limit < max {
+		return s.limit
+	}
+	return max
+}
+
+// push returns a new stack with the given file on top of the current ones, and with the limit set by the
+// `depth` attribute of its file inclusion, if applicable. As in Asciidoctor, the `depth` attribute is ignored
+// if its value is not a positive number.
+func (s includeStack) push(f includedFile, attrs types.ElementAttributes) includeStack {
+	files := make([]includedFile, len(s.files), len(s.files)+1)
+	copy(files, s.files)
+	result := includeStack{
+		files:   append(files, f),
+		limit:   s.limit,
+		baseDir: s.baseDir,
+	}
+	if d := attrs.GetAsString(types.AttrIncludeDepth); d != "" {
+		if depth, err := strconv.Atoi(d); err == nil && depth > 0 {
+			// the depth is relative to the file which contains the file inclusion
+			if limit := s.depth() + depth; result.limit < 0 || limit < result.limit {
+				result.limit = limit
+			}
+		} else {
+			log.Debugf("ignoring the '%s' attribute with value '%s'", types.AttrIncludeDepth, d)
+		}
+	}
+	return result
+}
+
+func parseFileToInclude(filename string, incl types.FileInclusion, attrs types.DocumentAttributes, levelOffsets []levelOffset, includes includeStack, opts ...Option) (types.DraftDocument, error) {
 	path := incl.Location.Resolve(attrs).String()
-	currentDir := filepath.Dir(filename)
-	log.Debugf("parsing '%s' from '%s' (%s)", path, currentDir, filename)
+	location := resolveLocation(filename, path)
+	log.Debugf("parsing '%s' from '%s' (%s)", path, location, filename)
 	log.Debugf("file inclusion attributes: %s", spew.Sdump(incl.Attributes))
-	f, absPath, done, err := open(filepath.Join(currentDir, path))
+	mode := safeMode(opts...)
+	if mode >= types.SafeModeSecure {
+		// the files are not read in secure mode
+		log.Warnf("the '%s' file is not included in secure mode", path)
+		return linkToFile(path)
+	}
+	fs := fileSystem(opts...)
+	if isURI(location) {
+		// the `allow-uri-read` attribute is ignored when it is declared in the document
+		if !attributes(opts...).Has(types.AttrAllowURIRead) {
+			return invalidFileErrMsg(filename, path, incl.RawText, errors.Errorf("the '%s' attribute is not set", types.AttrAllowURIRead))
+		}
+		fs = uriFileSystem(opts...)
+	} else if base := baseDir(opts...); mode >= types.SafeModeSafe && !vfs.Within(base, location) {
+		return invalidFileErrMsg(filename, path, incl.RawText, errors.Errorf("the file is outside of the base directory '%s' in %s mode", base, mode))
+	}
+	file := includes.file(location)
+	if includes.contains(file) {
+		return invalidFileErrMsg(filename, path, incl.RawText, errors.Errorf("circular file inclusion: %s", includes.chain(file)))
+	}
+	if max := includes.maxDepth(attrs); includes.depth() >= max {
+		return invalidFileErrMsg(filename, path, incl.RawText, errors.Errorf("maximum include depth of %d exceeded", max))
+	}
+	includes = includes.push(file, incl.Attributes)
+	f, absPath, done, err := open(fs, location)
 	defer done()
 	if err != nil {
 		return invalidFileErrMsg(filename, path, incl.RawText, err)
@@ -102,10 +332,35 @@ func parseFileToInclude(filename string, incl types.FileInclusion, attrs types.D
 		}
 	}
 	// use a simpler/different grammar for non-asciidoc files.
-	if !IsAsciidoc(absPath) {
+	if !IsAsciidoc(pathOf(absPath)) {
 		opts = append(opts, Entrypoint("TextDocument"))
 	}
-	return parseDraftDocument(absPath, content, levelOffsets, opts...)
+	return parseDraftDocument(absPath, content, attrs, levelOffsets, includes, opts...)
+}
+
+// linkToFile returns a paragraph with a link to the file to include, instead of its content
+func linkToFile(path string) (types.DraftDocument, error) {
+	return types.DraftDocument{
+		Blocks: []interface{}{
+			types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: [][]interface{}{
+					{
+						types.InlineLink{
+							Location: types.Location{
+								Elements: []interface{}{
+									types.StringElement{
+										Content: path,
+									},
+								},
+							},
+							Attributes: types.ElementAttributes{},
+						},
+					},
+				},
+			},
+		},
+	}, nil
 }
 
 func invalidFileErrMsg(filename, path, rawText string, err error) (types.DraftDocument, error) {
@@ -253,7 +508,58 @@ func readAll(scanner *bufio.Scanner, content *bytes.Buffer) error {
 	return nil
 }
 
-func open(path string) (*os.File, string, func(), error) {
+// resolveLocation returns the location of the file to include, given its path which is either a URI
+// or a path relative to the location of the file which includes it (which may itself be a URI)
+func resolveLocation(filename, path string) string {
+	if isURI(path) {
+		return path
+	}
+	if isURI(filename) {
+		base, err := url.Parse(filename)
+		if err != nil {
+			return path
+		}
+		ref, err := url.Parse(filepath.ToSlash(path))
+		if err != nil {
+			return path
+		}
+		return base.ResolveReference(ref).String()
+	}
+	return filepath.Join(filepath.Dir(filename), path)
+}
+
+// isURI returns true if the given location is an `http` or `https` URI
+func isURI(location string) bool {
+	u, err := url.Parse(location)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https")
+}
+
+// pathOf returns the path of the given location, without the query and the fragment if it is a URI
+func pathOf(location string) string {
+	if isURI(location) {
+		if u, err := url.Parse(location); err == nil {
+			return u.Path
+		}
+	}
+	return location
+}
+
+// open opens the file at the given path, and returns a func to call when the file is not needed anymore.
+// When the file is read on the local filesystem, the current working directory is also changed to the directory of the file,
+// until the func is called
+func open(fs vfs.FS, path string) (io.Reader, string, func(), error) {
+	if fs != vfs.OS {
+		log.Debugf("file path: %s", path)
+		f, err := fs.Open(path)
+		if err != nil {
+			return nil, path, func() {}, err
+		}
+		return f, path, func() {
+			if err := f.Close(); err != nil {
+				log.WithError(err).Errorf("failed to close file '%s'", path)
+			}
+		}, nil
+	}
 	wd, err := os.Getwd()
 	if err != nil {
 		return nil, "", func() {}, err
@@ -1,10 +1,15 @@
 package parser_test
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/parser"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/vfs"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	"github.com/davecgh/go-spew/spew"
@@ -2883,3 +2888,460 @@ include::../../test/includes/chapter-a.adoc[]
 		})
 	})
 })
+
+var _ = Describe("file inclusions from a file system", func() {
+
+	fs := vfs.MemFS{
+		"docs/chapter.adoc":          []byte("first line of chapter\n\ninclude::sections/section.adoc[]"),
+		"docs/sections/section.adoc": []byte("first line of section"),
+	}
+
+	paragraph := func(content string) types.Paragraph {
+		return types.Paragraph{
+			Attributes: types.ElementAttributes{},
+			Lines: [][]interface{}{
+				{
+					types.StringElement{Content: content},
+				},
+			},
+		}
+	}
+
+	It("should include the files relatively to the file which includes them", func() {
+		source := `include::chapter.adoc[]`
+		doc, err := parser.ParseDraftDocument("docs/index.adoc", strings.NewReader(source), parser.FileSystem(fs))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Blocks).To(Equal([]interface{}{
+			paragraph("first line of chapter"),
+			types.BlankLine{},
+			paragraph("first line of section"),
+		}))
+	})
+
+	It("should not include the files of the local filesystem", func() {
+		source := `include::../../test/includes/chapter-a.adoc[]`
+		doc, err := parser.ParseDraftDocument("foo.adoc", strings.NewReader(source), parser.FileSystem(fs))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Blocks).To(Equal([]interface{}{
+			paragraph("Unresolved directive in foo.adoc - include::../../test/includes/chapter-a.adoc[]"),
+		}))
+	})
+})
+
+var _ = Describe("file inclusions from URIs", func() {
+
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.FileServer(http.Dir("../../test/includes")))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	paragraph := func(content string) types.Paragraph {
+		return types.Paragraph{
+			Attributes: types.ElementAttributes{},
+			Lines: [][]interface{}{
+				{
+					types.StringElement{Content: content},
+				},
+			},
+		}
+	}
+
+	parse := func(source string, opts ...parser.Option) []interface{} {
+		doc, err := parser.ParseDraftDocument("foo.adoc", strings.NewReader(source), opts...)
+		Expect(err).NotTo(HaveOccurred())
+		return doc.Blocks
+	}
+
+	allowURIRead := parser.Attributes(types.DocumentAttributes{
+		types.AttrAllowURIRead: "",
+	})
+
+	It("should include the content at a URI when the allow-uri-read attribute is set", func() {
+		source := "include::" + server.URL + "/chapter-a.adoc[]"
+		Expect(parse(source, allowURIRead)).To(Equal([]interface{}{
+			types.Section{
+				Level:      0,
+				Attributes: types.ElementAttributes{},
+				Title: []interface{}{
+					types.StringElement{Content: "Chapter A"},
+				},
+				Elements: []interface{}{},
+			},
+			types.BlankLine{},
+			paragraph("content"),
+		}))
+	})
+
+	It("should not include the content at a URI when the allow-uri-read attribute is not set", func() {
+		source := "include::" + server.URL + "/chapter-a.adoc[]"
+		Expect(parse(source)).To(Equal([]interface{}{
+			paragraph("Unresolved directive in foo.adoc - " + source),
+		}))
+	})
+
+	It("should not include the content at a URI when the allow-uri-read attribute is declared in the document", func() {
+		source := ":allow-uri-read:\n\ninclude::" + server.URL + "/chapter-a.adoc[]"
+		Expect(parse(source)).To(Equal([]interface{}{
+			types.DocumentAttributeDeclaration{Name: types.AttrAllowURIRead},
+			types.BlankLine{},
+			paragraph("Unresolved directive in foo.adoc - include::" + server.URL + "/chapter-a.adoc[]"),
+		}))
+	})
+
+	It("should not include the content at a URI in secure mode", func() {
+		source := "include::" + server.URL + "/chapter-a.adoc[]"
+		Expect(parse(source, allowURIRead, parser.SafeMode(types.SafeModeSecure))).To(Equal([]interface{}{
+			types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: [][]interface{}{
+					{
+						types.InlineLink{
+							Location: types.Location{
+								Elements: []interface{}{
+									types.StringElement{Content: server.URL + "/chapter-a.adoc"},
+								},
+							},
+							Attributes: types.ElementAttributes{},
+						},
+					},
+				},
+			},
+		}))
+	})
+
+	It("should include the lines of the content at a URI", func() {
+		source := "include::" + server.URL + "/tag-include.adoc[lines=7]"
+		Expect(parse(source, allowURIRead)).To(Equal([]interface{}{
+			paragraph("content"),
+		}))
+	})
+
+	It("should include the tagged regions of the content at a URI", func() {
+		source := "include::" + server.URL + "/tag-include.adoc[tag=content]"
+		Expect(parse(source, allowURIRead)).To(Equal([]interface{}{
+			paragraph("content"),
+			types.BlankLine{},
+		}))
+	})
+
+	It("should include the files relatively to the URI of the file which includes them", func() {
+		source := "include::" + server.URL + "/grandchild-include.adoc[]"
+		remote := parse(source, allowURIRead)
+		local, err := parser.ParseDraftDocument("../../test/includes/foo.adoc", strings.NewReader("include::grandchild-include.adoc[]"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(remote).To(Equal(local.Blocks))
+	})
+})
+
+var _ = Describe("file inclusions in safe modes", func() {
+
+	paragraph := func(content string) types.Paragraph {
+		return types.Paragraph{
+			Attributes: types.ElementAttributes{},
+			Lines: [][]interface{}{
+				{
+					types.StringElement{Content: content},
+				},
+			},
+		}
+	}
+
+	parse := func(filename, source string, opts ...parser.Option) []interface{} {
+		doc, err := parser.ParseDraftDocument(filename, strings.NewReader(source), opts...)
+		Expect(err).NotTo(HaveOccurred())
+		return doc.Blocks
+	}
+
+	It("should include the files in the directory of the document in safe mode", func() {
+		source := `include::grandchild-include.adoc[lines=3]`
+		Expect(parse("../../test/includes/foo.adoc", source, parser.SafeMode(types.SafeModeSafe))).To(Equal([]interface{}{
+			paragraph("first line of grandchild"),
+		}))
+	})
+
+	It("should not include the files outside of the directory of the document in safe mode", func() {
+		source := `include::../../test/includes/grandchild-include.adoc[]`
+		Expect(parse("foo.adoc", source, parser.SafeMode(types.SafeModeSafe))).To(Equal([]interface{}{
+			paragraph("Unresolved directive in foo.adoc - include::../../test/includes/grandchild-include.adoc[]"),
+		}))
+	})
+
+	It("should include the nested files in the directory of the document in safe mode", func() {
+		console, reset := ConfigureLogger()
+		defer reset()
+		source := `include::sub/nested-a.adoc[]`
+		Expect(parse("../../test/includes/nested/foo.adoc", source, parser.SafeMode(types.SafeModeSafe))).To(Equal([]interface{}{
+			paragraph("first line of nested a"),
+			types.BlankLine{},
+			paragraph("first line of nested b"),
+		}))
+		Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel))
+	})
+
+	It("should include the symbolic links to the files in the directory of the document in safe mode", func() {
+		source := `include::inside.adoc[]`
+		Expect(parse("../../test/includes/symlinks/foo.adoc", source, parser.SafeMode(types.SafeModeSafe))).To(Equal([]interface{}{
+			paragraph("first line of target"),
+		}))
+	})
+
+	It("should not include the symbolic links to the files outside of the directory of the document in safe mode", func() {
+		source := `include::outside.adoc[]`
+		Expect(parse("../../test/includes/symlinks/foo.adoc", source, parser.SafeMode(types.SafeModeSafe))).To(Equal([]interface{}{
+			paragraph("Unresolved directive in ../../test/includes/symlinks/foo.adoc - include::outside.adoc[]"),
+		}))
+	})
+
+	It("should not include the absolute paths outside of the base directory in server mode", func() {
+		source := `include::/etc/passwd[]`
+		Expect(parse("foo.adoc", source, parser.SafeMode(types.SafeModeServer))).To(Equal([]interface{}{
+			paragraph("Unresolved directive in foo.adoc - include::/etc/passwd[]"),
+		}))
+	})
+
+	It("should include the files in the given base directory in safe mode", func() {
+		source := `include::../../test/includes/grandchild-include.adoc[lines=3]`
+		Expect(parse("foo.adoc", source, parser.SafeMode(types.SafeModeSafe), parser.BaseDir("../../test"))).To(Equal([]interface{}{
+			paragraph("first line of grandchild"),
+		}))
+	})
+
+	It("should render the file inclusions as links in secure mode", func() {
+		source := `include::grandchild-include.adoc[]`
+		Expect(parse("../../test/includes/foo.adoc", source, parser.SafeMode(types.SafeModeSecure))).To(Equal([]interface{}{
+			types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: [][]interface{}{
+					{
+						types.InlineLink{
+							Location: types.Location{
+								Elements: []interface{}{
+									types.StringElement{Content: "grandchild-include.adoc"},
+								},
+							},
+							Attributes: types.ElementAttributes{},
+						},
+					},
+				},
+			},
+		}))
+	})
+})
+
+var _ = Describe("file inclusion limits", func() {
+
+	fs := vfs.MemFS{
+		"docs/chapter.adoc":          []byte("first line of chapter\n\ninclude::sections/section.adoc[]"),
+		"docs/sections/section.adoc": []byte("first line of section"),
+		"docs/self.adoc":             []byte("first line of self\n\ninclude::self.adoc[]"),
+		"docs/a.adoc":                []byte("first line of a\n\ninclude::b.adoc[]"),
+		"docs/b.adoc":                []byte("first line of b\n\ninclude::a.adoc[]"),
+		"docs/c.adoc":                []byte("first line of c\n\ninclude::index.adoc[]"),
+	}
+	for i := 1; i <= parser.DefaultMaxIncludeDepth+1; i++ {
+		fs[fmt.Sprintf("docs/level-%d.adoc", i)] = []byte(fmt.Sprintf("level %d\n\ninclude::level-%d.adoc[]", i, i+1))
+	}
+
+	paragraph := func(content string) types.Paragraph {
+		return types.Paragraph{
+			Attributes: types.ElementAttributes{},
+			Lines: [][]interface{}{
+				{
+					types.StringElement{Content: content},
+				},
+			},
+		}
+	}
+
+	parse := func(source string) []interface{} {
+		doc, err := parser.ParseDraftDocument("docs/index.adoc", strings.NewReader(source), parser.FileSystem(fs))
+		Expect(err).NotTo(HaveOccurred())
+		return doc.Blocks
+	}
+
+	Context("circular file inclusions", func() {
+
+		It("should not include a file which includes itself", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			Expect(parse(`include::self.adoc[]`)).To(Equal([]interface{}{
+				paragraph("first line of self"),
+				types.BlankLine{},
+				paragraph("Unresolved directive in docs/self.adoc - include::self.adoc[]"),
+			}))
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel,
+				"failed to include 'self.adoc'",
+				"circular file inclusion: index.adoc -> self.adoc -> self.adoc",
+			))
+		})
+
+		It("should not include a file which includes itself through other files", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			Expect(parse(`include::a.adoc[]`)).To(Equal([]interface{}{
+				paragraph("first line of a"),
+				types.BlankLine{},
+				paragraph("first line of b"),
+				types.BlankLine{},
+				paragraph("Unresolved directive in docs/b.adoc - include::a.adoc[]"),
+			}))
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel,
+				"failed to include 'a.adoc'",
+				"circular file inclusion: index.adoc -> a.adoc -> b.adoc -> a.adoc",
+			))
+		})
+
+		It("should not include the document being parsed", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			Expect(parse(`include::c.adoc[]`)).To(Equal([]interface{}{
+				paragraph("first line of c"),
+				types.BlankLine{},
+				paragraph("Unresolved directive in docs/c.adoc - include::index.adoc[]"),
+			}))
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel,
+				"failed to include 'index.adoc'",
+				"circular file inclusion: index.adoc -> c.adoc -> index.adoc",
+			))
+		})
+
+		It("should include the same file several times", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			Expect(parse("include::sections/section.adoc[]\n\ninclude::chapter.adoc[]")).To(Equal([]interface{}{
+				paragraph("first line of section"),
+				types.BlankLine{},
+				paragraph("first line of chapter"),
+				types.BlankLine{},
+				paragraph("first line of section"),
+			}))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("should not include a file of the local filesystem which includes itself through another file", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			doc, err := parser.ParseDraftDocument("foo.adoc", strings.NewReader(`include::../../test/includes/circular-include-a.adoc[]`))
+			Expect(err).NotTo(HaveOccurred())
+			b, err := filepath.Abs("../../test/includes/circular-include-b.adoc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(doc.Blocks).To(Equal([]interface{}{
+				paragraph("first line of circular include a"),
+				types.BlankLine{},
+				paragraph("first line of circular include b"),
+				types.BlankLine{},
+				paragraph("Unresolved directive in " + b + " - include::circular-include-a.adoc[]"),
+			}))
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel,
+				"failed to include 'circular-include-a.adoc'",
+				"circular file inclusion: foo.adoc -> ../../test/includes/circular-include-a.adoc -> ../../test/includes/circular-include-b.adoc -> ../../test/includes/circular-include-a.adoc",
+			))
+		})
+	})
+
+	Context("maximum include depth", func() {
+
+		It("should include the files up to the default maximum depth", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			expected := []interface{}{}
+			for i := 1; i <= parser.DefaultMaxIncludeDepth; i++ {
+				expected = append(expected, paragraph(fmt.Sprintf("level %d", i)), types.BlankLine{})
+			}
+			expected = append(expected, paragraph(fmt.Sprintf("Unresolved directive in docs/level-%d.adoc - include::level-%d.adoc[]", parser.DefaultMaxIncludeDepth, parser.DefaultMaxIncludeDepth+1)))
+			Expect(parse(`include::level-1.adoc[]`)).To(Equal(expected))
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel,
+				fmt.Sprintf("failed to include 'level-%d.adoc'", parser.DefaultMaxIncludeDepth+1),
+				fmt.Sprintf("maximum include depth of %d exceeded", parser.DefaultMaxIncludeDepth),
+			))
+		})
+
+		It("should include the files up to the depth set in the document attributes", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			Expect(parse(":max-include-depth: 1\n\ninclude::chapter.adoc[]")).To(Equal([]interface{}{
+				types.DocumentAttributeDeclaration{Name: "max-include-depth", Value: "1"},
+				types.BlankLine{},
+				paragraph("first line of chapter"),
+				types.BlankLine{},
+				paragraph("Unresolved directive in docs/chapter.adoc - include::sections/section.adoc[]"),
+			}))
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel,
+				"failed to include 'sections/section.adoc'",
+				"maximum include depth of 1 exceeded",
+			))
+		})
+
+		It("should not include any file when the maximum depth is 0", func() {
+			Expect(parse(":max-include-depth: 0\n\ninclude::chapter.adoc[]")).To(Equal([]interface{}{
+				types.DocumentAttributeDeclaration{Name: "max-include-depth", Value: "0"},
+				types.BlankLine{},
+				paragraph("Unresolved directive in docs/index.adoc - include::chapter.adoc[]"),
+			}))
+		})
+
+		It("should include the files up to the depth set on the file inclusion", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			Expect(parse("include::chapter.adoc[depth=1]")).To(Equal([]interface{}{
+				paragraph("first line of chapter"),
+				types.BlankLine{},
+				paragraph("Unresolved directive in docs/chapter.adoc - include::sections/section.adoc[]"),
+			}))
+			Expect(console).To(ContainMessageWithLevelAndError(log.ErrorLevel,
+				"failed to include 'sections/section.adoc'",
+				"maximum include depth of 1 exceeded",
+			))
+		})
+
+		It("should include the nested files within the depth set on the file inclusion", func() {
+			Expect(parse("include::chapter.adoc[depth=2]")).To(Equal([]interface{}{
+				paragraph("first line of chapter"),
+				types.BlankLine{},
+				paragraph("first line of section"),
+			}))
+		})
+
+		It("should ignore the depth set on the file inclusion when it is 0", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			Expect(parse("include::chapter.adoc[depth=0]")).To(Equal([]interface{}{
+				paragraph("first line of chapter"),
+				types.BlankLine{},
+				paragraph("first line of section"),
+			}))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("should ignore the depth set on the file inclusion when it is invalid", func() {
+			console, reset := ConfigureLogger()
+			defer reset()
+			Expect(parse("include::chapter.adoc[depth=foo]")).To(Equal([]interface{}{
+				paragraph("first line of chapter"),
+				types.BlankLine{},
+				paragraph("first line of section"),
+			}))
+			Expect(console).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
+		})
+
+		It("should keep the depth set on an enclosing file inclusion when the depth is 0", func() {
+			fs := vfs.MemFS{
+				"docs/outer.adoc":            []byte("include::chapter.adoc[depth=0]"),
+				"docs/chapter.adoc":          []byte("first line of chapter\n\ninclude::sections/section.adoc[]"),
+				"docs/sections/section.adoc": []byte("first line of section"),
+			}
+			doc, err := parser.ParseDraftDocument("docs/index.adoc", strings.NewReader("include::outer.adoc[depth=2]"), parser.FileSystem(fs))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(doc.Blocks).To(Equal([]interface{}{
+				paragraph("first line of chapter"),
+				types.BlankLine{},
+				paragraph("Unresolved directive in docs/chapter.adoc - include::sections/section.adoc[]"),
+			}))
+		})
+	})
+})
@@ -0,0 +1,68 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("icons", func() {
+
+	It("inline icon without attributes", func() {
+		source := "icon:heart[]"
+		expected := types.Paragraph{
+			Attributes: types.ElementAttributes{},
+			Lines: [][]interface{}{
+				{
+					types.InlineIcon{
+						Name:       "heart",
+						Attributes: types.ElementAttributes{},
+					},
+				},
+			},
+		}
+		Expect(source).To(BecomeDocumentBlock(expected))
+	})
+
+	It("inline icon with size and role", func() {
+		source := "I icon:heart[2x, role=red] Asciidoc"
+		expected := types.Paragraph{
+			Attributes: types.ElementAttributes{},
+			Lines: [][]interface{}{
+				{
+					types.StringElement{Content: "I "},
+					types.InlineIcon{
+						Name: "heart",
+						Attributes: types.ElementAttributes{
+							types.AttrIconSize: "2x",
+							types.AttrRole:     "red",
+						},
+					},
+					types.StringElement{Content: " Asciidoc"},
+				},
+			},
+		}
+		Expect(source).To(BecomeDocumentBlock(expected))
+	})
+
+	It("inline icon with named size and title", func() {
+		source := "icon:file-pdf-o[size=lg, title=Download]"
+		expected := types.Paragraph{
+			Attributes: types.ElementAttributes{},
+			Lines: [][]interface{}{
+				{
+					types.InlineIcon{
+						Name: "file-pdf-o",
+						Attributes: types.ElementAttributes{
+							types.AttrIconSize: "lg",
+							types.AttrTitle:    "Download",
+						},
+					},
+				},
+			},
+		}
+		Expect(source).To(BecomeDocumentBlock(expected))
+	})
+})
@@ -125,6 +125,26 @@ var _ = Describe("images", func() {
 				Expect(source).To(BecomeDraftDocument(expected))
 			})
 
+			It("block image with quoted alt containing a comma", func() {
+				source := `image::images/foo.png["the foo, bar image",100]`
+				expected := types.DraftDocument{
+					Blocks: []interface{}{
+						types.ImageBlock{
+							Attributes: types.ElementAttributes{
+								types.AttrImageAlt:   "the foo, bar image",
+								types.AttrImageWidth: "100",
+							},
+							Location: types.Location{
+								Elements: []interface{}{
+									types.StringElement{Content: "images/foo.png"},
+								},
+							},
+						},
+					},
+				}
+				Expect(source).To(BecomeDraftDocument(expected))
+			})
+
 			It("block image with dimensions and id link title meta", func() {
 				source := `[#img-foobar]
 .A title to foobar
@@ -308,6 +328,62 @@ image::{imagesdir}/foo.png[]`
 				}
 				Expect(source).To(BecomeDocument(expected))
 			})
+
+			It("image block with imagesdir overridden in the block attributes", func() {
+				source := `
+:imagesdir: ./path/to/images
+
+image::foo.png[imagesdir=./other]`
+				expected := types.Document{
+					Attributes:         types.DocumentAttributes{},
+					ElementReferences:  types.ElementReferences{},
+					Footnotes:          types.Footnotes{},
+					FootnoteReferences: types.FootnoteReferences{},
+					Elements: []interface{}{
+						types.ImageBlock{
+							Attributes: types.ElementAttributes{
+								types.AttrImageAlt:  "foo",
+								types.AttrImagesDir: "./other",
+							},
+							Location: types.Location{
+								Elements: []interface{}{
+									types.StringElement{Content: "./other/foo.png"},
+								},
+							},
+						},
+					},
+				}
+				Expect(source).To(BecomeDocument(expected))
+			})
+
+			It("image block with title when figure caption is unset", func() {
+				source := `
+:figure-caption!:
+
+.A title
+image::foo.png[]`
+				expected := types.Document{
+					Attributes:         types.DocumentAttributes{},
+					ElementReferences:  types.ElementReferences{},
+					Footnotes:          types.Footnotes{},
+					FootnoteReferences: types.FootnoteReferences{},
+					Elements: []interface{}{
+						types.ImageBlock{
+							Attributes: types.ElementAttributes{
+								types.AttrImageAlt: "foo",
+								types.AttrTitle:    "A title",
+								types.AttrCaption:  "",
+							},
+							Location: types.Location{
+								Elements: []interface{}{
+									types.StringElement{Content: "foo.png"},
+								},
+							},
+						},
+					},
+				}
+				Expect(source).To(BecomeDocument(expected))
+			})
 		})
 
 		Context("errors", func() {
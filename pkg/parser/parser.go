@@ -177,58 +177,70 @@ var g = &grammar{
 								alternatives: []interface{}{
 									&ruleRefExpr{
 										pos:  position{line: 42, col: 12, offset: 1246},
+										name: "StemBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 43, col: 11, offset: 1300},
 										name: "SimpleParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 43, col: 11, offset: 1272},
+										pos:  position{line: 44, col: 11, offset: 1326},
 										name: "Section",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 44, col: 11, offset: 1291},
+										pos:  position{line: 45, col: 11, offset: 1345},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 45, col: 11, offset: 1316},
+										pos:  position{line: 46, col: 11, offset: 1370},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 46, col: 11, offset: 1340},
+										pos:  position{line: 47, col: 11, offset: 1394},
 										name: "VerseParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 47, col: 11, offset: 1394},
+										pos:  position{line: 48, col: 11, offset: 1448},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 48, col: 11, offset: 1416},
+										pos:  position{line: 49, col: 11, offset: 1470},
+										name: "VideoBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 50, col: 11, offset: 1491},
+										name: "AudioBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 51, col: 11, offset: 1512},
 										name: "ListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 49, col: 11, offset: 1435},
+										pos:  position{line: 52, col: 11, offset: 1531},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 50, col: 11, offset: 1486},
+										pos:  position{line: 53, col: 11, offset: 1582},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 51, col: 11, offset: 1510},
+										pos:  position{line: 54, col: 11, offset: 1606},
 										name: "DocumentAttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 52, col: 11, offset: 1550},
+										pos:  position{line: 55, col: 11, offset: 1646},
 										name: "DocumentAttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 53, col: 11, offset: 1584},
+										pos:  position{line: 56, col: 11, offset: 1680},
 										name: "TableOfContentsMacro",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 54, col: 11, offset: 1615},
+										pos:  position{line: 57, col: 11, offset: 1711},
 										name: "UserMacroBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 55, col: 11, offset: 1640},
+										pos:  position{line: 58, col: 11, offset: 1736},
 										name: "Paragraph",
 									},
 								},
@@ -240,14 +252,14 @@ var g = &grammar{
 		},
 		{
 			name: "AsciidocDocumentBlocksWithinDelimitedBlock",
-			pos:  position{line: 59, col: 1, offset: 1678},
+			pos:  position{line: 62, col: 1, offset: 1774},
 			expr: &labeledExpr{
-				pos:   position{line: 59, col: 47, offset: 1724},
+				pos:   position{line: 62, col: 47, offset: 1820},
 				label: "blocks",
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 59, col: 54, offset: 1731},
+					pos: position{line: 62, col: 54, offset: 1827},
 					expr: &ruleRefExpr{
-						pos:  position{line: 59, col: 55, offset: 1732},
+						pos:  position{line: 62, col: 55, offset: 1828},
 						name: "DocumentBlockWithinDelimitedBlock",
 					},
 				},
@@ -255,72 +267,84 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentBlockWithinDelimitedBlock",
-			pos:  position{line: 61, col: 1, offset: 1769},
+			pos:  position{line: 64, col: 1, offset: 1865},
 			expr: &actionExpr{
-				pos: position{line: 61, col: 38, offset: 1806},
+				pos: position{line: 64, col: 38, offset: 1902},
 				run: (*parser).callonDocumentBlockWithinDelimitedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 61, col: 38, offset: 1806},
+					pos: position{line: 64, col: 38, offset: 1902},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 61, col: 38, offset: 1806},
+							pos: position{line: 64, col: 38, offset: 1902},
 							expr: &ruleRefExpr{
-								pos:  position{line: 61, col: 39, offset: 1807},
+								pos:  position{line: 64, col: 39, offset: 1903},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 62, col: 5, offset: 1816},
+							pos:   position{line: 65, col: 5, offset: 1912},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 62, col: 12, offset: 1823},
+								pos: position{line: 65, col: 12, offset: 1919},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 62, col: 12, offset: 1823},
+										pos:  position{line: 65, col: 12, offset: 1919},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 63, col: 11, offset: 1848},
+										pos:  position{line: 66, col: 11, offset: 1944},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 64, col: 11, offset: 1872},
+										pos:  position{line: 67, col: 11, offset: 1968},
+										name: "StemBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 68, col: 11, offset: 1988},
 										name: "VerseParagraph",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 65, col: 11, offset: 1897},
+										pos:  position{line: 69, col: 11, offset: 2013},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 66, col: 11, offset: 1919},
+										pos:  position{line: 70, col: 11, offset: 2035},
+										name: "VideoBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 71, col: 11, offset: 2056},
+										name: "AudioBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 72, col: 11, offset: 2077},
 										name: "ListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 67, col: 11, offset: 1938},
+										pos:  position{line: 73, col: 11, offset: 2096},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 68, col: 11, offset: 1989},
+										pos:  position{line: 74, col: 11, offset: 2147},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 69, col: 11, offset: 2013},
+										pos:  position{line: 75, col: 11, offset: 2171},
 										name: "DocumentAttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 70, col: 11, offset: 2053},
+										pos:  position{line: 76, col: 11, offset: 2211},
 										name: "DocumentAttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 71, col: 11, offset: 2087},
+										pos:  position{line: 77, col: 11, offset: 2245},
 										name: "TableOfContentsMacro",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 72, col: 11, offset: 2118},
+										pos:  position{line: 78, col: 11, offset: 2276},
 										name: "UserMacroBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 73, col: 11, offset: 2143},
+										pos:  position{line: 79, col: 11, offset: 2301},
 										name: "Paragraph",
 									},
 								},
@@ -332,14 +356,14 @@ var g = &grammar{
 		},
 		{
 			name: "TextDocumentBlocks",
-			pos:  position{line: 77, col: 1, offset: 2181},
+			pos:  position{line: 83, col: 1, offset: 2339},
 			expr: &labeledExpr{
-				pos:   position{line: 77, col: 23, offset: 2203},
+				pos:   position{line: 83, col: 23, offset: 2361},
 				label: "blocks",
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 77, col: 30, offset: 2210},
+					pos: position{line: 83, col: 30, offset: 2368},
 					expr: &ruleRefExpr{
-						pos:  position{line: 77, col: 31, offset: 2211},
+						pos:  position{line: 83, col: 31, offset: 2369},
 						name: "TextDocumentBlock",
 					},
 				},
@@ -347,32 +371,32 @@ var g = &grammar{
 		},
 		{
 			name: "TextDocumentBlock",
-			pos:  position{line: 79, col: 1, offset: 2232},
+			pos:  position{line: 85, col: 1, offset: 2390},
 			expr: &actionExpr{
-				pos: position{line: 79, col: 22, offset: 2253},
+				pos: position{line: 85, col: 22, offset: 2411},
 				run: (*parser).callonTextDocumentBlock1,
 				expr: &seqExpr{
-					pos: position{line: 79, col: 22, offset: 2253},
+					pos: position{line: 85, col: 22, offset: 2411},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 79, col: 22, offset: 2253},
+							pos: position{line: 85, col: 22, offset: 2411},
 							expr: &ruleRefExpr{
-								pos:  position{line: 79, col: 23, offset: 2254},
+								pos:  position{line: 85, col: 23, offset: 2412},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 80, col: 5, offset: 2263},
+							pos:   position{line: 86, col: 5, offset: 2421},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 80, col: 12, offset: 2270},
+								pos: position{line: 86, col: 12, offset: 2428},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 80, col: 12, offset: 2270},
+										pos:  position{line: 86, col: 12, offset: 2428},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 80, col: 24, offset: 2282},
+										pos:  position{line: 86, col: 24, offset: 2440},
 										name: "Paragraph",
 									},
 								},
@@ -384,38 +408,38 @@ var g = &grammar{
 		},
 		{
 			name: "FrontMatter",
-			pos:  position{line: 87, col: 1, offset: 2428},
+			pos:  position{line: 93, col: 1, offset: 2586},
 			expr: &ruleRefExpr{
-				pos:  position{line: 87, col: 16, offset: 2443},
+				pos:  position{line: 93, col: 16, offset: 2601},
 				name: "YamlFrontMatter",
 			},
 		},
 		{
 			name: "YamlFrontMatter",
-			pos:  position{line: 89, col: 1, offset: 2461},
+			pos:  position{line: 95, col: 1, offset: 2619},
 			expr: &actionExpr{
-				pos: position{line: 89, col: 20, offset: 2480},
+				pos: position{line: 95, col: 20, offset: 2638},
 				run: (*parser).callonYamlFrontMatter1,
 				expr: &seqExpr{
-					pos: position{line: 89, col: 20, offset: 2480},
+					pos: position{line: 95, col: 20, offset: 2638},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 89, col: 20, offset: 2480},
+							pos:  position{line: 95, col: 20, offset: 2638},
 							name: "YamlFrontMatterToken",
 						},
 						&labeledExpr{
-							pos:   position{line: 89, col: 41, offset: 2501},
+							pos:   position{line: 95, col: 41, offset: 2659},
 							label: "content",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 89, col: 49, offset: 2509},
+								pos: position{line: 95, col: 49, offset: 2667},
 								expr: &ruleRefExpr{
-									pos:  position{line: 89, col: 50, offset: 2510},
+									pos:  position{line: 95, col: 50, offset: 2668},
 									name: "YamlFrontMatterContent",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 89, col: 75, offset: 2535},
+							pos:  position{line: 95, col: 75, offset: 2693},
 							name: "YamlFrontMatterToken",
 						},
 					},
@@ -424,17 +448,17 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterToken",
-			pos:  position{line: 93, col: 1, offset: 2615},
+			pos:  position{line: 99, col: 1, offset: 2773},
 			expr: &seqExpr{
-				pos: position{line: 93, col: 26, offset: 2640},
+				pos: position{line: 99, col: 26, offset: 2798},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 93, col: 26, offset: 2640},
+						pos:        position{line: 99, col: 26, offset: 2798},
 						val:        "---",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 93, col: 32, offset: 2646},
+						pos:  position{line: 99, col: 32, offset: 2804},
 						name: "EOLS",
 					},
 				},
@@ -442,26 +466,26 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterContent",
-			pos:  position{line: 95, col: 1, offset: 2652},
+			pos:  position{line: 101, col: 1, offset: 2810},
 			expr: &actionExpr{
-				pos: position{line: 95, col: 27, offset: 2678},
+				pos: position{line: 101, col: 27, offset: 2836},
 				run: (*parser).callonYamlFrontMatterContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 95, col: 27, offset: 2678},
+					pos: position{line: 101, col: 27, offset: 2836},
 					expr: &oneOrMoreExpr{
-						pos: position{line: 95, col: 28, offset: 2679},
+						pos: position{line: 101, col: 28, offset: 2837},
 						expr: &seqExpr{
-							pos: position{line: 95, col: 29, offset: 2680},
+							pos: position{line: 101, col: 29, offset: 2838},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 95, col: 29, offset: 2680},
+									pos: position{line: 101, col: 29, offset: 2838},
 									expr: &ruleRefExpr{
-										pos:  position{line: 95, col: 30, offset: 2681},
+										pos:  position{line: 101, col: 30, offset: 2839},
 										name: "YamlFrontMatterToken",
 									},
 								},
 								&anyMatcher{
-									line: 95, col: 51, offset: 2702,
+									line: 101, col: 51, offset: 2860,
 								},
 							},
 						},
@@ -471,66 +495,66 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentHeader",
-			pos:  position{line: 102, col: 1, offset: 2868},
+			pos:  position{line: 108, col: 1, offset: 3026},
 			expr: &actionExpr{
-				pos: position{line: 102, col: 19, offset: 2886},
+				pos: position{line: 108, col: 19, offset: 3044},
 				run: (*parser).callonDocumentHeader1,
 				expr: &seqExpr{
-					pos: position{line: 102, col: 19, offset: 2886},
+					pos: position{line: 108, col: 19, offset: 3044},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 102, col: 19, offset: 2886},
+							pos:        position{line: 108, col: 19, offset: 3044},
 							val:        "=",
 							ignoreCase: false,
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 102, col: 23, offset: 2890},
+							pos: position{line: 108, col: 23, offset: 3048},
 							expr: &ruleRefExpr{
-								pos:  position{line: 102, col: 23, offset: 2890},
+								pos:  position{line: 108, col: 23, offset: 3048},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 102, col: 27, offset: 2894},
+							pos:   position{line: 108, col: 27, offset: 3052},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 102, col: 34, offset: 2901},
+								pos:  position{line: 108, col: 34, offset: 3059},
 								name: "TitleElements",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 102, col: 49, offset: 2916},
+							pos:   position{line: 108, col: 49, offset: 3074},
 							label: "id",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 102, col: 53, offset: 2920},
+								pos: position{line: 108, col: 53, offset: 3078},
 								expr: &ruleRefExpr{
-									pos:  position{line: 102, col: 53, offset: 2920},
+									pos:  position{line: 108, col: 53, offset: 3078},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 102, col: 71, offset: 2938},
+							pos:  position{line: 108, col: 71, offset: 3096},
 							name: "EOL",
 						},
 						&labeledExpr{
-							pos:   position{line: 103, col: 9, offset: 2950},
+							pos:   position{line: 109, col: 9, offset: 3108},
 							label: "authors",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 103, col: 18, offset: 2959},
+								pos: position{line: 109, col: 18, offset: 3117},
 								expr: &ruleRefExpr{
-									pos:  position{line: 103, col: 18, offset: 2959},
+									pos:  position{line: 109, col: 18, offset: 3117},
 									name: "DocumentAuthors",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 104, col: 9, offset: 2986},
+							pos:   position{line: 110, col: 9, offset: 3144},
 							label: "revision",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 104, col: 19, offset: 2996},
+								pos: position{line: 110, col: 19, offset: 3154},
 								expr: &ruleRefExpr{
-									pos:  position{line: 104, col: 19, offset: 2996},
+									pos:  position{line: 110, col: 19, offset: 3154},
 									name: "DocumentRevision",
 								},
 							},
@@ -541,16 +565,16 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthors",
-			pos:  position{line: 108, col: 1, offset: 3097},
+			pos:  position{line: 114, col: 1, offset: 3255},
 			expr: &choiceExpr{
-				pos: position{line: 108, col: 20, offset: 3116},
+				pos: position{line: 114, col: 20, offset: 3274},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 108, col: 20, offset: 3116},
+						pos:  position{line: 114, col: 20, offset: 3274},
 						name: "DocumentAuthorsInlineForm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 108, col: 48, offset: 3144},
+						pos:  position{line: 114, col: 48, offset: 3302},
 						name: "DocumentAuthorsAttributeForm",
 					},
 				},
@@ -558,41 +582,41 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsInlineForm",
-			pos:  position{line: 110, col: 1, offset: 3174},
+			pos:  position{line: 116, col: 1, offset: 3332},
 			expr: &actionExpr{
-				pos: position{line: 110, col: 30, offset: 3203},
+				pos: position{line: 116, col: 30, offset: 3361},
 				run: (*parser).callonDocumentAuthorsInlineForm1,
 				expr: &seqExpr{
-					pos: position{line: 110, col: 30, offset: 3203},
+					pos: position{line: 116, col: 30, offset: 3361},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 110, col: 30, offset: 3203},
+							pos: position{line: 116, col: 30, offset: 3361},
 							expr: &ruleRefExpr{
-								pos:  position{line: 110, col: 30, offset: 3203},
+								pos:  position{line: 116, col: 30, offset: 3361},
 								name: "WS",
 							},
 						},
 						&notExpr{
-							pos: position{line: 110, col: 34, offset: 3207},
+							pos: position{line: 116, col: 34, offset: 3365},
 							expr: &litMatcher{
-								pos:        position{line: 110, col: 35, offset: 3208},
+								pos:        position{line: 116, col: 35, offset: 3366},
 								val:        ":",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 110, col: 39, offset: 3212},
+							pos:   position{line: 116, col: 39, offset: 3370},
 							label: "authors",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 110, col: 48, offset: 3221},
+								pos: position{line: 116, col: 48, offset: 3379},
 								expr: &ruleRefExpr{
-									pos:  position{line: 110, col: 48, offset: 3221},
+									pos:  position{line: 116, col: 48, offset: 3379},
 									name: "DocumentAuthor",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 110, col: 65, offset: 3238},
+							pos:  position{line: 116, col: 65, offset: 3396},
 							name: "EOL",
 						},
 					},
@@ -601,35 +625,35 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsAttributeForm",
-			pos:  position{line: 114, col: 1, offset: 3308},
+			pos:  position{line: 120, col: 1, offset: 3466},
 			expr: &actionExpr{
-				pos: position{line: 114, col: 33, offset: 3340},
+				pos: position{line: 120, col: 33, offset: 3498},
 				run: (*parser).callonDocumentAuthorsAttributeForm1,
 				expr: &seqExpr{
-					pos: position{line: 114, col: 33, offset: 3340},
+					pos: position{line: 120, col: 33, offset: 3498},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 114, col: 33, offset: 3340},
+							pos: position{line: 120, col: 33, offset: 3498},
 							expr: &ruleRefExpr{
-								pos:  position{line: 114, col: 33, offset: 3340},
+								pos:  position{line: 120, col: 33, offset: 3498},
 								name: "WS",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 114, col: 37, offset: 3344},
+							pos:        position{line: 120, col: 37, offset: 3502},
 							val:        ":author:",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 114, col: 48, offset: 3355},
+							pos:   position{line: 120, col: 48, offset: 3513},
 							label: "author",
 							expr: &ruleRefExpr{
-								pos:  position{line: 114, col: 56, offset: 3363},
+								pos:  position{line: 120, col: 56, offset: 3521},
 								name: "DocumentAuthor",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 114, col: 72, offset: 3379},
+							pos:  position{line: 120, col: 72, offset: 3537},
 							name: "EOL",
 						},
 					},
@@ -638,58 +662,58 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthor",
-			pos:  position{line: 118, col: 1, offset: 3458},
+			pos:  position{line: 124, col: 1, offset: 3616},
 			expr: &actionExpr{
-				pos: position{line: 118, col: 19, offset: 3476},
+				pos: position{line: 124, col: 19, offset: 3634},
 				run: (*parser).callonDocumentAuthor1,
 				expr: &seqExpr{
-					pos: position{line: 118, col: 19, offset: 3476},
+					pos: position{line: 124, col: 19, offset: 3634},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 118, col: 19, offset: 3476},
+							pos: position{line: 124, col: 19, offset: 3634},
 							expr: &ruleRefExpr{
-								pos:  position{line: 118, col: 19, offset: 3476},
+								pos:  position{line: 124, col: 19, offset: 3634},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 118, col: 23, offset: 3480},
+							pos:   position{line: 124, col: 23, offset: 3638},
 							label: "fullname",
 							expr: &ruleRefExpr{
-								pos:  position{line: 118, col: 33, offset: 3490},
+								pos:  position{line: 124, col: 33, offset: 3648},
 								name: "DocumentAuthorName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 118, col: 53, offset: 3510},
+							pos:   position{line: 124, col: 53, offset: 3668},
 							label: "email",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 118, col: 59, offset: 3516},
+								pos: position{line: 124, col: 59, offset: 3674},
 								expr: &ruleRefExpr{
-									pos:  position{line: 118, col: 60, offset: 3517},
+									pos:  position{line: 124, col: 60, offset: 3675},
 									name: "DocumentAuthorEmail",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 118, col: 82, offset: 3539},
+							pos: position{line: 124, col: 82, offset: 3697},
 							expr: &ruleRefExpr{
-								pos:  position{line: 118, col: 82, offset: 3539},
+								pos:  position{line: 124, col: 82, offset: 3697},
 								name: "WS",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 118, col: 86, offset: 3543},
+							pos: position{line: 124, col: 86, offset: 3701},
 							expr: &litMatcher{
-								pos:        position{line: 118, col: 86, offset: 3543},
+								pos:        position{line: 124, col: 86, offset: 3701},
 								val:        ";",
 								ignoreCase: false,
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 118, col: 91, offset: 3548},
+							pos: position{line: 124, col: 91, offset: 3706},
 							expr: &ruleRefExpr{
-								pos:  position{line: 118, col: 91, offset: 3548},
+								pos:  position{line: 124, col: 91, offset: 3706},
 								name: "WS",
 							},
 						},
@@ -699,47 +723,47 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorName",
-			pos:  position{line: 123, col: 1, offset: 3690},
+			pos:  position{line: 129, col: 1, offset: 3848},
 			expr: &actionExpr{
-				pos: position{line: 123, col: 23, offset: 3712},
+				pos: position{line: 129, col: 23, offset: 3870},
 				run: (*parser).callonDocumentAuthorName1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 123, col: 23, offset: 3712},
+					pos: position{line: 129, col: 23, offset: 3870},
 					expr: &choiceExpr{
-						pos: position{line: 123, col: 24, offset: 3713},
+						pos: position{line: 129, col: 24, offset: 3871},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 123, col: 24, offset: 3713},
+								pos:  position{line: 129, col: 24, offset: 3871},
 								name: "Alphanums",
 							},
 							&seqExpr{
-								pos: position{line: 123, col: 37, offset: 3726},
+								pos: position{line: 129, col: 37, offset: 3884},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 123, col: 37, offset: 3726},
+										pos: position{line: 129, col: 37, offset: 3884},
 										expr: &litMatcher{
-											pos:        position{line: 123, col: 38, offset: 3727},
+											pos:        position{line: 129, col: 38, offset: 3885},
 											val:        "<",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 123, col: 42, offset: 3731},
+										pos: position{line: 129, col: 42, offset: 3889},
 										expr: &litMatcher{
-											pos:        position{line: 123, col: 43, offset: 3732},
+											pos:        position{line: 129, col: 43, offset: 3890},
 											val:        ";",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 123, col: 47, offset: 3736},
+										pos: position{line: 129, col: 47, offset: 3894},
 										expr: &ruleRefExpr{
-											pos:  position{line: 123, col: 48, offset: 3737},
+											pos:  position{line: 129, col: 48, offset: 3895},
 											name: "NEWLINE",
 										},
 									},
 									&anyMatcher{
-										line: 123, col: 56, offset: 3745,
+										line: 129, col: 56, offset: 3903,
 									},
 								},
 							},
@@ -750,53 +774,53 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorEmail",
-			pos:  position{line: 127, col: 1, offset: 3786},
+			pos:  position{line: 133, col: 1, offset: 3944},
 			expr: &actionExpr{
-				pos: position{line: 127, col: 24, offset: 3809},
+				pos: position{line: 133, col: 24, offset: 3967},
 				run: (*parser).callonDocumentAuthorEmail1,
 				expr: &seqExpr{
-					pos: position{line: 127, col: 24, offset: 3809},
+					pos: position{line: 133, col: 24, offset: 3967},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 127, col: 24, offset: 3809},
+							pos:        position{line: 133, col: 24, offset: 3967},
 							val:        "<",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 127, col: 28, offset: 3813},
+							pos:   position{line: 133, col: 28, offset: 3971},
 							label: "email",
 							expr: &actionExpr{
-								pos: position{line: 127, col: 35, offset: 3820},
+								pos: position{line: 133, col: 35, offset: 3978},
 								run: (*parser).callonDocumentAuthorEmail5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 127, col: 35, offset: 3820},
+									pos: position{line: 133, col: 35, offset: 3978},
 									expr: &choiceExpr{
-										pos: position{line: 127, col: 36, offset: 3821},
+										pos: position{line: 133, col: 36, offset: 3979},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 127, col: 36, offset: 3821},
+												pos:  position{line: 133, col: 36, offset: 3979},
 												name: "Alphanums",
 											},
 											&seqExpr{
-												pos: position{line: 127, col: 49, offset: 3834},
+												pos: position{line: 133, col: 49, offset: 3992},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 127, col: 49, offset: 3834},
+														pos: position{line: 133, col: 49, offset: 3992},
 														expr: &litMatcher{
-															pos:        position{line: 127, col: 50, offset: 3835},
+															pos:        position{line: 133, col: 50, offset: 3993},
 															val:        ">",
 															ignoreCase: false,
 														},
 													},
 													&notExpr{
-														pos: position{line: 127, col: 54, offset: 3839},
+														pos: position{line: 133, col: 54, offset: 3997},
 														expr: &ruleRefExpr{
-															pos:  position{line: 127, col: 55, offset: 3840},
+															pos:  position{line: 133, col: 55, offset: 3998},
 															name: "EOL",
 														},
 													},
 													&anyMatcher{
-														line: 127, col: 60, offset: 3845,
+														line: 133, col: 60, offset: 4003,
 													},
 												},
 											},
@@ -806,7 +830,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 129, col: 4, offset: 3886},
+							pos:        position{line: 135, col: 4, offset: 4044},
 							val:        ">",
 							ignoreCase: false,
 						},
@@ -816,82 +840,82 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevision",
-			pos:  position{line: 135, col: 1, offset: 4047},
+			pos:  position{line: 141, col: 1, offset: 4205},
 			expr: &actionExpr{
-				pos: position{line: 135, col: 21, offset: 4067},
+				pos: position{line: 141, col: 21, offset: 4225},
 				run: (*parser).callonDocumentRevision1,
 				expr: &seqExpr{
-					pos: position{line: 135, col: 21, offset: 4067},
+					pos: position{line: 141, col: 21, offset: 4225},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 135, col: 21, offset: 4067},
+							pos: position{line: 141, col: 21, offset: 4225},
 							expr: &ruleRefExpr{
-								pos:  position{line: 135, col: 21, offset: 4067},
+								pos:  position{line: 141, col: 21, offset: 4225},
 								name: "WS",
 							},
 						},
 						&notExpr{
-							pos: position{line: 135, col: 25, offset: 4071},
+							pos: position{line: 141, col: 25, offset: 4229},
 							expr: &litMatcher{
-								pos:        position{line: 135, col: 26, offset: 4072},
+								pos:        position{line: 141, col: 26, offset: 4230},
 								val:        ":",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 135, col: 30, offset: 4076},
+							pos:   position{line: 141, col: 30, offset: 4234},
 							label: "revision",
 							expr: &choiceExpr{
-								pos: position{line: 136, col: 9, offset: 4095},
+								pos: position{line: 142, col: 9, offset: 4253},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 136, col: 10, offset: 4096},
+										pos: position{line: 142, col: 10, offset: 4254},
 										run: (*parser).callonDocumentRevision9,
 										expr: &seqExpr{
-											pos: position{line: 136, col: 10, offset: 4096},
+											pos: position{line: 142, col: 10, offset: 4254},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 136, col: 10, offset: 4096},
+													pos:   position{line: 142, col: 10, offset: 4254},
 													label: "revnumber",
 													expr: &ruleRefExpr{
-														pos:  position{line: 136, col: 21, offset: 4107},
+														pos:  position{line: 142, col: 21, offset: 4265},
 														name: "DocumentRevisionNumber",
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 136, col: 45, offset: 4131},
+													pos: position{line: 142, col: 45, offset: 4289},
 													expr: &litMatcher{
-														pos:        position{line: 136, col: 45, offset: 4131},
+														pos:        position{line: 142, col: 45, offset: 4289},
 														val:        ",",
 														ignoreCase: false,
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 136, col: 50, offset: 4136},
+													pos:   position{line: 142, col: 50, offset: 4294},
 													label: "revdate",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 136, col: 58, offset: 4144},
+														pos: position{line: 142, col: 58, offset: 4302},
 														expr: &ruleRefExpr{
-															pos:  position{line: 136, col: 59, offset: 4145},
+															pos:  position{line: 142, col: 59, offset: 4303},
 															name: "DocumentRevisionDate",
 														},
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 136, col: 82, offset: 4168},
+													pos: position{line: 142, col: 82, offset: 4326},
 													expr: &litMatcher{
-														pos:        position{line: 136, col: 82, offset: 4168},
+														pos:        position{line: 142, col: 82, offset: 4326},
 														val:        ":",
 														ignoreCase: false,
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 136, col: 87, offset: 4173},
+													pos:   position{line: 142, col: 87, offset: 4331},
 													label: "revremark",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 136, col: 97, offset: 4183},
+														pos: position{line: 142, col: 97, offset: 4341},
 														expr: &ruleRefExpr{
-															pos:  position{line: 136, col: 98, offset: 4184},
+															pos:  position{line: 142, col: 98, offset: 4342},
 															name: "DocumentRevisionRemark",
 														},
 													},
@@ -900,34 +924,34 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 138, col: 15, offset: 4301},
+										pos: position{line: 144, col: 15, offset: 4459},
 										run: (*parser).callonDocumentRevision23,
 										expr: &seqExpr{
-											pos: position{line: 138, col: 15, offset: 4301},
+											pos: position{line: 144, col: 15, offset: 4459},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 138, col: 15, offset: 4301},
+													pos:   position{line: 144, col: 15, offset: 4459},
 													label: "revdate",
 													expr: &ruleRefExpr{
-														pos:  position{line: 138, col: 24, offset: 4310},
+														pos:  position{line: 144, col: 24, offset: 4468},
 														name: "DocumentRevisionDate",
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 138, col: 46, offset: 4332},
+													pos: position{line: 144, col: 46, offset: 4490},
 													expr: &litMatcher{
-														pos:        position{line: 138, col: 46, offset: 4332},
+														pos:        position{line: 144, col: 46, offset: 4490},
 														val:        ":",
 														ignoreCase: false,
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 138, col: 51, offset: 4337},
+													pos:   position{line: 144, col: 51, offset: 4495},
 													label: "revremark",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 138, col: 61, offset: 4347},
+														pos: position{line: 144, col: 61, offset: 4505},
 														expr: &ruleRefExpr{
-															pos:  position{line: 138, col: 62, offset: 4348},
+															pos:  position{line: 144, col: 62, offset: 4506},
 															name: "DocumentRevisionRemark",
 														},
 													},
@@ -939,7 +963,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 140, col: 13, offset: 4457},
+							pos:  position{line: 146, col: 13, offset: 4615},
 							name: "EOL",
 						},
 					},
@@ -948,66 +972,66 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionNumber",
-			pos:  position{line: 145, col: 1, offset: 4587},
+			pos:  position{line: 151, col: 1, offset: 4745},
 			expr: &choiceExpr{
-				pos: position{line: 145, col: 27, offset: 4613},
+				pos: position{line: 151, col: 27, offset: 4771},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 145, col: 27, offset: 4613},
+						pos: position{line: 151, col: 27, offset: 4771},
 						run: (*parser).callonDocumentRevisionNumber2,
 						expr: &seqExpr{
-							pos: position{line: 145, col: 27, offset: 4613},
+							pos: position{line: 151, col: 27, offset: 4771},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 145, col: 27, offset: 4613},
+									pos:        position{line: 151, col: 27, offset: 4771},
 									val:        "v",
 									ignoreCase: true,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 145, col: 32, offset: 4618},
+									pos:  position{line: 151, col: 32, offset: 4776},
 									name: "DIGIT",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 145, col: 39, offset: 4625},
+									pos: position{line: 151, col: 39, offset: 4783},
 									expr: &choiceExpr{
-										pos: position{line: 145, col: 40, offset: 4626},
+										pos: position{line: 151, col: 40, offset: 4784},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 145, col: 40, offset: 4626},
+												pos:  position{line: 151, col: 40, offset: 4784},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 145, col: 52, offset: 4638},
+												pos:  position{line: 151, col: 52, offset: 4796},
 												name: "Spaces",
 											},
 											&seqExpr{
-												pos: position{line: 145, col: 62, offset: 4648},
+												pos: position{line: 151, col: 62, offset: 4806},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 145, col: 62, offset: 4648},
+														pos: position{line: 151, col: 62, offset: 4806},
 														expr: &ruleRefExpr{
-															pos:  position{line: 145, col: 63, offset: 4649},
+															pos:  position{line: 151, col: 63, offset: 4807},
 															name: "EOL",
 														},
 													},
 													&notExpr{
-														pos: position{line: 145, col: 67, offset: 4653},
+														pos: position{line: 151, col: 67, offset: 4811},
 														expr: &litMatcher{
-															pos:        position{line: 145, col: 68, offset: 4654},
+															pos:        position{line: 151, col: 68, offset: 4812},
 															val:        ",",
 															ignoreCase: false,
 														},
 													},
 													&notExpr{
-														pos: position{line: 145, col: 72, offset: 4658},
+														pos: position{line: 151, col: 72, offset: 4816},
 														expr: &litMatcher{
-															pos:        position{line: 145, col: 73, offset: 4659},
+															pos:        position{line: 151, col: 73, offset: 4817},
 															val:        ":",
 															ignoreCase: false,
 														},
 													},
 													&anyMatcher{
-														line: 145, col: 78, offset: 4664,
+														line: 151, col: 78, offset: 4822,
 													},
 												},
 											},
@@ -1018,64 +1042,64 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 147, col: 5, offset: 4706},
+						pos: position{line: 153, col: 5, offset: 4864},
 						run: (*parser).callonDocumentRevisionNumber18,
 						expr: &seqExpr{
-							pos: position{line: 147, col: 5, offset: 4706},
+							pos: position{line: 153, col: 5, offset: 4864},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 147, col: 5, offset: 4706},
+									pos: position{line: 153, col: 5, offset: 4864},
 									expr: &litMatcher{
-										pos:        position{line: 147, col: 5, offset: 4706},
+										pos:        position{line: 153, col: 5, offset: 4864},
 										val:        "v",
 										ignoreCase: true,
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 147, col: 11, offset: 4712},
+									pos:  position{line: 153, col: 11, offset: 4870},
 									name: "DIGIT",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 147, col: 18, offset: 4719},
+									pos: position{line: 153, col: 18, offset: 4877},
 									expr: &choiceExpr{
-										pos: position{line: 147, col: 19, offset: 4720},
+										pos: position{line: 153, col: 19, offset: 4878},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 147, col: 19, offset: 4720},
+												pos:  position{line: 153, col: 19, offset: 4878},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 147, col: 31, offset: 4732},
+												pos:  position{line: 153, col: 31, offset: 4890},
 												name: "Spaces",
 											},
 											&seqExpr{
-												pos: position{line: 147, col: 41, offset: 4742},
+												pos: position{line: 153, col: 41, offset: 4900},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 147, col: 41, offset: 4742},
+														pos: position{line: 153, col: 41, offset: 4900},
 														expr: &ruleRefExpr{
-															pos:  position{line: 147, col: 42, offset: 4743},
+															pos:  position{line: 153, col: 42, offset: 4901},
 															name: "EOL",
 														},
 													},
 													&notExpr{
-														pos: position{line: 147, col: 46, offset: 4747},
+														pos: position{line: 153, col: 46, offset: 4905},
 														expr: &litMatcher{
-															pos:        position{line: 147, col: 47, offset: 4748},
+															pos:        position{line: 153, col: 47, offset: 4906},
 															val:        ",",
 															ignoreCase: false,
 														},
 													},
 													&notExpr{
-														pos: position{line: 147, col: 51, offset: 4752},
+														pos: position{line: 153, col: 51, offset: 4910},
 														expr: &litMatcher{
-															pos:        position{line: 147, col: 52, offset: 4753},
+															pos:        position{line: 153, col: 52, offset: 4911},
 															val:        ":",
 															ignoreCase: false,
 														},
 													},
 													&anyMatcher{
-														line: 147, col: 57, offset: 4758,
+														line: 153, col: 57, offset: 4916,
 													},
 												},
 											},
@@ -1083,16 +1107,16 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 147, col: 62, offset: 4763},
+									pos: position{line: 153, col: 62, offset: 4921},
 									expr: &ruleRefExpr{
-										pos:  position{line: 147, col: 62, offset: 4763},
+										pos:  position{line: 153, col: 62, offset: 4921},
 										name: "WS",
 									},
 								},
 								&andExpr{
-									pos: position{line: 147, col: 66, offset: 4767},
+									pos: position{line: 153, col: 66, offset: 4925},
 									expr: &litMatcher{
-										pos:        position{line: 147, col: 67, offset: 4768},
+										pos:        position{line: 153, col: 67, offset: 4926},
 										val:        ",",
 										ignoreCase: false,
 									},
@@ -1105,43 +1129,43 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionDate",
-			pos:  position{line: 151, col: 1, offset: 4808},
+			pos:  position{line: 157, col: 1, offset: 4966},
 			expr: &actionExpr{
-				pos: position{line: 151, col: 25, offset: 4832},
+				pos: position{line: 157, col: 25, offset: 4990},
 				run: (*parser).callonDocumentRevisionDate1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 151, col: 25, offset: 4832},
+					pos: position{line: 157, col: 25, offset: 4990},
 					expr: &choiceExpr{
-						pos: position{line: 151, col: 26, offset: 4833},
+						pos: position{line: 157, col: 26, offset: 4991},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 151, col: 26, offset: 4833},
+								pos:  position{line: 157, col: 26, offset: 4991},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 151, col: 38, offset: 4845},
+								pos:  position{line: 157, col: 38, offset: 5003},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 151, col: 48, offset: 4855},
+								pos: position{line: 157, col: 48, offset: 5013},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 151, col: 48, offset: 4855},
+										pos: position{line: 157, col: 48, offset: 5013},
 										expr: &ruleRefExpr{
-											pos:  position{line: 151, col: 49, offset: 4856},
+											pos:  position{line: 157, col: 49, offset: 5014},
 											name: "EOL",
 										},
 									},
 									&notExpr{
-										pos: position{line: 151, col: 53, offset: 4860},
+										pos: position{line: 157, col: 53, offset: 5018},
 										expr: &litMatcher{
-											pos:        position{line: 151, col: 54, offset: 4861},
+											pos:        position{line: 157, col: 54, offset: 5019},
 											val:        ":",
 											ignoreCase: false,
 										},
 									},
 									&anyMatcher{
-										line: 151, col: 59, offset: 4866,
+										line: 157, col: 59, offset: 5024,
 									},
 								},
 							},
@@ -1152,35 +1176,35 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionRemark",
-			pos:  position{line: 155, col: 1, offset: 4907},
+			pos:  position{line: 161, col: 1, offset: 5065},
 			expr: &actionExpr{
-				pos: position{line: 155, col: 27, offset: 4933},
+				pos: position{line: 161, col: 27, offset: 5091},
 				run: (*parser).callonDocumentRevisionRemark1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 155, col: 27, offset: 4933},
+					pos: position{line: 161, col: 27, offset: 5091},
 					expr: &choiceExpr{
-						pos: position{line: 155, col: 28, offset: 4934},
+						pos: position{line: 161, col: 28, offset: 5092},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 155, col: 28, offset: 4934},
+								pos:  position{line: 161, col: 28, offset: 5092},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 155, col: 40, offset: 4946},
+								pos:  position{line: 161, col: 40, offset: 5104},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 155, col: 50, offset: 4956},
+								pos: position{line: 161, col: 50, offset: 5114},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 155, col: 50, offset: 4956},
+										pos: position{line: 161, col: 50, offset: 5114},
 										expr: &ruleRefExpr{
-											pos:  position{line: 155, col: 51, offset: 4957},
+											pos:  position{line: 161, col: 51, offset: 5115},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 155, col: 56, offset: 4962,
+										line: 161, col: 56, offset: 5120,
 									},
 								},
 							},
@@ -1191,54 +1215,54 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeDeclaration",
-			pos:  position{line: 162, col: 1, offset: 5118},
+			pos:  position{line: 168, col: 1, offset: 5276},
 			expr: &actionExpr{
-				pos: position{line: 162, col: 33, offset: 5150},
+				pos: position{line: 168, col: 33, offset: 5308},
 				run: (*parser).callonDocumentAttributeDeclaration1,
 				expr: &seqExpr{
-					pos: position{line: 162, col: 33, offset: 5150},
+					pos: position{line: 168, col: 33, offset: 5308},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 162, col: 33, offset: 5150},
+							pos:        position{line: 168, col: 33, offset: 5308},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 162, col: 37, offset: 5154},
+							pos:   position{line: 168, col: 37, offset: 5312},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 162, col: 43, offset: 5160},
+								pos:  position{line: 168, col: 43, offset: 5318},
 								name: "DocumentAttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 162, col: 66, offset: 5183},
+							pos:        position{line: 168, col: 66, offset: 5341},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 162, col: 70, offset: 5187},
+							pos:   position{line: 168, col: 70, offset: 5345},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 162, col: 76, offset: 5193},
+								pos: position{line: 168, col: 76, offset: 5351},
 								expr: &actionExpr{
-									pos: position{line: 162, col: 77, offset: 5194},
+									pos: position{line: 168, col: 77, offset: 5352},
 									run: (*parser).callonDocumentAttributeDeclaration9,
 									expr: &seqExpr{
-										pos: position{line: 162, col: 78, offset: 5195},
+										pos: position{line: 168, col: 78, offset: 5353},
 										exprs: []interface{}{
 											&oneOrMoreExpr{
-												pos: position{line: 162, col: 78, offset: 5195},
+												pos: position{line: 168, col: 78, offset: 5353},
 												expr: &ruleRefExpr{
-													pos:  position{line: 162, col: 78, offset: 5195},
+													pos:  position{line: 168, col: 78, offset: 5353},
 													name: "WS",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 162, col: 82, offset: 5199},
+												pos:   position{line: 168, col: 82, offset: 5357},
 												label: "value",
 												expr: &ruleRefExpr{
-													pos:  position{line: 162, col: 89, offset: 5206},
+													pos:  position{line: 168, col: 89, offset: 5364},
 													name: "DocumentAttributeValue",
 												},
 											},
@@ -1248,7 +1272,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 162, col: 138, offset: 5255},
+							pos:  position{line: 168, col: 138, offset: 5413},
 							name: "EOLS",
 						},
 					},
@@ -1257,72 +1281,77 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeName",
-			pos:  position{line: 169, col: 1, offset: 5504},
+			pos:  position{line: 175, col: 1, offset: 5662},
 			expr: &actionExpr{
-				pos: position{line: 169, col: 26, offset: 5529},
+				pos: position{line: 175, col: 26, offset: 5687},
 				run: (*parser).callonDocumentAttributeName1,
 				expr: &seqExpr{
-					pos: position{line: 169, col: 26, offset: 5529},
+					pos: position{line: 175, col: 26, offset: 5687},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 169, col: 27, offset: 5530},
+							pos: position{line: 175, col: 27, offset: 5688},
 							alternatives: []interface{}{
 								&charClassMatcher{
-									pos:        position{line: 169, col: 27, offset: 5530},
+									pos:        position{line: 175, col: 27, offset: 5688},
 									val:        "[A-Z]",
 									ranges:     []rune{'A', 'Z'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 169, col: 35, offset: 5538},
+									pos:        position{line: 175, col: 35, offset: 5696},
 									val:        "[a-z]",
 									ranges:     []rune{'a', 'z'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 169, col: 43, offset: 5546},
+									pos:        position{line: 175, col: 43, offset: 5704},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 169, col: 51, offset: 5554},
+									pos:        position{line: 175, col: 51, offset: 5712},
 									val:        "_",
 									ignoreCase: false,
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 169, col: 56, offset: 5559},
+							pos: position{line: 175, col: 56, offset: 5717},
 							expr: &choiceExpr{
-								pos: position{line: 169, col: 57, offset: 5560},
+								pos: position{line: 175, col: 57, offset: 5718},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 169, col: 57, offset: 5560},
+										pos:        position{line: 175, col: 57, offset: 5718},
 										val:        "[A-Z]",
 										ranges:     []rune{'A', 'Z'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&charClassMatcher{
-										pos:        position{line: 169, col: 65, offset: 5568},
+										pos:        position{line: 175, col: 65, offset: 5726},
 										val:        "[a-z]",
 										ranges:     []rune{'a', 'z'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&charClassMatcher{
-										pos:        position{line: 169, col: 73, offset: 5576},
+										pos:        position{line: 175, col: 73, offset: 5734},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&litMatcher{
-										pos:        position{line: 169, col: 81, offset: 5584},
+										pos:        position{line: 175, col: 81, offset: 5742},
+										val:        "_",
+										ignoreCase: false,
+									},
+									&litMatcher{
+										pos:        position{line: 175, col: 87, offset: 5748},
 										val:        "-",
 										ignoreCase: false,
 									},
@@ -1335,24 +1364,24 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeValue",
-			pos:  position{line: 173, col: 1, offset: 5626},
+			pos:  position{line: 179, col: 1, offset: 5790},
 			expr: &actionExpr{
-				pos: position{line: 173, col: 27, offset: 5652},
+				pos: position{line: 179, col: 27, offset: 5816},
 				run: (*parser).callonDocumentAttributeValue1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 173, col: 27, offset: 5652},
+					pos: position{line: 179, col: 27, offset: 5816},
 					expr: &seqExpr{
-						pos: position{line: 173, col: 28, offset: 5653},
+						pos: position{line: 179, col: 28, offset: 5817},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 173, col: 28, offset: 5653},
+								pos: position{line: 179, col: 28, offset: 5817},
 								expr: &ruleRefExpr{
-									pos:  position{line: 173, col: 29, offset: 5654},
+									pos:  position{line: 179, col: 29, offset: 5818},
 									name: "NEWLINE",
 								},
 							},
 							&anyMatcher{
-								line: 173, col: 37, offset: 5662,
+								line: 179, col: 37, offset: 5826,
 							},
 						},
 					},
@@ -1361,67 +1390,67 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeReset",
-			pos:  position{line: 177, col: 1, offset: 5702},
+			pos:  position{line: 183, col: 1, offset: 5866},
 			expr: &choiceExpr{
-				pos: position{line: 177, col: 27, offset: 5728},
+				pos: position{line: 183, col: 27, offset: 5892},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 177, col: 27, offset: 5728},
+						pos: position{line: 183, col: 27, offset: 5892},
 						run: (*parser).callonDocumentAttributeReset2,
 						expr: &seqExpr{
-							pos: position{line: 177, col: 27, offset: 5728},
+							pos: position{line: 183, col: 27, offset: 5892},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 177, col: 27, offset: 5728},
+									pos:        position{line: 183, col: 27, offset: 5892},
 									val:        ":!",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 177, col: 32, offset: 5733},
+									pos:   position{line: 183, col: 32, offset: 5897},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 177, col: 38, offset: 5739},
+										pos:  position{line: 183, col: 38, offset: 5903},
 										name: "DocumentAttributeName",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 177, col: 61, offset: 5762},
+									pos:        position{line: 183, col: 61, offset: 5926},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 177, col: 65, offset: 5766},
+									pos:  position{line: 183, col: 65, offset: 5930},
 									name: "EOLS",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 179, col: 5, offset: 5835},
+						pos: position{line: 185, col: 5, offset: 5999},
 						run: (*parser).callonDocumentAttributeReset9,
 						expr: &seqExpr{
-							pos: position{line: 179, col: 5, offset: 5835},
+							pos: position{line: 185, col: 5, offset: 5999},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 179, col: 5, offset: 5835},
+									pos:        position{line: 185, col: 5, offset: 5999},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 179, col: 9, offset: 5839},
+									pos:   position{line: 185, col: 9, offset: 6003},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 179, col: 15, offset: 5845},
+										pos:  position{line: 185, col: 15, offset: 6009},
 										name: "DocumentAttributeName",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 179, col: 38, offset: 5868},
+									pos:        position{line: 185, col: 38, offset: 6032},
 									val:        "!:",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 179, col: 43, offset: 5873},
+									pos:  position{line: 185, col: 43, offset: 6037},
 									name: "EOLS",
 								},
 							},
@@ -1432,28 +1461,28 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAttributeSubstitution",
-			pos:  position{line: 183, col: 1, offset: 5941},
+			pos:  position{line: 189, col: 1, offset: 6105},
 			expr: &actionExpr{
-				pos: position{line: 183, col: 34, offset: 5974},
+				pos: position{line: 189, col: 34, offset: 6138},
 				run: (*parser).callonDocumentAttributeSubstitution1,
 				expr: &seqExpr{
-					pos: position{line: 183, col: 34, offset: 5974},
+					pos: position{line: 189, col: 34, offset: 6138},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 183, col: 34, offset: 5974},
+							pos:        position{line: 189, col: 34, offset: 6138},
 							val:        "{",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 183, col: 38, offset: 5978},
+							pos:   position{line: 189, col: 38, offset: 6142},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 183, col: 44, offset: 5984},
+								pos:  position{line: 189, col: 44, offset: 6148},
 								name: "DocumentAttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 183, col: 67, offset: 6007},
+							pos:        position{line: 189, col: 67, offset: 6171},
 							val:        "}",
 							ignoreCase: false,
 						},
@@ -1463,17 +1492,17 @@ var g = &grammar{
 		},
 		{
 			name: "ElementAttributes",
-			pos:  position{line: 190, col: 1, offset: 6195},
+			pos:  position{line: 196, col: 1, offset: 6359},
 			expr: &actionExpr{
-				pos: position{line: 190, col: 22, offset: 6216},
+				pos: position{line: 196, col: 22, offset: 6380},
 				run: (*parser).callonElementAttributes1,
 				expr: &labeledExpr{
-					pos:   position{line: 190, col: 22, offset: 6216},
+					pos:   position{line: 196, col: 22, offset: 6380},
 					label: "attrs",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 190, col: 28, offset: 6222},
+						pos: position{line: 196, col: 28, offset: 6386},
 						expr: &ruleRefExpr{
-							pos:  position{line: 190, col: 29, offset: 6223},
+							pos:  position{line: 196, col: 29, offset: 6387},
 							name: "ElementAttribute",
 						},
 					},
@@ -1482,30 +1511,30 @@ var g = &grammar{
 		},
 		{
 			name: "ElementAttribute",
-			pos:  position{line: 194, col: 1, offset: 6313},
+			pos:  position{line: 200, col: 1, offset: 6477},
 			expr: &actionExpr{
-				pos: position{line: 194, col: 21, offset: 6333},
+				pos: position{line: 200, col: 21, offset: 6497},
 				run: (*parser).callonElementAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 194, col: 21, offset: 6333},
+					pos: position{line: 200, col: 21, offset: 6497},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 194, col: 21, offset: 6333},
+							pos: position{line: 200, col: 21, offset: 6497},
 							expr: &choiceExpr{
-								pos: position{line: 194, col: 23, offset: 6335},
+								pos: position{line: 200, col: 23, offset: 6499},
 								alternatives: []interface{}{
 									&litMatcher{
-										pos:        position{line: 194, col: 23, offset: 6335},
+										pos:        position{line: 200, col: 23, offset: 6499},
 										val:        "[",
 										ignoreCase: false,
 									},
 									&litMatcher{
-										pos:        position{line: 194, col: 29, offset: 6341},
+										pos:        position{line: 200, col: 29, offset: 6505},
 										val:        ".",
 										ignoreCase: false,
 									},
 									&litMatcher{
-										pos:        position{line: 194, col: 35, offset: 6347},
+										pos:        position{line: 200, col: 35, offset: 6511},
 										val:        "#",
 										ignoreCase: false,
 									},
@@ -1513,49 +1542,53 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 195, col: 5, offset: 6423},
+							pos:   position{line: 201, col: 5, offset: 6587},
 							label: "attr",
 							expr: &choiceExpr{
-								pos: position{line: 195, col: 11, offset: 6429},
+								pos: position{line: 201, col: 11, offset: 6593},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 195, col: 11, offset: 6429},
+										pos:  position{line: 201, col: 11, offset: 6593},
 										name: "ElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 196, col: 9, offset: 6450},
+										pos:  position{line: 202, col: 9, offset: 6614},
 										name: "ElementTitle",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 197, col: 9, offset: 6474},
+										pos:  position{line: 203, col: 9, offset: 6638},
 										name: "ElementRole",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 198, col: 9, offset: 6497},
+										pos:  position{line: 204, col: 9, offset: 6661},
 										name: "LiteralAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 199, col: 9, offset: 6525},
+										pos:  position{line: 205, col: 9, offset: 6689},
 										name: "SourceAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 200, col: 9, offset: 6553},
+										pos:  position{line: 206, col: 9, offset: 6717},
+										name: "StemAttributes",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 207, col: 9, offset: 6743},
 										name: "QuoteAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 201, col: 9, offset: 6580},
+										pos:  position{line: 208, col: 9, offset: 6770},
 										name: "VerseAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 202, col: 9, offset: 6607},
+										pos:  position{line: 209, col: 9, offset: 6797},
 										name: "AdmonitionMarkerAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 203, col: 9, offset: 6644},
+										pos:  position{line: 210, col: 9, offset: 6834},
 										name: "HorizontalLayout",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 204, col: 9, offset: 6672},
+										pos:  position{line: 211, col: 9, offset: 6862},
 										name: "AttributeGroup",
 									},
 								},
@@ -1567,16 +1600,16 @@ var g = &grammar{
 		},
 		{
 			name: "MasqueradeAttribute",
-			pos:  position{line: 209, col: 1, offset: 6855},
+			pos:  position{line: 216, col: 1, offset: 7045},
 			expr: &choiceExpr{
-				pos: position{line: 209, col: 24, offset: 6878},
+				pos: position{line: 216, col: 24, offset: 7068},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 209, col: 24, offset: 6878},
+						pos:  position{line: 216, col: 24, offset: 7068},
 						name: "QuoteAttributes",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 209, col: 42, offset: 6896},
+						pos:  position{line: 216, col: 42, offset: 7086},
 						name: "VerseAttributes",
 					},
 				},
@@ -1584,67 +1617,67 @@ var g = &grammar{
 		},
 		{
 			name: "ElementID",
-			pos:  position{line: 211, col: 1, offset: 6913},
+			pos:  position{line: 218, col: 1, offset: 7103},
 			expr: &choiceExpr{
-				pos: position{line: 211, col: 14, offset: 6926},
+				pos: position{line: 218, col: 14, offset: 7116},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 211, col: 14, offset: 6926},
+						pos: position{line: 218, col: 14, offset: 7116},
 						run: (*parser).callonElementID2,
 						expr: &seqExpr{
-							pos: position{line: 211, col: 14, offset: 6926},
+							pos: position{line: 218, col: 14, offset: 7116},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 211, col: 14, offset: 6926},
+									pos:        position{line: 218, col: 14, offset: 7116},
 									val:        "[[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 211, col: 19, offset: 6931},
+									pos:   position{line: 218, col: 19, offset: 7121},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 211, col: 23, offset: 6935},
+										pos:  position{line: 218, col: 23, offset: 7125},
 										name: "ID",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 211, col: 27, offset: 6939},
+									pos:        position{line: 218, col: 27, offset: 7129},
 									val:        "]]",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 211, col: 32, offset: 6944},
+									pos:  position{line: 218, col: 32, offset: 7134},
 									name: "EOLS",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 213, col: 5, offset: 6998},
+						pos: position{line: 220, col: 5, offset: 7188},
 						run: (*parser).callonElementID9,
 						expr: &seqExpr{
-							pos: position{line: 213, col: 5, offset: 6998},
+							pos: position{line: 220, col: 5, offset: 7188},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 213, col: 5, offset: 6998},
+									pos:        position{line: 220, col: 5, offset: 7188},
 									val:        "[#",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 213, col: 10, offset: 7003},
+									pos:   position{line: 220, col: 10, offset: 7193},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 213, col: 14, offset: 7007},
+										pos:  position{line: 220, col: 14, offset: 7197},
 										name: "ID",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 213, col: 18, offset: 7011},
+									pos:        position{line: 220, col: 18, offset: 7201},
 									val:        "]",
 									ignoreCase: false,
 								},
 								&ruleRefExpr{
-									pos:  position{line: 213, col: 23, offset: 7016},
+									pos:  position{line: 220, col: 23, offset: 7206},
 									name: "EOLS",
 								},
 							},
@@ -1655,35 +1688,35 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElementID",
-			pos:  position{line: 217, col: 1, offset: 7069},
+			pos:  position{line: 224, col: 1, offset: 7259},
 			expr: &actionExpr{
-				pos: position{line: 217, col: 20, offset: 7088},
+				pos: position{line: 224, col: 20, offset: 7278},
 				run: (*parser).callonInlineElementID1,
 				expr: &seqExpr{
-					pos: position{line: 217, col: 20, offset: 7088},
+					pos: position{line: 224, col: 20, offset: 7278},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 217, col: 20, offset: 7088},
+							pos:        position{line: 224, col: 20, offset: 7278},
 							val:        "[[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 217, col: 25, offset: 7093},
+							pos:   position{line: 224, col: 25, offset: 7283},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 217, col: 29, offset: 7097},
+								pos:  position{line: 224, col: 29, offset: 7287},
 								name: "ID",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 217, col: 33, offset: 7101},
+							pos:        position{line: 224, col: 33, offset: 7291},
 							val:        "]]",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 217, col: 38, offset: 7106},
+							pos: position{line: 224, col: 38, offset: 7296},
 							expr: &ruleRefExpr{
-								pos:  position{line: 217, col: 38, offset: 7106},
+								pos:  position{line: 224, col: 38, offset: 7296},
 								name: "WS",
 							},
 						},
@@ -1693,56 +1726,56 @@ var g = &grammar{
 		},
 		{
 			name: "ElementTitle",
-			pos:  position{line: 223, col: 1, offset: 7380},
+			pos:  position{line: 230, col: 1, offset: 7570},
 			expr: &actionExpr{
-				pos: position{line: 223, col: 17, offset: 7396},
+				pos: position{line: 230, col: 17, offset: 7586},
 				run: (*parser).callonElementTitle1,
 				expr: &seqExpr{
-					pos: position{line: 223, col: 17, offset: 7396},
+					pos: position{line: 230, col: 17, offset: 7586},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 223, col: 17, offset: 7396},
+							pos:        position{line: 230, col: 17, offset: 7586},
 							val:        ".",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 223, col: 21, offset: 7400},
+							pos:   position{line: 230, col: 21, offset: 7590},
 							label: "title",
 							expr: &actionExpr{
-								pos: position{line: 223, col: 28, offset: 7407},
+								pos: position{line: 230, col: 28, offset: 7597},
 								run: (*parser).callonElementTitle5,
 								expr: &seqExpr{
-									pos: position{line: 223, col: 28, offset: 7407},
+									pos: position{line: 230, col: 28, offset: 7597},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 223, col: 28, offset: 7407},
+											pos:  position{line: 230, col: 28, offset: 7597},
 											name: "Alphanums",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 223, col: 38, offset: 7417},
+											pos: position{line: 230, col: 38, offset: 7607},
 											expr: &choiceExpr{
-												pos: position{line: 223, col: 39, offset: 7418},
+												pos: position{line: 230, col: 39, offset: 7608},
 												alternatives: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 223, col: 39, offset: 7418},
+														pos:  position{line: 230, col: 39, offset: 7608},
 														name: "Alphanums",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 223, col: 51, offset: 7430},
+														pos:  position{line: 230, col: 51, offset: 7620},
 														name: "Spaces",
 													},
 													&seqExpr{
-														pos: position{line: 223, col: 61, offset: 7440},
+														pos: position{line: 230, col: 61, offset: 7630},
 														exprs: []interface{}{
 															&notExpr{
-																pos: position{line: 223, col: 61, offset: 7440},
+																pos: position{line: 230, col: 61, offset: 7630},
 																expr: &ruleRefExpr{
-																	pos:  position{line: 223, col: 62, offset: 7441},
+																	pos:  position{line: 230, col: 62, offset: 7631},
 																	name: "NEWLINE",
 																},
 															},
 															&anyMatcher{
-																line: 223, col: 70, offset: 7449,
+																line: 230, col: 70, offset: 7639,
 															},
 														},
 													},
@@ -1754,7 +1787,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 225, col: 4, offset: 7490},
+							pos:  position{line: 232, col: 4, offset: 7680},
 							name: "EOL",
 						},
 					},
@@ -1763,64 +1796,64 @@ var g = &grammar{
 		},
 		{
 			name: "ElementRole",
-			pos:  position{line: 231, col: 1, offset: 7642},
+			pos:  position{line: 238, col: 1, offset: 7832},
 			expr: &actionExpr{
-				pos: position{line: 231, col: 16, offset: 7657},
+				pos: position{line: 238, col: 16, offset: 7847},
 				run: (*parser).callonElementRole1,
 				expr: &seqExpr{
-					pos: position{line: 231, col: 16, offset: 7657},
+					pos: position{line: 238, col: 16, offset: 7847},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 231, col: 16, offset: 7657},
+							pos:        position{line: 238, col: 16, offset: 7847},
 							val:        "[.",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 231, col: 21, offset: 7662},
+							pos:   position{line: 238, col: 21, offset: 7852},
 							label: "role",
 							expr: &actionExpr{
-								pos: position{line: 231, col: 27, offset: 7668},
+								pos: position{line: 238, col: 27, offset: 7858},
 								run: (*parser).callonElementRole5,
 								expr: &seqExpr{
-									pos: position{line: 231, col: 27, offset: 7668},
+									pos: position{line: 238, col: 27, offset: 7858},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 231, col: 27, offset: 7668},
+											pos:  position{line: 238, col: 27, offset: 7858},
 											name: "Alphanums",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 231, col: 37, offset: 7678},
+											pos: position{line: 238, col: 37, offset: 7868},
 											expr: &choiceExpr{
-												pos: position{line: 231, col: 38, offset: 7679},
+												pos: position{line: 238, col: 38, offset: 7869},
 												alternatives: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 231, col: 38, offset: 7679},
+														pos:  position{line: 238, col: 38, offset: 7869},
 														name: "Alphanums",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 231, col: 50, offset: 7691},
+														pos:  position{line: 238, col: 50, offset: 7881},
 														name: "Spaces",
 													},
 													&seqExpr{
-														pos: position{line: 231, col: 60, offset: 7701},
+														pos: position{line: 238, col: 60, offset: 7891},
 														exprs: []interface{}{
 															&notExpr{
-																pos: position{line: 231, col: 60, offset: 7701},
+																pos: position{line: 238, col: 60, offset: 7891},
 																expr: &ruleRefExpr{
-																	pos:  position{line: 231, col: 61, offset: 7702},
+																	pos:  position{line: 238, col: 61, offset: 7892},
 																	name: "NEWLINE",
 																},
 															},
 															&notExpr{
-																pos: position{line: 231, col: 69, offset: 7710},
+																pos: position{line: 238, col: 69, offset: 7900},
 																expr: &litMatcher{
-																	pos:        position{line: 231, col: 70, offset: 7711},
+																	pos:        position{line: 238, col: 70, offset: 7901},
 																	val:        "]",
 																	ignoreCase: false,
 																},
 															},
 															&anyMatcher{
-																line: 231, col: 74, offset: 7715,
+																line: 238, col: 74, offset: 7905,
 															},
 														},
 													},
@@ -1832,12 +1865,12 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 233, col: 4, offset: 7756},
+							pos:        position{line: 240, col: 4, offset: 7946},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 233, col: 8, offset: 7760},
+							pos:  position{line: 240, col: 8, offset: 7950},
 							name: "EOLS",
 						},
 					},
@@ -1846,27 +1879,27 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralAttribute",
-			pos:  position{line: 237, col: 1, offset: 7817},
+			pos:  position{line: 244, col: 1, offset: 8007},
 			expr: &actionExpr{
-				pos: position{line: 237, col: 21, offset: 7837},
+				pos: position{line: 244, col: 21, offset: 8027},
 				run: (*parser).callonLiteralAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 237, col: 21, offset: 7837},
+					pos: position{line: 244, col: 21, offset: 8027},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 237, col: 21, offset: 7837},
+							pos:        position{line: 244, col: 21, offset: 8027},
 							val:        "[literal]",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 237, col: 33, offset: 7849},
+							pos: position{line: 244, col: 33, offset: 8039},
 							expr: &ruleRefExpr{
-								pos:  position{line: 237, col: 33, offset: 7849},
+								pos:  position{line: 244, col: 33, offset: 8039},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 237, col: 37, offset: 7853},
+							pos:  position{line: 244, col: 37, offset: 8043},
 							name: "NEWLINE",
 						},
 					},
@@ -1875,33 +1908,33 @@ var g = &grammar{
 		},
 		{
 			name: "AdmonitionMarkerAttribute",
-			pos:  position{line: 242, col: 1, offset: 7985},
+			pos:  position{line: 249, col: 1, offset: 8175},
 			expr: &actionExpr{
-				pos: position{line: 242, col: 30, offset: 8014},
+				pos: position{line: 249, col: 30, offset: 8204},
 				run: (*parser).callonAdmonitionMarkerAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 242, col: 30, offset: 8014},
+					pos: position{line: 249, col: 30, offset: 8204},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 242, col: 30, offset: 8014},
+							pos:        position{line: 249, col: 30, offset: 8204},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 242, col: 34, offset: 8018},
+							pos:   position{line: 249, col: 34, offset: 8208},
 							label: "k",
 							expr: &ruleRefExpr{
-								pos:  position{line: 242, col: 37, offset: 8021},
+								pos:  position{line: 249, col: 37, offset: 8211},
 								name: "AdmonitionKind",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 242, col: 53, offset: 8037},
+							pos:        position{line: 249, col: 53, offset: 8227},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 242, col: 57, offset: 8041},
+							pos:  position{line: 249, col: 57, offset: 8231},
 							name: "EOLS",
 						},
 					},
@@ -1910,67 +1943,81 @@ var g = &grammar{
 		},
 		{
 			name: "SourceAttributes",
-			pos:  position{line: 247, col: 1, offset: 8197},
+			pos:  position{line: 254, col: 1, offset: 8387},
 			expr: &actionExpr{
-				pos: position{line: 247, col: 21, offset: 8217},
+				pos: position{line: 254, col: 21, offset: 8407},
 				run: (*parser).callonSourceAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 247, col: 21, offset: 8217},
+					pos: position{line: 254, col: 21, offset: 8407},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 247, col: 21, offset: 8217},
+							pos:        position{line: 254, col: 21, offset: 8407},
 							val:        "[source",
 							ignoreCase: false,
 						},
+						&zeroOrMoreExpr{
+							pos: position{line: 254, col: 31, offset: 8417},
+							expr: &ruleRefExpr{
+								pos:  position{line: 254, col: 31, offset: 8417},
+								name: "WS",
+							},
+						},
 						&zeroOrOneExpr{
-							pos: position{line: 247, col: 31, offset: 8227},
+							pos: position{line: 254, col: 35, offset: 8421},
 							expr: &litMatcher{
-								pos:        position{line: 247, col: 31, offset: 8227},
+								pos:        position{line: 254, col: 35, offset: 8421},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
+						&zeroOrMoreExpr{
+							pos: position{line: 254, col: 40, offset: 8426},
+							expr: &ruleRefExpr{
+								pos:  position{line: 254, col: 40, offset: 8426},
+								name: "WS",
+							},
+						},
 						&labeledExpr{
-							pos:   position{line: 247, col: 36, offset: 8232},
+							pos:   position{line: 254, col: 44, offset: 8430},
 							label: "language",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 247, col: 45, offset: 8241},
+								pos: position{line: 254, col: 53, offset: 8439},
 								expr: &actionExpr{
-									pos: position{line: 247, col: 46, offset: 8242},
-									run: (*parser).callonSourceAttributes8,
+									pos: position{line: 254, col: 54, offset: 8440},
+									run: (*parser).callonSourceAttributes12,
 									expr: &oneOrMoreExpr{
-										pos: position{line: 247, col: 46, offset: 8242},
+										pos: position{line: 254, col: 54, offset: 8440},
 										expr: &choiceExpr{
-											pos: position{line: 247, col: 47, offset: 8243},
+											pos: position{line: 254, col: 55, offset: 8441},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 247, col: 47, offset: 8243},
+													pos:  position{line: 254, col: 55, offset: 8441},
 													name: "Alphanums",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 247, col: 59, offset: 8255},
+													pos:  position{line: 254, col: 67, offset: 8453},
 													name: "Spaces",
 												},
 												&seqExpr{
-													pos: position{line: 247, col: 69, offset: 8265},
+													pos: position{line: 254, col: 77, offset: 8463},
 													exprs: []interface{}{
 														&notExpr{
-															pos: position{line: 247, col: 69, offset: 8265},
+															pos: position{line: 254, col: 77, offset: 8463},
 															expr: &ruleRefExpr{
-																pos:  position{line: 247, col: 70, offset: 8266},
+																pos:  position{line: 254, col: 78, offset: 8464},
 																name: "NEWLINE",
 															},
 														},
 														&notExpr{
-															pos: position{line: 247, col: 78, offset: 8274},
+															pos: position{line: 254, col: 86, offset: 8472},
 															expr: &litMatcher{
-																pos:        position{line: 247, col: 79, offset: 8275},
+																pos:        position{line: 254, col: 87, offset: 8473},
 																val:        "]",
 																ignoreCase: false,
 															},
 														},
 														&anyMatcher{
-															line: 247, col: 83, offset: 8279,
+															line: 254, col: 91, offset: 8477,
 														},
 													},
 												},
@@ -1981,12 +2028,47 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 249, col: 9, offset: 8329},
+							pos:        position{line: 256, col: 9, offset: 8527},
+							val:        "]",
+							ignoreCase: false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 256, col: 13, offset: 8531},
+							name: "EOLS",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StemAttributes",
+			pos:  position{line: 261, col: 1, offset: 8683},
+			expr: &actionExpr{
+				pos: position{line: 261, col: 19, offset: 8701},
+				run: (*parser).callonStemAttributes1,
+				expr: &seqExpr{
+					pos: position{line: 261, col: 19, offset: 8701},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 261, col: 19, offset: 8701},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 261, col: 23, offset: 8705},
+							label: "notation",
+							expr: &ruleRefExpr{
+								pos:  position{line: 261, col: 33, offset: 8715},
+								name: "StemNotation",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 261, col: 47, offset: 8729},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 249, col: 13, offset: 8333},
+							pos:  position{line: 261, col: 51, offset: 8733},
 							name: "EOLS",
 						},
 					},
@@ -1995,36 +2077,36 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeGroup",
-			pos:  position{line: 254, col: 1, offset: 8458},
+			pos:  position{line: 266, col: 1, offset: 8865},
 			expr: &actionExpr{
-				pos: position{line: 254, col: 19, offset: 8476},
+				pos: position{line: 266, col: 19, offset: 8883},
 				run: (*parser).callonAttributeGroup1,
 				expr: &seqExpr{
-					pos: position{line: 254, col: 19, offset: 8476},
+					pos: position{line: 266, col: 19, offset: 8883},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 254, col: 19, offset: 8476},
+							pos:        position{line: 266, col: 19, offset: 8883},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 254, col: 23, offset: 8480},
+							pos:   position{line: 266, col: 23, offset: 8887},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 254, col: 34, offset: 8491},
+								pos: position{line: 266, col: 34, offset: 8898},
 								expr: &ruleRefExpr{
-									pos:  position{line: 254, col: 35, offset: 8492},
+									pos:  position{line: 266, col: 35, offset: 8899},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 254, col: 54, offset: 8511},
+							pos:        position{line: 266, col: 54, offset: 8918},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 254, col: 58, offset: 8515},
+							pos:  position{line: 266, col: 58, offset: 8922},
 							name: "EOLS",
 						},
 					},
@@ -2033,52 +2115,59 @@ var g = &grammar{
 		},
 		{
 			name: "GenericAttribute",
-			pos:  position{line: 258, col: 1, offset: 8588},
+			pos:  position{line: 270, col: 1, offset: 8995},
 			expr: &choiceExpr{
-				pos: position{line: 259, col: 5, offset: 8613},
+				pos: position{line: 271, col: 5, offset: 9020},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 259, col: 5, offset: 8613},
+						pos: position{line: 271, col: 5, offset: 9020},
 						run: (*parser).callonGenericAttribute2,
 						expr: &seqExpr{
-							pos: position{line: 259, col: 5, offset: 8613},
+							pos: position{line: 271, col: 5, offset: 9020},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 259, col: 5, offset: 8613},
+									pos:   position{line: 271, col: 5, offset: 9020},
 									label: "key",
 									expr: &ruleRefExpr{
-										pos:  position{line: 259, col: 10, offset: 8618},
+										pos:  position{line: 271, col: 10, offset: 9025},
 										name: "AttributeKey",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 259, col: 24, offset: 8632},
+									pos:        position{line: 271, col: 24, offset: 9039},
 									val:        "=",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 259, col: 28, offset: 8636},
+									pos:   position{line: 271, col: 28, offset: 9043},
 									label: "value",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 259, col: 34, offset: 8642},
+										pos: position{line: 271, col: 34, offset: 9049},
 										expr: &ruleRefExpr{
-											pos:  position{line: 259, col: 35, offset: 8643},
+											pos:  position{line: 271, col: 35, offset: 9050},
 											name: "AttributeValue",
 										},
 									},
 								},
+								&zeroOrMoreExpr{
+									pos: position{line: 271, col: 52, offset: 9067},
+									expr: &ruleRefExpr{
+										pos:  position{line: 271, col: 52, offset: 9067},
+										name: "WS",
+									},
+								},
 								&zeroOrOneExpr{
-									pos: position{line: 259, col: 52, offset: 8660},
+									pos: position{line: 271, col: 56, offset: 9071},
 									expr: &litMatcher{
-										pos:        position{line: 259, col: 52, offset: 8660},
+										pos:        position{line: 271, col: 56, offset: 9071},
 										val:        ",",
 										ignoreCase: false,
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 259, col: 57, offset: 8665},
+									pos: position{line: 271, col: 61, offset: 9076},
 									expr: &ruleRefExpr{
-										pos:  position{line: 259, col: 57, offset: 8665},
+										pos:  position{line: 271, col: 61, offset: 9076},
 										name: "WS",
 									},
 								},
@@ -2086,31 +2175,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 261, col: 9, offset: 8770},
-						run: (*parser).callonGenericAttribute14,
+						pos: position{line: 273, col: 9, offset: 9181},
+						run: (*parser).callonGenericAttribute16,
 						expr: &seqExpr{
-							pos: position{line: 261, col: 9, offset: 8770},
+							pos: position{line: 273, col: 9, offset: 9181},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 261, col: 9, offset: 8770},
+									pos:   position{line: 273, col: 9, offset: 9181},
 									label: "key",
 									expr: &ruleRefExpr{
-										pos:  position{line: 261, col: 14, offset: 8775},
+										pos:  position{line: 273, col: 14, offset: 9186},
 										name: "AttributeKey",
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 261, col: 28, offset: 8789},
+									pos: position{line: 273, col: 28, offset: 9200},
 									expr: &litMatcher{
-										pos:        position{line: 261, col: 28, offset: 8789},
+										pos:        position{line: 273, col: 28, offset: 9200},
 										val:        ",",
 										ignoreCase: false,
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 261, col: 33, offset: 8794},
+									pos: position{line: 273, col: 33, offset: 9205},
 									expr: &ruleRefExpr{
-										pos:  position{line: 261, col: 33, offset: 8794},
+										pos:  position{line: 273, col: 33, offset: 9205},
 										name: "WS",
 									},
 								},
@@ -2122,58 +2211,58 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeKey",
-			pos:  position{line: 265, col: 1, offset: 8887},
+			pos:  position{line: 277, col: 1, offset: 9298},
 			expr: &actionExpr{
-				pos: position{line: 265, col: 17, offset: 8903},
+				pos: position{line: 277, col: 17, offset: 9314},
 				run: (*parser).callonAttributeKey1,
 				expr: &seqExpr{
-					pos: position{line: 265, col: 17, offset: 8903},
+					pos: position{line: 277, col: 17, offset: 9314},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 265, col: 17, offset: 8903},
+							pos: position{line: 277, col: 17, offset: 9314},
 							expr: &litMatcher{
-								pos:        position{line: 265, col: 18, offset: 8904},
+								pos:        position{line: 277, col: 18, offset: 9315},
 								val:        "quote",
 								ignoreCase: false,
 							},
 						},
 						&notExpr{
-							pos: position{line: 265, col: 26, offset: 8912},
+							pos: position{line: 277, col: 26, offset: 9323},
 							expr: &litMatcher{
-								pos:        position{line: 265, col: 27, offset: 8913},
+								pos:        position{line: 277, col: 27, offset: 9324},
 								val:        "verse",
 								ignoreCase: false,
 							},
 						},
 						&notExpr{
-							pos: position{line: 265, col: 35, offset: 8921},
+							pos: position{line: 277, col: 35, offset: 9332},
 							expr: &litMatcher{
-								pos:        position{line: 265, col: 36, offset: 8922},
+								pos:        position{line: 277, col: 36, offset: 9333},
 								val:        "literal",
 								ignoreCase: false,
 							},
 						},
 						&notExpr{
-							pos: position{line: 265, col: 46, offset: 8932},
+							pos: position{line: 277, col: 46, offset: 9343},
 							expr: &ruleRefExpr{
-								pos:  position{line: 265, col: 47, offset: 8933},
+								pos:  position{line: 277, col: 47, offset: 9344},
 								name: "Spaces",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 265, col: 54, offset: 8940},
+							pos:   position{line: 277, col: 54, offset: 9351},
 							label: "key",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 265, col: 58, offset: 8944},
+								pos: position{line: 277, col: 58, offset: 9355},
 								expr: &choiceExpr{
-									pos: position{line: 265, col: 59, offset: 8945},
+									pos: position{line: 277, col: 59, offset: 9356},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 265, col: 59, offset: 8945},
+											pos:  position{line: 277, col: 59, offset: 9356},
 											name: "Alphanums",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 265, col: 71, offset: 8957},
+											pos:  position{line: 277, col: 71, offset: 9368},
 											name: "OtherAttributeChar",
 										},
 									},
@@ -2181,9 +2270,9 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 265, col: 92, offset: 8978},
+							pos: position{line: 277, col: 92, offset: 9389},
 							expr: &ruleRefExpr{
-								pos:  position{line: 265, col: 92, offset: 8978},
+								pos:  position{line: 277, col: 92, offset: 9389},
 								name: "WS",
 							},
 						},
@@ -2193,43 +2282,131 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeValue",
-			pos:  position{line: 269, col: 1, offset: 9018},
-			expr: &actionExpr{
-				pos: position{line: 269, col: 19, offset: 9036},
-				run: (*parser).callonAttributeValue1,
-				expr: &seqExpr{
-					pos: position{line: 269, col: 19, offset: 9036},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 269, col: 19, offset: 9036},
-							label: "value",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 269, col: 25, offset: 9042},
-								expr: &choiceExpr{
-									pos: position{line: 269, col: 26, offset: 9043},
-									alternatives: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 269, col: 26, offset: 9043},
-											name: "Alphanums",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 269, col: 38, offset: 9055},
-											name: "Spaces",
+			pos:  position{line: 281, col: 1, offset: 9429},
+			expr: &choiceExpr{
+				pos: position{line: 281, col: 19, offset: 9447},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 281, col: 19, offset: 9447},
+						run: (*parser).callonAttributeValue2,
+						expr: &seqExpr{
+							pos: position{line: 281, col: 19, offset: 9447},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 281, col: 19, offset: 9447},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 281, col: 24, offset: 9452},
+									label: "value",
+									expr: &actionExpr{
+										pos: position{line: 281, col: 31, offset: 9459},
+										run: (*parser).callonAttributeValue6,
+										expr: &zeroOrMoreExpr{
+											pos: position{line: 281, col: 31, offset: 9459},
+											expr: &seqExpr{
+												pos: position{line: 281, col: 32, offset: 9460},
+												exprs: []interface{}{
+													&notExpr{
+														pos: position{line: 281, col: 32, offset: 9460},
+														expr: &litMatcher{
+															pos:        position{line: 281, col: 33, offset: 9461},
+															val:        "\"",
+															ignoreCase: false,
+														},
+													},
+													&notExpr{
+														pos: position{line: 281, col: 38, offset: 9466},
+														expr: &ruleRefExpr{
+															pos:  position{line: 281, col: 39, offset: 9467},
+															name: "EOL",
+														},
+													},
+													&anyMatcher{
+														line: 281, col: 43, offset: 9471,
+													},
+												},
+											},
 										},
-										&ruleRefExpr{
-											pos:  position{line: 269, col: 47, offset: 9064},
-											name: "OtherAttributeChar",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 281, col: 79, offset: 9507},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&andExpr{
+									pos: position{line: 281, col: 84, offset: 9512},
+									expr: &seqExpr{
+										pos: position{line: 281, col: 86, offset: 9514},
+										exprs: []interface{}{
+											&zeroOrMoreExpr{
+												pos: position{line: 281, col: 86, offset: 9514},
+												expr: &ruleRefExpr{
+													pos:  position{line: 281, col: 86, offset: 9514},
+													name: "WS",
+												},
+											},
+											&choiceExpr{
+												pos: position{line: 281, col: 91, offset: 9519},
+												alternatives: []interface{}{
+													&litMatcher{
+														pos:        position{line: 281, col: 91, offset: 9519},
+														val:        ",",
+														ignoreCase: false,
+													},
+													&litMatcher{
+														pos:        position{line: 281, col: 97, offset: 9525},
+														val:        "]",
+														ignoreCase: false,
+													},
+												},
+											},
 										},
 									},
 								},
 							},
 						},
-						&notExpr{
-							pos: position{line: 269, col: 68, offset: 9085},
-							expr: &litMatcher{
-								pos:        position{line: 269, col: 69, offset: 9086},
-								val:        "=",
-								ignoreCase: false,
+					},
+					&actionExpr{
+						pos: position{line: 283, col: 5, offset: 9634},
+						run: (*parser).callonAttributeValue22,
+						expr: &seqExpr{
+							pos: position{line: 283, col: 5, offset: 9634},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 283, col: 5, offset: 9634},
+									label: "value",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 283, col: 11, offset: 9640},
+										expr: &choiceExpr{
+											pos: position{line: 283, col: 12, offset: 9641},
+											alternatives: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 283, col: 12, offset: 9641},
+													name: "Alphanums",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 283, col: 24, offset: 9653},
+													name: "Spaces",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 283, col: 33, offset: 9662},
+													name: "OtherAttributeChar",
+												},
+											},
+										},
+									},
+								},
+								&notExpr{
+									pos: position{line: 283, col: 54, offset: 9683},
+									expr: &litMatcher{
+										pos:        position{line: 283, col: 55, offset: 9684},
+										val:        "=",
+										ignoreCase: false,
+									},
+								},
 							},
 						},
 					},
@@ -2238,56 +2415,56 @@ var g = &grammar{
 		},
 		{
 			name: "OtherAttributeChar",
-			pos:  position{line: 273, col: 1, offset: 9241},
+			pos:  position{line: 287, col: 1, offset: 9839},
 			expr: &seqExpr{
-				pos: position{line: 273, col: 24, offset: 9264},
+				pos: position{line: 287, col: 24, offset: 9862},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 273, col: 24, offset: 9264},
+						pos: position{line: 287, col: 24, offset: 9862},
 						expr: &litMatcher{
-							pos:        position{line: 273, col: 25, offset: 9265},
+							pos:        position{line: 287, col: 25, offset: 9863},
 							val:        "=",
 							ignoreCase: false,
 						},
 					},
 					&notExpr{
-						pos: position{line: 273, col: 29, offset: 9269},
+						pos: position{line: 287, col: 29, offset: 9867},
 						expr: &litMatcher{
-							pos:        position{line: 273, col: 30, offset: 9270},
+							pos:        position{line: 287, col: 30, offset: 9868},
 							val:        ",",
 							ignoreCase: false,
 						},
 					},
 					&notExpr{
-						pos: position{line: 273, col: 34, offset: 9274},
+						pos: position{line: 287, col: 34, offset: 9872},
 						expr: &litMatcher{
-							pos:        position{line: 273, col: 35, offset: 9275},
+							pos:        position{line: 287, col: 35, offset: 9873},
 							val:        "]",
 							ignoreCase: false,
 						},
 					},
 					&anyMatcher{
-						line: 273, col: 39, offset: 9279,
+						line: 287, col: 39, offset: 9877,
 					},
 				},
 			},
 		},
 		{
 			name: "HorizontalLayout",
-			pos:  position{line: 275, col: 1, offset: 9283},
+			pos:  position{line: 289, col: 1, offset: 9881},
 			expr: &actionExpr{
-				pos: position{line: 275, col: 21, offset: 9303},
+				pos: position{line: 289, col: 21, offset: 9901},
 				run: (*parser).callonHorizontalLayout1,
 				expr: &seqExpr{
-					pos: position{line: 275, col: 21, offset: 9303},
+					pos: position{line: 289, col: 21, offset: 9901},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 275, col: 21, offset: 9303},
+							pos:        position{line: 289, col: 21, offset: 9901},
 							val:        "[horizontal]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 275, col: 36, offset: 9318},
+							pos:  position{line: 289, col: 36, offset: 9916},
 							name: "EOLS",
 						},
 					},
@@ -2296,70 +2473,70 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteAttributes",
-			pos:  position{line: 279, col: 1, offset: 9392},
+			pos:  position{line: 293, col: 1, offset: 9990},
 			expr: &actionExpr{
-				pos: position{line: 279, col: 20, offset: 9411},
+				pos: position{line: 293, col: 20, offset: 10009},
 				run: (*parser).callonQuoteAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 279, col: 20, offset: 9411},
+					pos: position{line: 293, col: 20, offset: 10009},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 279, col: 20, offset: 9411},
+							pos:        position{line: 293, col: 20, offset: 10009},
 							val:        "[quote",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 279, col: 29, offset: 9420},
+							pos: position{line: 293, col: 29, offset: 10018},
 							expr: &ruleRefExpr{
-								pos:  position{line: 279, col: 29, offset: 9420},
+								pos:  position{line: 293, col: 29, offset: 10018},
 								name: "WS",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 279, col: 33, offset: 9424},
+							pos: position{line: 293, col: 33, offset: 10022},
 							expr: &litMatcher{
-								pos:        position{line: 279, col: 33, offset: 9424},
+								pos:        position{line: 293, col: 33, offset: 10022},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 279, col: 38, offset: 9429},
+							pos:   position{line: 293, col: 38, offset: 10027},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 279, col: 45, offset: 9436},
+								pos: position{line: 293, col: 45, offset: 10034},
 								expr: &ruleRefExpr{
-									pos:  position{line: 279, col: 46, offset: 9437},
+									pos:  position{line: 293, col: 46, offset: 10035},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 279, col: 63, offset: 9454},
+							pos: position{line: 293, col: 63, offset: 10052},
 							expr: &litMatcher{
-								pos:        position{line: 279, col: 63, offset: 9454},
+								pos:        position{line: 293, col: 63, offset: 10052},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 279, col: 68, offset: 9459},
+							pos:   position{line: 293, col: 68, offset: 10057},
 							label: "title",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 279, col: 74, offset: 9465},
+								pos: position{line: 293, col: 74, offset: 10063},
 								expr: &ruleRefExpr{
-									pos:  position{line: 279, col: 75, offset: 9466},
+									pos:  position{line: 293, col: 75, offset: 10064},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 279, col: 92, offset: 9483},
+							pos:        position{line: 293, col: 92, offset: 10081},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 279, col: 96, offset: 9487},
+							pos:  position{line: 293, col: 96, offset: 10085},
 							name: "EOLS",
 						},
 					},
@@ -2368,70 +2545,70 @@ var g = &grammar{
 		},
 		{
 			name: "VerseAttributes",
-			pos:  position{line: 283, col: 1, offset: 9557},
+			pos:  position{line: 297, col: 1, offset: 10155},
 			expr: &actionExpr{
-				pos: position{line: 283, col: 20, offset: 9576},
+				pos: position{line: 297, col: 20, offset: 10174},
 				run: (*parser).callonVerseAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 283, col: 20, offset: 9576},
+					pos: position{line: 297, col: 20, offset: 10174},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 283, col: 20, offset: 9576},
+							pos:        position{line: 297, col: 20, offset: 10174},
 							val:        "[verse",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 283, col: 29, offset: 9585},
+							pos: position{line: 297, col: 29, offset: 10183},
 							expr: &ruleRefExpr{
-								pos:  position{line: 283, col: 29, offset: 9585},
+								pos:  position{line: 297, col: 29, offset: 10183},
 								name: "WS",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 283, col: 33, offset: 9589},
+							pos: position{line: 297, col: 33, offset: 10187},
 							expr: &litMatcher{
-								pos:        position{line: 283, col: 33, offset: 9589},
+								pos:        position{line: 297, col: 33, offset: 10187},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 283, col: 38, offset: 9594},
+							pos:   position{line: 297, col: 38, offset: 10192},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 283, col: 45, offset: 9601},
+								pos: position{line: 297, col: 45, offset: 10199},
 								expr: &ruleRefExpr{
-									pos:  position{line: 283, col: 46, offset: 9602},
+									pos:  position{line: 297, col: 46, offset: 10200},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 283, col: 63, offset: 9619},
+							pos: position{line: 297, col: 63, offset: 10217},
 							expr: &litMatcher{
-								pos:        position{line: 283, col: 63, offset: 9619},
+								pos:        position{line: 297, col: 63, offset: 10217},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 283, col: 68, offset: 9624},
+							pos:   position{line: 297, col: 68, offset: 10222},
 							label: "title",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 283, col: 74, offset: 9630},
+								pos: position{line: 297, col: 74, offset: 10228},
 								expr: &ruleRefExpr{
-									pos:  position{line: 283, col: 75, offset: 9631},
+									pos:  position{line: 297, col: 75, offset: 10229},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 283, col: 92, offset: 9648},
+							pos:        position{line: 297, col: 92, offset: 10246},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 283, col: 96, offset: 9652},
+							pos:  position{line: 297, col: 96, offset: 10250},
 							name: "EOLS",
 						},
 					},
@@ -2440,51 +2617,51 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteAttribute",
-			pos:  position{line: 287, col: 1, offset: 9740},
+			pos:  position{line: 301, col: 1, offset: 10338},
 			expr: &actionExpr{
-				pos: position{line: 287, col: 19, offset: 9758},
+				pos: position{line: 301, col: 19, offset: 10356},
 				run: (*parser).callonQuoteAttribute1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 287, col: 19, offset: 9758},
+					pos: position{line: 301, col: 19, offset: 10356},
 					expr: &choiceExpr{
-						pos: position{line: 287, col: 20, offset: 9759},
+						pos: position{line: 301, col: 20, offset: 10357},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 287, col: 20, offset: 9759},
+								pos:  position{line: 301, col: 20, offset: 10357},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 287, col: 32, offset: 9771},
+								pos:  position{line: 301, col: 32, offset: 10369},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 287, col: 42, offset: 9781},
+								pos: position{line: 301, col: 42, offset: 10379},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 287, col: 42, offset: 9781},
+										pos: position{line: 301, col: 42, offset: 10379},
 										expr: &litMatcher{
-											pos:        position{line: 287, col: 43, offset: 9782},
+											pos:        position{line: 301, col: 43, offset: 10380},
 											val:        ",",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 287, col: 47, offset: 9786},
+										pos: position{line: 301, col: 47, offset: 10384},
 										expr: &litMatcher{
-											pos:        position{line: 287, col: 48, offset: 9787},
+											pos:        position{line: 301, col: 48, offset: 10385},
 											val:        "]",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 287, col: 52, offset: 9791},
+										pos: position{line: 301, col: 52, offset: 10389},
 										expr: &ruleRefExpr{
-											pos:  position{line: 287, col: 53, offset: 9792},
+											pos:  position{line: 301, col: 53, offset: 10390},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 287, col: 57, offset: 9796,
+										line: 301, col: 57, offset: 10394,
 									},
 								},
 							},
@@ -2495,31 +2672,31 @@ var g = &grammar{
 		},
 		{
 			name: "InlineAttributes",
-			pos:  position{line: 291, col: 1, offset: 9837},
+			pos:  position{line: 305, col: 1, offset: 10435},
 			expr: &actionExpr{
-				pos: position{line: 291, col: 21, offset: 9857},
+				pos: position{line: 305, col: 21, offset: 10455},
 				run: (*parser).callonInlineAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 291, col: 21, offset: 9857},
+					pos: position{line: 305, col: 21, offset: 10455},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 291, col: 21, offset: 9857},
+							pos:        position{line: 305, col: 21, offset: 10455},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 291, col: 25, offset: 9861},
+							pos:   position{line: 305, col: 25, offset: 10459},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 291, col: 31, offset: 9867},
+								pos: position{line: 305, col: 31, offset: 10465},
 								expr: &ruleRefExpr{
-									pos:  position{line: 291, col: 32, offset: 9868},
+									pos:  position{line: 305, col: 32, offset: 10466},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 291, col: 51, offset: 9887},
+							pos:        position{line: 305, col: 51, offset: 10485},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -2529,34 +2706,34 @@ var g = &grammar{
 		},
 		{
 			name: "Section",
-			pos:  position{line: 298, col: 1, offset: 10061},
+			pos:  position{line: 312, col: 1, offset: 10659},
 			expr: &actionExpr{
-				pos: position{line: 298, col: 12, offset: 10072},
+				pos: position{line: 312, col: 12, offset: 10670},
 				run: (*parser).callonSection1,
 				expr: &seqExpr{
-					pos: position{line: 298, col: 12, offset: 10072},
+					pos: position{line: 312, col: 12, offset: 10670},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 298, col: 12, offset: 10072},
+							pos:   position{line: 312, col: 12, offset: 10670},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 298, col: 23, offset: 10083},
+								pos: position{line: 312, col: 23, offset: 10681},
 								expr: &ruleRefExpr{
-									pos:  position{line: 298, col: 24, offset: 10084},
+									pos:  position{line: 312, col: 24, offset: 10682},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 299, col: 5, offset: 10108},
+							pos:   position{line: 313, col: 5, offset: 10706},
 							label: "level",
 							expr: &actionExpr{
-								pos: position{line: 299, col: 12, offset: 10115},
+								pos: position{line: 313, col: 12, offset: 10713},
 								run: (*parser).callonSection7,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 299, col: 12, offset: 10115},
+									pos: position{line: 313, col: 12, offset: 10713},
 									expr: &litMatcher{
-										pos:        position{line: 299, col: 13, offset: 10116},
+										pos:        position{line: 313, col: 13, offset: 10714},
 										val:        "=",
 										ignoreCase: false,
 									},
@@ -2564,37 +2741,37 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 303, col: 5, offset: 10207},
+							pos: position{line: 317, col: 5, offset: 10805},
 							run: (*parser).callonSection10,
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 307, col: 5, offset: 10359},
+							pos: position{line: 321, col: 5, offset: 10957},
 							expr: &ruleRefExpr{
-								pos:  position{line: 307, col: 5, offset: 10359},
+								pos:  position{line: 321, col: 5, offset: 10957},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 307, col: 9, offset: 10363},
+							pos:   position{line: 321, col: 9, offset: 10961},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 307, col: 16, offset: 10370},
+								pos:  position{line: 321, col: 16, offset: 10968},
 								name: "TitleElements",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 307, col: 31, offset: 10385},
+							pos:   position{line: 321, col: 31, offset: 10983},
 							label: "id",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 307, col: 35, offset: 10389},
+								pos: position{line: 321, col: 35, offset: 10987},
 								expr: &ruleRefExpr{
-									pos:  position{line: 307, col: 35, offset: 10389},
+									pos:  position{line: 321, col: 35, offset: 10987},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 307, col: 53, offset: 10407},
+							pos:  position{line: 321, col: 53, offset: 11005},
 							name: "EOL",
 						},
 					},
@@ -2603,34 +2780,34 @@ var g = &grammar{
 		},
 		{
 			name: "TitleElements",
-			pos:  position{line: 311, col: 1, offset: 10513},
+			pos:  position{line: 325, col: 1, offset: 11111},
 			expr: &actionExpr{
-				pos: position{line: 311, col: 18, offset: 10530},
+				pos: position{line: 325, col: 18, offset: 11128},
 				run: (*parser).callonTitleElements1,
 				expr: &labeledExpr{
-					pos:   position{line: 311, col: 18, offset: 10530},
+					pos:   position{line: 325, col: 18, offset: 11128},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 311, col: 27, offset: 10539},
+						pos: position{line: 325, col: 27, offset: 11137},
 						expr: &seqExpr{
-							pos: position{line: 311, col: 28, offset: 10540},
+							pos: position{line: 325, col: 28, offset: 11138},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 311, col: 28, offset: 10540},
+									pos: position{line: 325, col: 28, offset: 11138},
 									expr: &ruleRefExpr{
-										pos:  position{line: 311, col: 29, offset: 10541},
+										pos:  position{line: 325, col: 29, offset: 11139},
 										name: "NEWLINE",
 									},
 								},
 								&notExpr{
-									pos: position{line: 311, col: 37, offset: 10549},
+									pos: position{line: 325, col: 37, offset: 11147},
 									expr: &ruleRefExpr{
-										pos:  position{line: 311, col: 38, offset: 10550},
+										pos:  position{line: 325, col: 38, offset: 11148},
 										name: "InlineElementID",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 311, col: 54, offset: 10566},
+									pos:  position{line: 325, col: 54, offset: 11164},
 									name: "TitleElement",
 								},
 							},
@@ -2641,62 +2818,62 @@ var g = &grammar{
 		},
 		{
 			name: "TitleElement",
-			pos:  position{line: 315, col: 1, offset: 10687},
+			pos:  position{line: 329, col: 1, offset: 11285},
 			expr: &actionExpr{
-				pos: position{line: 315, col: 17, offset: 10703},
+				pos: position{line: 329, col: 17, offset: 11301},
 				run: (*parser).callonTitleElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 315, col: 17, offset: 10703},
+					pos:   position{line: 329, col: 17, offset: 11301},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 315, col: 26, offset: 10712},
+						pos: position{line: 329, col: 26, offset: 11310},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 315, col: 26, offset: 10712},
+								pos:  position{line: 329, col: 26, offset: 11310},
 								name: "SimpleWord",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 316, col: 11, offset: 10733},
+								pos:  position{line: 330, col: 11, offset: 11331},
 								name: "Spaces",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 317, col: 11, offset: 10751},
+								pos:  position{line: 331, col: 11, offset: 11349},
 								name: "CrossReference",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 318, col: 11, offset: 10776},
+								pos:  position{line: 332, col: 11, offset: 11374},
 								name: "Passthrough",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 319, col: 11, offset: 10798},
+								pos:  position{line: 333, col: 11, offset: 11396},
 								name: "InlineImage",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 320, col: 11, offset: 10821},
+								pos:  position{line: 334, col: 11, offset: 11419},
 								name: "Link",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 321, col: 11, offset: 10836},
+								pos:  position{line: 335, col: 11, offset: 11434},
 								name: "InlineFootnote",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 322, col: 11, offset: 10861},
+								pos:  position{line: 336, col: 11, offset: 11459},
 								name: "QuotedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 323, col: 11, offset: 10882},
+								pos:  position{line: 337, col: 11, offset: 11480},
 								name: "DocumentAttributeSubstitution",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 324, col: 11, offset: 10922},
+								pos:  position{line: 338, col: 11, offset: 11520},
 								name: "LineBreak",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 325, col: 11, offset: 10942},
+								pos:  position{line: 339, col: 11, offset: 11540},
 								name: "OtherWord",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 326, col: 11, offset: 10962},
+								pos:  position{line: 340, col: 11, offset: 11560},
 								name: "Parenthesis",
 							},
 						},
@@ -2706,17 +2883,17 @@ var g = &grammar{
 		},
 		{
 			name: "TableOfContentsMacro",
-			pos:  position{line: 333, col: 1, offset: 11117},
+			pos:  position{line: 347, col: 1, offset: 11715},
 			expr: &seqExpr{
-				pos: position{line: 333, col: 25, offset: 11141},
+				pos: position{line: 347, col: 25, offset: 11739},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 333, col: 25, offset: 11141},
+						pos:        position{line: 347, col: 25, offset: 11739},
 						val:        "toc::[]",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 35, offset: 11151},
+						pos:  position{line: 347, col: 35, offset: 11749},
 						name: "EOL",
 					},
 				},
@@ -2724,39 +2901,39 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroBlock",
-			pos:  position{line: 338, col: 1, offset: 11262},
+			pos:  position{line: 352, col: 1, offset: 11860},
 			expr: &actionExpr{
-				pos: position{line: 338, col: 19, offset: 11280},
+				pos: position{line: 352, col: 19, offset: 11878},
 				run: (*parser).callonUserMacroBlock1,
 				expr: &seqExpr{
-					pos: position{line: 338, col: 19, offset: 11280},
+					pos: position{line: 352, col: 19, offset: 11878},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 338, col: 19, offset: 11280},
+							pos:   position{line: 352, col: 19, offset: 11878},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 338, col: 25, offset: 11286},
+								pos:  position{line: 352, col: 25, offset: 11884},
 								name: "UserMacroName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 338, col: 40, offset: 11301},
+							pos:        position{line: 352, col: 40, offset: 11899},
 							val:        "::",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 338, col: 45, offset: 11306},
+							pos:   position{line: 352, col: 45, offset: 11904},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 338, col: 52, offset: 11313},
+								pos:  position{line: 352, col: 52, offset: 11911},
 								name: "UserMacroValue",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 338, col: 68, offset: 11329},
+							pos:   position{line: 352, col: 68, offset: 11927},
 							label: "attrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 338, col: 75, offset: 11336},
+								pos:  position{line: 352, col: 75, offset: 11934},
 								name: "UserMacroAttributes",
 							},
 						},
@@ -2766,39 +2943,39 @@ var g = &grammar{
 		},
 		{
 			name: "InlineUserMacro",
-			pos:  position{line: 342, col: 1, offset: 11477},
+			pos:  position{line: 356, col: 1, offset: 12075},
 			expr: &actionExpr{
-				pos: position{line: 342, col: 20, offset: 11496},
+				pos: position{line: 356, col: 20, offset: 12094},
 				run: (*parser).callonInlineUserMacro1,
 				expr: &seqExpr{
-					pos: position{line: 342, col: 20, offset: 11496},
+					pos: position{line: 356, col: 20, offset: 12094},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 342, col: 20, offset: 11496},
+							pos:   position{line: 356, col: 20, offset: 12094},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 342, col: 26, offset: 11502},
+								pos:  position{line: 356, col: 26, offset: 12100},
 								name: "UserMacroName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 342, col: 41, offset: 11517},
+							pos:        position{line: 356, col: 41, offset: 12115},
 							val:        ":",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 342, col: 45, offset: 11521},
+							pos:   position{line: 356, col: 45, offset: 12119},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 342, col: 52, offset: 11528},
+								pos:  position{line: 356, col: 52, offset: 12126},
 								name: "UserMacroValue",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 342, col: 68, offset: 11544},
+							pos:   position{line: 356, col: 68, offset: 12142},
 							label: "attrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 342, col: 75, offset: 11551},
+								pos:  position{line: 356, col: 75, offset: 12149},
 								name: "UserMacroAttributes",
 							},
 						},
@@ -2808,29 +2985,29 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroName",
-			pos:  position{line: 346, col: 1, offset: 11693},
+			pos:  position{line: 360, col: 1, offset: 12291},
 			expr: &actionExpr{
-				pos: position{line: 346, col: 18, offset: 11710},
+				pos: position{line: 360, col: 18, offset: 12308},
 				run: (*parser).callonUserMacroName1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 346, col: 18, offset: 11710},
+					pos: position{line: 360, col: 18, offset: 12308},
 					expr: &choiceExpr{
-						pos: position{line: 346, col: 19, offset: 11711},
+						pos: position{line: 360, col: 19, offset: 12309},
 						alternatives: []interface{}{
 							&charClassMatcher{
-								pos:        position{line: 346, col: 19, offset: 11711},
+								pos:        position{line: 360, col: 19, offset: 12309},
 								val:        "[a-zA-Z0-9]",
 								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
 								ignoreCase: false,
 								inverted:   false,
 							},
 							&litMatcher{
-								pos:        position{line: 346, col: 33, offset: 11725},
+								pos:        position{line: 360, col: 33, offset: 12323},
 								val:        "_",
 								ignoreCase: false,
 							},
 							&litMatcher{
-								pos:        position{line: 346, col: 39, offset: 11731},
+								pos:        position{line: 360, col: 39, offset: 12329},
 								val:        "-",
 								ignoreCase: false,
 							},
@@ -2841,54 +3018,54 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroValue",
-			pos:  position{line: 350, col: 1, offset: 11773},
+			pos:  position{line: 364, col: 1, offset: 12371},
 			expr: &actionExpr{
-				pos: position{line: 350, col: 19, offset: 11791},
+				pos: position{line: 364, col: 19, offset: 12389},
 				run: (*parser).callonUserMacroValue1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 350, col: 19, offset: 11791},
+					pos: position{line: 364, col: 19, offset: 12389},
 					expr: &choiceExpr{
-						pos: position{line: 350, col: 20, offset: 11792},
+						pos: position{line: 364, col: 20, offset: 12390},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 350, col: 20, offset: 11792},
+								pos:  position{line: 364, col: 20, offset: 12390},
 								name: "Alphanums",
 							},
 							&seqExpr{
-								pos: position{line: 350, col: 33, offset: 11805},
+								pos: position{line: 364, col: 33, offset: 12403},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 350, col: 33, offset: 11805},
+										pos: position{line: 364, col: 33, offset: 12403},
 										expr: &ruleRefExpr{
-											pos:  position{line: 350, col: 34, offset: 11806},
+											pos:  position{line: 364, col: 34, offset: 12404},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 350, col: 37, offset: 11809},
+										pos: position{line: 364, col: 37, offset: 12407},
 										expr: &litMatcher{
-											pos:        position{line: 350, col: 38, offset: 11810},
+											pos:        position{line: 364, col: 38, offset: 12408},
 											val:        ":",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 350, col: 42, offset: 11814},
+										pos: position{line: 364, col: 42, offset: 12412},
 										expr: &litMatcher{
-											pos:        position{line: 350, col: 43, offset: 11815},
+											pos:        position{line: 364, col: 43, offset: 12413},
 											val:        "[",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 350, col: 47, offset: 11819},
+										pos: position{line: 364, col: 47, offset: 12417},
 										expr: &ruleRefExpr{
-											pos:  position{line: 350, col: 48, offset: 11820},
+											pos:  position{line: 364, col: 48, offset: 12418},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 350, col: 52, offset: 11824,
+										line: 364, col: 52, offset: 12422,
 									},
 								},
 							},
@@ -2899,31 +3076,31 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroAttributes",
-			pos:  position{line: 354, col: 1, offset: 11865},
+			pos:  position{line: 368, col: 1, offset: 12463},
 			expr: &actionExpr{
-				pos: position{line: 354, col: 24, offset: 11888},
+				pos: position{line: 368, col: 24, offset: 12486},
 				run: (*parser).callonUserMacroAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 354, col: 24, offset: 11888},
+					pos: position{line: 368, col: 24, offset: 12486},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 354, col: 24, offset: 11888},
+							pos:        position{line: 368, col: 24, offset: 12486},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 354, col: 28, offset: 11892},
+							pos:   position{line: 368, col: 28, offset: 12490},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 354, col: 34, offset: 11898},
+								pos: position{line: 368, col: 34, offset: 12496},
 								expr: &ruleRefExpr{
-									pos:  position{line: 354, col: 35, offset: 11899},
+									pos:  position{line: 368, col: 35, offset: 12497},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 354, col: 54, offset: 11918},
+							pos:        position{line: 368, col: 54, offset: 12516},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -2933,40 +3110,40 @@ var g = &grammar{
 		},
 		{
 			name: "FileInclusion",
-			pos:  position{line: 361, col: 1, offset: 12098},
+			pos:  position{line: 375, col: 1, offset: 12696},
 			expr: &actionExpr{
-				pos: position{line: 361, col: 18, offset: 12115},
+				pos: position{line: 375, col: 18, offset: 12713},
 				run: (*parser).callonFileInclusion1,
 				expr: &seqExpr{
-					pos: position{line: 361, col: 18, offset: 12115},
+					pos: position{line: 375, col: 18, offset: 12713},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 361, col: 18, offset: 12115},
+							pos:   position{line: 375, col: 18, offset: 12713},
 							label: "incl",
 							expr: &actionExpr{
-								pos: position{line: 361, col: 24, offset: 12121},
+								pos: position{line: 375, col: 24, offset: 12719},
 								run: (*parser).callonFileInclusion4,
 								expr: &seqExpr{
-									pos: position{line: 361, col: 24, offset: 12121},
+									pos: position{line: 375, col: 24, offset: 12719},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 361, col: 24, offset: 12121},
+											pos:        position{line: 375, col: 24, offset: 12719},
 											val:        "include::",
 											ignoreCase: false,
 										},
 										&labeledExpr{
-											pos:   position{line: 361, col: 36, offset: 12133},
+											pos:   position{line: 375, col: 36, offset: 12731},
 											label: "path",
 											expr: &ruleRefExpr{
-												pos:  position{line: 361, col: 42, offset: 12139},
+												pos:  position{line: 375, col: 42, offset: 12737},
 												name: "FileLocation",
 											},
 										},
 										&labeledExpr{
-											pos:   position{line: 361, col: 56, offset: 12153},
+											pos:   position{line: 375, col: 56, offset: 12751},
 											label: "inlineAttributes",
 											expr: &ruleRefExpr{
-												pos:  position{line: 361, col: 74, offset: 12171},
+												pos:  position{line: 375, col: 74, offset: 12769},
 												name: "FileIncludeAttributes",
 											},
 										},
@@ -2975,7 +3152,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 363, col: 8, offset: 12325},
+							pos:  position{line: 377, col: 8, offset: 12923},
 							name: "EOLS",
 						},
 					},
@@ -2984,36 +3161,36 @@ var g = &grammar{
 		},
 		{
 			name: "FileIncludeAttributes",
-			pos:  position{line: 367, col: 1, offset: 12378},
+			pos:  position{line: 381, col: 1, offset: 12976},
 			expr: &actionExpr{
-				pos: position{line: 367, col: 26, offset: 12403},
+				pos: position{line: 381, col: 26, offset: 13001},
 				run: (*parser).callonFileIncludeAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 367, col: 26, offset: 12403},
+					pos: position{line: 381, col: 26, offset: 13001},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 367, col: 26, offset: 12403},
+							pos:        position{line: 381, col: 26, offset: 13001},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 367, col: 30, offset: 12407},
+							pos:   position{line: 381, col: 30, offset: 13005},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 367, col: 36, offset: 12413},
+								pos: position{line: 381, col: 36, offset: 13011},
 								expr: &choiceExpr{
-									pos: position{line: 367, col: 37, offset: 12414},
+									pos: position{line: 381, col: 37, offset: 13012},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 367, col: 37, offset: 12414},
+											pos:  position{line: 381, col: 37, offset: 13012},
 											name: "LineRangesAttribute",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 367, col: 59, offset: 12436},
+											pos:  position{line: 381, col: 59, offset: 13034},
 											name: "TagRangesAttribute",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 367, col: 80, offset: 12457},
+											pos:  position{line: 381, col: 80, offset: 13055},
 											name: "GenericAttribute",
 										},
 									},
@@ -3021,7 +3198,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 367, col: 99, offset: 12476},
+							pos:        position{line: 381, col: 99, offset: 13074},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -3031,30 +3208,30 @@ var g = &grammar{
 		},
 		{
 			name: "LineRangesAttribute",
-			pos:  position{line: 371, col: 1, offset: 12546},
+			pos:  position{line: 385, col: 1, offset: 13144},
 			expr: &actionExpr{
-				pos: position{line: 371, col: 24, offset: 12569},
+				pos: position{line: 385, col: 24, offset: 13167},
 				run: (*parser).callonLineRangesAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 371, col: 24, offset: 12569},
+					pos: position{line: 385, col: 24, offset: 13167},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 371, col: 24, offset: 12569},
+							pos:        position{line: 385, col: 24, offset: 13167},
 							val:        "lines=",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 371, col: 33, offset: 12578},
+							pos:   position{line: 385, col: 33, offset: 13176},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 371, col: 40, offset: 12585},
+								pos:  position{line: 385, col: 40, offset: 13183},
 								name: "LineRangesAttributeValue",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 371, col: 66, offset: 12611},
+							pos: position{line: 385, col: 66, offset: 13209},
 							expr: &litMatcher{
-								pos:        position{line: 371, col: 66, offset: 12611},
+								pos:        position{line: 385, col: 66, offset: 13209},
 								val:        ",",
 								ignoreCase: false,
 							},
@@ -3065,72 +3242,72 @@ var g = &grammar{
 		},
 		{
 			name: "LineRangesAttributeValue",
-			pos:  position{line: 375, col: 1, offset: 12670},
+			pos:  position{line: 389, col: 1, offset: 13268},
 			expr: &actionExpr{
-				pos: position{line: 375, col: 29, offset: 12698},
+				pos: position{line: 389, col: 29, offset: 13296},
 				run: (*parser).callonLineRangesAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 375, col: 29, offset: 12698},
+					pos: position{line: 389, col: 29, offset: 13296},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 375, col: 29, offset: 12698},
+							pos:   position{line: 389, col: 29, offset: 13296},
 							label: "value",
 							expr: &choiceExpr{
-								pos: position{line: 375, col: 36, offset: 12705},
+								pos: position{line: 389, col: 36, offset: 13303},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 375, col: 36, offset: 12705},
+										pos:  position{line: 389, col: 36, offset: 13303},
 										name: "MultipleLineRanges",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 376, col: 11, offset: 12822},
+										pos:  position{line: 390, col: 11, offset: 13420},
 										name: "MultipleQuotedLineRanges",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 377, col: 11, offset: 12858},
+										pos:  position{line: 391, col: 11, offset: 13456},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 378, col: 11, offset: 12884},
+										pos:  position{line: 392, col: 11, offset: 13482},
 										name: "MultiLineQuotedRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 379, col: 11, offset: 12916},
+										pos:  position{line: 393, col: 11, offset: 13514},
 										name: "SingleLineQuotedRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 380, col: 11, offset: 12948},
+										pos:  position{line: 394, col: 11, offset: 13546},
 										name: "SingleLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 381, col: 11, offset: 12975},
+										pos:  position{line: 395, col: 11, offset: 13573},
 										name: "UndefinedLineRange",
 									},
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 381, col: 31, offset: 12995},
+							pos: position{line: 395, col: 31, offset: 13593},
 							expr: &ruleRefExpr{
-								pos:  position{line: 381, col: 31, offset: 12995},
+								pos:  position{line: 395, col: 31, offset: 13593},
 								name: "WS",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 381, col: 36, offset: 13000},
+							pos: position{line: 395, col: 36, offset: 13598},
 							alternatives: []interface{}{
 								&andExpr{
-									pos: position{line: 381, col: 36, offset: 13000},
+									pos: position{line: 395, col: 36, offset: 13598},
 									expr: &litMatcher{
-										pos:        position{line: 381, col: 37, offset: 13001},
+										pos:        position{line: 395, col: 37, offset: 13599},
 										val:        ",",
 										ignoreCase: false,
 									},
 								},
 								&andExpr{
-									pos: position{line: 381, col: 43, offset: 13007},
+									pos: position{line: 395, col: 43, offset: 13605},
 									expr: &litMatcher{
-										pos:        position{line: 381, col: 44, offset: 13008},
+										pos:        position{line: 395, col: 44, offset: 13606},
 										val:        "]",
 										ignoreCase: false,
 									},
@@ -3143,58 +3320,58 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleLineRanges",
-			pos:  position{line: 385, col: 1, offset: 13040},
+			pos:  position{line: 399, col: 1, offset: 13638},
 			expr: &actionExpr{
-				pos: position{line: 385, col: 23, offset: 13062},
+				pos: position{line: 399, col: 23, offset: 13660},
 				run: (*parser).callonMultipleLineRanges1,
 				expr: &seqExpr{
-					pos: position{line: 385, col: 23, offset: 13062},
+					pos: position{line: 399, col: 23, offset: 13660},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 385, col: 23, offset: 13062},
+							pos:   position{line: 399, col: 23, offset: 13660},
 							label: "first",
 							expr: &choiceExpr{
-								pos: position{line: 385, col: 30, offset: 13069},
+								pos: position{line: 399, col: 30, offset: 13667},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 385, col: 30, offset: 13069},
+										pos:  position{line: 399, col: 30, offset: 13667},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 385, col: 47, offset: 13086},
+										pos:  position{line: 399, col: 47, offset: 13684},
 										name: "SingleLineRange",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 386, col: 5, offset: 13108},
+							pos:   position{line: 400, col: 5, offset: 13706},
 							label: "others",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 386, col: 12, offset: 13115},
+								pos: position{line: 400, col: 12, offset: 13713},
 								expr: &actionExpr{
-									pos: position{line: 386, col: 13, offset: 13116},
+									pos: position{line: 400, col: 13, offset: 13714},
 									run: (*parser).callonMultipleLineRanges9,
 									expr: &seqExpr{
-										pos: position{line: 386, col: 13, offset: 13116},
+										pos: position{line: 400, col: 13, offset: 13714},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 386, col: 13, offset: 13116},
+												pos:        position{line: 400, col: 13, offset: 13714},
 												val:        ";",
 												ignoreCase: false,
 											},
 											&labeledExpr{
-												pos:   position{line: 386, col: 17, offset: 13120},
+												pos:   position{line: 400, col: 17, offset: 13718},
 												label: "other",
 												expr: &choiceExpr{
-													pos: position{line: 386, col: 24, offset: 13127},
+													pos: position{line: 400, col: 24, offset: 13725},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 386, col: 24, offset: 13127},
+															pos:  position{line: 400, col: 24, offset: 13725},
 															name: "MultiLineRange",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 386, col: 41, offset: 13144},
+															pos:  position{line: 400, col: 41, offset: 13742},
 															name: "SingleLineRange",
 														},
 													},
@@ -3211,63 +3388,63 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleQuotedLineRanges",
-			pos:  position{line: 392, col: 1, offset: 13282},
+			pos:  position{line: 406, col: 1, offset: 13880},
 			expr: &actionExpr{
-				pos: position{line: 392, col: 29, offset: 13310},
+				pos: position{line: 406, col: 29, offset: 13908},
 				run: (*parser).callonMultipleQuotedLineRanges1,
 				expr: &seqExpr{
-					pos: position{line: 392, col: 29, offset: 13310},
+					pos: position{line: 406, col: 29, offset: 13908},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 392, col: 29, offset: 13310},
+							pos:        position{line: 406, col: 29, offset: 13908},
 							val:        "\"",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 392, col: 34, offset: 13315},
+							pos:   position{line: 406, col: 34, offset: 13913},
 							label: "first",
 							expr: &choiceExpr{
-								pos: position{line: 392, col: 41, offset: 13322},
+								pos: position{line: 406, col: 41, offset: 13920},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 392, col: 41, offset: 13322},
+										pos:  position{line: 406, col: 41, offset: 13920},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 392, col: 58, offset: 13339},
+										pos:  position{line: 406, col: 58, offset: 13937},
 										name: "SingleLineRange",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 393, col: 5, offset: 13361},
+							pos:   position{line: 407, col: 5, offset: 13959},
 							label: "others",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 393, col: 12, offset: 13368},
+								pos: position{line: 407, col: 12, offset: 13966},
 								expr: &actionExpr{
-									pos: position{line: 393, col: 13, offset: 13369},
+									pos: position{line: 407, col: 13, offset: 13967},
 									run: (*parser).callonMultipleQuotedLineRanges10,
 									expr: &seqExpr{
-										pos: position{line: 393, col: 13, offset: 13369},
+										pos: position{line: 407, col: 13, offset: 13967},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 393, col: 13, offset: 13369},
+												pos:        position{line: 407, col: 13, offset: 13967},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&labeledExpr{
-												pos:   position{line: 393, col: 17, offset: 13373},
+												pos:   position{line: 407, col: 17, offset: 13971},
 												label: "other",
 												expr: &choiceExpr{
-													pos: position{line: 393, col: 24, offset: 13380},
+													pos: position{line: 407, col: 24, offset: 13978},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 393, col: 24, offset: 13380},
+															pos:  position{line: 407, col: 24, offset: 13978},
 															name: "MultiLineRange",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 393, col: 41, offset: 13397},
+															pos:  position{line: 407, col: 41, offset: 13995},
 															name: "SingleLineRange",
 														},
 													},
@@ -3279,7 +3456,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 395, col: 9, offset: 13450},
+							pos:        position{line: 409, col: 9, offset: 14048},
 							val:        "\"",
 							ignoreCase: false,
 						},
@@ -3289,31 +3466,31 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineRange",
-			pos:  position{line: 399, col: 1, offset: 13540},
+			pos:  position{line: 413, col: 1, offset: 14138},
 			expr: &actionExpr{
-				pos: position{line: 399, col: 19, offset: 13558},
+				pos: position{line: 413, col: 19, offset: 14156},
 				run: (*parser).callonMultiLineRange1,
 				expr: &seqExpr{
-					pos: position{line: 399, col: 19, offset: 13558},
+					pos: position{line: 413, col: 19, offset: 14156},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 399, col: 19, offset: 13558},
+							pos:   position{line: 413, col: 19, offset: 14156},
 							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 399, col: 26, offset: 13565},
+								pos:  position{line: 413, col: 26, offset: 14163},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 399, col: 34, offset: 13573},
+							pos:        position{line: 413, col: 34, offset: 14171},
 							val:        "..",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 399, col: 39, offset: 13578},
+							pos:   position{line: 413, col: 39, offset: 14176},
 							label: "end",
 							expr: &ruleRefExpr{
-								pos:  position{line: 399, col: 44, offset: 13583},
+								pos:  position{line: 413, col: 44, offset: 14181},
 								name: "NUMBER",
 							},
 						},
@@ -3323,41 +3500,41 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineQuotedRange",
-			pos:  position{line: 403, col: 1, offset: 13671},
+			pos:  position{line: 417, col: 1, offset: 14269},
 			expr: &actionExpr{
-				pos: position{line: 403, col: 25, offset: 13695},
+				pos: position{line: 417, col: 25, offset: 14293},
 				run: (*parser).callonMultiLineQuotedRange1,
 				expr: &seqExpr{
-					pos: position{line: 403, col: 25, offset: 13695},
+					pos: position{line: 417, col: 25, offset: 14293},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 403, col: 25, offset: 13695},
+							pos:        position{line: 417, col: 25, offset: 14293},
 							val:        "\"",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 403, col: 30, offset: 13700},
+							pos:   position{line: 417, col: 30, offset: 14298},
 							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 403, col: 37, offset: 13707},
+								pos:  position{line: 417, col: 37, offset: 14305},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 403, col: 45, offset: 13715},
+							pos:        position{line: 417, col: 45, offset: 14313},
 							val:        "..",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 403, col: 50, offset: 13720},
+							pos:   position{line: 417, col: 50, offset: 14318},
 							label: "end",
 							expr: &ruleRefExpr{
-								pos:  position{line: 403, col: 55, offset: 13725},
+								pos:  position{line: 417, col: 55, offset: 14323},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 403, col: 63, offset: 13733},
+							pos:        position{line: 417, col: 63, offset: 14331},
 							val:        "\"",
 							ignoreCase: false,
 						},
@@ -3367,15 +3544,15 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineRange",
-			pos:  position{line: 407, col: 1, offset: 13818},
+			pos:  position{line: 421, col: 1, offset: 14416},
 			expr: &actionExpr{
-				pos: position{line: 407, col: 20, offset: 13837},
+				pos: position{line: 421, col: 20, offset: 14435},
 				run: (*parser).callonSingleLineRange1,
 				expr: &labeledExpr{
-					pos:   position{line: 407, col: 20, offset: 13837},
+					pos:   position{line: 421, col: 20, offset: 14435},
 					label: "singleline",
 					expr: &ruleRefExpr{
-						pos:  position{line: 407, col: 32, offset: 13849},
+						pos:  position{line: 421, col: 32, offset: 14447},
 						name: "NUMBER",
 					},
 				},
@@ -3383,28 +3560,28 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineQuotedRange",
-			pos:  position{line: 411, col: 1, offset: 13944},
+			pos:  position{line: 425, col: 1, offset: 14542},
 			expr: &actionExpr{
-				pos: position{line: 411, col: 26, offset: 13969},
+				pos: position{line: 425, col: 26, offset: 14567},
 				run: (*parser).callonSingleLineQuotedRange1,
 				expr: &seqExpr{
-					pos: position{line: 411, col: 26, offset: 13969},
+					pos: position{line: 425, col: 26, offset: 14567},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 411, col: 26, offset: 13969},
+							pos:        position{line: 425, col: 26, offset: 14567},
 							val:        "\"",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 411, col: 31, offset: 13974},
+							pos:   position{line: 425, col: 31, offset: 14572},
 							label: "singleline",
 							expr: &ruleRefExpr{
-								pos:  position{line: 411, col: 43, offset: 13986},
+								pos:  position{line: 425, col: 43, offset: 14584},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 411, col: 51, offset: 13994},
+							pos:        position{line: 425, col: 51, offset: 14592},
 							val:        "\"",
 							ignoreCase: false,
 						},
@@ -3414,40 +3591,40 @@ var g = &grammar{
 		},
 		{
 			name: "UndefinedLineRange",
-			pos:  position{line: 415, col: 1, offset: 14086},
+			pos:  position{line: 429, col: 1, offset: 14684},
 			expr: &actionExpr{
-				pos: position{line: 415, col: 23, offset: 14108},
+				pos: position{line: 429, col: 23, offset: 14706},
 				run: (*parser).callonUndefinedLineRange1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 415, col: 23, offset: 14108},
+					pos: position{line: 429, col: 23, offset: 14706},
 					expr: &seqExpr{
-						pos: position{line: 415, col: 24, offset: 14109},
+						pos: position{line: 429, col: 24, offset: 14707},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 415, col: 24, offset: 14109},
+								pos: position{line: 429, col: 24, offset: 14707},
 								expr: &litMatcher{
-									pos:        position{line: 415, col: 25, offset: 14110},
+									pos:        position{line: 429, col: 25, offset: 14708},
 									val:        "]",
 									ignoreCase: false,
 								},
 							},
 							&notExpr{
-								pos: position{line: 415, col: 29, offset: 14114},
+								pos: position{line: 429, col: 29, offset: 14712},
 								expr: &litMatcher{
-									pos:        position{line: 415, col: 30, offset: 14115},
+									pos:        position{line: 429, col: 30, offset: 14713},
 									val:        ",",
 									ignoreCase: false,
 								},
 							},
 							&notExpr{
-								pos: position{line: 415, col: 34, offset: 14119},
+								pos: position{line: 429, col: 34, offset: 14717},
 								expr: &ruleRefExpr{
-									pos:  position{line: 415, col: 35, offset: 14120},
+									pos:  position{line: 429, col: 35, offset: 14718},
 									name: "WS",
 								},
 							},
 							&anyMatcher{
-								line: 415, col: 38, offset: 14123,
+								line: 429, col: 38, offset: 14721,
 							},
 						},
 					},
@@ -3456,40 +3633,40 @@ var g = &grammar{
 		},
 		{
 			name: "TagRangesAttribute",
-			pos:  position{line: 419, col: 1, offset: 14163},
+			pos:  position{line: 433, col: 1, offset: 14761},
 			expr: &actionExpr{
-				pos: position{line: 419, col: 23, offset: 14185},
+				pos: position{line: 433, col: 23, offset: 14783},
 				run: (*parser).callonTagRangesAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 419, col: 23, offset: 14185},
+					pos: position{line: 433, col: 23, offset: 14783},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 419, col: 24, offset: 14186},
+							pos: position{line: 433, col: 24, offset: 14784},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 419, col: 24, offset: 14186},
+									pos:        position{line: 433, col: 24, offset: 14784},
 									val:        "tags=",
 									ignoreCase: false,
 								},
 								&litMatcher{
-									pos:        position{line: 419, col: 34, offset: 14196},
+									pos:        position{line: 433, col: 34, offset: 14794},
 									val:        "tag=",
 									ignoreCase: false,
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 419, col: 42, offset: 14204},
+							pos:   position{line: 433, col: 42, offset: 14802},
 							label: "tags",
 							expr: &ruleRefExpr{
-								pos:  position{line: 419, col: 48, offset: 14210},
+								pos:  position{line: 433, col: 48, offset: 14808},
 								name: "TagRangesAttributeValue",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 419, col: 73, offset: 14235},
+							pos: position{line: 433, col: 73, offset: 14833},
 							expr: &litMatcher{
-								pos:        position{line: 419, col: 73, offset: 14235},
+								pos:        position{line: 433, col: 73, offset: 14833},
 								val:        ",",
 								ignoreCase: false,
 							},
@@ -3500,43 +3677,43 @@ var g = &grammar{
 		},
 		{
 			name: "TagRangesAttributeValue",
-			pos:  position{line: 423, col: 1, offset: 14384},
+			pos:  position{line: 437, col: 1, offset: 14982},
 			expr: &actionExpr{
-				pos: position{line: 423, col: 28, offset: 14411},
+				pos: position{line: 437, col: 28, offset: 15009},
 				run: (*parser).callonTagRangesAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 423, col: 28, offset: 14411},
+					pos: position{line: 437, col: 28, offset: 15009},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 423, col: 28, offset: 14411},
+							pos:   position{line: 437, col: 28, offset: 15009},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 423, col: 35, offset: 14418},
+								pos:  position{line: 437, col: 35, offset: 15016},
 								name: "MultipleTagRanges",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 423, col: 54, offset: 14437},
+							pos: position{line: 437, col: 54, offset: 15035},
 							expr: &ruleRefExpr{
-								pos:  position{line: 423, col: 54, offset: 14437},
+								pos:  position{line: 437, col: 54, offset: 15035},
 								name: "WS",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 423, col: 59, offset: 14442},
+							pos: position{line: 437, col: 59, offset: 15040},
 							alternatives: []interface{}{
 								&andExpr{
-									pos: position{line: 423, col: 59, offset: 14442},
+									pos: position{line: 437, col: 59, offset: 15040},
 									expr: &litMatcher{
-										pos:        position{line: 423, col: 60, offset: 14443},
+										pos:        position{line: 437, col: 60, offset: 15041},
 										val:        ",",
 										ignoreCase: false,
 									},
 								},
 								&andExpr{
-									pos: position{line: 423, col: 66, offset: 14449},
+									pos: position{line: 437, col: 66, offset: 15047},
 									expr: &litMatcher{
-										pos:        position{line: 423, col: 67, offset: 14450},
+										pos:        position{line: 437, col: 67, offset: 15048},
 										val:        "]",
 										ignoreCase: false,
 									},
@@ -3549,42 +3726,42 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleTagRanges",
-			pos:  position{line: 427, col: 1, offset: 14482},
+			pos:  position{line: 441, col: 1, offset: 15080},
 			expr: &actionExpr{
-				pos: position{line: 427, col: 22, offset: 14503},
+				pos: position{line: 441, col: 22, offset: 15101},
 				run: (*parser).callonMultipleTagRanges1,
 				expr: &seqExpr{
-					pos: position{line: 427, col: 22, offset: 14503},
+					pos: position{line: 441, col: 22, offset: 15101},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 427, col: 22, offset: 14503},
+							pos:   position{line: 441, col: 22, offset: 15101},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 427, col: 29, offset: 14510},
+								pos:  position{line: 441, col: 29, offset: 15108},
 								name: "TagRange",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 428, col: 5, offset: 14524},
+							pos:   position{line: 442, col: 5, offset: 15122},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 428, col: 12, offset: 14531},
+								pos: position{line: 442, col: 12, offset: 15129},
 								expr: &actionExpr{
-									pos: position{line: 428, col: 13, offset: 14532},
+									pos: position{line: 442, col: 13, offset: 15130},
 									run: (*parser).callonMultipleTagRanges7,
 									expr: &seqExpr{
-										pos: position{line: 428, col: 13, offset: 14532},
+										pos: position{line: 442, col: 13, offset: 15130},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 428, col: 13, offset: 14532},
+												pos:        position{line: 442, col: 13, offset: 15130},
 												val:        ";",
 												ignoreCase: false,
 											},
 											&labeledExpr{
-												pos:   position{line: 428, col: 17, offset: 14536},
+												pos:   position{line: 442, col: 17, offset: 15134},
 												label: "other",
 												expr: &ruleRefExpr{
-													pos:  position{line: 428, col: 24, offset: 14543},
+													pos:  position{line: 442, col: 24, offset: 15141},
 													name: "TagRange",
 												},
 											},
@@ -3599,25 +3776,25 @@ var g = &grammar{
 		},
 		{
 			name: "TagRange",
-			pos:  position{line: 434, col: 1, offset: 14674},
+			pos:  position{line: 448, col: 1, offset: 15272},
 			expr: &choiceExpr{
-				pos: position{line: 434, col: 13, offset: 14686},
+				pos: position{line: 448, col: 13, offset: 15284},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 434, col: 13, offset: 14686},
+						pos: position{line: 448, col: 13, offset: 15284},
 						run: (*parser).callonTagRange2,
 						expr: &labeledExpr{
-							pos:   position{line: 434, col: 13, offset: 14686},
+							pos:   position{line: 448, col: 13, offset: 15284},
 							label: "tag",
 							expr: &choiceExpr{
-								pos: position{line: 434, col: 18, offset: 14691},
+								pos: position{line: 448, col: 18, offset: 15289},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 434, col: 18, offset: 14691},
+										pos:  position{line: 448, col: 18, offset: 15289},
 										name: "Alphanums",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 434, col: 30, offset: 14703},
+										pos:  position{line: 448, col: 30, offset: 15301},
 										name: "TagWildcard",
 									},
 								},
@@ -3625,28 +3802,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 436, col: 5, offset: 14771},
+						pos: position{line: 450, col: 5, offset: 15369},
 						run: (*parser).callonTagRange7,
 						expr: &seqExpr{
-							pos: position{line: 436, col: 5, offset: 14771},
+							pos: position{line: 450, col: 5, offset: 15369},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 436, col: 5, offset: 14771},
+									pos:        position{line: 450, col: 5, offset: 15369},
 									val:        "!",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 436, col: 9, offset: 14775},
+									pos:   position{line: 450, col: 9, offset: 15373},
 									label: "tag",
 									expr: &choiceExpr{
-										pos: position{line: 436, col: 14, offset: 14780},
+										pos: position{line: 450, col: 14, offset: 15378},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 436, col: 14, offset: 14780},
+												pos:  position{line: 450, col: 14, offset: 15378},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 436, col: 26, offset: 14792},
+												pos:  position{line: 450, col: 26, offset: 15390},
 												name: "TagWildcard",
 											},
 										},
@@ -3660,23 +3837,23 @@ var g = &grammar{
 		},
 		{
 			name: "TagWildcard",
-			pos:  position{line: 440, col: 1, offset: 14860},
+			pos:  position{line: 454, col: 1, offset: 15458},
 			expr: &actionExpr{
-				pos: position{line: 440, col: 16, offset: 14875},
+				pos: position{line: 454, col: 16, offset: 15473},
 				run: (*parser).callonTagWildcard1,
 				expr: &seqExpr{
-					pos: position{line: 440, col: 16, offset: 14875},
+					pos: position{line: 454, col: 16, offset: 15473},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 440, col: 16, offset: 14875},
+							pos:   position{line: 454, col: 16, offset: 15473},
 							label: "stars",
 							expr: &actionExpr{
-								pos: position{line: 440, col: 23, offset: 14882},
+								pos: position{line: 454, col: 23, offset: 15480},
 								run: (*parser).callonTagWildcard4,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 440, col: 23, offset: 14882},
+									pos: position{line: 454, col: 23, offset: 15480},
 									expr: &litMatcher{
-										pos:        position{line: 440, col: 24, offset: 14883},
+										pos:        position{line: 454, col: 24, offset: 15481},
 										val:        "*",
 										ignoreCase: false,
 									},
@@ -3684,7 +3861,7 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 443, col: 5, offset: 14937},
+							pos: position{line: 457, col: 5, offset: 15535},
 							run: (*parser).callonTagWildcard7,
 						},
 					},
@@ -3693,34 +3870,34 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileLine",
-			pos:  position{line: 453, col: 1, offset: 15231},
+			pos:  position{line: 467, col: 1, offset: 15829},
 			expr: &actionExpr{
-				pos: position{line: 453, col: 21, offset: 15251},
+				pos: position{line: 467, col: 21, offset: 15849},
 				run: (*parser).callonIncludedFileLine1,
 				expr: &seqExpr{
-					pos: position{line: 453, col: 21, offset: 15251},
+					pos: position{line: 467, col: 21, offset: 15849},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 453, col: 21, offset: 15251},
+							pos:   position{line: 467, col: 21, offset: 15849},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 453, col: 29, offset: 15259},
+								pos: position{line: 467, col: 29, offset: 15857},
 								expr: &choiceExpr{
-									pos: position{line: 453, col: 30, offset: 15260},
+									pos: position{line: 467, col: 30, offset: 15858},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 453, col: 30, offset: 15260},
+											pos:  position{line: 467, col: 30, offset: 15858},
 											name: "IncludedFileStartTag",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 453, col: 53, offset: 15283},
+											pos:  position{line: 467, col: 53, offset: 15881},
 											name: "IncludedFileEndTag",
 										},
 										&actionExpr{
-											pos: position{line: 453, col: 74, offset: 15304},
+											pos: position{line: 467, col: 74, offset: 15902},
 											run: (*parser).callonIncludedFileLine8,
 											expr: &anyMatcher{
-												line: 453, col: 74, offset: 15304,
+												line: 467, col: 74, offset: 15902,
 											},
 										},
 									},
@@ -3728,7 +3905,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 453, col: 107, offset: 15337},
+							pos:  position{line: 467, col: 107, offset: 15935},
 							name: "EOL",
 						},
 					},
@@ -3737,32 +3914,32 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileStartTag",
-			pos:  position{line: 457, col: 1, offset: 15408},
+			pos:  position{line: 471, col: 1, offset: 16006},
 			expr: &actionExpr{
-				pos: position{line: 457, col: 25, offset: 15432},
+				pos: position{line: 471, col: 25, offset: 16030},
 				run: (*parser).callonIncludedFileStartTag1,
 				expr: &seqExpr{
-					pos: position{line: 457, col: 25, offset: 15432},
+					pos: position{line: 471, col: 25, offset: 16030},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 457, col: 25, offset: 15432},
+							pos:        position{line: 471, col: 25, offset: 16030},
 							val:        "tag::",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 457, col: 33, offset: 15440},
+							pos:   position{line: 471, col: 33, offset: 16038},
 							label: "tag",
 							expr: &actionExpr{
-								pos: position{line: 457, col: 38, offset: 15445},
+								pos: position{line: 471, col: 38, offset: 16043},
 								run: (*parser).callonIncludedFileStartTag5,
 								expr: &ruleRefExpr{
-									pos:  position{line: 457, col: 38, offset: 15445},
+									pos:  position{line: 471, col: 38, offset: 16043},
 									name: "Alphanums",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 457, col: 78, offset: 15485},
+							pos:        position{line: 471, col: 78, offset: 16083},
 							val:        "[]",
 							ignoreCase: false,
 						},
@@ -3772,32 +3949,32 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileEndTag",
-			pos:  position{line: 461, col: 1, offset: 15550},
+			pos:  position{line: 475, col: 1, offset: 16148},
 			expr: &actionExpr{
-				pos: position{line: 461, col: 23, offset: 15572},
+				pos: position{line: 475, col: 23, offset: 16170},
 				run: (*parser).callonIncludedFileEndTag1,
 				expr: &seqExpr{
-					pos: position{line: 461, col: 23, offset: 15572},
+					pos: position{line: 475, col: 23, offset: 16170},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 461, col: 23, offset: 15572},
+							pos:        position{line: 475, col: 23, offset: 16170},
 							val:        "end::",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 461, col: 31, offset: 15580},
+							pos:   position{line: 475, col: 31, offset: 16178},
 							label: "tag",
 							expr: &actionExpr{
-								pos: position{line: 461, col: 36, offset: 15585},
+								pos: position{line: 475, col: 36, offset: 16183},
 								run: (*parser).callonIncludedFileEndTag5,
 								expr: &ruleRefExpr{
-									pos:  position{line: 461, col: 36, offset: 15585},
+									pos:  position{line: 475, col: 36, offset: 16183},
 									name: "Alphanums",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 461, col: 76, offset: 15625},
+							pos:        position{line: 475, col: 76, offset: 16223},
 							val:        "[]",
 							ignoreCase: false,
 						},
@@ -3807,35 +3984,35 @@ var g = &grammar{
 		},
 		{
 			name: "ListItems",
-			pos:  position{line: 468, col: 1, offset: 15789},
+			pos:  position{line: 482, col: 1, offset: 16387},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 468, col: 14, offset: 15802},
+				pos: position{line: 482, col: 14, offset: 16400},
 				expr: &ruleRefExpr{
-					pos:  position{line: 468, col: 14, offset: 15802},
+					pos:  position{line: 482, col: 14, offset: 16400},
 					name: "ListItem",
 				},
 			},
 		},
 		{
 			name: "ListItem",
-			pos:  position{line: 470, col: 1, offset: 15813},
+			pos:  position{line: 484, col: 1, offset: 16411},
 			expr: &choiceExpr{
-				pos: position{line: 470, col: 13, offset: 15825},
+				pos: position{line: 484, col: 13, offset: 16423},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 470, col: 13, offset: 15825},
+						pos:  position{line: 484, col: 13, offset: 16423},
 						name: "OrderedListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 470, col: 31, offset: 15843},
+						pos:  position{line: 484, col: 31, offset: 16441},
 						name: "UnorderedListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 470, col: 51, offset: 15863},
+						pos:  position{line: 484, col: 51, offset: 16461},
 						name: "LabeledListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 470, col: 69, offset: 15881},
+						pos:  position{line: 484, col: 69, offset: 16479},
 						name: "ContinuedListItemElement",
 					},
 				},
@@ -3843,32 +4020,32 @@ var g = &grammar{
 		},
 		{
 			name: "ListParagraph",
-			pos:  position{line: 472, col: 1, offset: 15907},
+			pos:  position{line: 486, col: 1, offset: 16505},
 			expr: &choiceExpr{
-				pos: position{line: 472, col: 18, offset: 15924},
+				pos: position{line: 486, col: 18, offset: 16522},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 472, col: 18, offset: 15924},
+						pos: position{line: 486, col: 18, offset: 16522},
 						run: (*parser).callonListParagraph2,
 						expr: &labeledExpr{
-							pos:   position{line: 472, col: 18, offset: 15924},
+							pos:   position{line: 486, col: 18, offset: 16522},
 							label: "comment",
 							expr: &ruleRefExpr{
-								pos:  position{line: 472, col: 27, offset: 15933},
+								pos:  position{line: 486, col: 27, offset: 16531},
 								name: "SingleLineComment",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 474, col: 9, offset: 15990},
+						pos: position{line: 488, col: 9, offset: 16588},
 						run: (*parser).callonListParagraph5,
 						expr: &labeledExpr{
-							pos:   position{line: 474, col: 9, offset: 15990},
+							pos:   position{line: 488, col: 9, offset: 16588},
 							label: "lines",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 474, col: 15, offset: 15996},
+								pos: position{line: 488, col: 15, offset: 16594},
 								expr: &ruleRefExpr{
-									pos:  position{line: 474, col: 16, offset: 15997},
+									pos:  position{line: 488, col: 16, offset: 16595},
 									name: "ListParagraphLine",
 								},
 							},
@@ -3879,112 +4056,112 @@ var g = &grammar{
 		},
 		{
 			name: "ListParagraphLine",
-			pos:  position{line: 478, col: 1, offset: 16089},
+			pos:  position{line: 492, col: 1, offset: 16687},
 			expr: &actionExpr{
-				pos: position{line: 478, col: 22, offset: 16110},
+				pos: position{line: 492, col: 22, offset: 16708},
 				run: (*parser).callonListParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 478, col: 22, offset: 16110},
+					pos: position{line: 492, col: 22, offset: 16708},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 478, col: 22, offset: 16110},
+							pos: position{line: 492, col: 22, offset: 16708},
 							expr: &ruleRefExpr{
-								pos:  position{line: 478, col: 23, offset: 16111},
+								pos:  position{line: 492, col: 23, offset: 16709},
 								name: "EOF",
 							},
 						},
 						&notExpr{
-							pos: position{line: 479, col: 5, offset: 16119},
+							pos: position{line: 493, col: 5, offset: 16717},
 							expr: &ruleRefExpr{
-								pos:  position{line: 479, col: 6, offset: 16120},
+								pos:  position{line: 493, col: 6, offset: 16718},
 								name: "BlankLine",
 							},
 						},
 						&notExpr{
-							pos: position{line: 480, col: 5, offset: 16135},
+							pos: position{line: 494, col: 5, offset: 16733},
 							expr: &ruleRefExpr{
-								pos:  position{line: 480, col: 6, offset: 16136},
+								pos:  position{line: 494, col: 6, offset: 16734},
 								name: "SingleLineComment",
 							},
 						},
 						&notExpr{
-							pos: position{line: 481, col: 5, offset: 16158},
+							pos: position{line: 495, col: 5, offset: 16756},
 							expr: &ruleRefExpr{
-								pos:  position{line: 481, col: 6, offset: 16159},
+								pos:  position{line: 495, col: 6, offset: 16757},
 								name: "OrderedListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 482, col: 5, offset: 16185},
+							pos: position{line: 496, col: 5, offset: 16783},
 							expr: &ruleRefExpr{
-								pos:  position{line: 482, col: 6, offset: 16186},
+								pos:  position{line: 496, col: 6, offset: 16784},
 								name: "UnorderedListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 483, col: 5, offset: 16214},
+							pos: position{line: 497, col: 5, offset: 16812},
 							expr: &seqExpr{
-								pos: position{line: 483, col: 7, offset: 16216},
+								pos: position{line: 497, col: 7, offset: 16814},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 483, col: 7, offset: 16216},
+										pos:  position{line: 497, col: 7, offset: 16814},
 										name: "SimpleLabeledListItemTerm",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 483, col: 33, offset: 16242},
+										pos:  position{line: 497, col: 33, offset: 16840},
 										name: "LabeledListItemSeparator",
 									},
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 484, col: 5, offset: 16273},
+							pos: position{line: 498, col: 5, offset: 16871},
 							expr: &ruleRefExpr{
-								pos:  position{line: 484, col: 6, offset: 16274},
+								pos:  position{line: 498, col: 6, offset: 16872},
 								name: "ListItemContinuation",
 							},
 						},
 						&notExpr{
-							pos: position{line: 485, col: 5, offset: 16299},
+							pos: position{line: 499, col: 5, offset: 16897},
 							expr: &ruleRefExpr{
-								pos:  position{line: 485, col: 6, offset: 16300},
+								pos:  position{line: 499, col: 6, offset: 16898},
 								name: "ElementAttribute",
 							},
 						},
 						&notExpr{
-							pos: position{line: 486, col: 5, offset: 16321},
+							pos: position{line: 500, col: 5, offset: 16919},
 							expr: &ruleRefExpr{
-								pos:  position{line: 486, col: 6, offset: 16322},
+								pos:  position{line: 500, col: 6, offset: 16920},
 								name: "BlockDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 487, col: 5, offset: 16341},
+							pos:   position{line: 501, col: 5, offset: 16939},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 488, col: 9, offset: 16356},
+								pos: position{line: 502, col: 9, offset: 16954},
 								run: (*parser).callonListParagraphLine24,
 								expr: &seqExpr{
-									pos: position{line: 488, col: 9, offset: 16356},
+									pos: position{line: 502, col: 9, offset: 16954},
 									exprs: []interface{}{
 										&labeledExpr{
-											pos:   position{line: 488, col: 9, offset: 16356},
+											pos:   position{line: 502, col: 9, offset: 16954},
 											label: "elements",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 488, col: 18, offset: 16365},
+												pos: position{line: 502, col: 18, offset: 16963},
 												expr: &ruleRefExpr{
-													pos:  position{line: 488, col: 19, offset: 16366},
+													pos:  position{line: 502, col: 19, offset: 16964},
 													name: "InlineElement",
 												},
 											},
 										},
 										&labeledExpr{
-											pos:   position{line: 488, col: 35, offset: 16382},
+											pos:   position{line: 502, col: 35, offset: 16980},
 											label: "linebreak",
 											expr: &zeroOrOneExpr{
-												pos: position{line: 488, col: 45, offset: 16392},
+												pos: position{line: 502, col: 45, offset: 16990},
 												expr: &ruleRefExpr{
-													pos:  position{line: 488, col: 46, offset: 16393},
+													pos:  position{line: 502, col: 46, offset: 16991},
 													name: "LineBreak",
 												},
 											},
@@ -3994,7 +4171,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 490, col: 12, offset: 16545},
+							pos:  position{line: 504, col: 12, offset: 17143},
 							name: "EOL",
 						},
 					},
@@ -4003,17 +4180,17 @@ var g = &grammar{
 		},
 		{
 			name: "ListItemContinuation",
-			pos:  position{line: 494, col: 1, offset: 16592},
+			pos:  position{line: 508, col: 1, offset: 17190},
 			expr: &seqExpr{
-				pos: position{line: 494, col: 25, offset: 16616},
+				pos: position{line: 508, col: 25, offset: 17214},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 494, col: 25, offset: 16616},
+						pos:        position{line: 508, col: 25, offset: 17214},
 						val:        "+",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 494, col: 29, offset: 16620},
+						pos:  position{line: 508, col: 29, offset: 17218},
 						name: "EOLS",
 					},
 				},
@@ -4021,33 +4198,33 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedListItemElement",
-			pos:  position{line: 496, col: 1, offset: 16627},
+			pos:  position{line: 510, col: 1, offset: 17225},
 			expr: &actionExpr{
-				pos: position{line: 496, col: 29, offset: 16655},
+				pos: position{line: 510, col: 29, offset: 17253},
 				run: (*parser).callonContinuedListItemElement1,
 				expr: &seqExpr{
-					pos: position{line: 496, col: 29, offset: 16655},
+					pos: position{line: 510, col: 29, offset: 17253},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 496, col: 29, offset: 16655},
+							pos:   position{line: 510, col: 29, offset: 17253},
 							label: "blanklines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 496, col: 41, offset: 16667},
+								pos: position{line: 510, col: 41, offset: 17265},
 								expr: &ruleRefExpr{
-									pos:  position{line: 496, col: 41, offset: 16667},
+									pos:  position{line: 510, col: 41, offset: 17265},
 									name: "BlankLine",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 496, col: 53, offset: 16679},
+							pos:  position{line: 510, col: 53, offset: 17277},
 							name: "ListItemContinuation",
 						},
 						&labeledExpr{
-							pos:   position{line: 496, col: 74, offset: 16700},
+							pos:   position{line: 510, col: 74, offset: 17298},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 496, col: 82, offset: 16708},
+								pos:  position{line: 510, col: 82, offset: 17306},
 								name: "DocumentBlock",
 							},
 						},
@@ -4057,37 +4234,37 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItem",
-			pos:  position{line: 503, col: 1, offset: 16950},
+			pos:  position{line: 517, col: 1, offset: 17548},
 			expr: &actionExpr{
-				pos: position{line: 503, col: 20, offset: 16969},
+				pos: position{line: 517, col: 20, offset: 17567},
 				run: (*parser).callonOrderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 503, col: 20, offset: 16969},
+					pos: position{line: 517, col: 20, offset: 17567},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 503, col: 20, offset: 16969},
+							pos:   position{line: 517, col: 20, offset: 17567},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 503, col: 31, offset: 16980},
+								pos: position{line: 517, col: 31, offset: 17578},
 								expr: &ruleRefExpr{
-									pos:  position{line: 503, col: 32, offset: 16981},
+									pos:  position{line: 517, col: 32, offset: 17579},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 503, col: 52, offset: 17001},
+							pos:   position{line: 517, col: 52, offset: 17599},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 503, col: 60, offset: 17009},
+								pos:  position{line: 517, col: 60, offset: 17607},
 								name: "OrderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 503, col: 83, offset: 17032},
+							pos:   position{line: 517, col: 83, offset: 17630},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 503, col: 92, offset: 17041},
+								pos:  position{line: 517, col: 92, offset: 17639},
 								name: "OrderedListItemContent",
 							},
 						},
@@ -4097,42 +4274,42 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemPrefix",
-			pos:  position{line: 507, col: 1, offset: 17181},
+			pos:  position{line: 521, col: 1, offset: 17779},
 			expr: &actionExpr{
-				pos: position{line: 508, col: 5, offset: 17211},
+				pos: position{line: 522, col: 5, offset: 17809},
 				run: (*parser).callonOrderedListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 508, col: 5, offset: 17211},
+					pos: position{line: 522, col: 5, offset: 17809},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 508, col: 5, offset: 17211},
+							pos: position{line: 522, col: 5, offset: 17809},
 							expr: &ruleRefExpr{
-								pos:  position{line: 508, col: 5, offset: 17211},
+								pos:  position{line: 522, col: 5, offset: 17809},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 508, col: 9, offset: 17215},
+							pos:   position{line: 522, col: 9, offset: 17813},
 							label: "prefix",
 							expr: &choiceExpr{
-								pos: position{line: 510, col: 9, offset: 17278},
+								pos: position{line: 524, col: 9, offset: 17876},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 510, col: 9, offset: 17278},
+										pos: position{line: 524, col: 9, offset: 17876},
 										run: (*parser).callonOrderedListItemPrefix7,
 										expr: &seqExpr{
-											pos: position{line: 510, col: 9, offset: 17278},
+											pos: position{line: 524, col: 9, offset: 17876},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 510, col: 9, offset: 17278},
+													pos:   position{line: 524, col: 9, offset: 17876},
 													label: "depth",
 													expr: &actionExpr{
-														pos: position{line: 510, col: 16, offset: 17285},
+														pos: position{line: 524, col: 16, offset: 17883},
 														run: (*parser).callonOrderedListItemPrefix10,
 														expr: &oneOrMoreExpr{
-															pos: position{line: 510, col: 16, offset: 17285},
+															pos: position{line: 524, col: 16, offset: 17883},
 															expr: &litMatcher{
-																pos:        position{line: 510, col: 17, offset: 17286},
+																pos:        position{line: 524, col: 17, offset: 17884},
 																val:        ".",
 																ignoreCase: false,
 															},
@@ -4140,22 +4317,22 @@ var g = &grammar{
 													},
 												},
 												&andCodeExpr{
-													pos: position{line: 514, col: 9, offset: 17386},
+													pos: position{line: 528, col: 9, offset: 17984},
 													run: (*parser).callonOrderedListItemPrefix13,
 												},
 											},
 										},
 									},
 									&actionExpr{
-										pos: position{line: 533, col: 11, offset: 18103},
+										pos: position{line: 547, col: 11, offset: 18701},
 										run: (*parser).callonOrderedListItemPrefix14,
 										expr: &seqExpr{
-											pos: position{line: 533, col: 11, offset: 18103},
+											pos: position{line: 547, col: 11, offset: 18701},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 533, col: 11, offset: 18103},
+													pos: position{line: 547, col: 11, offset: 18701},
 													expr: &charClassMatcher{
-														pos:        position{line: 533, col: 12, offset: 18104},
+														pos:        position{line: 547, col: 12, offset: 18702},
 														val:        "[0-9]",
 														ranges:     []rune{'0', '9'},
 														ignoreCase: false,
@@ -4163,7 +4340,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 533, col: 20, offset: 18112},
+													pos:        position{line: 547, col: 20, offset: 18710},
 													val:        ".",
 													ignoreCase: false,
 												},
@@ -4171,20 +4348,20 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 535, col: 13, offset: 18223},
+										pos: position{line: 549, col: 13, offset: 18821},
 										run: (*parser).callonOrderedListItemPrefix19,
 										expr: &seqExpr{
-											pos: position{line: 535, col: 13, offset: 18223},
+											pos: position{line: 549, col: 13, offset: 18821},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 535, col: 14, offset: 18224},
+													pos:        position{line: 549, col: 14, offset: 18822},
 													val:        "[a-z]",
 													ranges:     []rune{'a', 'z'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 535, col: 21, offset: 18231},
+													pos:        position{line: 549, col: 21, offset: 18829},
 													val:        ".",
 													ignoreCase: false,
 												},
@@ -4192,20 +4369,20 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 537, col: 13, offset: 18345},
+										pos: position{line: 551, col: 13, offset: 18943},
 										run: (*parser).callonOrderedListItemPrefix23,
 										expr: &seqExpr{
-											pos: position{line: 537, col: 13, offset: 18345},
+											pos: position{line: 551, col: 13, offset: 18943},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 537, col: 14, offset: 18346},
+													pos:        position{line: 551, col: 14, offset: 18944},
 													val:        "[A-Z]",
 													ranges:     []rune{'A', 'Z'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 537, col: 21, offset: 18353},
+													pos:        position{line: 551, col: 21, offset: 18951},
 													val:        ".",
 													ignoreCase: false,
 												},
@@ -4213,15 +4390,15 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 539, col: 13, offset: 18467},
+										pos: position{line: 553, col: 13, offset: 19065},
 										run: (*parser).callonOrderedListItemPrefix27,
 										expr: &seqExpr{
-											pos: position{line: 539, col: 13, offset: 18467},
+											pos: position{line: 553, col: 13, offset: 19065},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 539, col: 13, offset: 18467},
+													pos: position{line: 553, col: 13, offset: 19065},
 													expr: &charClassMatcher{
-														pos:        position{line: 539, col: 14, offset: 18468},
+														pos:        position{line: 553, col: 14, offset: 19066},
 														val:        "[a-z]",
 														ranges:     []rune{'a', 'z'},
 														ignoreCase: false,
@@ -4229,7 +4406,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 539, col: 22, offset: 18476},
+													pos:        position{line: 553, col: 22, offset: 19074},
 													val:        ")",
 													ignoreCase: false,
 												},
@@ -4237,15 +4414,15 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 541, col: 13, offset: 18590},
+										pos: position{line: 555, col: 13, offset: 19188},
 										run: (*parser).callonOrderedListItemPrefix32,
 										expr: &seqExpr{
-											pos: position{line: 541, col: 13, offset: 18590},
+											pos: position{line: 555, col: 13, offset: 19188},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 541, col: 13, offset: 18590},
+													pos: position{line: 555, col: 13, offset: 19188},
 													expr: &charClassMatcher{
-														pos:        position{line: 541, col: 14, offset: 18591},
+														pos:        position{line: 555, col: 14, offset: 19189},
 														val:        "[A-Z]",
 														ranges:     []rune{'A', 'Z'},
 														ignoreCase: false,
@@ -4253,7 +4430,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 541, col: 22, offset: 18599},
+													pos:        position{line: 555, col: 22, offset: 19197},
 													val:        ")",
 													ignoreCase: false,
 												},
@@ -4264,9 +4441,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 543, col: 12, offset: 18712},
+							pos: position{line: 557, col: 12, offset: 19310},
 							expr: &ruleRefExpr{
-								pos:  position{line: 543, col: 12, offset: 18712},
+								pos:  position{line: 557, col: 12, offset: 19310},
 								name: "WS",
 							},
 						},
@@ -4276,17 +4453,17 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemContent",
-			pos:  position{line: 547, col: 1, offset: 18744},
+			pos:  position{line: 561, col: 1, offset: 19342},
 			expr: &actionExpr{
-				pos: position{line: 547, col: 27, offset: 18770},
+				pos: position{line: 561, col: 27, offset: 19368},
 				run: (*parser).callonOrderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 547, col: 27, offset: 18770},
+					pos:   position{line: 561, col: 27, offset: 19368},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 547, col: 37, offset: 18780},
+						pos: position{line: 561, col: 37, offset: 19378},
 						expr: &ruleRefExpr{
-							pos:  position{line: 547, col: 37, offset: 18780},
+							pos:  position{line: 561, col: 37, offset: 19378},
 							name: "ListParagraph",
 						},
 					},
@@ -4295,48 +4472,48 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItem",
-			pos:  position{line: 554, col: 1, offset: 18980},
+			pos:  position{line: 568, col: 1, offset: 19578},
 			expr: &actionExpr{
-				pos: position{line: 554, col: 22, offset: 19001},
+				pos: position{line: 568, col: 22, offset: 19599},
 				run: (*parser).callonUnorderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 554, col: 22, offset: 19001},
+					pos: position{line: 568, col: 22, offset: 19599},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 554, col: 22, offset: 19001},
+							pos:   position{line: 568, col: 22, offset: 19599},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 554, col: 33, offset: 19012},
+								pos: position{line: 568, col: 33, offset: 19610},
 								expr: &ruleRefExpr{
-									pos:  position{line: 554, col: 34, offset: 19013},
+									pos:  position{line: 568, col: 34, offset: 19611},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 554, col: 54, offset: 19033},
+							pos:   position{line: 568, col: 54, offset: 19631},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 554, col: 62, offset: 19041},
+								pos:  position{line: 568, col: 62, offset: 19639},
 								name: "UnorderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 554, col: 87, offset: 19066},
+							pos:   position{line: 568, col: 87, offset: 19664},
 							label: "checkstyle",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 554, col: 98, offset: 19077},
+								pos: position{line: 568, col: 98, offset: 19675},
 								expr: &ruleRefExpr{
-									pos:  position{line: 554, col: 99, offset: 19078},
+									pos:  position{line: 568, col: 99, offset: 19676},
 									name: "UnorderedListItemCheckStyle",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 554, col: 129, offset: 19108},
+							pos:   position{line: 568, col: 129, offset: 19706},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 554, col: 138, offset: 19117},
+								pos:  position{line: 568, col: 138, offset: 19715},
 								name: "UnorderedListItemContent",
 							},
 						},
@@ -4346,42 +4523,42 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemPrefix",
-			pos:  position{line: 558, col: 1, offset: 19275},
+			pos:  position{line: 572, col: 1, offset: 19873},
 			expr: &actionExpr{
-				pos: position{line: 559, col: 5, offset: 19307},
+				pos: position{line: 573, col: 5, offset: 19905},
 				run: (*parser).callonUnorderedListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 559, col: 5, offset: 19307},
+					pos: position{line: 573, col: 5, offset: 19905},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 559, col: 5, offset: 19307},
+							pos: position{line: 573, col: 5, offset: 19905},
 							expr: &ruleRefExpr{
-								pos:  position{line: 559, col: 5, offset: 19307},
+								pos:  position{line: 573, col: 5, offset: 19905},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 559, col: 9, offset: 19311},
+							pos:   position{line: 573, col: 9, offset: 19909},
 							label: "prefix",
 							expr: &choiceExpr{
-								pos: position{line: 559, col: 17, offset: 19319},
+								pos: position{line: 573, col: 17, offset: 19917},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 561, col: 9, offset: 19376},
+										pos: position{line: 575, col: 9, offset: 19974},
 										run: (*parser).callonUnorderedListItemPrefix7,
 										expr: &seqExpr{
-											pos: position{line: 561, col: 9, offset: 19376},
+											pos: position{line: 575, col: 9, offset: 19974},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 561, col: 9, offset: 19376},
+													pos:   position{line: 575, col: 9, offset: 19974},
 													label: "depth",
 													expr: &actionExpr{
-														pos: position{line: 561, col: 16, offset: 19383},
+														pos: position{line: 575, col: 16, offset: 19981},
 														run: (*parser).callonUnorderedListItemPrefix10,
 														expr: &oneOrMoreExpr{
-															pos: position{line: 561, col: 16, offset: 19383},
+															pos: position{line: 575, col: 16, offset: 19981},
 															expr: &litMatcher{
-																pos:        position{line: 561, col: 17, offset: 19384},
+																pos:        position{line: 575, col: 17, offset: 19982},
 																val:        "*",
 																ignoreCase: false,
 															},
@@ -4389,20 +4566,20 @@ var g = &grammar{
 													},
 												},
 												&andCodeExpr{
-													pos: position{line: 565, col: 9, offset: 19484},
+													pos: position{line: 579, col: 9, offset: 20082},
 													run: (*parser).callonUnorderedListItemPrefix13,
 												},
 											},
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 582, col: 14, offset: 20191},
+										pos:   position{line: 596, col: 14, offset: 20789},
 										label: "depth",
 										expr: &actionExpr{
-											pos: position{line: 582, col: 21, offset: 20198},
+											pos: position{line: 596, col: 21, offset: 20796},
 											run: (*parser).callonUnorderedListItemPrefix15,
 											expr: &litMatcher{
-												pos:        position{line: 582, col: 22, offset: 20199},
+												pos:        position{line: 596, col: 22, offset: 20797},
 												val:        "-",
 												ignoreCase: false,
 											},
@@ -4412,9 +4589,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 584, col: 13, offset: 20285},
+							pos: position{line: 598, col: 13, offset: 20883},
 							expr: &ruleRefExpr{
-								pos:  position{line: 584, col: 13, offset: 20285},
+								pos:  position{line: 598, col: 13, offset: 20883},
 								name: "WS",
 							},
 						},
@@ -4424,50 +4601,50 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemCheckStyle",
-			pos:  position{line: 588, col: 1, offset: 20318},
+			pos:  position{line: 602, col: 1, offset: 20916},
 			expr: &actionExpr{
-				pos: position{line: 588, col: 32, offset: 20349},
+				pos: position{line: 602, col: 32, offset: 20947},
 				run: (*parser).callonUnorderedListItemCheckStyle1,
 				expr: &seqExpr{
-					pos: position{line: 588, col: 32, offset: 20349},
+					pos: position{line: 602, col: 32, offset: 20947},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 588, col: 32, offset: 20349},
+							pos: position{line: 602, col: 32, offset: 20947},
 							expr: &litMatcher{
-								pos:        position{line: 588, col: 33, offset: 20350},
+								pos:        position{line: 602, col: 33, offset: 20948},
 								val:        "[",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 588, col: 37, offset: 20354},
+							pos:   position{line: 602, col: 37, offset: 20952},
 							label: "style",
 							expr: &choiceExpr{
-								pos: position{line: 589, col: 7, offset: 20368},
+								pos: position{line: 603, col: 7, offset: 20966},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 589, col: 7, offset: 20368},
+										pos: position{line: 603, col: 7, offset: 20966},
 										run: (*parser).callonUnorderedListItemCheckStyle7,
 										expr: &litMatcher{
-											pos:        position{line: 589, col: 7, offset: 20368},
+											pos:        position{line: 603, col: 7, offset: 20966},
 											val:        "[ ]",
 											ignoreCase: false,
 										},
 									},
 									&actionExpr{
-										pos: position{line: 590, col: 7, offset: 20413},
+										pos: position{line: 604, col: 7, offset: 21011},
 										run: (*parser).callonUnorderedListItemCheckStyle9,
 										expr: &litMatcher{
-											pos:        position{line: 590, col: 7, offset: 20413},
+											pos:        position{line: 604, col: 7, offset: 21011},
 											val:        "[*]",
 											ignoreCase: false,
 										},
 									},
 									&actionExpr{
-										pos: position{line: 591, col: 7, offset: 20456},
+										pos: position{line: 605, col: 7, offset: 21054},
 										run: (*parser).callonUnorderedListItemCheckStyle11,
 										expr: &litMatcher{
-											pos:        position{line: 591, col: 7, offset: 20456},
+											pos:        position{line: 605, col: 7, offset: 21054},
 											val:        "[x]",
 											ignoreCase: false,
 										},
@@ -4476,9 +4653,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 592, col: 7, offset: 20498},
+							pos: position{line: 606, col: 7, offset: 21096},
 							expr: &ruleRefExpr{
-								pos:  position{line: 592, col: 7, offset: 20498},
+								pos:  position{line: 606, col: 7, offset: 21096},
 								name: "WS",
 							},
 						},
@@ -4488,17 +4665,17 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemContent",
-			pos:  position{line: 596, col: 1, offset: 20537},
+			pos:  position{line: 610, col: 1, offset: 21135},
 			expr: &actionExpr{
-				pos: position{line: 596, col: 29, offset: 20565},
+				pos: position{line: 610, col: 29, offset: 21163},
 				run: (*parser).callonUnorderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 596, col: 29, offset: 20565},
+					pos:   position{line: 610, col: 29, offset: 21163},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 596, col: 39, offset: 20575},
+						pos: position{line: 610, col: 39, offset: 21173},
 						expr: &ruleRefExpr{
-							pos:  position{line: 596, col: 39, offset: 20575},
+							pos:  position{line: 610, col: 39, offset: 21173},
 							name: "ListParagraph",
 						},
 					},
@@ -4507,47 +4684,47 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItem",
-			pos:  position{line: 603, col: 1, offset: 20891},
+			pos:  position{line: 617, col: 1, offset: 21489},
 			expr: &actionExpr{
-				pos: position{line: 603, col: 20, offset: 20910},
+				pos: position{line: 617, col: 20, offset: 21508},
 				run: (*parser).callonLabeledListItem1,
 				expr: &seqExpr{
-					pos: position{line: 603, col: 20, offset: 20910},
+					pos: position{line: 617, col: 20, offset: 21508},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 603, col: 20, offset: 20910},
+							pos:   position{line: 617, col: 20, offset: 21508},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 603, col: 31, offset: 20921},
+								pos: position{line: 617, col: 31, offset: 21519},
 								expr: &ruleRefExpr{
-									pos:  position{line: 603, col: 32, offset: 20922},
+									pos:  position{line: 617, col: 32, offset: 21520},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 603, col: 52, offset: 20942},
+							pos:   position{line: 617, col: 52, offset: 21540},
 							label: "term",
 							expr: &ruleRefExpr{
-								pos:  position{line: 603, col: 58, offset: 20948},
+								pos:  position{line: 617, col: 58, offset: 21546},
 								name: "SimpleLabeledListItemTerm",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 603, col: 85, offset: 20975},
+							pos:   position{line: 617, col: 85, offset: 21573},
 							label: "separator",
 							expr: &ruleRefExpr{
-								pos:  position{line: 603, col: 96, offset: 20986},
+								pos:  position{line: 617, col: 96, offset: 21584},
 								name: "LabeledListItemSeparator",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 603, col: 122, offset: 21012},
+							pos:   position{line: 617, col: 122, offset: 21610},
 							label: "description",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 603, col: 134, offset: 21024},
+								pos: position{line: 617, col: 134, offset: 21622},
 								expr: &ruleRefExpr{
-									pos:  position{line: 603, col: 135, offset: 21025},
+									pos:  position{line: 617, col: 135, offset: 21623},
 									name: "LabeledListItemDescription",
 								},
 							},
@@ -4558,48 +4735,48 @@ var g = &grammar{
 		},
 		{
 			name: "SimpleLabeledListItemTerm",
-			pos:  position{line: 607, col: 1, offset: 21171},
+			pos:  position{line: 621, col: 1, offset: 21769},
 			expr: &actionExpr{
-				pos: position{line: 607, col: 30, offset: 21200},
+				pos: position{line: 621, col: 30, offset: 21798},
 				run: (*parser).callonSimpleLabeledListItemTerm1,
 				expr: &labeledExpr{
-					pos:   position{line: 607, col: 30, offset: 21200},
+					pos:   position{line: 621, col: 30, offset: 21798},
 					label: "content",
 					expr: &actionExpr{
-						pos: position{line: 607, col: 39, offset: 21209},
+						pos: position{line: 621, col: 39, offset: 21807},
 						run: (*parser).callonSimpleLabeledListItemTerm3,
 						expr: &oneOrMoreExpr{
-							pos: position{line: 607, col: 39, offset: 21209},
+							pos: position{line: 621, col: 39, offset: 21807},
 							expr: &choiceExpr{
-								pos: position{line: 607, col: 40, offset: 21210},
+								pos: position{line: 621, col: 40, offset: 21808},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 607, col: 40, offset: 21210},
+										pos:  position{line: 621, col: 40, offset: 21808},
 										name: "Alphanums",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 607, col: 52, offset: 21222},
+										pos:  position{line: 621, col: 52, offset: 21820},
 										name: "Spaces",
 									},
 									&seqExpr{
-										pos: position{line: 607, col: 62, offset: 21232},
+										pos: position{line: 621, col: 62, offset: 21830},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 607, col: 62, offset: 21232},
+												pos: position{line: 621, col: 62, offset: 21830},
 												expr: &ruleRefExpr{
-													pos:  position{line: 607, col: 63, offset: 21233},
+													pos:  position{line: 621, col: 63, offset: 21831},
 													name: "NEWLINE",
 												},
 											},
 											&notExpr{
-												pos: position{line: 607, col: 71, offset: 21241},
+												pos: position{line: 621, col: 71, offset: 21839},
 												expr: &ruleRefExpr{
-													pos:  position{line: 607, col: 72, offset: 21242},
+													pos:  position{line: 621, col: 72, offset: 21840},
 													name: "LabeledListItemSeparator",
 												},
 											},
 											&anyMatcher{
-												line: 607, col: 97, offset: 21267,
+												line: 621, col: 97, offset: 21865,
 											},
 										},
 									},
@@ -4612,34 +4789,34 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemTerm",
-			pos:  position{line: 613, col: 1, offset: 21396},
+			pos:  position{line: 627, col: 1, offset: 21994},
 			expr: &actionExpr{
-				pos: position{line: 613, col: 24, offset: 21419},
+				pos: position{line: 627, col: 24, offset: 22017},
 				run: (*parser).callonLabeledListItemTerm1,
 				expr: &labeledExpr{
-					pos:   position{line: 613, col: 24, offset: 21419},
+					pos:   position{line: 627, col: 24, offset: 22017},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 613, col: 33, offset: 21428},
+						pos: position{line: 627, col: 33, offset: 22026},
 						expr: &seqExpr{
-							pos: position{line: 613, col: 34, offset: 21429},
+							pos: position{line: 627, col: 34, offset: 22027},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 613, col: 34, offset: 21429},
+									pos: position{line: 627, col: 34, offset: 22027},
 									expr: &ruleRefExpr{
-										pos:  position{line: 613, col: 35, offset: 21430},
+										pos:  position{line: 627, col: 35, offset: 22028},
 										name: "NEWLINE",
 									},
 								},
 								&notExpr{
-									pos: position{line: 613, col: 43, offset: 21438},
+									pos: position{line: 627, col: 43, offset: 22036},
 									expr: &ruleRefExpr{
-										pos:  position{line: 613, col: 44, offset: 21439},
+										pos:  position{line: 627, col: 44, offset: 22037},
 										name: "LabeledListItemSeparator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 613, col: 69, offset: 21464},
+									pos:  position{line: 627, col: 69, offset: 22062},
 									name: "LabeledListItemTermElement",
 								},
 							},
@@ -4650,62 +4827,62 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemTermElement",
-			pos:  position{line: 617, col: 1, offset: 21599},
+			pos:  position{line: 631, col: 1, offset: 22197},
 			expr: &actionExpr{
-				pos: position{line: 617, col: 31, offset: 21629},
+				pos: position{line: 631, col: 31, offset: 22227},
 				run: (*parser).callonLabeledListItemTermElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 617, col: 31, offset: 21629},
+					pos:   position{line: 631, col: 31, offset: 22227},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 617, col: 40, offset: 21638},
+						pos: position{line: 631, col: 40, offset: 22236},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 617, col: 40, offset: 21638},
+								pos:  position{line: 631, col: 40, offset: 22236},
 								name: "SimpleWord",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 618, col: 11, offset: 21659},
+								pos:  position{line: 632, col: 11, offset: 22257},
 								name: "Spaces",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 619, col: 11, offset: 21677},
+								pos:  position{line: 633, col: 11, offset: 22275},
 								name: "CrossReference",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 620, col: 11, offset: 21702},
+								pos:  position{line: 634, col: 11, offset: 22300},
 								name: "Passthrough",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 621, col: 11, offset: 21724},
+								pos:  position{line: 635, col: 11, offset: 22322},
 								name: "InlineImage",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 622, col: 11, offset: 21747},
+								pos:  position{line: 636, col: 11, offset: 22345},
 								name: "Link",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 623, col: 11, offset: 21762},
+								pos:  position{line: 637, col: 11, offset: 22360},
 								name: "InlineFootnote",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 624, col: 11, offset: 21787},
+								pos:  position{line: 638, col: 11, offset: 22385},
 								name: "QuotedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 625, col: 11, offset: 21808},
+								pos:  position{line: 639, col: 11, offset: 22406},
 								name: "DocumentAttributeSubstitution",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 626, col: 11, offset: 21848},
+								pos:  position{line: 640, col: 11, offset: 22446},
 								name: "LineBreak",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 627, col: 11, offset: 21868},
+								pos:  position{line: 641, col: 11, offset: 22466},
 								name: "OtherWord",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 628, col: 11, offset: 21888},
+								pos:  position{line: 642, col: 11, offset: 22486},
 								name: "Parenthesis",
 							},
 						},
@@ -4715,23 +4892,23 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemSeparator",
-			pos:  position{line: 632, col: 1, offset: 21930},
+			pos:  position{line: 646, col: 1, offset: 22528},
 			expr: &actionExpr{
-				pos: position{line: 633, col: 5, offset: 21963},
+				pos: position{line: 647, col: 5, offset: 22561},
 				run: (*parser).callonLabeledListItemSeparator1,
 				expr: &seqExpr{
-					pos: position{line: 633, col: 5, offset: 21963},
+					pos: position{line: 647, col: 5, offset: 22561},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 633, col: 5, offset: 21963},
+							pos:   position{line: 647, col: 5, offset: 22561},
 							label: "separator",
 							expr: &actionExpr{
-								pos: position{line: 633, col: 16, offset: 21974},
+								pos: position{line: 647, col: 16, offset: 22572},
 								run: (*parser).callonLabeledListItemSeparator4,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 633, col: 16, offset: 21974},
+									pos: position{line: 647, col: 16, offset: 22572},
 									expr: &litMatcher{
-										pos:        position{line: 633, col: 17, offset: 21975},
+										pos:        position{line: 647, col: 17, offset: 22573},
 										val:        ":",
 										ignoreCase: false,
 									},
@@ -4739,30 +4916,30 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 636, col: 5, offset: 22033},
+							pos: position{line: 650, col: 5, offset: 22631},
 							run: (*parser).callonLabeledListItemSeparator7,
 						},
 						&choiceExpr{
-							pos: position{line: 640, col: 6, offset: 22209},
+							pos: position{line: 654, col: 6, offset: 22807},
 							alternatives: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 640, col: 6, offset: 22209},
+									pos: position{line: 654, col: 6, offset: 22807},
 									expr: &choiceExpr{
-										pos: position{line: 640, col: 7, offset: 22210},
+										pos: position{line: 654, col: 7, offset: 22808},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 640, col: 7, offset: 22210},
+												pos:  position{line: 654, col: 7, offset: 22808},
 												name: "WS",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 640, col: 12, offset: 22215},
+												pos:  position{line: 654, col: 12, offset: 22813},
 												name: "NEWLINE",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 640, col: 24, offset: 22227},
+									pos:  position{line: 654, col: 24, offset: 22825},
 									name: "EOL",
 								},
 							},
@@ -4773,17 +4950,17 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemDescription",
-			pos:  position{line: 644, col: 1, offset: 22267},
+			pos:  position{line: 658, col: 1, offset: 22865},
 			expr: &actionExpr{
-				pos: position{line: 644, col: 31, offset: 22297},
+				pos: position{line: 658, col: 31, offset: 22895},
 				run: (*parser).callonLabeledListItemDescription1,
 				expr: &labeledExpr{
-					pos:   position{line: 644, col: 31, offset: 22297},
+					pos:   position{line: 658, col: 31, offset: 22895},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 644, col: 40, offset: 22306},
+						pos: position{line: 658, col: 40, offset: 22904},
 						expr: &ruleRefExpr{
-							pos:  position{line: 644, col: 41, offset: 22307},
+							pos:  position{line: 658, col: 41, offset: 22905},
 							name: "ListParagraph",
 						},
 					},
@@ -4792,51 +4969,51 @@ var g = &grammar{
 		},
 		{
 			name: "AdmonitionKind",
-			pos:  position{line: 651, col: 1, offset: 22498},
+			pos:  position{line: 665, col: 1, offset: 23096},
 			expr: &choiceExpr{
-				pos: position{line: 651, col: 19, offset: 22516},
+				pos: position{line: 665, col: 19, offset: 23114},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 651, col: 19, offset: 22516},
+						pos: position{line: 665, col: 19, offset: 23114},
 						run: (*parser).callonAdmonitionKind2,
 						expr: &litMatcher{
-							pos:        position{line: 651, col: 19, offset: 22516},
+							pos:        position{line: 665, col: 19, offset: 23114},
 							val:        "TIP",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 653, col: 9, offset: 22562},
+						pos: position{line: 667, col: 9, offset: 23160},
 						run: (*parser).callonAdmonitionKind4,
 						expr: &litMatcher{
-							pos:        position{line: 653, col: 9, offset: 22562},
+							pos:        position{line: 667, col: 9, offset: 23160},
 							val:        "NOTE",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 655, col: 9, offset: 22610},
+						pos: position{line: 669, col: 9, offset: 23208},
 						run: (*parser).callonAdmonitionKind6,
 						expr: &litMatcher{
-							pos:        position{line: 655, col: 9, offset: 22610},
+							pos:        position{line: 669, col: 9, offset: 23208},
 							val:        "IMPORTANT",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 657, col: 9, offset: 22668},
+						pos: position{line: 671, col: 9, offset: 23266},
 						run: (*parser).callonAdmonitionKind8,
 						expr: &litMatcher{
-							pos:        position{line: 657, col: 9, offset: 22668},
+							pos:        position{line: 671, col: 9, offset: 23266},
 							val:        "WARNING",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 659, col: 9, offset: 22722},
+						pos: position{line: 673, col: 9, offset: 23320},
 						run: (*parser).callonAdmonitionKind10,
 						expr: &litMatcher{
-							pos:        position{line: 659, col: 9, offset: 22722},
+							pos:        position{line: 673, col: 9, offset: 23320},
 							val:        "CAUTION",
 							ignoreCase: false,
 						},
@@ -4846,47 +5023,47 @@ var g = &grammar{
 		},
 		{
 			name: "Paragraph",
-			pos:  position{line: 668, col: 1, offset: 23029},
+			pos:  position{line: 682, col: 1, offset: 23627},
 			expr: &choiceExpr{
-				pos: position{line: 670, col: 5, offset: 23076},
+				pos: position{line: 684, col: 5, offset: 23674},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 670, col: 5, offset: 23076},
+						pos: position{line: 684, col: 5, offset: 23674},
 						run: (*parser).callonParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 670, col: 5, offset: 23076},
+							pos: position{line: 684, col: 5, offset: 23674},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 670, col: 5, offset: 23076},
+									pos:   position{line: 684, col: 5, offset: 23674},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 670, col: 16, offset: 23087},
+										pos: position{line: 684, col: 16, offset: 23685},
 										expr: &ruleRefExpr{
-											pos:  position{line: 670, col: 17, offset: 23088},
+											pos:  position{line: 684, col: 17, offset: 23686},
 											name: "ElementAttributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 670, col: 37, offset: 23108},
+									pos:   position{line: 684, col: 37, offset: 23706},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 670, col: 40, offset: 23111},
+										pos:  position{line: 684, col: 40, offset: 23709},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 670, col: 56, offset: 23127},
+									pos:        position{line: 684, col: 56, offset: 23725},
 									val:        ": ",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 670, col: 61, offset: 23132},
+									pos:   position{line: 684, col: 61, offset: 23730},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 670, col: 67, offset: 23138},
+										pos: position{line: 684, col: 67, offset: 23736},
 										expr: &ruleRefExpr{
-											pos:  position{line: 670, col: 68, offset: 23139},
+											pos:  position{line: 684, col: 68, offset: 23737},
 											name: "InlineElements",
 										},
 									},
@@ -4895,29 +5072,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 674, col: 5, offset: 23331},
+						pos: position{line: 688, col: 5, offset: 23929},
 						run: (*parser).callonParagraph13,
 						expr: &seqExpr{
-							pos: position{line: 674, col: 5, offset: 23331},
+							pos: position{line: 688, col: 5, offset: 23929},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 674, col: 5, offset: 23331},
+									pos:   position{line: 688, col: 5, offset: 23929},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 674, col: 16, offset: 23342},
+										pos: position{line: 688, col: 16, offset: 23940},
 										expr: &ruleRefExpr{
-											pos:  position{line: 674, col: 17, offset: 23343},
+											pos:  position{line: 688, col: 17, offset: 23941},
 											name: "ElementAttributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 674, col: 37, offset: 23363},
+									pos:   position{line: 688, col: 37, offset: 23961},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 674, col: 43, offset: 23369},
+										pos: position{line: 688, col: 43, offset: 23967},
 										expr: &ruleRefExpr{
-											pos:  position{line: 674, col: 44, offset: 23370},
+											pos:  position{line: 688, col: 44, offset: 23968},
 											name: "InlineElements",
 										},
 									},
@@ -4930,43 +5107,43 @@ var g = &grammar{
 		},
 		{
 			name: "SimpleParagraph",
-			pos:  position{line: 679, col: 1, offset: 23535},
+			pos:  position{line: 693, col: 1, offset: 24133},
 			expr: &actionExpr{
-				pos: position{line: 679, col: 20, offset: 23554},
+				pos: position{line: 693, col: 20, offset: 24152},
 				run: (*parser).callonSimpleParagraph1,
 				expr: &seqExpr{
-					pos: position{line: 679, col: 20, offset: 23554},
+					pos: position{line: 693, col: 20, offset: 24152},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 679, col: 20, offset: 23554},
+							pos:   position{line: 693, col: 20, offset: 24152},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 679, col: 31, offset: 23565},
+								pos: position{line: 693, col: 31, offset: 24163},
 								expr: &ruleRefExpr{
-									pos:  position{line: 679, col: 32, offset: 23566},
+									pos:  position{line: 693, col: 32, offset: 24164},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 680, col: 5, offset: 23591},
+							pos: position{line: 694, col: 5, offset: 24189},
 							run: (*parser).callonSimpleParagraph6,
 						},
 						&labeledExpr{
-							pos:   position{line: 688, col: 5, offset: 23882},
+							pos:   position{line: 702, col: 5, offset: 24480},
 							label: "firstLine",
 							expr: &ruleRefExpr{
-								pos:  position{line: 688, col: 16, offset: 23893},
+								pos:  position{line: 702, col: 16, offset: 24491},
 								name: "FirstParagraphLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 689, col: 5, offset: 23916},
+							pos:   position{line: 703, col: 5, offset: 24514},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 689, col: 16, offset: 23927},
+								pos: position{line: 703, col: 16, offset: 24525},
 								expr: &ruleRefExpr{
-									pos:  position{line: 689, col: 17, offset: 23928},
+									pos:  position{line: 703, col: 17, offset: 24526},
 									name: "OtherParagraphLine",
 								},
 							},
@@ -4977,28 +5154,28 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphLines",
-			pos:  position{line: 693, col: 1, offset: 24062},
+			pos:  position{line: 707, col: 1, offset: 24660},
 			expr: &actionExpr{
-				pos: position{line: 693, col: 19, offset: 24080},
+				pos: position{line: 707, col: 19, offset: 24678},
 				run: (*parser).callonParagraphLines1,
 				expr: &seqExpr{
-					pos: position{line: 693, col: 19, offset: 24080},
+					pos: position{line: 707, col: 19, offset: 24678},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 693, col: 19, offset: 24080},
+							pos:   position{line: 707, col: 19, offset: 24678},
 							label: "firstLine",
 							expr: &ruleRefExpr{
-								pos:  position{line: 693, col: 30, offset: 24091},
+								pos:  position{line: 707, col: 30, offset: 24689},
 								name: "FirstParagraphLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 693, col: 50, offset: 24111},
+							pos:   position{line: 707, col: 50, offset: 24709},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 693, col: 61, offset: 24122},
+								pos: position{line: 707, col: 61, offset: 24720},
 								expr: &ruleRefExpr{
-									pos:  position{line: 693, col: 62, offset: 24123},
+									pos:  position{line: 707, col: 62, offset: 24721},
 									name: "OtherParagraphLine",
 								},
 							},
@@ -5009,43 +5186,43 @@ var g = &grammar{
 		},
 		{
 			name: "FirstParagraphLine",
-			pos:  position{line: 697, col: 1, offset: 24229},
+			pos:  position{line: 711, col: 1, offset: 24827},
 			expr: &actionExpr{
-				pos: position{line: 697, col: 23, offset: 24251},
+				pos: position{line: 711, col: 23, offset: 24849},
 				run: (*parser).callonFirstParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 697, col: 23, offset: 24251},
+					pos: position{line: 711, col: 23, offset: 24849},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 697, col: 23, offset: 24251},
+							pos: position{line: 711, col: 23, offset: 24849},
 							expr: &seqExpr{
-								pos: position{line: 697, col: 25, offset: 24253},
+								pos: position{line: 711, col: 25, offset: 24851},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 697, col: 25, offset: 24253},
+										pos:  position{line: 711, col: 25, offset: 24851},
 										name: "SimpleLabeledListItemTerm",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 697, col: 51, offset: 24279},
+										pos:  position{line: 711, col: 51, offset: 24877},
 										name: "LabeledListItemSeparator",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 698, col: 5, offset: 24309},
+							pos:   position{line: 712, col: 5, offset: 24907},
 							label: "elements",
 							expr: &seqExpr{
-								pos: position{line: 698, col: 15, offset: 24319},
+								pos: position{line: 712, col: 15, offset: 24917},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 698, col: 15, offset: 24319},
+										pos:  position{line: 712, col: 15, offset: 24917},
 										name: "SimpleWord",
 									},
 									&zeroOrMoreExpr{
-										pos: position{line: 698, col: 26, offset: 24330},
+										pos: position{line: 712, col: 26, offset: 24928},
 										expr: &ruleRefExpr{
-											pos:  position{line: 698, col: 26, offset: 24330},
+											pos:  position{line: 712, col: 26, offset: 24928},
 											name: "InlineElement",
 										},
 									},
@@ -5053,18 +5230,18 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 698, col: 42, offset: 24346},
+							pos:   position{line: 712, col: 42, offset: 24944},
 							label: "linebreak",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 698, col: 52, offset: 24356},
+								pos: position{line: 712, col: 52, offset: 24954},
 								expr: &ruleRefExpr{
-									pos:  position{line: 698, col: 53, offset: 24357},
+									pos:  position{line: 712, col: 53, offset: 24955},
 									name: "LineBreak",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 698, col: 65, offset: 24369},
+							pos:  position{line: 712, col: 65, offset: 24967},
 							name: "EOL",
 						},
 					},
@@ -5073,15 +5250,15 @@ var g = &grammar{
 		},
 		{
 			name: "OtherParagraphLine",
-			pos:  position{line: 702, col: 1, offset: 24459},
+			pos:  position{line: 716, col: 1, offset: 25057},
 			expr: &actionExpr{
-				pos: position{line: 702, col: 23, offset: 24481},
+				pos: position{line: 716, col: 23, offset: 25079},
 				run: (*parser).callonOtherParagraphLine1,
 				expr: &labeledExpr{
-					pos:   position{line: 702, col: 23, offset: 24481},
+					pos:   position{line: 716, col: 23, offset: 25079},
 					label: "elements",
 					expr: &ruleRefExpr{
-						pos:  position{line: 702, col: 33, offset: 24491},
+						pos:  position{line: 716, col: 33, offset: 25089},
 						name: "InlineElements",
 					},
 				},
@@ -5089,51 +5266,51 @@ var g = &grammar{
 		},
 		{
 			name: "VerseParagraph",
-			pos:  position{line: 706, col: 1, offset: 24537},
+			pos:  position{line: 720, col: 1, offset: 25135},
 			expr: &choiceExpr{
-				pos: position{line: 708, col: 5, offset: 24589},
+				pos: position{line: 722, col: 5, offset: 25187},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 708, col: 5, offset: 24589},
+						pos: position{line: 722, col: 5, offset: 25187},
 						run: (*parser).callonVerseParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 708, col: 5, offset: 24589},
+							pos: position{line: 722, col: 5, offset: 25187},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 708, col: 5, offset: 24589},
+									pos:   position{line: 722, col: 5, offset: 25187},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 708, col: 16, offset: 24600},
+										pos: position{line: 722, col: 16, offset: 25198},
 										expr: &ruleRefExpr{
-											pos:  position{line: 708, col: 17, offset: 24601},
+											pos:  position{line: 722, col: 17, offset: 25199},
 											name: "ElementAttributes",
 										},
 									},
 								},
 								&andCodeExpr{
-									pos: position{line: 709, col: 5, offset: 24625},
+									pos: position{line: 723, col: 5, offset: 25223},
 									run: (*parser).callonVerseParagraph7,
 								},
 								&labeledExpr{
-									pos:   position{line: 716, col: 5, offset: 24837},
+									pos:   position{line: 730, col: 5, offset: 25435},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 716, col: 8, offset: 24840},
+										pos:  position{line: 730, col: 8, offset: 25438},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 716, col: 24, offset: 24856},
+									pos:        position{line: 730, col: 24, offset: 25454},
 									val:        ": ",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 716, col: 29, offset: 24861},
+									pos:   position{line: 730, col: 29, offset: 25459},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 716, col: 35, offset: 24867},
+										pos: position{line: 730, col: 35, offset: 25465},
 										expr: &ruleRefExpr{
-											pos:  position{line: 716, col: 36, offset: 24868},
+											pos:  position{line: 730, col: 36, offset: 25466},
 											name: "InlineElements",
 										},
 									},
@@ -5142,33 +5319,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 720, col: 5, offset: 25060},
+						pos: position{line: 734, col: 5, offset: 25658},
 						run: (*parser).callonVerseParagraph14,
 						expr: &seqExpr{
-							pos: position{line: 720, col: 5, offset: 25060},
+							pos: position{line: 734, col: 5, offset: 25658},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 720, col: 5, offset: 25060},
+									pos:   position{line: 734, col: 5, offset: 25658},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 720, col: 16, offset: 25071},
+										pos: position{line: 734, col: 16, offset: 25669},
 										expr: &ruleRefExpr{
-											pos:  position{line: 720, col: 17, offset: 25072},
+											pos:  position{line: 734, col: 17, offset: 25670},
 											name: "ElementAttributes",
 										},
 									},
 								},
 								&andCodeExpr{
-									pos: position{line: 721, col: 5, offset: 25096},
+									pos: position{line: 735, col: 5, offset: 25694},
 									run: (*parser).callonVerseParagraph19,
 								},
 								&labeledExpr{
-									pos:   position{line: 728, col: 5, offset: 25308},
+									pos:   position{line: 742, col: 5, offset: 25906},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 728, col: 11, offset: 25314},
+										pos: position{line: 742, col: 11, offset: 25912},
 										expr: &ruleRefExpr{
-											pos:  position{line: 728, col: 12, offset: 25315},
+											pos:  position{line: 742, col: 12, offset: 25913},
 											name: "InlineElements",
 										},
 									},
@@ -5181,82 +5358,82 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElements",
-			pos:  position{line: 732, col: 1, offset: 25416},
+			pos:  position{line: 746, col: 1, offset: 26014},
 			expr: &actionExpr{
-				pos: position{line: 732, col: 19, offset: 25434},
+				pos: position{line: 746, col: 19, offset: 26032},
 				run: (*parser).callonInlineElements1,
 				expr: &seqExpr{
-					pos: position{line: 732, col: 19, offset: 25434},
+					pos: position{line: 746, col: 19, offset: 26032},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 732, col: 19, offset: 25434},
+							pos: position{line: 746, col: 19, offset: 26032},
 							expr: &ruleRefExpr{
-								pos:  position{line: 732, col: 20, offset: 25435},
+								pos:  position{line: 746, col: 20, offset: 26033},
 								name: "EOF",
 							},
 						},
 						&notExpr{
-							pos: position{line: 732, col: 24, offset: 25439},
+							pos: position{line: 746, col: 24, offset: 26037},
 							expr: &ruleRefExpr{
-								pos:  position{line: 732, col: 25, offset: 25440},
+								pos:  position{line: 746, col: 25, offset: 26038},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 733, col: 5, offset: 25454},
+							pos:   position{line: 747, col: 5, offset: 26052},
 							label: "elements",
 							expr: &choiceExpr{
-								pos: position{line: 733, col: 15, offset: 25464},
+								pos: position{line: 747, col: 15, offset: 26062},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 733, col: 15, offset: 25464},
+										pos: position{line: 747, col: 15, offset: 26062},
 										run: (*parser).callonInlineElements9,
 										expr: &labeledExpr{
-											pos:   position{line: 733, col: 15, offset: 25464},
+											pos:   position{line: 747, col: 15, offset: 26062},
 											label: "comment",
 											expr: &ruleRefExpr{
-												pos:  position{line: 733, col: 24, offset: 25473},
+												pos:  position{line: 747, col: 24, offset: 26071},
 												name: "SingleLineComment",
 											},
 										},
 									},
 									&actionExpr{
-										pos: position{line: 735, col: 9, offset: 25565},
+										pos: position{line: 749, col: 9, offset: 26163},
 										run: (*parser).callonInlineElements12,
 										expr: &seqExpr{
-											pos: position{line: 735, col: 9, offset: 25565},
+											pos: position{line: 749, col: 9, offset: 26163},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 735, col: 9, offset: 25565},
+													pos: position{line: 749, col: 9, offset: 26163},
 													expr: &ruleRefExpr{
-														pos:  position{line: 735, col: 10, offset: 25566},
+														pos:  position{line: 749, col: 10, offset: 26164},
 														name: "BlockDelimiter",
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 735, col: 25, offset: 25581},
+													pos:   position{line: 749, col: 25, offset: 26179},
 													label: "elements",
 													expr: &oneOrMoreExpr{
-														pos: position{line: 735, col: 34, offset: 25590},
+														pos: position{line: 749, col: 34, offset: 26188},
 														expr: &ruleRefExpr{
-															pos:  position{line: 735, col: 35, offset: 25591},
+															pos:  position{line: 749, col: 35, offset: 26189},
 															name: "InlineElement",
 														},
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 735, col: 51, offset: 25607},
+													pos:   position{line: 749, col: 51, offset: 26205},
 													label: "linebreak",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 735, col: 61, offset: 25617},
+														pos: position{line: 749, col: 61, offset: 26215},
 														expr: &ruleRefExpr{
-															pos:  position{line: 735, col: 62, offset: 25618},
+															pos:  position{line: 749, col: 62, offset: 26216},
 															name: "LineBreak",
 														},
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 735, col: 74, offset: 25630},
+													pos:  position{line: 749, col: 74, offset: 26228},
 													name: "EOL",
 												},
 											},
@@ -5271,87 +5448,95 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElement",
-			pos:  position{line: 741, col: 1, offset: 25766},
+			pos:  position{line: 755, col: 1, offset: 26364},
 			expr: &actionExpr{
-				pos: position{line: 741, col: 18, offset: 25783},
+				pos: position{line: 755, col: 18, offset: 26381},
 				run: (*parser).callonInlineElement1,
 				expr: &seqExpr{
-					pos: position{line: 741, col: 18, offset: 25783},
+					pos: position{line: 755, col: 18, offset: 26381},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 741, col: 18, offset: 25783},
+							pos: position{line: 755, col: 18, offset: 26381},
 							expr: &ruleRefExpr{
-								pos:  position{line: 741, col: 19, offset: 25784},
+								pos:  position{line: 755, col: 19, offset: 26382},
 								name: "EOL",
 							},
 						},
 						&notExpr{
-							pos: position{line: 741, col: 23, offset: 25788},
+							pos: position{line: 755, col: 23, offset: 26386},
 							expr: &ruleRefExpr{
-								pos:  position{line: 741, col: 24, offset: 25789},
+								pos:  position{line: 755, col: 24, offset: 26387},
 								name: "LineBreak",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 742, col: 5, offset: 25804},
+							pos:   position{line: 756, col: 5, offset: 26402},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 742, col: 14, offset: 25813},
+								pos: position{line: 756, col: 14, offset: 26411},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 742, col: 14, offset: 25813},
+										pos:  position{line: 756, col: 14, offset: 26411},
 										name: "SimpleWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 743, col: 11, offset: 25834},
+										pos:  position{line: 757, col: 11, offset: 26432},
 										name: "Spaces",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 744, col: 11, offset: 25852},
+										pos:  position{line: 758, col: 11, offset: 26450},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 745, col: 11, offset: 25875},
+										pos:  position{line: 759, col: 11, offset: 26473},
 										name: "Link",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 746, col: 11, offset: 25891},
+										pos:  position{line: 760, col: 11, offset: 26489},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 747, col: 11, offset: 25914},
+										pos:  position{line: 761, col: 11, offset: 26512},
+										name: "InlineStem",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 762, col: 11, offset: 26533},
+										name: "InlineIcon",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 763, col: 11, offset: 26554},
 										name: "InlineFootnote",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 748, col: 11, offset: 25940},
+										pos:  position{line: 764, col: 11, offset: 26580},
 										name: "QuotedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 749, col: 11, offset: 25962},
+										pos:  position{line: 765, col: 11, offset: 26602},
 										name: "CrossReference",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 750, col: 11, offset: 25988},
+										pos:  position{line: 766, col: 11, offset: 26628},
 										name: "InlineUserMacro",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 751, col: 11, offset: 26015},
+										pos:  position{line: 767, col: 11, offset: 26655},
 										name: "DocumentAttributeSubstitution",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 752, col: 11, offset: 26056},
+										pos:  position{line: 768, col: 11, offset: 26696},
 										name: "InlineElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 753, col: 11, offset: 26083},
+										pos:  position{line: 769, col: 11, offset: 26723},
 										name: "OtherWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 754, col: 11, offset: 26103},
+										pos:  position{line: 770, col: 11, offset: 26743},
 										name: "ConceleadIndexTerm",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 755, col: 11, offset: 26132},
+										pos:  position{line: 771, col: 11, offset: 26772},
 										name: "Parenthesis",
 									},
 								},
@@ -5363,51 +5548,51 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElementsWithoutSubtitution",
-			pos:  position{line: 763, col: 1, offset: 26395},
+			pos:  position{line: 779, col: 1, offset: 27035},
 			expr: &actionExpr{
-				pos: position{line: 763, col: 37, offset: 26431},
+				pos: position{line: 779, col: 37, offset: 27071},
 				run: (*parser).callonInlineElementsWithoutSubtitution1,
 				expr: &seqExpr{
-					pos: position{line: 763, col: 37, offset: 26431},
+					pos: position{line: 779, col: 37, offset: 27071},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 763, col: 37, offset: 26431},
+							pos: position{line: 779, col: 37, offset: 27071},
 							expr: &ruleRefExpr{
-								pos:  position{line: 763, col: 38, offset: 26432},
+								pos:  position{line: 779, col: 38, offset: 27072},
 								name: "BlankLine",
 							},
 						},
 						&notExpr{
-							pos: position{line: 763, col: 48, offset: 26442},
+							pos: position{line: 779, col: 48, offset: 27082},
 							expr: &ruleRefExpr{
-								pos:  position{line: 763, col: 49, offset: 26443},
+								pos:  position{line: 779, col: 49, offset: 27083},
 								name: "BlockDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 763, col: 64, offset: 26458},
+							pos:   position{line: 779, col: 64, offset: 27098},
 							label: "elements",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 763, col: 73, offset: 26467},
+								pos: position{line: 779, col: 73, offset: 27107},
 								expr: &ruleRefExpr{
-									pos:  position{line: 763, col: 74, offset: 26468},
+									pos:  position{line: 779, col: 74, offset: 27108},
 									name: "InlineElementWithoutSubtitution",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 763, col: 108, offset: 26502},
+							pos:   position{line: 779, col: 108, offset: 27142},
 							label: "linebreak",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 763, col: 118, offset: 26512},
+								pos: position{line: 779, col: 118, offset: 27152},
 								expr: &ruleRefExpr{
-									pos:  position{line: 763, col: 119, offset: 26513},
+									pos:  position{line: 779, col: 119, offset: 27153},
 									name: "LineBreak",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 763, col: 131, offset: 26525},
+							pos:  position{line: 779, col: 131, offset: 27165},
 							name: "EOL",
 						},
 					},
@@ -5416,71 +5601,71 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElementWithoutSubtitution",
-			pos:  position{line: 767, col: 1, offset: 26616},
+			pos:  position{line: 783, col: 1, offset: 27256},
 			expr: &actionExpr{
-				pos: position{line: 767, col: 36, offset: 26651},
+				pos: position{line: 783, col: 36, offset: 27291},
 				run: (*parser).callonInlineElementWithoutSubtitution1,
 				expr: &seqExpr{
-					pos: position{line: 767, col: 36, offset: 26651},
+					pos: position{line: 783, col: 36, offset: 27291},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 767, col: 36, offset: 26651},
+							pos: position{line: 783, col: 36, offset: 27291},
 							expr: &ruleRefExpr{
-								pos:  position{line: 767, col: 37, offset: 26652},
+								pos:  position{line: 783, col: 37, offset: 27292},
 								name: "EOL",
 							},
 						},
 						&notExpr{
-							pos: position{line: 767, col: 41, offset: 26656},
+							pos: position{line: 783, col: 41, offset: 27296},
 							expr: &ruleRefExpr{
-								pos:  position{line: 767, col: 42, offset: 26657},
+								pos:  position{line: 783, col: 42, offset: 27297},
 								name: "LineBreak",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 768, col: 5, offset: 26672},
+							pos:   position{line: 784, col: 5, offset: 27312},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 768, col: 14, offset: 26681},
+								pos: position{line: 784, col: 14, offset: 27321},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 768, col: 14, offset: 26681},
+										pos:  position{line: 784, col: 14, offset: 27321},
 										name: "SimpleWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 769, col: 11, offset: 26702},
+										pos:  position{line: 785, col: 11, offset: 27342},
 										name: "Spaces",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 770, col: 11, offset: 26720},
+										pos:  position{line: 786, col: 11, offset: 27360},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 771, col: 11, offset: 26743},
+										pos:  position{line: 787, col: 11, offset: 27383},
 										name: "Link",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 772, col: 11, offset: 26759},
+										pos:  position{line: 788, col: 11, offset: 27399},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 773, col: 11, offset: 26782},
+										pos:  position{line: 789, col: 11, offset: 27422},
 										name: "QuotedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 774, col: 11, offset: 26804},
+										pos:  position{line: 790, col: 11, offset: 27444},
 										name: "CrossReference",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 775, col: 11, offset: 26830},
+										pos:  position{line: 791, col: 11, offset: 27470},
 										name: "InlineElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 776, col: 11, offset: 26856},
+										pos:  position{line: 792, col: 11, offset: 27496},
 										name: "OtherWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 777, col: 11, offset: 26876},
+										pos:  position{line: 793, col: 11, offset: 27516},
 										name: "Parenthesis",
 									},
 								},
@@ -5492,47 +5677,47 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimParagraph",
-			pos:  position{line: 781, col: 1, offset: 26918},
+			pos:  position{line: 797, col: 1, offset: 27558},
 			expr: &actionExpr{
-				pos: position{line: 781, col: 22, offset: 26939},
+				pos: position{line: 797, col: 22, offset: 27579},
 				run: (*parser).callonVerbatimParagraph1,
 				expr: &seqExpr{
-					pos: position{line: 781, col: 22, offset: 26939},
+					pos: position{line: 797, col: 22, offset: 27579},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 781, col: 22, offset: 26939},
+							pos:   position{line: 797, col: 22, offset: 27579},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 781, col: 33, offset: 26950},
+								pos: position{line: 797, col: 33, offset: 27590},
 								expr: &ruleRefExpr{
-									pos:  position{line: 781, col: 34, offset: 26951},
+									pos:  position{line: 797, col: 34, offset: 27591},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 781, col: 54, offset: 26971},
+							pos:   position{line: 797, col: 54, offset: 27611},
 							label: "lines",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 781, col: 60, offset: 26977},
+								pos: position{line: 797, col: 60, offset: 27617},
 								expr: &actionExpr{
-									pos: position{line: 781, col: 61, offset: 26978},
+									pos: position{line: 797, col: 61, offset: 27618},
 									run: (*parser).callonVerbatimParagraph8,
 									expr: &seqExpr{
-										pos: position{line: 781, col: 61, offset: 26978},
+										pos: position{line: 797, col: 61, offset: 27618},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 781, col: 61, offset: 26978},
+												pos: position{line: 797, col: 61, offset: 27618},
 												expr: &ruleRefExpr{
-													pos:  position{line: 781, col: 62, offset: 26979},
+													pos:  position{line: 797, col: 62, offset: 27619},
 													name: "EOF",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 781, col: 66, offset: 26983},
+												pos:   position{line: 797, col: 66, offset: 27623},
 												label: "line",
 												expr: &ruleRefExpr{
-													pos:  position{line: 781, col: 72, offset: 26989},
+													pos:  position{line: 797, col: 72, offset: 27629},
 													name: "VerbatimParagraphLine",
 												},
 											},
@@ -5547,51 +5732,51 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimParagraphLine",
-			pos:  position{line: 787, col: 1, offset: 27109},
+			pos:  position{line: 803, col: 1, offset: 27749},
 			expr: &actionExpr{
-				pos: position{line: 787, col: 26, offset: 27134},
+				pos: position{line: 803, col: 26, offset: 27774},
 				run: (*parser).callonVerbatimParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 787, col: 26, offset: 27134},
+					pos: position{line: 803, col: 26, offset: 27774},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 787, col: 26, offset: 27134},
+							pos: position{line: 803, col: 26, offset: 27774},
 							expr: &ruleRefExpr{
-								pos:  position{line: 787, col: 27, offset: 27135},
+								pos:  position{line: 803, col: 27, offset: 27775},
 								name: "BlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 787, col: 42, offset: 27150},
+							pos: position{line: 803, col: 42, offset: 27790},
 							expr: &ruleRefExpr{
-								pos:  position{line: 787, col: 43, offset: 27151},
+								pos:  position{line: 803, col: 43, offset: 27791},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 787, col: 53, offset: 27161},
+							pos:   position{line: 803, col: 53, offset: 27801},
 							label: "elements",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 787, col: 62, offset: 27170},
+								pos: position{line: 803, col: 62, offset: 27810},
 								expr: &ruleRefExpr{
-									pos:  position{line: 787, col: 63, offset: 27171},
+									pos:  position{line: 803, col: 63, offset: 27811},
 									name: "VerbatimParagraphLineElement",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 787, col: 94, offset: 27202},
+							pos:   position{line: 803, col: 94, offset: 27842},
 							label: "linebreak",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 787, col: 104, offset: 27212},
+								pos: position{line: 803, col: 104, offset: 27852},
 								expr: &ruleRefExpr{
-									pos:  position{line: 787, col: 105, offset: 27213},
+									pos:  position{line: 803, col: 105, offset: 27853},
 									name: "LineBreak",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 787, col: 117, offset: 27225},
+							pos:  position{line: 803, col: 117, offset: 27865},
 							name: "EOL",
 						},
 					},
@@ -5600,31 +5785,31 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimParagraphLineElement",
-			pos:  position{line: 791, col: 1, offset: 27316},
+			pos:  position{line: 807, col: 1, offset: 27956},
 			expr: &actionExpr{
-				pos: position{line: 791, col: 33, offset: 27348},
+				pos: position{line: 807, col: 33, offset: 27988},
 				run: (*parser).callonVerbatimParagraphLineElement1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 791, col: 33, offset: 27348},
+					pos: position{line: 807, col: 33, offset: 27988},
 					expr: &seqExpr{
-						pos: position{line: 791, col: 34, offset: 27349},
+						pos: position{line: 807, col: 34, offset: 27989},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 791, col: 34, offset: 27349},
+								pos: position{line: 807, col: 34, offset: 27989},
 								expr: &ruleRefExpr{
-									pos:  position{line: 791, col: 35, offset: 27350},
+									pos:  position{line: 807, col: 35, offset: 27990},
 									name: "EOL",
 								},
 							},
 							&notExpr{
-								pos: position{line: 791, col: 39, offset: 27354},
+								pos: position{line: 807, col: 39, offset: 27994},
 								expr: &ruleRefExpr{
-									pos:  position{line: 791, col: 40, offset: 27355},
+									pos:  position{line: 807, col: 40, offset: 27995},
 									name: "LineBreak",
 								},
 							},
 							&anyMatcher{
-								line: 791, col: 50, offset: 27365,
+								line: 807, col: 50, offset: 28005,
 							},
 						},
 					},
@@ -5633,33 +5818,33 @@ var g = &grammar{
 		},
 		{
 			name: "LineBreak",
-			pos:  position{line: 798, col: 1, offset: 27589},
+			pos:  position{line: 814, col: 1, offset: 28229},
 			expr: &actionExpr{
-				pos: position{line: 798, col: 14, offset: 27602},
+				pos: position{line: 814, col: 14, offset: 28242},
 				run: (*parser).callonLineBreak1,
 				expr: &seqExpr{
-					pos: position{line: 798, col: 14, offset: 27602},
+					pos: position{line: 814, col: 14, offset: 28242},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 798, col: 14, offset: 27602},
+							pos:  position{line: 814, col: 14, offset: 28242},
 							name: "WS",
 						},
 						&litMatcher{
-							pos:        position{line: 798, col: 17, offset: 27605},
+							pos:        position{line: 814, col: 17, offset: 28245},
 							val:        "+",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 798, col: 21, offset: 27609},
+							pos: position{line: 814, col: 21, offset: 28249},
 							expr: &ruleRefExpr{
-								pos:  position{line: 798, col: 21, offset: 27609},
+								pos:  position{line: 814, col: 21, offset: 28249},
 								name: "WS",
 							},
 						},
 						&andExpr{
-							pos: position{line: 798, col: 25, offset: 27613},
+							pos: position{line: 814, col: 25, offset: 28253},
 							expr: &ruleRefExpr{
-								pos:  position{line: 798, col: 26, offset: 27614},
+								pos:  position{line: 814, col: 26, offset: 28254},
 								name: "EOL",
 							},
 						},
@@ -5669,68 +5854,68 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedText",
-			pos:  position{line: 805, col: 1, offset: 27898},
+			pos:  position{line: 821, col: 1, offset: 28538},
 			expr: &actionExpr{
-				pos: position{line: 805, col: 15, offset: 27912},
+				pos: position{line: 821, col: 15, offset: 28552},
 				run: (*parser).callonQuotedText1,
 				expr: &seqExpr{
-					pos: position{line: 805, col: 15, offset: 27912},
+					pos: position{line: 821, col: 15, offset: 28552},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 805, col: 15, offset: 27912},
+							pos: position{line: 821, col: 15, offset: 28552},
 							expr: &ruleRefExpr{
-								pos:  position{line: 805, col: 16, offset: 27913},
+								pos:  position{line: 821, col: 16, offset: 28553},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 805, col: 19, offset: 27916},
+							pos:   position{line: 821, col: 19, offset: 28556},
 							label: "text",
 							expr: &choiceExpr{
-								pos: position{line: 805, col: 25, offset: 27922},
+								pos: position{line: 821, col: 25, offset: 28562},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 805, col: 25, offset: 27922},
+										pos:  position{line: 821, col: 25, offset: 28562},
 										name: "BoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 806, col: 15, offset: 27946},
+										pos:  position{line: 822, col: 15, offset: 28586},
 										name: "ItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 807, col: 15, offset: 27972},
+										pos:  position{line: 823, col: 15, offset: 28612},
 										name: "MonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 808, col: 15, offset: 28001},
+										pos:  position{line: 824, col: 15, offset: 28641},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 809, col: 15, offset: 28030},
+										pos:  position{line: 825, col: 15, offset: 28670},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 810, col: 15, offset: 28061},
+										pos:  position{line: 826, col: 15, offset: 28701},
 										name: "EscapedBoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 811, col: 15, offset: 28092},
+										pos:  position{line: 827, col: 15, offset: 28732},
 										name: "EscapedItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 812, col: 15, offset: 28125},
+										pos:  position{line: 828, col: 15, offset: 28765},
 										name: "EscapedMonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 813, col: 15, offset: 28161},
+										pos:  position{line: 829, col: 15, offset: 28801},
 										name: "EscapedSubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 814, col: 15, offset: 28197},
+										pos:  position{line: 830, col: 15, offset: 28837},
 										name: "EscapedSuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 815, col: 15, offset: 28234},
+										pos:  position{line: 831, col: 15, offset: 28874},
 										name: "SubscriptOrSuperscriptPrefix",
 									},
 								},
@@ -5742,47 +5927,47 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedTextPrefix",
-			pos:  position{line: 819, col: 1, offset: 28388},
+			pos:  position{line: 835, col: 1, offset: 29028},
 			expr: &choiceExpr{
-				pos: position{line: 819, col: 21, offset: 28408},
+				pos: position{line: 835, col: 21, offset: 29048},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 819, col: 21, offset: 28408},
+						pos:        position{line: 835, col: 21, offset: 29048},
 						val:        "**",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 819, col: 28, offset: 28415},
+						pos:        position{line: 835, col: 28, offset: 29055},
 						val:        "*",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 819, col: 34, offset: 28421},
+						pos:        position{line: 835, col: 34, offset: 29061},
 						val:        "__",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 819, col: 41, offset: 28428},
+						pos:        position{line: 835, col: 41, offset: 29068},
 						val:        "_",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 819, col: 47, offset: 28434},
+						pos:        position{line: 835, col: 47, offset: 29074},
 						val:        "``",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 819, col: 54, offset: 28441},
+						pos:        position{line: 835, col: 54, offset: 29081},
 						val:        "`",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 819, col: 60, offset: 28447},
+						pos:        position{line: 835, col: 60, offset: 29087},
 						val:        "^",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 819, col: 66, offset: 28453},
+						pos:        position{line: 835, col: 66, offset: 29093},
 						val:        "~",
 						ignoreCase: false,
 					},
@@ -5791,20 +5976,20 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptOrSuperscriptPrefix",
-			pos:  position{line: 821, col: 1, offset: 28458},
+			pos:  position{line: 837, col: 1, offset: 29098},
 			expr: &choiceExpr{
-				pos: position{line: 821, col: 33, offset: 28490},
+				pos: position{line: 837, col: 33, offset: 29130},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 821, col: 33, offset: 28490},
+						pos:        position{line: 837, col: 33, offset: 29130},
 						val:        "^",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 821, col: 39, offset: 28496},
+						pos: position{line: 837, col: 39, offset: 29136},
 						run: (*parser).callonSubscriptOrSuperscriptPrefix3,
 						expr: &litMatcher{
-							pos:        position{line: 821, col: 39, offset: 28496},
+							pos:        position{line: 837, col: 39, offset: 29136},
 							val:        "~",
 							ignoreCase: false,
 						},
@@ -5814,14 +5999,14 @@ var g = &grammar{
 		},
 		{
 			name: "OneOrMoreBackslashes",
-			pos:  position{line: 825, col: 1, offset: 28629},
+			pos:  position{line: 841, col: 1, offset: 29269},
 			expr: &actionExpr{
-				pos: position{line: 825, col: 25, offset: 28653},
+				pos: position{line: 841, col: 25, offset: 29293},
 				run: (*parser).callonOneOrMoreBackslashes1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 825, col: 25, offset: 28653},
+					pos: position{line: 841, col: 25, offset: 29293},
 					expr: &litMatcher{
-						pos:        position{line: 825, col: 25, offset: 28653},
+						pos:        position{line: 841, col: 25, offset: 29293},
 						val:        "\\",
 						ignoreCase: false,
 					},
@@ -5830,22 +6015,22 @@ var g = &grammar{
 		},
 		{
 			name: "TwoOrMoreBackslashes",
-			pos:  position{line: 829, col: 1, offset: 28694},
+			pos:  position{line: 845, col: 1, offset: 29334},
 			expr: &actionExpr{
-				pos: position{line: 829, col: 25, offset: 28718},
+				pos: position{line: 845, col: 25, offset: 29358},
 				run: (*parser).callonTwoOrMoreBackslashes1,
 				expr: &seqExpr{
-					pos: position{line: 829, col: 25, offset: 28718},
+					pos: position{line: 845, col: 25, offset: 29358},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 829, col: 25, offset: 28718},
+							pos:        position{line: 845, col: 25, offset: 29358},
 							val:        "\\\\",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 829, col: 30, offset: 28723},
+							pos: position{line: 845, col: 30, offset: 29363},
 							expr: &litMatcher{
-								pos:        position{line: 829, col: 30, offset: 28723},
+								pos:        position{line: 845, col: 30, offset: 29363},
 								val:        "\\",
 								ignoreCase: false,
 							},
@@ -5856,16 +6041,16 @@ var g = &grammar{
 		},
 		{
 			name: "BoldText",
-			pos:  position{line: 837, col: 1, offset: 28820},
+			pos:  position{line: 853, col: 1, offset: 29460},
 			expr: &choiceExpr{
-				pos: position{line: 837, col: 13, offset: 28832},
+				pos: position{line: 853, col: 13, offset: 29472},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 837, col: 13, offset: 28832},
+						pos:  position{line: 853, col: 13, offset: 29472},
 						name: "DoubleQuoteBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 837, col: 35, offset: 28854},
+						pos:  position{line: 853, col: 35, offset: 29494},
 						name: "SingleQuoteBoldText",
 					},
 				},
@@ -5873,36 +6058,36 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldText",
-			pos:  position{line: 839, col: 1, offset: 28875},
+			pos:  position{line: 855, col: 1, offset: 29515},
 			expr: &actionExpr{
-				pos: position{line: 839, col: 24, offset: 28898},
+				pos: position{line: 855, col: 24, offset: 29538},
 				run: (*parser).callonDoubleQuoteBoldText1,
 				expr: &seqExpr{
-					pos: position{line: 839, col: 24, offset: 28898},
+					pos: position{line: 855, col: 24, offset: 29538},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 839, col: 24, offset: 28898},
+							pos: position{line: 855, col: 24, offset: 29538},
 							expr: &litMatcher{
-								pos:        position{line: 839, col: 25, offset: 28899},
+								pos:        position{line: 855, col: 25, offset: 29539},
 								val:        "\\\\",
 								ignoreCase: false,
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 839, col: 30, offset: 28904},
+							pos:        position{line: 855, col: 30, offset: 29544},
 							val:        "**",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 839, col: 35, offset: 28909},
+							pos:   position{line: 855, col: 35, offset: 29549},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 839, col: 44, offset: 28918},
+								pos:  position{line: 855, col: 44, offset: 29558},
 								name: "DoubleQuoteBoldTextContent",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 839, col: 72, offset: 28946},
+							pos:        position{line: 855, col: 72, offset: 29586},
 							val:        "**",
 							ignoreCase: false,
 						},
@@ -5912,42 +6097,42 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextContent",
-			pos:  position{line: 843, col: 1, offset: 29071},
+			pos:  position{line: 859, col: 1, offset: 29711},
 			expr: &seqExpr{
-				pos: position{line: 843, col: 31, offset: 29101},
+				pos: position{line: 859, col: 31, offset: 29741},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 843, col: 31, offset: 29101},
+						pos:  position{line: 859, col: 31, offset: 29741},
 						name: "DoubleQuoteBoldTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 843, col: 58, offset: 29128},
+						pos: position{line: 859, col: 58, offset: 29768},
 						expr: &actionExpr{
-							pos: position{line: 843, col: 59, offset: 29129},
+							pos: position{line: 859, col: 59, offset: 29769},
 							run: (*parser).callonDoubleQuoteBoldTextContent4,
 							expr: &seqExpr{
-								pos: position{line: 843, col: 59, offset: 29129},
+								pos: position{line: 859, col: 59, offset: 29769},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 843, col: 59, offset: 29129},
+										pos: position{line: 859, col: 59, offset: 29769},
 										expr: &litMatcher{
-											pos:        position{line: 843, col: 61, offset: 29131},
+											pos:        position{line: 859, col: 61, offset: 29771},
 											val:        "**",
 											ignoreCase: false,
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 843, col: 67, offset: 29137},
+										pos:   position{line: 859, col: 67, offset: 29777},
 										label: "element",
 										expr: &choiceExpr{
-											pos: position{line: 843, col: 76, offset: 29146},
+											pos: position{line: 859, col: 76, offset: 29786},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 843, col: 76, offset: 29146},
+													pos:  position{line: 859, col: 76, offset: 29786},
 													name: "WS",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 843, col: 81, offset: 29151},
+													pos:  position{line: 859, col: 81, offset: 29791},
 													name: "DoubleQuoteBoldTextElement",
 												},
 											},
@@ -5962,68 +6147,68 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextElement",
-			pos:  position{line: 847, col: 1, offset: 29243},
+			pos:  position{line: 863, col: 1, offset: 29883},
 			expr: &actionExpr{
-				pos: position{line: 847, col: 31, offset: 29273},
+				pos: position{line: 863, col: 31, offset: 29913},
 				run: (*parser).callonDoubleQuoteBoldTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 847, col: 31, offset: 29273},
+					pos: position{line: 863, col: 31, offset: 29913},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 847, col: 31, offset: 29273},
+							pos: position{line: 863, col: 31, offset: 29913},
 							expr: &ruleRefExpr{
-								pos:  position{line: 847, col: 32, offset: 29274},
+								pos:  position{line: 863, col: 32, offset: 29914},
 								name: "NEWLINE",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 847, col: 40, offset: 29282},
+							pos:   position{line: 863, col: 40, offset: 29922},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 847, col: 49, offset: 29291},
+								pos: position{line: 863, col: 49, offset: 29931},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 847, col: 49, offset: 29291},
+										pos:  position{line: 863, col: 49, offset: 29931},
 										name: "SingleQuoteBoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 848, col: 11, offset: 29322},
+										pos:  position{line: 864, col: 11, offset: 29962},
 										name: "ItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 849, col: 11, offset: 29344},
+										pos:  position{line: 865, col: 11, offset: 29984},
 										name: "MonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 850, col: 11, offset: 29368},
+										pos:  position{line: 866, col: 11, offset: 30008},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 851, col: 11, offset: 29392},
+										pos:  position{line: 867, col: 11, offset: 30032},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 852, col: 11, offset: 29418},
+										pos:  position{line: 868, col: 11, offset: 30058},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 853, col: 11, offset: 29441},
+										pos:  position{line: 869, col: 11, offset: 30081},
 										name: "QuotedLink",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 854, col: 11, offset: 29463},
+										pos:  position{line: 870, col: 11, offset: 30103},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 855, col: 11, offset: 29486},
+										pos:  position{line: 871, col: 11, offset: 30126},
 										name: "DocumentAttributeSubstitution",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 856, col: 11, offset: 29526},
+										pos:  position{line: 872, col: 11, offset: 30166},
 										name: "NonDoubleQuoteBoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 857, col: 11, offset: 29559},
+										pos:  position{line: 873, col: 11, offset: 30199},
 										name: "Parenthesis",
 									},
 								},
@@ -6035,68 +6220,68 @@ var g = &grammar{
 		},
 		{
 			name: "NonDoubleQuoteBoldText",
-			pos:  position{line: 861, col: 1, offset: 29704},
+			pos:  position{line: 877, col: 1, offset: 30344},
 			expr: &actionExpr{
-				pos: position{line: 861, col: 27, offset: 29730},
+				pos: position{line: 877, col: 27, offset: 30370},
 				run: (*parser).callonNonDoubleQuoteBoldText1,
 				expr: &seqExpr{
-					pos: position{line: 861, col: 27, offset: 29730},
+					pos: position{line: 877, col: 27, offset: 30370},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 861, col: 28, offset: 29731,
+							line: 877, col: 28, offset: 30371,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 861, col: 31, offset: 29734},
+							pos: position{line: 877, col: 31, offset: 30374},
 							expr: &seqExpr{
-								pos: position{line: 861, col: 32, offset: 29735},
+								pos: position{line: 877, col: 32, offset: 30375},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 861, col: 32, offset: 29735},
+										pos: position{line: 877, col: 32, offset: 30375},
 										expr: &litMatcher{
-											pos:        position{line: 861, col: 33, offset: 29736},
+											pos:        position{line: 877, col: 33, offset: 30376},
 											val:        "**",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 861, col: 38, offset: 29741},
+										pos: position{line: 877, col: 38, offset: 30381},
 										expr: &ruleRefExpr{
-											pos:  position{line: 861, col: 39, offset: 29742},
+											pos:  position{line: 877, col: 39, offset: 30382},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 861, col: 42, offset: 29745},
+										pos: position{line: 877, col: 42, offset: 30385},
 										expr: &litMatcher{
-											pos:        position{line: 861, col: 43, offset: 29746},
+											pos:        position{line: 877, col: 43, offset: 30386},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 861, col: 47, offset: 29750},
+										pos: position{line: 877, col: 47, offset: 30390},
 										expr: &litMatcher{
-											pos:        position{line: 861, col: 48, offset: 29751},
+											pos:        position{line: 877, col: 48, offset: 30391},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 861, col: 52, offset: 29755},
+										pos: position{line: 877, col: 52, offset: 30395},
 										expr: &ruleRefExpr{
-											pos:  position{line: 861, col: 53, offset: 29756},
+											pos:  position{line: 877, col: 53, offset: 30396},
 											name: "NEWLINE",
 										},
 									},
 									&notExpr{
-										pos: position{line: 861, col: 61, offset: 29764},
+										pos: position{line: 877, col: 61, offset: 30404},
 										expr: &ruleRefExpr{
-											pos:  position{line: 861, col: 62, offset: 29765},
+											pos:  position{line: 877, col: 62, offset: 30405},
 											name: "Parenthesis",
 										},
 									},
 									&anyMatcher{
-										line: 861, col: 74, offset: 29777,
+										line: 877, col: 74, offset: 30417,
 									},
 								},
 							},
@@ -6107,47 +6292,47 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldText",
-			pos:  position{line: 865, col: 1, offset: 29837},
+			pos:  position{line: 881, col: 1, offset: 30477},
 			expr: &choiceExpr{
-				pos: position{line: 865, col: 24, offset: 29860},
+				pos: position{line: 881, col: 24, offset: 30500},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 865, col: 24, offset: 29860},
+						pos: position{line: 881, col: 24, offset: 30500},
 						run: (*parser).callonSingleQuoteBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 865, col: 24, offset: 29860},
+							pos: position{line: 881, col: 24, offset: 30500},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 865, col: 24, offset: 29860},
+									pos: position{line: 881, col: 24, offset: 30500},
 									expr: &litMatcher{
-										pos:        position{line: 865, col: 25, offset: 29861},
+										pos:        position{line: 881, col: 25, offset: 30501},
 										val:        "\\",
 										ignoreCase: false,
 									},
 								},
 								&notExpr{
-									pos: position{line: 865, col: 29, offset: 29865},
+									pos: position{line: 881, col: 29, offset: 30505},
 									expr: &litMatcher{
-										pos:        position{line: 865, col: 30, offset: 29866},
+										pos:        position{line: 881, col: 30, offset: 30506},
 										val:        "**",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 865, col: 35, offset: 29871},
+									pos:        position{line: 881, col: 35, offset: 30511},
 									val:        "*",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 865, col: 39, offset: 29875},
+									pos:   position{line: 881, col: 39, offset: 30515},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 865, col: 48, offset: 29884},
+										pos:  position{line: 881, col: 48, offset: 30524},
 										name: "SingleQuoteBoldTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 865, col: 76, offset: 29912},
+									pos:        position{line: 881, col: 76, offset: 30552},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -6155,34 +6340,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 867, col: 5, offset: 30092},
+						pos: position{line: 883, col: 5, offset: 30732},
 						run: (*parser).callonSingleQuoteBoldText12,
 						expr: &seqExpr{
-							pos: position{line: 867, col: 5, offset: 30092},
+							pos: position{line: 883, col: 5, offset: 30732},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 867, col: 5, offset: 30092},
+									pos: position{line: 883, col: 5, offset: 30732},
 									expr: &litMatcher{
-										pos:        position{line: 867, col: 6, offset: 30093},
+										pos:        position{line: 883, col: 6, offset: 30733},
 										val:        "\\\\",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 867, col: 11, offset: 30098},
+									pos:        position{line: 883, col: 11, offset: 30738},
 									val:        "**",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 867, col: 16, offset: 30103},
+									pos:   position{line: 883, col: 16, offset: 30743},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 867, col: 25, offset: 30112},
+										pos:  position{line: 883, col: 25, offset: 30752},
 										name: "SingleQuoteBoldTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 867, col: 53, offset: 30140},
+									pos:        position{line: 883, col: 53, offset: 30780},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -6194,43 +6379,43 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextContent",
-			pos:  position{line: 871, col: 1, offset: 30398},
+			pos:  position{line: 887, col: 1, offset: 31038},
 			expr: &seqExpr{
-				pos: position{line: 871, col: 31, offset: 30428},
+				pos: position{line: 887, col: 31, offset: 31068},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 871, col: 31, offset: 30428},
+						pos: position{line: 887, col: 31, offset: 31068},
 						expr: &ruleRefExpr{
-							pos:  position{line: 871, col: 32, offset: 30429},
+							pos:  position{line: 887, col: 32, offset: 31069},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 871, col: 35, offset: 30432},
+						pos:  position{line: 887, col: 35, offset: 31072},
 						name: "SingleQuoteBoldTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 871, col: 62, offset: 30459},
+						pos: position{line: 887, col: 62, offset: 31099},
 						expr: &actionExpr{
-							pos: position{line: 871, col: 63, offset: 30460},
+							pos: position{line: 887, col: 63, offset: 31100},
 							run: (*parser).callonSingleQuoteBoldTextContent6,
 							expr: &seqExpr{
-								pos: position{line: 871, col: 63, offset: 30460},
+								pos: position{line: 887, col: 63, offset: 31100},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 871, col: 63, offset: 30460},
+										pos: position{line: 887, col: 63, offset: 31100},
 										expr: &seqExpr{
-											pos: position{line: 871, col: 65, offset: 30462},
+											pos: position{line: 887, col: 65, offset: 31102},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 871, col: 65, offset: 30462},
+													pos:        position{line: 887, col: 65, offset: 31102},
 													val:        "*",
 													ignoreCase: false,
 												},
 												&notExpr{
-													pos: position{line: 871, col: 69, offset: 30466},
+													pos: position{line: 887, col: 69, offset: 31106},
 													expr: &ruleRefExpr{
-														pos:  position{line: 871, col: 70, offset: 30467},
+														pos:  position{line: 887, col: 70, offset: 31107},
 														name: "Alphanum",
 													},
 												},
@@ -6238,21 +6423,21 @@ var g = &grammar{
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 871, col: 80, offset: 30477},
+										pos:   position{line: 887, col: 80, offset: 31117},
 										label: "spaces",
 										expr: &zeroOrMoreExpr{
-											pos: position{line: 871, col: 88, offset: 30485},
+											pos: position{line: 887, col: 88, offset: 31125},
 											expr: &ruleRefExpr{
-												pos:  position{line: 871, col: 88, offset: 30485},
+												pos:  position{line: 887, col: 88, offset: 31125},
 												name: "WS",
 											},
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 871, col: 93, offset: 30490},
+										pos:   position{line: 887, col: 93, offset: 31130},
 										label: "element",
 										expr: &ruleRefExpr{
-											pos:  position{line: 871, col: 102, offset: 30499},
+											pos:  position{line: 887, col: 102, offset: 31139},
 											name: "SingleQuoteBoldTextElement",
 										},
 									},
@@ -6265,68 +6450,68 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextElement",
-			pos:  position{line: 875, col: 1, offset: 30590},
+			pos:  position{line: 891, col: 1, offset: 31230},
 			expr: &actionExpr{
-				pos: position{line: 875, col: 31, offset: 30620},
+				pos: position{line: 891, col: 31, offset: 31260},
 				run: (*parser).callonSingleQuoteBoldTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 875, col: 31, offset: 30620},
+					pos: position{line: 891, col: 31, offset: 31260},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 875, col: 31, offset: 30620},
+							pos: position{line: 891, col: 31, offset: 31260},
 							expr: &ruleRefExpr{
-								pos:  position{line: 875, col: 32, offset: 30621},
+								pos:  position{line: 891, col: 32, offset: 31261},
 								name: "NEWLINE",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 875, col: 40, offset: 30629},
+							pos:   position{line: 891, col: 40, offset: 31269},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 875, col: 49, offset: 30638},
+								pos: position{line: 891, col: 49, offset: 31278},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 875, col: 49, offset: 30638},
+										pos:  position{line: 891, col: 49, offset: 31278},
 										name: "DoubleQuoteBoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 876, col: 11, offset: 30668},
+										pos:  position{line: 892, col: 11, offset: 31308},
 										name: "ItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 877, col: 11, offset: 30690},
+										pos:  position{line: 893, col: 11, offset: 31330},
 										name: "MonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 878, col: 11, offset: 30714},
+										pos:  position{line: 894, col: 11, offset: 31354},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 879, col: 11, offset: 30738},
+										pos:  position{line: 895, col: 11, offset: 31378},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 880, col: 11, offset: 30764},
+										pos:  position{line: 896, col: 11, offset: 31404},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 881, col: 11, offset: 30787},
+										pos:  position{line: 897, col: 11, offset: 31427},
 										name: "QuotedLink",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 882, col: 11, offset: 30809},
+										pos:  position{line: 898, col: 11, offset: 31449},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 883, col: 11, offset: 30832},
+										pos:  position{line: 899, col: 11, offset: 31472},
 										name: "DocumentAttributeSubstitution",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 884, col: 11, offset: 30872},
+										pos:  position{line: 900, col: 11, offset: 31512},
 										name: "NonSingleQuoteBoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 885, col: 11, offset: 30905},
+										pos:  position{line: 901, col: 11, offset: 31545},
 										name: "Parenthesis",
 									},
 								},
@@ -6338,68 +6523,68 @@ var g = &grammar{
 		},
 		{
 			name: "NonSingleQuoteBoldText",
-			pos:  position{line: 889, col: 1, offset: 31050},
+			pos:  position{line: 905, col: 1, offset: 31690},
 			expr: &actionExpr{
-				pos: position{line: 889, col: 27, offset: 31076},
+				pos: position{line: 905, col: 27, offset: 31716},
 				run: (*parser).callonNonSingleQuoteBoldText1,
 				expr: &seqExpr{
-					pos: position{line: 889, col: 27, offset: 31076},
+					pos: position{line: 905, col: 27, offset: 31716},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 889, col: 28, offset: 31077,
+							line: 905, col: 28, offset: 31717,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 889, col: 31, offset: 31080},
+							pos: position{line: 905, col: 31, offset: 31720},
 							expr: &seqExpr{
-								pos: position{line: 889, col: 32, offset: 31081},
+								pos: position{line: 905, col: 32, offset: 31721},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 889, col: 32, offset: 31081},
+										pos: position{line: 905, col: 32, offset: 31721},
 										expr: &litMatcher{
-											pos:        position{line: 889, col: 33, offset: 31082},
+											pos:        position{line: 905, col: 33, offset: 31722},
 											val:        "*",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 889, col: 37, offset: 31086},
+										pos: position{line: 905, col: 37, offset: 31726},
 										expr: &ruleRefExpr{
-											pos:  position{line: 889, col: 38, offset: 31087},
+											pos:  position{line: 905, col: 38, offset: 31727},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 889, col: 41, offset: 31090},
+										pos: position{line: 905, col: 41, offset: 31730},
 										expr: &litMatcher{
-											pos:        position{line: 889, col: 42, offset: 31091},
+											pos:        position{line: 905, col: 42, offset: 31731},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 889, col: 46, offset: 31095},
+										pos: position{line: 905, col: 46, offset: 31735},
 										expr: &litMatcher{
-											pos:        position{line: 889, col: 47, offset: 31096},
+											pos:        position{line: 905, col: 47, offset: 31736},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 889, col: 51, offset: 31100},
+										pos: position{line: 905, col: 51, offset: 31740},
 										expr: &ruleRefExpr{
-											pos:  position{line: 889, col: 52, offset: 31101},
+											pos:  position{line: 905, col: 52, offset: 31741},
 											name: "NEWLINE",
 										},
 									},
 									&notExpr{
-										pos: position{line: 889, col: 60, offset: 31109},
+										pos: position{line: 905, col: 60, offset: 31749},
 										expr: &ruleRefExpr{
-											pos:  position{line: 889, col: 61, offset: 31110},
+											pos:  position{line: 905, col: 61, offset: 31750},
 											name: "Parenthesis",
 										},
 									},
 									&anyMatcher{
-										line: 889, col: 73, offset: 31122,
+										line: 905, col: 73, offset: 31762,
 									},
 								},
 							},
@@ -6410,39 +6595,39 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedBoldText",
-			pos:  position{line: 893, col: 1, offset: 31182},
+			pos:  position{line: 909, col: 1, offset: 31822},
 			expr: &choiceExpr{
-				pos: position{line: 894, col: 5, offset: 31206},
+				pos: position{line: 910, col: 5, offset: 31846},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 894, col: 5, offset: 31206},
+						pos: position{line: 910, col: 5, offset: 31846},
 						run: (*parser).callonEscapedBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 894, col: 5, offset: 31206},
+							pos: position{line: 910, col: 5, offset: 31846},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 894, col: 5, offset: 31206},
+									pos:   position{line: 910, col: 5, offset: 31846},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 894, col: 18, offset: 31219},
+										pos:  position{line: 910, col: 18, offset: 31859},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 894, col: 40, offset: 31241},
+									pos:        position{line: 910, col: 40, offset: 31881},
 									val:        "**",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 894, col: 45, offset: 31246},
+									pos:   position{line: 910, col: 45, offset: 31886},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 894, col: 54, offset: 31255},
+										pos:  position{line: 910, col: 54, offset: 31895},
 										name: "DoubleQuoteBoldTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 894, col: 82, offset: 31283},
+									pos:        position{line: 910, col: 82, offset: 31923},
 									val:        "**",
 									ignoreCase: false,
 								},
@@ -6450,34 +6635,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 896, col: 9, offset: 31439},
+						pos: position{line: 912, col: 9, offset: 32075},
 						run: (*parser).callonEscapedBoldText10,
 						expr: &seqExpr{
-							pos: position{line: 896, col: 9, offset: 31439},
+							pos: position{line: 912, col: 9, offset: 32075},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 896, col: 9, offset: 31439},
+									pos:   position{line: 912, col: 9, offset: 32075},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 896, col: 22, offset: 31452},
+										pos:  position{line: 912, col: 22, offset: 32088},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 896, col: 44, offset: 31474},
+									pos:        position{line: 912, col: 44, offset: 32110},
 									val:        "**",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 896, col: 49, offset: 31479},
+									pos:   position{line: 912, col: 49, offset: 32115},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 896, col: 58, offset: 31488},
+										pos:  position{line: 912, col: 58, offset: 32124},
 										name: "SingleQuoteBoldTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 896, col: 86, offset: 31516},
+									pos:        position{line: 912, col: 86, offset: 32152},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -6485,34 +6670,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 899, col: 9, offset: 31715},
+						pos: position{line: 915, col: 9, offset: 32347},
 						run: (*parser).callonEscapedBoldText18,
 						expr: &seqExpr{
-							pos: position{line: 899, col: 9, offset: 31715},
+							pos: position{line: 915, col: 9, offset: 32347},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 899, col: 9, offset: 31715},
+									pos:   position{line: 915, col: 9, offset: 32347},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 899, col: 22, offset: 31728},
+										pos:  position{line: 915, col: 22, offset: 32360},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 899, col: 44, offset: 31750},
+									pos:        position{line: 915, col: 44, offset: 32382},
 									val:        "*",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 899, col: 48, offset: 31754},
+									pos:   position{line: 915, col: 48, offset: 32386},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 899, col: 57, offset: 31763},
+										pos:  position{line: 915, col: 57, offset: 32395},
 										name: "SingleQuoteBoldTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 899, col: 85, offset: 31791},
+									pos:        position{line: 915, col: 85, offset: 32423},
 									val:        "*",
 									ignoreCase: false,
 								},
@@ -6524,16 +6709,16 @@ var g = &grammar{
 		},
 		{
 			name: "ItalicText",
-			pos:  position{line: 907, col: 1, offset: 31998},
+			pos:  position{line: 923, col: 1, offset: 32626},
 			expr: &choiceExpr{
-				pos: position{line: 907, col: 15, offset: 32012},
+				pos: position{line: 923, col: 15, offset: 32640},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 907, col: 15, offset: 32012},
+						pos:  position{line: 923, col: 15, offset: 32640},
 						name: "DoubleQuoteItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 907, col: 39, offset: 32036},
+						pos:  position{line: 923, col: 39, offset: 32664},
 						name: "SingleQuoteItalicText",
 					},
 				},
@@ -6541,36 +6726,36 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicText",
-			pos:  position{line: 909, col: 1, offset: 32059},
+			pos:  position{line: 925, col: 1, offset: 32687},
 			expr: &actionExpr{
-				pos: position{line: 909, col: 26, offset: 32084},
+				pos: position{line: 925, col: 26, offset: 32712},
 				run: (*parser).callonDoubleQuoteItalicText1,
 				expr: &seqExpr{
-					pos: position{line: 909, col: 26, offset: 32084},
+					pos: position{line: 925, col: 26, offset: 32712},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 909, col: 26, offset: 32084},
+							pos: position{line: 925, col: 26, offset: 32712},
 							expr: &litMatcher{
-								pos:        position{line: 909, col: 27, offset: 32085},
+								pos:        position{line: 925, col: 27, offset: 32713},
 								val:        "\\\\",
 								ignoreCase: false,
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 909, col: 32, offset: 32090},
+							pos:        position{line: 925, col: 32, offset: 32718},
 							val:        "__",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 909, col: 37, offset: 32095},
+							pos:   position{line: 925, col: 37, offset: 32723},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 909, col: 46, offset: 32104},
+								pos:  position{line: 925, col: 46, offset: 32732},
 								name: "DoubleQuoteItalicTextContent",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 909, col: 76, offset: 32134},
+							pos:        position{line: 925, col: 76, offset: 32762},
 							val:        "__",
 							ignoreCase: false,
 						},
@@ -6580,42 +6765,42 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextContent",
-			pos:  position{line: 913, col: 1, offset: 32260},
+			pos:  position{line: 929, col: 1, offset: 32888},
 			expr: &seqExpr{
-				pos: position{line: 913, col: 33, offset: 32292},
+				pos: position{line: 929, col: 33, offset: 32920},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 913, col: 33, offset: 32292},
+						pos:  position{line: 929, col: 33, offset: 32920},
 						name: "DoubleQuoteItalicTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 913, col: 62, offset: 32321},
+						pos: position{line: 929, col: 62, offset: 32949},
 						expr: &actionExpr{
-							pos: position{line: 913, col: 63, offset: 32322},
+							pos: position{line: 929, col: 63, offset: 32950},
 							run: (*parser).callonDoubleQuoteItalicTextContent4,
 							expr: &seqExpr{
-								pos: position{line: 913, col: 63, offset: 32322},
+								pos: position{line: 929, col: 63, offset: 32950},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 913, col: 63, offset: 32322},
+										pos: position{line: 929, col: 63, offset: 32950},
 										expr: &litMatcher{
-											pos:        position{line: 913, col: 65, offset: 32324},
+											pos:        position{line: 929, col: 65, offset: 32952},
 											val:        "__",
 											ignoreCase: false,
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 913, col: 71, offset: 32330},
+										pos:   position{line: 929, col: 71, offset: 32958},
 										label: "element",
 										expr: &choiceExpr{
-											pos: position{line: 913, col: 80, offset: 32339},
+											pos: position{line: 929, col: 80, offset: 32967},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 913, col: 80, offset: 32339},
+													pos:  position{line: 929, col: 80, offset: 32967},
 													name: "WS",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 913, col: 85, offset: 32344},
+													pos:  position{line: 929, col: 85, offset: 32972},
 													name: "DoubleQuoteItalicTextElement",
 												},
 											},
@@ -6630,64 +6815,64 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextElement",
-			pos:  position{line: 917, col: 1, offset: 32438},
+			pos:  position{line: 933, col: 1, offset: 33066},
 			expr: &actionExpr{
-				pos: position{line: 917, col: 33, offset: 32470},
+				pos: position{line: 933, col: 33, offset: 33098},
 				run: (*parser).callonDoubleQuoteItalicTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 917, col: 33, offset: 32470},
+					pos: position{line: 933, col: 33, offset: 33098},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 917, col: 33, offset: 32470},
+							pos: position{line: 933, col: 33, offset: 33098},
 							expr: &ruleRefExpr{
-								pos:  position{line: 917, col: 34, offset: 32471},
+								pos:  position{line: 933, col: 34, offset: 33099},
 								name: "NEWLINE",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 917, col: 42, offset: 32479},
+							pos:   position{line: 933, col: 42, offset: 33107},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 917, col: 51, offset: 32488},
+								pos: position{line: 933, col: 51, offset: 33116},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 917, col: 51, offset: 32488},
+										pos:  position{line: 933, col: 51, offset: 33116},
 										name: "SingleQuoteItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 918, col: 11, offset: 32521},
+										pos:  position{line: 934, col: 11, offset: 33149},
 										name: "BoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 919, col: 11, offset: 32541},
+										pos:  position{line: 935, col: 11, offset: 33169},
 										name: "MonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 920, col: 11, offset: 32565},
+										pos:  position{line: 936, col: 11, offset: 33193},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 921, col: 11, offset: 32589},
+										pos:  position{line: 937, col: 11, offset: 33217},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 922, col: 11, offset: 32615},
+										pos:  position{line: 938, col: 11, offset: 33243},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 923, col: 11, offset: 32638},
+										pos:  position{line: 939, col: 11, offset: 33266},
 										name: "QuotedLink",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 924, col: 11, offset: 32660},
+										pos:  position{line: 940, col: 11, offset: 33288},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 925, col: 11, offset: 32683},
+										pos:  position{line: 941, col: 11, offset: 33311},
 										name: "NonDoubleQuoteItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 926, col: 11, offset: 32718},
+										pos:  position{line: 942, col: 11, offset: 33346},
 										name: "Parenthesis",
 									},
 								},
@@ -6699,61 +6884,61 @@ var g = &grammar{
 		},
 		{
 			name: "NonDoubleQuoteItalicText",
-			pos:  position{line: 930, col: 1, offset: 32863},
+			pos:  position{line: 946, col: 1, offset: 33491},
 			expr: &actionExpr{
-				pos: position{line: 930, col: 29, offset: 32891},
+				pos: position{line: 946, col: 29, offset: 33519},
 				run: (*parser).callonNonDoubleQuoteItalicText1,
 				expr: &seqExpr{
-					pos: position{line: 930, col: 29, offset: 32891},
+					pos: position{line: 946, col: 29, offset: 33519},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 930, col: 30, offset: 32892,
+							line: 946, col: 30, offset: 33520,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 930, col: 33, offset: 32895},
+							pos: position{line: 946, col: 33, offset: 33523},
 							expr: &seqExpr{
-								pos: position{line: 930, col: 34, offset: 32896},
+								pos: position{line: 946, col: 34, offset: 33524},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 930, col: 34, offset: 32896},
+										pos: position{line: 946, col: 34, offset: 33524},
 										expr: &litMatcher{
-											pos:        position{line: 930, col: 35, offset: 32897},
+											pos:        position{line: 946, col: 35, offset: 33525},
 											val:        "__",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 930, col: 40, offset: 32902},
+										pos: position{line: 946, col: 40, offset: 33530},
 										expr: &litMatcher{
-											pos:        position{line: 930, col: 41, offset: 32903},
+											pos:        position{line: 946, col: 41, offset: 33531},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 930, col: 45, offset: 32907},
+										pos: position{line: 946, col: 45, offset: 33535},
 										expr: &litMatcher{
-											pos:        position{line: 930, col: 46, offset: 32908},
+											pos:        position{line: 946, col: 46, offset: 33536},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 930, col: 50, offset: 32912},
+										pos: position{line: 946, col: 50, offset: 33540},
 										expr: &ruleRefExpr{
-											pos:  position{line: 930, col: 51, offset: 32913},
+											pos:  position{line: 946, col: 51, offset: 33541},
 											name: "NEWLINE",
 										},
 									},
 									&notExpr{
-										pos: position{line: 930, col: 59, offset: 32921},
+										pos: position{line: 946, col: 59, offset: 33549},
 										expr: &ruleRefExpr{
-											pos:  position{line: 930, col: 60, offset: 32922},
+											pos:  position{line: 946, col: 60, offset: 33550},
 											name: "Parenthesis",
 										},
 									},
 									&anyMatcher{
-										line: 930, col: 72, offset: 32934,
+										line: 946, col: 72, offset: 33562,
 									},
 								},
 							},
@@ -6764,47 +6949,47 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicText",
-			pos:  position{line: 934, col: 1, offset: 32994},
+			pos:  position{line: 950, col: 1, offset: 33622},
 			expr: &choiceExpr{
-				pos: position{line: 934, col: 26, offset: 33019},
+				pos: position{line: 950, col: 26, offset: 33647},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 934, col: 26, offset: 33019},
+						pos: position{line: 950, col: 26, offset: 33647},
 						run: (*parser).callonSingleQuoteItalicText2,
 						expr: &seqExpr{
-							pos: position{line: 934, col: 26, offset: 33019},
+							pos: position{line: 950, col: 26, offset: 33647},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 934, col: 26, offset: 33019},
+									pos: position{line: 950, col: 26, offset: 33647},
 									expr: &litMatcher{
-										pos:        position{line: 934, col: 27, offset: 33020},
+										pos:        position{line: 950, col: 27, offset: 33648},
 										val:        "\\",
 										ignoreCase: false,
 									},
 								},
 								&notExpr{
-									pos: position{line: 934, col: 31, offset: 33024},
+									pos: position{line: 950, col: 31, offset: 33652},
 									expr: &litMatcher{
-										pos:        position{line: 934, col: 32, offset: 33025},
+										pos:        position{line: 950, col: 32, offset: 33653},
 										val:        "__",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 934, col: 37, offset: 33030},
+									pos:        position{line: 950, col: 37, offset: 33658},
 									val:        "_",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 934, col: 41, offset: 33034},
+									pos:   position{line: 950, col: 41, offset: 33662},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 934, col: 50, offset: 33043},
+										pos:  position{line: 950, col: 50, offset: 33671},
 										name: "SingleQuoteItalicTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 934, col: 80, offset: 33073},
+									pos:        position{line: 950, col: 80, offset: 33701},
 									val:        "_",
 									ignoreCase: false,
 								},
@@ -6812,34 +6997,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 936, col: 5, offset: 33255},
+						pos: position{line: 952, col: 5, offset: 33883},
 						run: (*parser).callonSingleQuoteItalicText12,
 						expr: &seqExpr{
-							pos: position{line: 936, col: 5, offset: 33255},
+							pos: position{line: 952, col: 5, offset: 33883},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 936, col: 5, offset: 33255},
+									pos: position{line: 952, col: 5, offset: 33883},
 									expr: &litMatcher{
-										pos:        position{line: 936, col: 6, offset: 33256},
+										pos:        position{line: 952, col: 6, offset: 33884},
 										val:        "\\\\",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 936, col: 11, offset: 33261},
+									pos:        position{line: 952, col: 11, offset: 33889},
 									val:        "__",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 936, col: 16, offset: 33266},
+									pos:   position{line: 952, col: 16, offset: 33894},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 936, col: 25, offset: 33275},
+										pos:  position{line: 952, col: 25, offset: 33903},
 										name: "SingleQuoteItalicTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 936, col: 55, offset: 33305},
+									pos:        position{line: 952, col: 55, offset: 33933},
 									val:        "_",
 									ignoreCase: false,
 								},
@@ -6851,43 +7036,43 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextContent",
-			pos:  position{line: 940, col: 1, offset: 33567},
+			pos:  position{line: 956, col: 1, offset: 34195},
 			expr: &seqExpr{
-				pos: position{line: 940, col: 33, offset: 33599},
+				pos: position{line: 956, col: 33, offset: 34227},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 940, col: 33, offset: 33599},
+						pos: position{line: 956, col: 33, offset: 34227},
 						expr: &ruleRefExpr{
-							pos:  position{line: 940, col: 34, offset: 33600},
+							pos:  position{line: 956, col: 34, offset: 34228},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 940, col: 37, offset: 33603},
+						pos:  position{line: 956, col: 37, offset: 34231},
 						name: "SingleQuoteItalicTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 940, col: 66, offset: 33632},
+						pos: position{line: 956, col: 66, offset: 34260},
 						expr: &actionExpr{
-							pos: position{line: 940, col: 67, offset: 33633},
+							pos: position{line: 956, col: 67, offset: 34261},
 							run: (*parser).callonSingleQuoteItalicTextContent6,
 							expr: &seqExpr{
-								pos: position{line: 940, col: 67, offset: 33633},
+								pos: position{line: 956, col: 67, offset: 34261},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 940, col: 67, offset: 33633},
+										pos: position{line: 956, col: 67, offset: 34261},
 										expr: &seqExpr{
-											pos: position{line: 940, col: 69, offset: 33635},
+											pos: position{line: 956, col: 69, offset: 34263},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 940, col: 69, offset: 33635},
+													pos:        position{line: 956, col: 69, offset: 34263},
 													val:        "_",
 													ignoreCase: false,
 												},
 												&notExpr{
-													pos: position{line: 940, col: 73, offset: 33639},
+													pos: position{line: 956, col: 73, offset: 34267},
 													expr: &ruleRefExpr{
-														pos:  position{line: 940, col: 74, offset: 33640},
+														pos:  position{line: 956, col: 74, offset: 34268},
 														name: "Alphanum",
 													},
 												},
@@ -6895,21 +7080,21 @@ var g = &grammar{
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 940, col: 84, offset: 33650},
+										pos:   position{line: 956, col: 84, offset: 34278},
 										label: "spaces",
 										expr: &zeroOrMoreExpr{
-											pos: position{line: 940, col: 92, offset: 33658},
+											pos: position{line: 956, col: 92, offset: 34286},
 											expr: &ruleRefExpr{
-												pos:  position{line: 940, col: 92, offset: 33658},
+												pos:  position{line: 956, col: 92, offset: 34286},
 												name: "WS",
 											},
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 940, col: 97, offset: 33663},
+										pos:   position{line: 956, col: 97, offset: 34291},
 										label: "element",
 										expr: &ruleRefExpr{
-											pos:  position{line: 940, col: 106, offset: 33672},
+											pos:  position{line: 956, col: 106, offset: 34300},
 											name: "SingleQuoteItalicTextElement",
 										},
 									},
@@ -6922,64 +7107,64 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextElement",
-			pos:  position{line: 944, col: 1, offset: 33765},
+			pos:  position{line: 960, col: 1, offset: 34393},
 			expr: &actionExpr{
-				pos: position{line: 944, col: 33, offset: 33797},
+				pos: position{line: 960, col: 33, offset: 34425},
 				run: (*parser).callonSingleQuoteItalicTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 944, col: 33, offset: 33797},
+					pos: position{line: 960, col: 33, offset: 34425},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 944, col: 33, offset: 33797},
+							pos: position{line: 960, col: 33, offset: 34425},
 							expr: &ruleRefExpr{
-								pos:  position{line: 944, col: 34, offset: 33798},
+								pos:  position{line: 960, col: 34, offset: 34426},
 								name: "NEWLINE",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 944, col: 42, offset: 33806},
+							pos:   position{line: 960, col: 42, offset: 34434},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 944, col: 51, offset: 33815},
+								pos: position{line: 960, col: 51, offset: 34443},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 944, col: 51, offset: 33815},
+										pos:  position{line: 960, col: 51, offset: 34443},
 										name: "DoubleQuoteItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 945, col: 11, offset: 33847},
+										pos:  position{line: 961, col: 11, offset: 34475},
 										name: "BoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 946, col: 11, offset: 33867},
+										pos:  position{line: 962, col: 11, offset: 34495},
 										name: "MonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 947, col: 11, offset: 33891},
+										pos:  position{line: 963, col: 11, offset: 34519},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 948, col: 11, offset: 33915},
+										pos:  position{line: 964, col: 11, offset: 34543},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 949, col: 11, offset: 33941},
+										pos:  position{line: 965, col: 11, offset: 34569},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 950, col: 11, offset: 33964},
+										pos:  position{line: 966, col: 11, offset: 34592},
 										name: "QuotedLink",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 951, col: 11, offset: 33986},
+										pos:  position{line: 967, col: 11, offset: 34614},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 952, col: 11, offset: 34009},
+										pos:  position{line: 968, col: 11, offset: 34637},
 										name: "NonSingleQuoteItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 953, col: 11, offset: 34044},
+										pos:  position{line: 969, col: 11, offset: 34672},
 										name: "Parenthesis",
 									},
 								},
@@ -6991,68 +7176,68 @@ var g = &grammar{
 		},
 		{
 			name: "NonSingleQuoteItalicText",
-			pos:  position{line: 957, col: 1, offset: 34189},
+			pos:  position{line: 973, col: 1, offset: 34817},
 			expr: &actionExpr{
-				pos: position{line: 957, col: 29, offset: 34217},
+				pos: position{line: 973, col: 29, offset: 34845},
 				run: (*parser).callonNonSingleQuoteItalicText1,
 				expr: &seqExpr{
-					pos: position{line: 957, col: 29, offset: 34217},
+					pos: position{line: 973, col: 29, offset: 34845},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 957, col: 30, offset: 34218,
+							line: 973, col: 30, offset: 34846,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 957, col: 33, offset: 34221},
+							pos: position{line: 973, col: 33, offset: 34849},
 							expr: &seqExpr{
-								pos: position{line: 957, col: 34, offset: 34222},
+								pos: position{line: 973, col: 34, offset: 34850},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 957, col: 34, offset: 34222},
+										pos: position{line: 973, col: 34, offset: 34850},
 										expr: &litMatcher{
-											pos:        position{line: 957, col: 35, offset: 34223},
+											pos:        position{line: 973, col: 35, offset: 34851},
 											val:        "_",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 957, col: 39, offset: 34227},
+										pos: position{line: 973, col: 39, offset: 34855},
 										expr: &ruleRefExpr{
-											pos:  position{line: 957, col: 40, offset: 34228},
+											pos:  position{line: 973, col: 40, offset: 34856},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 957, col: 43, offset: 34231},
+										pos: position{line: 973, col: 43, offset: 34859},
 										expr: &litMatcher{
-											pos:        position{line: 957, col: 44, offset: 34232},
+											pos:        position{line: 973, col: 44, offset: 34860},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 957, col: 48, offset: 34236},
+										pos: position{line: 973, col: 48, offset: 34864},
 										expr: &litMatcher{
-											pos:        position{line: 957, col: 49, offset: 34237},
+											pos:        position{line: 973, col: 49, offset: 34865},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 957, col: 53, offset: 34241},
+										pos: position{line: 973, col: 53, offset: 34869},
 										expr: &ruleRefExpr{
-											pos:  position{line: 957, col: 54, offset: 34242},
+											pos:  position{line: 973, col: 54, offset: 34870},
 											name: "NEWLINE",
 										},
 									},
 									&notExpr{
-										pos: position{line: 957, col: 62, offset: 34250},
+										pos: position{line: 973, col: 62, offset: 34878},
 										expr: &ruleRefExpr{
-											pos:  position{line: 957, col: 63, offset: 34251},
+											pos:  position{line: 973, col: 63, offset: 34879},
 											name: "Parenthesis",
 										},
 									},
 									&anyMatcher{
-										line: 957, col: 75, offset: 34263,
+										line: 973, col: 75, offset: 34891,
 									},
 								},
 							},
@@ -7063,39 +7248,39 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedItalicText",
-			pos:  position{line: 961, col: 1, offset: 34323},
+			pos:  position{line: 977, col: 1, offset: 34951},
 			expr: &choiceExpr{
-				pos: position{line: 962, col: 5, offset: 34349},
+				pos: position{line: 978, col: 5, offset: 34977},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 962, col: 5, offset: 34349},
+						pos: position{line: 978, col: 5, offset: 34977},
 						run: (*parser).callonEscapedItalicText2,
 						expr: &seqExpr{
-							pos: position{line: 962, col: 5, offset: 34349},
+							pos: position{line: 978, col: 5, offset: 34977},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 962, col: 5, offset: 34349},
+									pos:   position{line: 978, col: 5, offset: 34977},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 962, col: 18, offset: 34362},
+										pos:  position{line: 978, col: 18, offset: 34990},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 962, col: 40, offset: 34384},
+									pos:        position{line: 978, col: 40, offset: 35012},
 									val:        "__",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 962, col: 45, offset: 34389},
+									pos:   position{line: 978, col: 45, offset: 35017},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 962, col: 54, offset: 34398},
+										pos:  position{line: 978, col: 54, offset: 35026},
 										name: "DoubleQuoteItalicTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 962, col: 84, offset: 34428},
+									pos:        position{line: 978, col: 84, offset: 35056},
 									val:        "__",
 									ignoreCase: false,
 								},
@@ -7103,34 +7288,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 964, col: 9, offset: 34584},
+						pos: position{line: 980, col: 9, offset: 35208},
 						run: (*parser).callonEscapedItalicText10,
 						expr: &seqExpr{
-							pos: position{line: 964, col: 9, offset: 34584},
+							pos: position{line: 980, col: 9, offset: 35208},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 964, col: 9, offset: 34584},
+									pos:   position{line: 980, col: 9, offset: 35208},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 964, col: 22, offset: 34597},
+										pos:  position{line: 980, col: 22, offset: 35221},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 964, col: 44, offset: 34619},
+									pos:        position{line: 980, col: 44, offset: 35243},
 									val:        "__",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 964, col: 49, offset: 34624},
+									pos:   position{line: 980, col: 49, offset: 35248},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 964, col: 58, offset: 34633},
+										pos:  position{line: 980, col: 58, offset: 35257},
 										name: "SingleQuoteItalicTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 964, col: 88, offset: 34663},
+									pos:        position{line: 980, col: 88, offset: 35287},
 									val:        "_",
 									ignoreCase: false,
 								},
@@ -7138,34 +7323,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 967, col: 9, offset: 34862},
+						pos: position{line: 983, col: 9, offset: 35482},
 						run: (*parser).callonEscapedItalicText18,
 						expr: &seqExpr{
-							pos: position{line: 967, col: 9, offset: 34862},
+							pos: position{line: 983, col: 9, offset: 35482},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 967, col: 9, offset: 34862},
+									pos:   position{line: 983, col: 9, offset: 35482},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 967, col: 22, offset: 34875},
+										pos:  position{line: 983, col: 22, offset: 35495},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 967, col: 44, offset: 34897},
+									pos:        position{line: 983, col: 44, offset: 35517},
 									val:        "_",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 967, col: 48, offset: 34901},
+									pos:   position{line: 983, col: 48, offset: 35521},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 967, col: 57, offset: 34910},
+										pos:  position{line: 983, col: 57, offset: 35530},
 										name: "SingleQuoteItalicTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 967, col: 87, offset: 34940},
+									pos:        position{line: 983, col: 87, offset: 35560},
 									val:        "_",
 									ignoreCase: false,
 								},
@@ -7177,16 +7362,16 @@ var g = &grammar{
 		},
 		{
 			name: "MonospaceText",
-			pos:  position{line: 974, col: 1, offset: 35149},
+			pos:  position{line: 990, col: 1, offset: 35765},
 			expr: &choiceExpr{
-				pos: position{line: 974, col: 18, offset: 35166},
+				pos: position{line: 990, col: 18, offset: 35782},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 974, col: 18, offset: 35166},
+						pos:  position{line: 990, col: 18, offset: 35782},
 						name: "DoubleQuoteMonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 974, col: 45, offset: 35193},
+						pos:  position{line: 990, col: 45, offset: 35809},
 						name: "SingleQuoteMonospaceText",
 					},
 				},
@@ -7194,36 +7379,36 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceText",
-			pos:  position{line: 976, col: 1, offset: 35219},
+			pos:  position{line: 992, col: 1, offset: 35835},
 			expr: &actionExpr{
-				pos: position{line: 976, col: 29, offset: 35247},
+				pos: position{line: 992, col: 29, offset: 35863},
 				run: (*parser).callonDoubleQuoteMonospaceText1,
 				expr: &seqExpr{
-					pos: position{line: 976, col: 29, offset: 35247},
+					pos: position{line: 992, col: 29, offset: 35863},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 976, col: 29, offset: 35247},
+							pos: position{line: 992, col: 29, offset: 35863},
 							expr: &litMatcher{
-								pos:        position{line: 976, col: 30, offset: 35248},
+								pos:        position{line: 992, col: 30, offset: 35864},
 								val:        "\\\\",
 								ignoreCase: false,
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 976, col: 35, offset: 35253},
+							pos:        position{line: 992, col: 35, offset: 35869},
 							val:        "``",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 976, col: 40, offset: 35258},
+							pos:   position{line: 992, col: 40, offset: 35874},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 976, col: 49, offset: 35267},
+								pos:  position{line: 992, col: 49, offset: 35883},
 								name: "DoubleQuoteMonospaceTextContent",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 976, col: 82, offset: 35300},
+							pos:        position{line: 992, col: 82, offset: 35916},
 							val:        "``",
 							ignoreCase: false,
 						},
@@ -7233,42 +7418,42 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextContent",
-			pos:  position{line: 980, col: 1, offset: 35429},
+			pos:  position{line: 996, col: 1, offset: 36045},
 			expr: &seqExpr{
-				pos: position{line: 980, col: 36, offset: 35464},
+				pos: position{line: 996, col: 36, offset: 36080},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 980, col: 36, offset: 35464},
+						pos:  position{line: 996, col: 36, offset: 36080},
 						name: "DoubleQuoteMonospaceTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 980, col: 68, offset: 35496},
+						pos: position{line: 996, col: 68, offset: 36112},
 						expr: &actionExpr{
-							pos: position{line: 980, col: 69, offset: 35497},
+							pos: position{line: 996, col: 69, offset: 36113},
 							run: (*parser).callonDoubleQuoteMonospaceTextContent4,
 							expr: &seqExpr{
-								pos: position{line: 980, col: 69, offset: 35497},
+								pos: position{line: 996, col: 69, offset: 36113},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 980, col: 69, offset: 35497},
+										pos: position{line: 996, col: 69, offset: 36113},
 										expr: &litMatcher{
-											pos:        position{line: 980, col: 71, offset: 35499},
+											pos:        position{line: 996, col: 71, offset: 36115},
 											val:        "``",
 											ignoreCase: false,
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 980, col: 77, offset: 35505},
+										pos:   position{line: 996, col: 77, offset: 36121},
 										label: "element",
 										expr: &choiceExpr{
-											pos: position{line: 980, col: 86, offset: 35514},
+											pos: position{line: 996, col: 86, offset: 36130},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 980, col: 86, offset: 35514},
+													pos:  position{line: 996, col: 86, offset: 36130},
 													name: "WS",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 980, col: 91, offset: 35519},
+													pos:  position{line: 996, col: 91, offset: 36135},
 													name: "DoubleQuoteMonospaceTextElement",
 												},
 											},
@@ -7283,64 +7468,64 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextElement",
-			pos:  position{line: 984, col: 1, offset: 35616},
+			pos:  position{line: 1000, col: 1, offset: 36232},
 			expr: &actionExpr{
-				pos: position{line: 984, col: 36, offset: 35651},
+				pos: position{line: 1000, col: 36, offset: 36267},
 				run: (*parser).callonDoubleQuoteMonospaceTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 984, col: 36, offset: 35651},
+					pos: position{line: 1000, col: 36, offset: 36267},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 984, col: 36, offset: 35651},
+							pos: position{line: 1000, col: 36, offset: 36267},
 							expr: &ruleRefExpr{
-								pos:  position{line: 984, col: 37, offset: 35652},
+								pos:  position{line: 1000, col: 37, offset: 36268},
 								name: "NEWLINE",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 984, col: 45, offset: 35660},
+							pos:   position{line: 1000, col: 45, offset: 36276},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 984, col: 54, offset: 35669},
+								pos: position{line: 1000, col: 54, offset: 36285},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 984, col: 54, offset: 35669},
+										pos:  position{line: 1000, col: 54, offset: 36285},
 										name: "SingleQuoteMonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 985, col: 11, offset: 35705},
+										pos:  position{line: 1001, col: 11, offset: 36321},
 										name: "BoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 986, col: 11, offset: 35724},
+										pos:  position{line: 1002, col: 11, offset: 36340},
 										name: "ItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 987, col: 11, offset: 35746},
+										pos:  position{line: 1003, col: 11, offset: 36362},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 988, col: 11, offset: 35770},
+										pos:  position{line: 1004, col: 11, offset: 36386},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 989, col: 11, offset: 35796},
+										pos:  position{line: 1005, col: 11, offset: 36412},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 990, col: 11, offset: 35819},
+										pos:  position{line: 1006, col: 11, offset: 36435},
 										name: "QuotedLink",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 991, col: 11, offset: 35841},
+										pos:  position{line: 1007, col: 11, offset: 36457},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 992, col: 11, offset: 35864},
+										pos:  position{line: 1008, col: 11, offset: 36480},
 										name: "NonDoubleQuoteMonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 993, col: 11, offset: 35902},
+										pos:  position{line: 1009, col: 11, offset: 36518},
 										name: "Parenthesis",
 									},
 								},
@@ -7352,68 +7537,68 @@ var g = &grammar{
 		},
 		{
 			name: "NonDoubleQuoteMonospaceText",
-			pos:  position{line: 997, col: 1, offset: 36047},
+			pos:  position{line: 1013, col: 1, offset: 36663},
 			expr: &actionExpr{
-				pos: position{line: 997, col: 32, offset: 36078},
+				pos: position{line: 1013, col: 32, offset: 36694},
 				run: (*parser).callonNonDoubleQuoteMonospaceText1,
 				expr: &seqExpr{
-					pos: position{line: 997, col: 32, offset: 36078},
+					pos: position{line: 1013, col: 32, offset: 36694},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 997, col: 33, offset: 36079,
+							line: 1013, col: 33, offset: 36695,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 997, col: 36, offset: 36082},
+							pos: position{line: 1013, col: 36, offset: 36698},
 							expr: &seqExpr{
-								pos: position{line: 997, col: 37, offset: 36083},
+								pos: position{line: 1013, col: 37, offset: 36699},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 997, col: 37, offset: 36083},
+										pos: position{line: 1013, col: 37, offset: 36699},
 										expr: &litMatcher{
-											pos:        position{line: 997, col: 38, offset: 36084},
+											pos:        position{line: 1013, col: 38, offset: 36700},
 											val:        "``",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 997, col: 43, offset: 36089},
+										pos: position{line: 1013, col: 43, offset: 36705},
 										expr: &ruleRefExpr{
-											pos:  position{line: 997, col: 44, offset: 36090},
+											pos:  position{line: 1013, col: 44, offset: 36706},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 997, col: 47, offset: 36093},
+										pos: position{line: 1013, col: 47, offset: 36709},
 										expr: &litMatcher{
-											pos:        position{line: 997, col: 48, offset: 36094},
+											pos:        position{line: 1013, col: 48, offset: 36710},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 997, col: 52, offset: 36098},
+										pos: position{line: 1013, col: 52, offset: 36714},
 										expr: &litMatcher{
-											pos:        position{line: 997, col: 53, offset: 36099},
+											pos:        position{line: 1013, col: 53, offset: 36715},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 997, col: 57, offset: 36103},
+										pos: position{line: 1013, col: 57, offset: 36719},
 										expr: &ruleRefExpr{
-											pos:  position{line: 997, col: 58, offset: 36104},
+											pos:  position{line: 1013, col: 58, offset: 36720},
 											name: "NEWLINE",
 										},
 									},
 									&notExpr{
-										pos: position{line: 997, col: 66, offset: 36112},
+										pos: position{line: 1013, col: 66, offset: 36728},
 										expr: &ruleRefExpr{
-											pos:  position{line: 997, col: 67, offset: 36113},
+											pos:  position{line: 1013, col: 67, offset: 36729},
 											name: "Parenthesis",
 										},
 									},
 									&anyMatcher{
-										line: 997, col: 79, offset: 36125,
+										line: 1013, col: 79, offset: 36741,
 									},
 								},
 							},
@@ -7424,47 +7609,47 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceText",
-			pos:  position{line: 1001, col: 1, offset: 36185},
+			pos:  position{line: 1017, col: 1, offset: 36801},
 			expr: &choiceExpr{
-				pos: position{line: 1001, col: 29, offset: 36213},
+				pos: position{line: 1017, col: 29, offset: 36829},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1001, col: 29, offset: 36213},
+						pos: position{line: 1017, col: 29, offset: 36829},
 						run: (*parser).callonSingleQuoteMonospaceText2,
 						expr: &seqExpr{
-							pos: position{line: 1001, col: 29, offset: 36213},
+							pos: position{line: 1017, col: 29, offset: 36829},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1001, col: 29, offset: 36213},
+									pos: position{line: 1017, col: 29, offset: 36829},
 									expr: &litMatcher{
-										pos:        position{line: 1001, col: 30, offset: 36214},
+										pos:        position{line: 1017, col: 30, offset: 36830},
 										val:        "\\",
 										ignoreCase: false,
 									},
 								},
 								&notExpr{
-									pos: position{line: 1001, col: 34, offset: 36218},
+									pos: position{line: 1017, col: 34, offset: 36834},
 									expr: &litMatcher{
-										pos:        position{line: 1001, col: 35, offset: 36219},
+										pos:        position{line: 1017, col: 35, offset: 36835},
 										val:        "``",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1001, col: 40, offset: 36224},
+									pos:        position{line: 1017, col: 40, offset: 36840},
 									val:        "`",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1001, col: 44, offset: 36228},
+									pos:   position{line: 1017, col: 44, offset: 36844},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1001, col: 53, offset: 36237},
+										pos:  position{line: 1017, col: 53, offset: 36853},
 										name: "SingleQuoteMonospaceTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1001, col: 86, offset: 36270},
+									pos:        position{line: 1017, col: 86, offset: 36886},
 									val:        "`",
 									ignoreCase: false,
 								},
@@ -7472,34 +7657,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1003, col: 5, offset: 36455},
+						pos: position{line: 1019, col: 5, offset: 37071},
 						run: (*parser).callonSingleQuoteMonospaceText12,
 						expr: &seqExpr{
-							pos: position{line: 1003, col: 5, offset: 36455},
+							pos: position{line: 1019, col: 5, offset: 37071},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1003, col: 5, offset: 36455},
+									pos: position{line: 1019, col: 5, offset: 37071},
 									expr: &litMatcher{
-										pos:        position{line: 1003, col: 6, offset: 36456},
+										pos:        position{line: 1019, col: 6, offset: 37072},
 										val:        "\\\\",
 										ignoreCase: false,
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1003, col: 11, offset: 36461},
+									pos:        position{line: 1019, col: 11, offset: 37077},
 									val:        "``",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1003, col: 16, offset: 36466},
+									pos:   position{line: 1019, col: 16, offset: 37082},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1003, col: 25, offset: 36475},
+										pos:  position{line: 1019, col: 25, offset: 37091},
 										name: "SingleQuoteMonospaceTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1003, col: 58, offset: 36508},
+									pos:        position{line: 1019, col: 58, offset: 37124},
 									val:        "`",
 									ignoreCase: false,
 								},
@@ -7511,43 +7696,43 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextContent",
-			pos:  position{line: 1007, col: 1, offset: 36776},
+			pos:  position{line: 1023, col: 1, offset: 37392},
 			expr: &seqExpr{
-				pos: position{line: 1007, col: 36, offset: 36811},
+				pos: position{line: 1023, col: 36, offset: 37427},
 				exprs: []interface{}{
 					&notExpr{
-						pos: position{line: 1007, col: 36, offset: 36811},
+						pos: position{line: 1023, col: 36, offset: 37427},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1007, col: 37, offset: 36812},
+							pos:  position{line: 1023, col: 37, offset: 37428},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1007, col: 40, offset: 36815},
+						pos:  position{line: 1023, col: 40, offset: 37431},
 						name: "SingleQuoteMonospaceTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1007, col: 72, offset: 36847},
+						pos: position{line: 1023, col: 72, offset: 37463},
 						expr: &actionExpr{
-							pos: position{line: 1007, col: 73, offset: 36848},
+							pos: position{line: 1023, col: 73, offset: 37464},
 							run: (*parser).callonSingleQuoteMonospaceTextContent6,
 							expr: &seqExpr{
-								pos: position{line: 1007, col: 73, offset: 36848},
+								pos: position{line: 1023, col: 73, offset: 37464},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1007, col: 73, offset: 36848},
+										pos: position{line: 1023, col: 73, offset: 37464},
 										expr: &seqExpr{
-											pos: position{line: 1007, col: 75, offset: 36850},
+											pos: position{line: 1023, col: 75, offset: 37466},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 1007, col: 75, offset: 36850},
+													pos:        position{line: 1023, col: 75, offset: 37466},
 													val:        "`",
 													ignoreCase: false,
 												},
 												&notExpr{
-													pos: position{line: 1007, col: 79, offset: 36854},
+													pos: position{line: 1023, col: 79, offset: 37470},
 													expr: &ruleRefExpr{
-														pos:  position{line: 1007, col: 80, offset: 36855},
+														pos:  position{line: 1023, col: 80, offset: 37471},
 														name: "Alphanum",
 													},
 												},
@@ -7555,21 +7740,21 @@ var g = &grammar{
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 1007, col: 90, offset: 36865},
+										pos:   position{line: 1023, col: 90, offset: 37481},
 										label: "spaces",
 										expr: &zeroOrMoreExpr{
-											pos: position{line: 1007, col: 98, offset: 36873},
+											pos: position{line: 1023, col: 98, offset: 37489},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1007, col: 98, offset: 36873},
+												pos:  position{line: 1023, col: 98, offset: 37489},
 												name: "WS",
 											},
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 1007, col: 103, offset: 36878},
+										pos:   position{line: 1023, col: 103, offset: 37494},
 										label: "element",
 										expr: &ruleRefExpr{
-											pos:  position{line: 1007, col: 112, offset: 36887},
+											pos:  position{line: 1023, col: 112, offset: 37503},
 											name: "SingleQuoteMonospaceTextElement",
 										},
 									},
@@ -7582,58 +7767,58 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextElement",
-			pos:  position{line: 1011, col: 1, offset: 36983},
+			pos:  position{line: 1027, col: 1, offset: 37599},
 			expr: &actionExpr{
-				pos: position{line: 1011, col: 37, offset: 37019},
+				pos: position{line: 1027, col: 37, offset: 37635},
 				run: (*parser).callonSingleQuoteMonospaceTextElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 1011, col: 37, offset: 37019},
+					pos:   position{line: 1027, col: 37, offset: 37635},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 1011, col: 46, offset: 37028},
+						pos: position{line: 1027, col: 46, offset: 37644},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1011, col: 46, offset: 37028},
+								pos:  position{line: 1027, col: 46, offset: 37644},
 								name: "NEWLINE",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1012, col: 11, offset: 37066},
+								pos:  position{line: 1028, col: 11, offset: 37682},
 								name: "DoubleQuoteMonospaceText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1013, col: 11, offset: 37102},
+								pos:  position{line: 1029, col: 11, offset: 37718},
 								name: "BoldText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1014, col: 11, offset: 37122},
+								pos:  position{line: 1030, col: 11, offset: 37738},
 								name: "ItalicText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1015, col: 11, offset: 37143},
+								pos:  position{line: 1031, col: 11, offset: 37759},
 								name: "SubscriptText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1016, col: 11, offset: 37167},
+								pos:  position{line: 1032, col: 11, offset: 37783},
 								name: "SuperscriptText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1017, col: 11, offset: 37193},
+								pos:  position{line: 1033, col: 11, offset: 37809},
 								name: "InlineImage",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1018, col: 11, offset: 37216},
+								pos:  position{line: 1034, col: 11, offset: 37832},
 								name: "QuotedLink",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1019, col: 11, offset: 37238},
+								pos:  position{line: 1035, col: 11, offset: 37854},
 								name: "Passthrough",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1020, col: 11, offset: 37261},
+								pos:  position{line: 1036, col: 11, offset: 37877},
 								name: "NonSingleQuoteMonospaceText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1021, col: 11, offset: 37299},
+								pos:  position{line: 1037, col: 11, offset: 37915},
 								name: "Parenthesis",
 							},
 						},
@@ -7643,68 +7828,68 @@ var g = &grammar{
 		},
 		{
 			name: "NonSingleQuoteMonospaceText",
-			pos:  position{line: 1025, col: 1, offset: 37444},
+			pos:  position{line: 1041, col: 1, offset: 38060},
 			expr: &actionExpr{
-				pos: position{line: 1025, col: 32, offset: 37475},
+				pos: position{line: 1041, col: 32, offset: 38091},
 				run: (*parser).callonNonSingleQuoteMonospaceText1,
 				expr: &seqExpr{
-					pos: position{line: 1025, col: 32, offset: 37475},
+					pos: position{line: 1041, col: 32, offset: 38091},
 					exprs: []interface{}{
 						&anyMatcher{
-							line: 1025, col: 33, offset: 37476,
+							line: 1041, col: 33, offset: 38092,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1025, col: 36, offset: 37479},
+							pos: position{line: 1041, col: 36, offset: 38095},
 							expr: &seqExpr{
-								pos: position{line: 1025, col: 37, offset: 37480},
+								pos: position{line: 1041, col: 37, offset: 38096},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1025, col: 37, offset: 37480},
+										pos: position{line: 1041, col: 37, offset: 38096},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1025, col: 38, offset: 37481},
+											pos:  position{line: 1041, col: 38, offset: 38097},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1025, col: 41, offset: 37484},
+										pos: position{line: 1041, col: 41, offset: 38100},
 										expr: &litMatcher{
-											pos:        position{line: 1025, col: 42, offset: 37485},
+											pos:        position{line: 1041, col: 42, offset: 38101},
 											val:        "`",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1025, col: 46, offset: 37489},
+										pos: position{line: 1041, col: 46, offset: 38105},
 										expr: &litMatcher{
-											pos:        position{line: 1025, col: 47, offset: 37490},
+											pos:        position{line: 1041, col: 47, offset: 38106},
 											val:        "^",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1025, col: 51, offset: 37494},
+										pos: position{line: 1041, col: 51, offset: 38110},
 										expr: &litMatcher{
-											pos:        position{line: 1025, col: 52, offset: 37495},
+											pos:        position{line: 1041, col: 52, offset: 38111},
 											val:        "~",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1025, col: 56, offset: 37499},
+										pos: position{line: 1041, col: 56, offset: 38115},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1025, col: 57, offset: 37500},
+											pos:  position{line: 1041, col: 57, offset: 38116},
 											name: "NEWLINE",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1025, col: 65, offset: 37508},
+										pos: position{line: 1041, col: 65, offset: 38124},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1025, col: 66, offset: 37509},
+											pos:  position{line: 1041, col: 66, offset: 38125},
 											name: "Parenthesis",
 										},
 									},
 									&anyMatcher{
-										line: 1025, col: 78, offset: 37521,
+										line: 1041, col: 78, offset: 38137,
 									},
 								},
 							},
@@ -7715,39 +7900,39 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedMonospaceText",
-			pos:  position{line: 1029, col: 1, offset: 37602},
+			pos:  position{line: 1045, col: 1, offset: 38218},
 			expr: &choiceExpr{
-				pos: position{line: 1030, col: 5, offset: 37631},
+				pos: position{line: 1046, col: 5, offset: 38247},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1030, col: 5, offset: 37631},
+						pos: position{line: 1046, col: 5, offset: 38247},
 						run: (*parser).callonEscapedMonospaceText2,
 						expr: &seqExpr{
-							pos: position{line: 1030, col: 5, offset: 37631},
+							pos: position{line: 1046, col: 5, offset: 38247},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1030, col: 5, offset: 37631},
+									pos:   position{line: 1046, col: 5, offset: 38247},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1030, col: 18, offset: 37644},
+										pos:  position{line: 1046, col: 18, offset: 38260},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1030, col: 40, offset: 37666},
+									pos:        position{line: 1046, col: 40, offset: 38282},
 									val:        "``",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1030, col: 45, offset: 37671},
+									pos:   position{line: 1046, col: 45, offset: 38287},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1030, col: 54, offset: 37680},
+										pos:  position{line: 1046, col: 54, offset: 38296},
 										name: "DoubleQuoteMonospaceTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1030, col: 87, offset: 37713},
+									pos:        position{line: 1046, col: 87, offset: 38329},
 									val:        "``",
 									ignoreCase: false,
 								},
@@ -7755,34 +7940,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1032, col: 9, offset: 37869},
+						pos: position{line: 1048, col: 9, offset: 38481},
 						run: (*parser).callonEscapedMonospaceText10,
 						expr: &seqExpr{
-							pos: position{line: 1032, col: 9, offset: 37869},
+							pos: position{line: 1048, col: 9, offset: 38481},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1032, col: 9, offset: 37869},
+									pos:   position{line: 1048, col: 9, offset: 38481},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1032, col: 22, offset: 37882},
+										pos:  position{line: 1048, col: 22, offset: 38494},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1032, col: 44, offset: 37904},
+									pos:        position{line: 1048, col: 44, offset: 38516},
 									val:        "``",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1032, col: 49, offset: 37909},
+									pos:   position{line: 1048, col: 49, offset: 38521},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1032, col: 58, offset: 37918},
+										pos:  position{line: 1048, col: 58, offset: 38530},
 										name: "SingleQuoteMonospaceTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1032, col: 91, offset: 37951},
+									pos:        position{line: 1048, col: 91, offset: 38563},
 									val:        "`",
 									ignoreCase: false,
 								},
@@ -7790,34 +7975,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1035, col: 9, offset: 38150},
+						pos: position{line: 1051, col: 9, offset: 38758},
 						run: (*parser).callonEscapedMonospaceText18,
 						expr: &seqExpr{
-							pos: position{line: 1035, col: 9, offset: 38150},
+							pos: position{line: 1051, col: 9, offset: 38758},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1035, col: 9, offset: 38150},
+									pos:   position{line: 1051, col: 9, offset: 38758},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1035, col: 22, offset: 38163},
+										pos:  position{line: 1051, col: 22, offset: 38771},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1035, col: 44, offset: 38185},
+									pos:        position{line: 1051, col: 44, offset: 38793},
 									val:        "`",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1035, col: 48, offset: 38189},
+									pos:   position{line: 1051, col: 48, offset: 38797},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1035, col: 57, offset: 38198},
+										pos:  position{line: 1051, col: 57, offset: 38806},
 										name: "SingleQuoteMonospaceTextContent",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1035, col: 90, offset: 38231},
+									pos:        position{line: 1051, col: 90, offset: 38839},
 									val:        "`",
 									ignoreCase: false,
 								},
@@ -7829,36 +8014,36 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptText",
-			pos:  position{line: 1039, col: 1, offset: 38380},
+			pos:  position{line: 1055, col: 1, offset: 38984},
 			expr: &actionExpr{
-				pos: position{line: 1039, col: 18, offset: 38397},
+				pos: position{line: 1055, col: 18, offset: 39001},
 				run: (*parser).callonSubscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1039, col: 18, offset: 38397},
+					pos: position{line: 1055, col: 18, offset: 39001},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1039, col: 18, offset: 38397},
+							pos: position{line: 1055, col: 18, offset: 39001},
 							expr: &litMatcher{
-								pos:        position{line: 1039, col: 19, offset: 38398},
+								pos:        position{line: 1055, col: 19, offset: 39002},
 								val:        "\\",
 								ignoreCase: false,
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1039, col: 23, offset: 38402},
+							pos:        position{line: 1055, col: 23, offset: 39006},
 							val:        "~",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1039, col: 27, offset: 38406},
+							pos:   position{line: 1055, col: 27, offset: 39010},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1039, col: 36, offset: 38415},
+								pos:  position{line: 1055, col: 36, offset: 39019},
 								name: "SubscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1039, col: 58, offset: 38437},
+							pos:        position{line: 1055, col: 58, offset: 39041},
 							val:        "~",
 							ignoreCase: false,
 						},
@@ -7868,16 +8053,16 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptTextElement",
-			pos:  position{line: 1043, col: 1, offset: 38526},
+			pos:  position{line: 1059, col: 1, offset: 39130},
 			expr: &choiceExpr{
-				pos: position{line: 1043, col: 25, offset: 38550},
+				pos: position{line: 1059, col: 25, offset: 39154},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1043, col: 25, offset: 38550},
+						pos:  position{line: 1059, col: 25, offset: 39154},
 						name: "QuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1043, col: 38, offset: 38563},
+						pos:  position{line: 1059, col: 38, offset: 39167},
 						name: "NonSubscriptText",
 					},
 				},
@@ -7885,39 +8070,39 @@ var g = &grammar{
 		},
 		{
 			name: "NonSubscriptText",
-			pos:  position{line: 1045, col: 1, offset: 38582},
+			pos:  position{line: 1061, col: 1, offset: 39186},
 			expr: &actionExpr{
-				pos: position{line: 1045, col: 21, offset: 38602},
+				pos: position{line: 1061, col: 21, offset: 39206},
 				run: (*parser).callonNonSubscriptText1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1045, col: 21, offset: 38602},
+					pos: position{line: 1061, col: 21, offset: 39206},
 					expr: &seqExpr{
-						pos: position{line: 1045, col: 22, offset: 38603},
+						pos: position{line: 1061, col: 22, offset: 39207},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1045, col: 22, offset: 38603},
+								pos: position{line: 1061, col: 22, offset: 39207},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1045, col: 23, offset: 38604},
+									pos:  position{line: 1061, col: 23, offset: 39208},
 									name: "NEWLINE",
 								},
 							},
 							&notExpr{
-								pos: position{line: 1045, col: 31, offset: 38612},
+								pos: position{line: 1061, col: 31, offset: 39216},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1045, col: 32, offset: 38613},
+									pos:  position{line: 1061, col: 32, offset: 39217},
 									name: "WS",
 								},
 							},
 							&notExpr{
-								pos: position{line: 1045, col: 35, offset: 38616},
+								pos: position{line: 1061, col: 35, offset: 39220},
 								expr: &litMatcher{
-									pos:        position{line: 1045, col: 36, offset: 38617},
+									pos:        position{line: 1061, col: 36, offset: 39221},
 									val:        "~",
 									ignoreCase: false,
 								},
 							},
 							&anyMatcher{
-								line: 1045, col: 40, offset: 38621,
+								line: 1061, col: 40, offset: 39225,
 							},
 						},
 					},
@@ -7926,36 +8111,36 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedSubscriptText",
-			pos:  position{line: 1049, col: 1, offset: 38654},
+			pos:  position{line: 1065, col: 1, offset: 39258},
 			expr: &actionExpr{
-				pos: position{line: 1049, col: 25, offset: 38678},
+				pos: position{line: 1065, col: 25, offset: 39282},
 				run: (*parser).callonEscapedSubscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1049, col: 25, offset: 38678},
+					pos: position{line: 1065, col: 25, offset: 39282},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1049, col: 25, offset: 38678},
+							pos:   position{line: 1065, col: 25, offset: 39282},
 							label: "backslashes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1049, col: 38, offset: 38691},
+								pos:  position{line: 1065, col: 38, offset: 39295},
 								name: "OneOrMoreBackslashes",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1049, col: 60, offset: 38713},
+							pos:        position{line: 1065, col: 60, offset: 39317},
 							val:        "~",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1049, col: 64, offset: 38717},
+							pos:   position{line: 1065, col: 64, offset: 39321},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1049, col: 73, offset: 38726},
+								pos:  position{line: 1065, col: 73, offset: 39330},
 								name: "SubscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1049, col: 95, offset: 38748},
+							pos:        position{line: 1065, col: 95, offset: 39352},
 							val:        "~",
 							ignoreCase: false,
 						},
@@ -7965,36 +8150,36 @@ var g = &grammar{
 		},
 		{
 			name: "SuperscriptText",
-			pos:  position{line: 1053, col: 1, offset: 38877},
+			pos:  position{line: 1069, col: 1, offset: 39477},
 			expr: &actionExpr{
-				pos: position{line: 1053, col: 20, offset: 38896},
+				pos: position{line: 1069, col: 20, offset: 39496},
 				run: (*parser).callonSuperscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1053, col: 20, offset: 38896},
+					pos: position{line: 1069, col: 20, offset: 39496},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1053, col: 20, offset: 38896},
+							pos: position{line: 1069, col: 20, offset: 39496},
 							expr: &litMatcher{
-								pos:        position{line: 1053, col: 21, offset: 38897},
+								pos:        position{line: 1069, col: 21, offset: 39497},
 								val:        "\\",
 								ignoreCase: false,
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1053, col: 25, offset: 38901},
+							pos:        position{line: 1069, col: 25, offset: 39501},
 							val:        "^",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1053, col: 29, offset: 38905},
+							pos:   position{line: 1069, col: 29, offset: 39505},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1053, col: 38, offset: 38914},
+								pos:  position{line: 1069, col: 38, offset: 39514},
 								name: "SuperscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1053, col: 62, offset: 38938},
+							pos:        position{line: 1069, col: 62, offset: 39538},
 							val:        "^",
 							ignoreCase: false,
 						},
@@ -8004,16 +8189,16 @@ var g = &grammar{
 		},
 		{
 			name: "SuperscriptTextElement",
-			pos:  position{line: 1057, col: 1, offset: 39029},
+			pos:  position{line: 1073, col: 1, offset: 39629},
 			expr: &choiceExpr{
-				pos: position{line: 1057, col: 27, offset: 39055},
+				pos: position{line: 1073, col: 27, offset: 39655},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1057, col: 27, offset: 39055},
+						pos:  position{line: 1073, col: 27, offset: 39655},
 						name: "QuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1057, col: 40, offset: 39068},
+						pos:  position{line: 1073, col: 40, offset: 39668},
 						name: "NonSuperscriptText",
 					},
 				},
@@ -8021,39 +8206,39 @@ var g = &grammar{
 		},
 		{
 			name: "NonSuperscriptText",
-			pos:  position{line: 1059, col: 1, offset: 39089},
+			pos:  position{line: 1075, col: 1, offset: 39689},
 			expr: &actionExpr{
-				pos: position{line: 1059, col: 23, offset: 39111},
+				pos: position{line: 1075, col: 23, offset: 39711},
 				run: (*parser).callonNonSuperscriptText1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1059, col: 23, offset: 39111},
+					pos: position{line: 1075, col: 23, offset: 39711},
 					expr: &seqExpr{
-						pos: position{line: 1059, col: 24, offset: 39112},
+						pos: position{line: 1075, col: 24, offset: 39712},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1059, col: 24, offset: 39112},
+								pos: position{line: 1075, col: 24, offset: 39712},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1059, col: 25, offset: 39113},
+									pos:  position{line: 1075, col: 25, offset: 39713},
 									name: "NEWLINE",
 								},
 							},
 							&notExpr{
-								pos: position{line: 1059, col: 33, offset: 39121},
+								pos: position{line: 1075, col: 33, offset: 39721},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1059, col: 34, offset: 39122},
+									pos:  position{line: 1075, col: 34, offset: 39722},
 									name: "WS",
 								},
 							},
 							&notExpr{
-								pos: position{line: 1059, col: 37, offset: 39125},
+								pos: position{line: 1075, col: 37, offset: 39725},
 								expr: &litMatcher{
-									pos:        position{line: 1059, col: 38, offset: 39126},
+									pos:        position{line: 1075, col: 38, offset: 39726},
 									val:        "^",
 									ignoreCase: false,
 								},
 							},
 							&anyMatcher{
-								line: 1059, col: 42, offset: 39130,
+								line: 1075, col: 42, offset: 39730,
 							},
 						},
 					},
@@ -8062,36 +8247,36 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedSuperscriptText",
-			pos:  position{line: 1063, col: 1, offset: 39163},
+			pos:  position{line: 1079, col: 1, offset: 39763},
 			expr: &actionExpr{
-				pos: position{line: 1063, col: 27, offset: 39189},
+				pos: position{line: 1079, col: 27, offset: 39789},
 				run: (*parser).callonEscapedSuperscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1063, col: 27, offset: 39189},
+					pos: position{line: 1079, col: 27, offset: 39789},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1063, col: 27, offset: 39189},
+							pos:   position{line: 1079, col: 27, offset: 39789},
 							label: "backslashes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1063, col: 40, offset: 39202},
+								pos:  position{line: 1079, col: 40, offset: 39802},
 								name: "OneOrMoreBackslashes",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1063, col: 62, offset: 39224},
+							pos:        position{line: 1079, col: 62, offset: 39824},
 							val:        "^",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1063, col: 66, offset: 39228},
+							pos:   position{line: 1079, col: 66, offset: 39828},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1063, col: 75, offset: 39237},
+								pos:  position{line: 1079, col: 75, offset: 39837},
 								name: "SuperscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1063, col: 99, offset: 39261},
+							pos:        position{line: 1079, col: 99, offset: 39861},
 							val:        "^",
 							ignoreCase: false,
 						},
@@ -8101,20 +8286,20 @@ var g = &grammar{
 		},
 		{
 			name: "Passthrough",
-			pos:  position{line: 1070, col: 1, offset: 39497},
+			pos:  position{line: 1086, col: 1, offset: 40093},
 			expr: &choiceExpr{
-				pos: position{line: 1070, col: 16, offset: 39512},
+				pos: position{line: 1086, col: 16, offset: 40108},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1070, col: 16, offset: 39512},
+						pos:  position{line: 1086, col: 16, offset: 40108},
 						name: "TriplePlusPassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1070, col: 40, offset: 39536},
+						pos:  position{line: 1086, col: 40, offset: 40132},
 						name: "SinglePlusPassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1070, col: 64, offset: 39560},
+						pos:  position{line: 1086, col: 64, offset: 40156},
 						name: "PassthroughMacro",
 					},
 				},
@@ -8122,42 +8307,42 @@ var g = &grammar{
 		},
 		{
 			name: "SinglePlusPassthroughPrefix",
-			pos:  position{line: 1072, col: 1, offset: 39578},
+			pos:  position{line: 1088, col: 1, offset: 40174},
 			expr: &litMatcher{
-				pos:        position{line: 1072, col: 32, offset: 39609},
+				pos:        position{line: 1088, col: 32, offset: 40205},
 				val:        "+",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "SinglePlusPassthrough",
-			pos:  position{line: 1074, col: 1, offset: 39614},
+			pos:  position{line: 1090, col: 1, offset: 40210},
 			expr: &actionExpr{
-				pos: position{line: 1074, col: 26, offset: 39639},
+				pos: position{line: 1090, col: 26, offset: 40235},
 				run: (*parser).callonSinglePlusPassthrough1,
 				expr: &seqExpr{
-					pos: position{line: 1074, col: 26, offset: 39639},
+					pos: position{line: 1090, col: 26, offset: 40235},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1074, col: 26, offset: 39639},
+							pos:  position{line: 1090, col: 26, offset: 40235},
 							name: "SinglePlusPassthroughPrefix",
 						},
 						&labeledExpr{
-							pos:   position{line: 1074, col: 54, offset: 39667},
+							pos:   position{line: 1090, col: 54, offset: 40263},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1074, col: 63, offset: 39676},
+								pos:  position{line: 1090, col: 63, offset: 40272},
 								name: "SinglePlusPassthroughContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1074, col: 93, offset: 39706},
+							pos:  position{line: 1090, col: 93, offset: 40302},
 							name: "SinglePlusPassthroughPrefix",
 						},
 						&notExpr{
-							pos: position{line: 1074, col: 121, offset: 39734},
+							pos: position{line: 1090, col: 121, offset: 40330},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1074, col: 122, offset: 39735},
+								pos:  position{line: 1090, col: 122, offset: 40331},
 								name: "Alphanum",
 							},
 						},
@@ -8167,85 +8352,85 @@ var g = &grammar{
 		},
 		{
 			name: "SinglePlusPassthroughContent",
-			pos:  position{line: 1078, col: 1, offset: 39834},
+			pos:  position{line: 1094, col: 1, offset: 40430},
 			expr: &choiceExpr{
-				pos: position{line: 1078, col: 33, offset: 39866},
+				pos: position{line: 1094, col: 33, offset: 40462},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1078, col: 34, offset: 39867},
+						pos: position{line: 1094, col: 34, offset: 40463},
 						run: (*parser).callonSinglePlusPassthroughContent2,
 						expr: &seqExpr{
-							pos: position{line: 1078, col: 34, offset: 39867},
+							pos: position{line: 1094, col: 34, offset: 40463},
 							exprs: []interface{}{
 								&seqExpr{
-									pos: position{line: 1078, col: 35, offset: 39868},
+									pos: position{line: 1094, col: 35, offset: 40464},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1078, col: 35, offset: 39868},
+											pos: position{line: 1094, col: 35, offset: 40464},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1078, col: 36, offset: 39869},
+												pos:  position{line: 1094, col: 36, offset: 40465},
 												name: "SinglePlusPassthroughPrefix",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1078, col: 64, offset: 39897},
+											pos: position{line: 1094, col: 64, offset: 40493},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1078, col: 65, offset: 39898},
+												pos:  position{line: 1094, col: 65, offset: 40494},
 												name: "WS",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1078, col: 68, offset: 39901},
+											pos: position{line: 1094, col: 68, offset: 40497},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1078, col: 69, offset: 39902},
+												pos:  position{line: 1094, col: 69, offset: 40498},
 												name: "NEWLINE",
 											},
 										},
 										&anyMatcher{
-											line: 1078, col: 77, offset: 39910,
+											line: 1094, col: 77, offset: 40506,
 										},
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1078, col: 80, offset: 39913},
+									pos: position{line: 1094, col: 80, offset: 40509},
 									expr: &seqExpr{
-										pos: position{line: 1078, col: 81, offset: 39914},
+										pos: position{line: 1094, col: 81, offset: 40510},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1078, col: 81, offset: 39914},
+												pos: position{line: 1094, col: 81, offset: 40510},
 												expr: &seqExpr{
-													pos: position{line: 1078, col: 83, offset: 39916},
+													pos: position{line: 1094, col: 83, offset: 40512},
 													exprs: []interface{}{
 														&oneOrMoreExpr{
-															pos: position{line: 1078, col: 83, offset: 39916},
+															pos: position{line: 1094, col: 83, offset: 40512},
 															expr: &ruleRefExpr{
-																pos:  position{line: 1078, col: 83, offset: 39916},
+																pos:  position{line: 1094, col: 83, offset: 40512},
 																name: "WS",
 															},
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1078, col: 87, offset: 39920},
+															pos:  position{line: 1094, col: 87, offset: 40516},
 															name: "SinglePlusPassthroughPrefix",
 														},
 													},
 												},
 											},
 											&notExpr{
-												pos: position{line: 1078, col: 116, offset: 39949},
+												pos: position{line: 1094, col: 116, offset: 40545},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1078, col: 117, offset: 39950},
+													pos:  position{line: 1094, col: 117, offset: 40546},
 													name: "SinglePlusPassthroughPrefix",
 												},
 											},
 											&notExpr{
-												pos: position{line: 1078, col: 145, offset: 39978},
+												pos: position{line: 1094, col: 145, offset: 40574},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1078, col: 146, offset: 39979},
+													pos:  position{line: 1094, col: 146, offset: 40575},
 													name: "NEWLINE",
 												},
 											},
 											&anyMatcher{
-												line: 1078, col: 154, offset: 39987,
+												line: 1094, col: 154, offset: 40583,
 											},
 										},
 									},
@@ -8254,34 +8439,34 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1080, col: 7, offset: 40129},
+						pos: position{line: 1096, col: 7, offset: 40725},
 						run: (*parser).callonSinglePlusPassthroughContent24,
 						expr: &seqExpr{
-							pos: position{line: 1080, col: 8, offset: 40130},
+							pos: position{line: 1096, col: 8, offset: 40726},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1080, col: 8, offset: 40130},
+									pos: position{line: 1096, col: 8, offset: 40726},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1080, col: 9, offset: 40131},
+										pos:  position{line: 1096, col: 9, offset: 40727},
 										name: "WS",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1080, col: 12, offset: 40134},
+									pos: position{line: 1096, col: 12, offset: 40730},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1080, col: 13, offset: 40135},
+										pos:  position{line: 1096, col: 13, offset: 40731},
 										name: "NEWLINE",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1080, col: 21, offset: 40143},
+									pos: position{line: 1096, col: 21, offset: 40739},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1080, col: 22, offset: 40144},
+										pos:  position{line: 1096, col: 22, offset: 40740},
 										name: "SinglePlusPassthroughPrefix",
 									},
 								},
 								&anyMatcher{
-									line: 1080, col: 50, offset: 40172,
+									line: 1096, col: 50, offset: 40768,
 								},
 							},
 						},
@@ -8291,42 +8476,42 @@ var g = &grammar{
 		},
 		{
 			name: "TriplePlusPassthroughPrefix",
-			pos:  position{line: 1084, col: 1, offset: 40254},
+			pos:  position{line: 1100, col: 1, offset: 40850},
 			expr: &litMatcher{
-				pos:        position{line: 1084, col: 32, offset: 40285},
+				pos:        position{line: 1100, col: 32, offset: 40881},
 				val:        "+++",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "TriplePlusPassthrough",
-			pos:  position{line: 1086, col: 1, offset: 40292},
+			pos:  position{line: 1102, col: 1, offset: 40888},
 			expr: &actionExpr{
-				pos: position{line: 1086, col: 26, offset: 40317},
+				pos: position{line: 1102, col: 26, offset: 40913},
 				run: (*parser).callonTriplePlusPassthrough1,
 				expr: &seqExpr{
-					pos: position{line: 1086, col: 26, offset: 40317},
+					pos: position{line: 1102, col: 26, offset: 40913},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1086, col: 26, offset: 40317},
+							pos:  position{line: 1102, col: 26, offset: 40913},
 							name: "TriplePlusPassthroughPrefix",
 						},
 						&labeledExpr{
-							pos:   position{line: 1086, col: 54, offset: 40345},
+							pos:   position{line: 1102, col: 54, offset: 40941},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1086, col: 63, offset: 40354},
+								pos:  position{line: 1102, col: 63, offset: 40950},
 								name: "TriplePlusPassthroughContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1086, col: 93, offset: 40384},
+							pos:  position{line: 1102, col: 93, offset: 40980},
 							name: "TriplePlusPassthroughPrefix",
 						},
 						&notExpr{
-							pos: position{line: 1086, col: 121, offset: 40412},
+							pos: position{line: 1102, col: 121, offset: 41008},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1086, col: 122, offset: 40413},
+								pos:  position{line: 1102, col: 122, offset: 41009},
 								name: "Alphanum",
 							},
 						},
@@ -8336,63 +8521,63 @@ var g = &grammar{
 		},
 		{
 			name: "TriplePlusPassthroughContent",
-			pos:  position{line: 1090, col: 1, offset: 40512},
+			pos:  position{line: 1106, col: 1, offset: 41108},
 			expr: &choiceExpr{
-				pos: position{line: 1090, col: 33, offset: 40544},
+				pos: position{line: 1106, col: 33, offset: 41140},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1090, col: 34, offset: 40545},
+						pos: position{line: 1106, col: 34, offset: 41141},
 						run: (*parser).callonTriplePlusPassthroughContent2,
 						expr: &zeroOrMoreExpr{
-							pos: position{line: 1090, col: 34, offset: 40545},
+							pos: position{line: 1106, col: 34, offset: 41141},
 							expr: &seqExpr{
-								pos: position{line: 1090, col: 35, offset: 40546},
+								pos: position{line: 1106, col: 35, offset: 41142},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1090, col: 35, offset: 40546},
+										pos: position{line: 1106, col: 35, offset: 41142},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1090, col: 36, offset: 40547},
+											pos:  position{line: 1106, col: 36, offset: 41143},
 											name: "TriplePlusPassthroughPrefix",
 										},
 									},
 									&anyMatcher{
-										line: 1090, col: 64, offset: 40575,
+										line: 1106, col: 64, offset: 41171,
 									},
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1092, col: 7, offset: 40740},
+						pos: position{line: 1108, col: 7, offset: 41336},
 						run: (*parser).callonTriplePlusPassthroughContent8,
 						expr: &zeroOrOneExpr{
-							pos: position{line: 1092, col: 7, offset: 40740},
+							pos: position{line: 1108, col: 7, offset: 41336},
 							expr: &seqExpr{
-								pos: position{line: 1092, col: 8, offset: 40741},
+								pos: position{line: 1108, col: 8, offset: 41337},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1092, col: 8, offset: 40741},
+										pos: position{line: 1108, col: 8, offset: 41337},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1092, col: 9, offset: 40742},
+											pos:  position{line: 1108, col: 9, offset: 41338},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1092, col: 12, offset: 40745},
+										pos: position{line: 1108, col: 12, offset: 41341},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1092, col: 13, offset: 40746},
+											pos:  position{line: 1108, col: 13, offset: 41342},
 											name: "NEWLINE",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1092, col: 21, offset: 40754},
+										pos: position{line: 1108, col: 21, offset: 41350},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1092, col: 22, offset: 40755},
+											pos:  position{line: 1108, col: 22, offset: 41351},
 											name: "TriplePlusPassthroughPrefix",
 										},
 									},
 									&anyMatcher{
-										line: 1092, col: 50, offset: 40783,
+										line: 1108, col: 50, offset: 41379,
 									},
 								},
 							},
@@ -8403,34 +8588,34 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughMacro",
-			pos:  position{line: 1096, col: 1, offset: 40866},
+			pos:  position{line: 1112, col: 1, offset: 41462},
 			expr: &choiceExpr{
-				pos: position{line: 1096, col: 21, offset: 40886},
+				pos: position{line: 1112, col: 21, offset: 41482},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1096, col: 21, offset: 40886},
+						pos: position{line: 1112, col: 21, offset: 41482},
 						run: (*parser).callonPassthroughMacro2,
 						expr: &seqExpr{
-							pos: position{line: 1096, col: 21, offset: 40886},
+							pos: position{line: 1112, col: 21, offset: 41482},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1096, col: 21, offset: 40886},
+									pos:        position{line: 1112, col: 21, offset: 41482},
 									val:        "pass:[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1096, col: 30, offset: 40895},
+									pos:   position{line: 1112, col: 30, offset: 41491},
 									label: "content",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1096, col: 38, offset: 40903},
+										pos: position{line: 1112, col: 38, offset: 41499},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1096, col: 39, offset: 40904},
+											pos:  position{line: 1112, col: 39, offset: 41500},
 											name: "PassthroughMacroCharacter",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1096, col: 67, offset: 40932},
+									pos:        position{line: 1112, col: 67, offset: 41528},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -8438,30 +8623,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1098, col: 5, offset: 41022},
+						pos: position{line: 1114, col: 5, offset: 41618},
 						run: (*parser).callonPassthroughMacro9,
 						expr: &seqExpr{
-							pos: position{line: 1098, col: 5, offset: 41022},
+							pos: position{line: 1114, col: 5, offset: 41618},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1098, col: 5, offset: 41022},
+									pos:        position{line: 1114, col: 5, offset: 41618},
 									val:        "pass:q[",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1098, col: 15, offset: 41032},
+									pos:   position{line: 1114, col: 15, offset: 41628},
 									label: "content",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1098, col: 23, offset: 41040},
+										pos: position{line: 1114, col: 23, offset: 41636},
 										expr: &choiceExpr{
-											pos: position{line: 1098, col: 24, offset: 41041},
+											pos: position{line: 1114, col: 24, offset: 41637},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 1098, col: 24, offset: 41041},
+													pos:  position{line: 1114, col: 24, offset: 41637},
 													name: "QuotedText",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1098, col: 37, offset: 41054},
+													pos:  position{line: 1114, col: 37, offset: 41650},
 													name: "PassthroughMacroCharacter",
 												},
 											},
@@ -8469,7 +8654,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1098, col: 65, offset: 41082},
+									pos:        position{line: 1114, col: 65, offset: 41678},
 									val:        "]",
 									ignoreCase: false,
 								},
@@ -8481,34 +8666,34 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughMacroCharacter",
-			pos:  position{line: 1102, col: 1, offset: 41172},
+			pos:  position{line: 1118, col: 1, offset: 41768},
 			expr: &choiceExpr{
-				pos: position{line: 1102, col: 31, offset: 41202},
+				pos: position{line: 1118, col: 31, offset: 41798},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1102, col: 31, offset: 41202},
+						pos:  position{line: 1118, col: 31, offset: 41798},
 						name: "Alphanums",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1102, col: 43, offset: 41214},
+						pos:  position{line: 1118, col: 43, offset: 41810},
 						name: "Spaces",
 					},
 					&actionExpr{
-						pos: position{line: 1102, col: 52, offset: 41223},
+						pos: position{line: 1118, col: 52, offset: 41819},
 						run: (*parser).callonPassthroughMacroCharacter4,
 						expr: &seqExpr{
-							pos: position{line: 1102, col: 53, offset: 41224},
+							pos: position{line: 1118, col: 53, offset: 41820},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1102, col: 53, offset: 41224},
+									pos: position{line: 1118, col: 53, offset: 41820},
 									expr: &litMatcher{
-										pos:        position{line: 1102, col: 54, offset: 41225},
+										pos:        position{line: 1118, col: 54, offset: 41821},
 										val:        "]",
 										ignoreCase: false,
 									},
 								},
 								&anyMatcher{
-									line: 1102, col: 58, offset: 41229,
+									line: 1118, col: 58, offset: 41825,
 								},
 							},
 						},
@@ -8518,16 +8703,16 @@ var g = &grammar{
 		},
 		{
 			name: "CrossReference",
-			pos:  position{line: 1109, col: 1, offset: 41399},
+			pos:  position{line: 1125, col: 1, offset: 41995},
 			expr: &choiceExpr{
-				pos: position{line: 1109, col: 19, offset: 41417},
+				pos: position{line: 1125, col: 19, offset: 42013},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1109, col: 19, offset: 41417},
+						pos:  position{line: 1125, col: 19, offset: 42013},
 						name: "InternalCrossReference",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1109, col: 44, offset: 41442},
+						pos:  position{line: 1125, col: 44, offset: 42038},
 						name: "ExternalCrossReference",
 					},
 				},
@@ -8535,51 +8720,51 @@ var g = &grammar{
 		},
 		{
 			name: "InternalCrossReference",
-			pos:  position{line: 1111, col: 1, offset: 41467},
+			pos:  position{line: 1127, col: 1, offset: 42063},
 			expr: &choiceExpr{
-				pos: position{line: 1111, col: 27, offset: 41493},
+				pos: position{line: 1127, col: 27, offset: 42089},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1111, col: 27, offset: 41493},
+						pos: position{line: 1127, col: 27, offset: 42089},
 						run: (*parser).callonInternalCrossReference2,
 						expr: &seqExpr{
-							pos: position{line: 1111, col: 27, offset: 41493},
+							pos: position{line: 1127, col: 27, offset: 42089},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1111, col: 27, offset: 41493},
+									pos:        position{line: 1127, col: 27, offset: 42089},
 									val:        "<<",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1111, col: 32, offset: 41498},
+									pos:   position{line: 1127, col: 32, offset: 42094},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1111, col: 36, offset: 41502},
+										pos:  position{line: 1127, col: 36, offset: 42098},
 										name: "ID",
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1111, col: 40, offset: 41506},
+									pos: position{line: 1127, col: 40, offset: 42102},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1111, col: 40, offset: 41506},
+										pos:  position{line: 1127, col: 40, offset: 42102},
 										name: "WS",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1111, col: 44, offset: 41510},
+									pos:        position{line: 1127, col: 44, offset: 42106},
 									val:        ",",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1111, col: 48, offset: 41514},
+									pos:   position{line: 1127, col: 48, offset: 42110},
 									label: "label",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1111, col: 55, offset: 41521},
+										pos:  position{line: 1127, col: 55, offset: 42117},
 										name: "CrossReferenceLabel",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1111, col: 76, offset: 41542},
+									pos:        position{line: 1127, col: 76, offset: 42138},
 									val:        ">>",
 									ignoreCase: false,
 								},
@@ -8587,26 +8772,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1113, col: 5, offset: 41625},
+						pos: position{line: 1129, col: 5, offset: 42221},
 						run: (*parser).callonInternalCrossReference13,
 						expr: &seqExpr{
-							pos: position{line: 1113, col: 5, offset: 41625},
+							pos: position{line: 1129, col: 5, offset: 42221},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1113, col: 5, offset: 41625},
+									pos:        position{line: 1129, col: 5, offset: 42221},
 									val:        "<<",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1113, col: 10, offset: 41630},
+									pos:   position{line: 1129, col: 10, offset: 42226},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1113, col: 14, offset: 41634},
+										pos:  position{line: 1129, col: 14, offset: 42230},
 										name: "ID",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1113, col: 18, offset: 41638},
+									pos:        position{line: 1129, col: 18, offset: 42234},
 									val:        ">>",
 									ignoreCase: false,
 								},
@@ -8618,31 +8803,31 @@ var g = &grammar{
 		},
 		{
 			name: "ExternalCrossReference",
-			pos:  position{line: 1117, col: 1, offset: 41710},
+			pos:  position{line: 1133, col: 1, offset: 42306},
 			expr: &actionExpr{
-				pos: position{line: 1117, col: 27, offset: 41736},
+				pos: position{line: 1133, col: 27, offset: 42332},
 				run: (*parser).callonExternalCrossReference1,
 				expr: &seqExpr{
-					pos: position{line: 1117, col: 27, offset: 41736},
+					pos: position{line: 1133, col: 27, offset: 42332},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1117, col: 27, offset: 41736},
+							pos:        position{line: 1133, col: 27, offset: 42332},
 							val:        "xref:",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1117, col: 35, offset: 41744},
+							pos:   position{line: 1133, col: 35, offset: 42340},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1117, col: 40, offset: 41749},
+								pos:  position{line: 1133, col: 40, offset: 42345},
 								name: "FileLocation",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1117, col: 54, offset: 41763},
+							pos:   position{line: 1133, col: 54, offset: 42359},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1117, col: 72, offset: 41781},
+								pos:  position{line: 1133, col: 72, offset: 42377},
 								name: "LinkAttributes",
 							},
 						},
@@ -8652,36 +8837,36 @@ var g = &grammar{
 		},
 		{
 			name: "CrossReferenceLabel",
-			pos:  position{line: 1121, col: 1, offset: 41911},
+			pos:  position{line: 1137, col: 1, offset: 42507},
 			expr: &actionExpr{
-				pos: position{line: 1121, col: 24, offset: 41934},
+				pos: position{line: 1137, col: 24, offset: 42530},
 				run: (*parser).callonCrossReferenceLabel1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1121, col: 24, offset: 41934},
+					pos: position{line: 1137, col: 24, offset: 42530},
 					expr: &choiceExpr{
-						pos: position{line: 1121, col: 25, offset: 41935},
+						pos: position{line: 1137, col: 25, offset: 42531},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1121, col: 25, offset: 41935},
+								pos:  position{line: 1137, col: 25, offset: 42531},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1121, col: 37, offset: 41947},
+								pos:  position{line: 1137, col: 37, offset: 42543},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 1121, col: 47, offset: 41957},
+								pos: position{line: 1137, col: 47, offset: 42553},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1121, col: 47, offset: 41957},
+										pos: position{line: 1137, col: 47, offset: 42553},
 										expr: &litMatcher{
-											pos:        position{line: 1121, col: 48, offset: 41958},
+											pos:        position{line: 1137, col: 48, offset: 42554},
 											val:        ">>",
 											ignoreCase: false,
 										},
 									},
 									&anyMatcher{
-										line: 1121, col: 54, offset: 41964,
+										line: 1137, col: 54, offset: 42560,
 									},
 								},
 							},
@@ -8692,16 +8877,16 @@ var g = &grammar{
 		},
 		{
 			name: "Link",
-			pos:  position{line: 1128, col: 1, offset: 42106},
+			pos:  position{line: 1144, col: 1, offset: 42702},
 			expr: &choiceExpr{
-				pos: position{line: 1128, col: 9, offset: 42114},
+				pos: position{line: 1144, col: 9, offset: 42710},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1128, col: 9, offset: 42114},
+						pos:  position{line: 1144, col: 9, offset: 42710},
 						name: "RelativeLink",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1128, col: 24, offset: 42129},
+						pos:  position{line: 1144, col: 24, offset: 42725},
 						name: "ExternalLink",
 					},
 				},
@@ -8709,40 +8894,40 @@ var g = &grammar{
 		},
 		{
 			name: "RelativeLink",
-			pos:  position{line: 1131, col: 1, offset: 42210},
+			pos:  position{line: 1147, col: 1, offset: 42806},
 			expr: &actionExpr{
-				pos: position{line: 1131, col: 17, offset: 42226},
+				pos: position{line: 1147, col: 17, offset: 42822},
 				run: (*parser).callonRelativeLink1,
 				expr: &seqExpr{
-					pos: position{line: 1131, col: 17, offset: 42226},
+					pos: position{line: 1147, col: 17, offset: 42822},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1131, col: 17, offset: 42226},
+							pos:        position{line: 1147, col: 17, offset: 42822},
 							val:        "link:",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1131, col: 25, offset: 42234},
+							pos:   position{line: 1147, col: 25, offset: 42830},
 							label: "url",
 							expr: &choiceExpr{
-								pos: position{line: 1131, col: 30, offset: 42239},
+								pos: position{line: 1147, col: 30, offset: 42835},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1131, col: 30, offset: 42239},
+										pos:  position{line: 1147, col: 30, offset: 42835},
 										name: "Location",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1131, col: 41, offset: 42250},
+										pos:  position{line: 1147, col: 41, offset: 42846},
 										name: "FileLocation",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1131, col: 55, offset: 42264},
+							pos:   position{line: 1147, col: 55, offset: 42860},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1131, col: 73, offset: 42282},
+								pos:  position{line: 1147, col: 73, offset: 42878},
 								name: "LinkAttributes",
 							},
 						},
@@ -8752,28 +8937,28 @@ var g = &grammar{
 		},
 		{
 			name: "ExternalLink",
-			pos:  position{line: 1135, col: 1, offset: 42400},
+			pos:  position{line: 1151, col: 1, offset: 42996},
 			expr: &actionExpr{
-				pos: position{line: 1135, col: 17, offset: 42416},
+				pos: position{line: 1151, col: 17, offset: 43012},
 				run: (*parser).callonExternalLink1,
 				expr: &seqExpr{
-					pos: position{line: 1135, col: 17, offset: 42416},
+					pos: position{line: 1151, col: 17, offset: 43012},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1135, col: 17, offset: 42416},
+							pos:   position{line: 1151, col: 17, offset: 43012},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1135, col: 22, offset: 42421},
+								pos:  position{line: 1151, col: 22, offset: 43017},
 								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1135, col: 32, offset: 42431},
+							pos:   position{line: 1151, col: 32, offset: 43027},
 							label: "inlineAttributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1135, col: 49, offset: 42448},
+								pos: position{line: 1151, col: 49, offset: 43044},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1135, col: 50, offset: 42449},
+									pos:  position{line: 1151, col: 50, offset: 43045},
 									name: "LinkAttributes",
 								},
 							},
@@ -8784,16 +8969,16 @@ var g = &grammar{
 		},
 		{
 			name: "LinkAttributes",
-			pos:  position{line: 1139, col: 1, offset: 42542},
+			pos:  position{line: 1155, col: 1, offset: 43138},
 			expr: &choiceExpr{
-				pos: position{line: 1139, col: 19, offset: 42560},
+				pos: position{line: 1155, col: 19, offset: 43156},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1139, col: 19, offset: 42560},
+						pos:  position{line: 1155, col: 19, offset: 43156},
 						name: "TextOnlyLinkAttributes",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1139, col: 44, offset: 42585},
+						pos:  position{line: 1155, col: 44, offset: 43181},
 						name: "TextAndMoreLinkAttributes",
 					},
 				},
@@ -8801,31 +8986,31 @@ var g = &grammar{
 		},
 		{
 			name: "TextOnlyLinkAttributes",
-			pos:  position{line: 1141, col: 1, offset: 42612},
+			pos:  position{line: 1157, col: 1, offset: 43208},
 			expr: &actionExpr{
-				pos: position{line: 1141, col: 27, offset: 42638},
+				pos: position{line: 1157, col: 27, offset: 43234},
 				run: (*parser).callonTextOnlyLinkAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 1141, col: 27, offset: 42638},
+					pos: position{line: 1157, col: 27, offset: 43234},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1141, col: 27, offset: 42638},
+							pos:        position{line: 1157, col: 27, offset: 43234},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1141, col: 31, offset: 42642},
+							pos:   position{line: 1157, col: 31, offset: 43238},
 							label: "text",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1141, col: 36, offset: 42647},
+								pos: position{line: 1157, col: 36, offset: 43243},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1141, col: 37, offset: 42648},
+									pos:  position{line: 1157, col: 37, offset: 43244},
 									name: "LinkTextWithCommaAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1141, col: 66, offset: 42677},
+							pos:        position{line: 1157, col: 66, offset: 43273},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -8835,83 +9020,83 @@ var g = &grammar{
 		},
 		{
 			name: "LinkTextWithCommaAttribute",
-			pos:  position{line: 1145, col: 1, offset: 42739},
+			pos:  position{line: 1161, col: 1, offset: 43335},
 			expr: &choiceExpr{
-				pos: position{line: 1147, col: 5, offset: 42811},
+				pos: position{line: 1163, col: 5, offset: 43407},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1147, col: 5, offset: 42811},
+						pos: position{line: 1163, col: 5, offset: 43407},
 						run: (*parser).callonLinkTextWithCommaAttribute2,
 						expr: &seqExpr{
-							pos: position{line: 1147, col: 5, offset: 42811},
+							pos: position{line: 1163, col: 5, offset: 43407},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1147, col: 5, offset: 42811},
+									pos:        position{line: 1163, col: 5, offset: 43407},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1147, col: 10, offset: 42816},
+									pos:   position{line: 1163, col: 10, offset: 43412},
 									label: "elements",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1147, col: 19, offset: 42825},
+										pos: position{line: 1163, col: 19, offset: 43421},
 										expr: &seqExpr{
-											pos: position{line: 1147, col: 20, offset: 42826},
+											pos: position{line: 1163, col: 20, offset: 43422},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 1147, col: 20, offset: 42826},
+													pos: position{line: 1163, col: 20, offset: 43422},
 													expr: &litMatcher{
-														pos:        position{line: 1147, col: 21, offset: 42827},
+														pos:        position{line: 1163, col: 21, offset: 43423},
 														val:        "=",
 														ignoreCase: false,
 													},
 												},
 												&notExpr{
-													pos: position{line: 1147, col: 25, offset: 42831},
+													pos: position{line: 1163, col: 25, offset: 43427},
 													expr: &litMatcher{
-														pos:        position{line: 1147, col: 26, offset: 42832},
+														pos:        position{line: 1163, col: 26, offset: 43428},
 														val:        "]",
 														ignoreCase: false,
 													},
 												},
 												&notExpr{
-													pos: position{line: 1147, col: 30, offset: 42836},
+													pos: position{line: 1163, col: 30, offset: 43432},
 													expr: &litMatcher{
-														pos:        position{line: 1147, col: 31, offset: 42837},
+														pos:        position{line: 1163, col: 31, offset: 43433},
 														val:        "\"",
 														ignoreCase: false,
 													},
 												},
 												&choiceExpr{
-													pos: position{line: 1147, col: 37, offset: 42843},
+													pos: position{line: 1163, col: 37, offset: 43439},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 1147, col: 37, offset: 42843},
+															pos:  position{line: 1163, col: 37, offset: 43439},
 															name: "QuotedText",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1147, col: 50, offset: 42856},
+															pos:  position{line: 1163, col: 50, offset: 43452},
 															name: "SimpleWord",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1147, col: 63, offset: 42869},
+															pos:  position{line: 1163, col: 63, offset: 43465},
 															name: "Spaces",
 														},
 														&actionExpr{
-															pos: position{line: 1147, col: 73, offset: 42879},
+															pos: position{line: 1163, col: 73, offset: 43475},
 															run: (*parser).callonLinkTextWithCommaAttribute18,
 															expr: &seqExpr{
-																pos: position{line: 1147, col: 74, offset: 42880},
+																pos: position{line: 1163, col: 74, offset: 43476},
 																exprs: []interface{}{
 																	&notExpr{
-																		pos: position{line: 1147, col: 74, offset: 42880},
+																		pos: position{line: 1163, col: 74, offset: 43476},
 																		expr: &ruleRefExpr{
-																			pos:  position{line: 1147, col: 75, offset: 42881},
+																			pos:  position{line: 1163, col: 75, offset: 43477},
 																			name: "WS",
 																		},
 																	},
 																	&anyMatcher{
-																		line: 1147, col: 78, offset: 42884,
+																		line: 1163, col: 78, offset: 43480,
 																	},
 																},
 															},
@@ -8923,23 +9108,23 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1149, col: 11, offset: 42953},
+									pos:        position{line: 1165, col: 11, offset: 43549},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1149, col: 16, offset: 42958},
+									pos: position{line: 1165, col: 16, offset: 43554},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1149, col: 16, offset: 42958},
+										pos:  position{line: 1165, col: 16, offset: 43554},
 										name: "Spaces",
 									},
 								},
 								&andExpr{
-									pos: position{line: 1149, col: 24, offset: 42966},
+									pos: position{line: 1165, col: 24, offset: 43562},
 									expr: &notExpr{
-										pos: position{line: 1149, col: 26, offset: 42968},
+										pos: position{line: 1165, col: 26, offset: 43564},
 										expr: &litMatcher{
-											pos:        position{line: 1149, col: 27, offset: 42969},
+											pos:        position{line: 1165, col: 27, offset: 43565},
 											val:        "=",
 											ignoreCase: false,
 										},
@@ -8949,65 +9134,65 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1153, col: 5, offset: 43094},
+						pos: position{line: 1169, col: 5, offset: 43690},
 						run: (*parser).callonLinkTextWithCommaAttribute29,
 						expr: &seqExpr{
-							pos: position{line: 1153, col: 5, offset: 43094},
+							pos: position{line: 1169, col: 5, offset: 43690},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1153, col: 5, offset: 43094},
+									pos:   position{line: 1169, col: 5, offset: 43690},
 									label: "elements",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1153, col: 14, offset: 43103},
+										pos: position{line: 1169, col: 14, offset: 43699},
 										expr: &seqExpr{
-											pos: position{line: 1153, col: 15, offset: 43104},
+											pos: position{line: 1169, col: 15, offset: 43700},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 1153, col: 15, offset: 43104},
+													pos: position{line: 1169, col: 15, offset: 43700},
 													expr: &litMatcher{
-														pos:        position{line: 1153, col: 16, offset: 43105},
+														pos:        position{line: 1169, col: 16, offset: 43701},
 														val:        "=",
 														ignoreCase: false,
 													},
 												},
 												&notExpr{
-													pos: position{line: 1153, col: 20, offset: 43109},
+													pos: position{line: 1169, col: 20, offset: 43705},
 													expr: &litMatcher{
-														pos:        position{line: 1153, col: 21, offset: 43110},
+														pos:        position{line: 1169, col: 21, offset: 43706},
 														val:        "]",
 														ignoreCase: false,
 													},
 												},
 												&choiceExpr{
-													pos: position{line: 1153, col: 26, offset: 43115},
+													pos: position{line: 1169, col: 26, offset: 43711},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 1153, col: 26, offset: 43115},
+															pos:  position{line: 1169, col: 26, offset: 43711},
 															name: "QuotedText",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1153, col: 39, offset: 43128},
+															pos:  position{line: 1169, col: 39, offset: 43724},
 															name: "SimpleWord",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1153, col: 52, offset: 43141},
+															pos:  position{line: 1169, col: 52, offset: 43737},
 															name: "Spaces",
 														},
 														&actionExpr{
-															pos: position{line: 1153, col: 62, offset: 43151},
+															pos: position{line: 1169, col: 62, offset: 43747},
 															run: (*parser).callonLinkTextWithCommaAttribute42,
 															expr: &seqExpr{
-																pos: position{line: 1153, col: 63, offset: 43152},
+																pos: position{line: 1169, col: 63, offset: 43748},
 																exprs: []interface{}{
 																	&notExpr{
-																		pos: position{line: 1153, col: 63, offset: 43152},
+																		pos: position{line: 1169, col: 63, offset: 43748},
 																		expr: &ruleRefExpr{
-																			pos:  position{line: 1153, col: 64, offset: 43153},
+																			pos:  position{line: 1169, col: 64, offset: 43749},
 																			name: "WS",
 																		},
 																	},
 																	&anyMatcher{
-																		line: 1153, col: 67, offset: 43156,
+																		line: 1169, col: 67, offset: 43752,
 																	},
 																},
 															},
@@ -9019,11 +9204,11 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 1155, col: 11, offset: 43225},
+									pos: position{line: 1171, col: 11, offset: 43821},
 									expr: &notExpr{
-										pos: position{line: 1155, col: 13, offset: 43227},
+										pos: position{line: 1171, col: 13, offset: 43823},
 										expr: &litMatcher{
-											pos:        position{line: 1155, col: 14, offset: 43228},
+											pos:        position{line: 1171, col: 14, offset: 43824},
 											val:        "=",
 											ignoreCase: false,
 										},
@@ -9037,57 +9222,57 @@ var g = &grammar{
 		},
 		{
 			name: "TextAndMoreLinkAttributes",
-			pos:  position{line: 1160, col: 1, offset: 43308},
+			pos:  position{line: 1176, col: 1, offset: 43904},
 			expr: &actionExpr{
-				pos: position{line: 1160, col: 30, offset: 43337},
+				pos: position{line: 1176, col: 30, offset: 43933},
 				run: (*parser).callonTextAndMoreLinkAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 1160, col: 30, offset: 43337},
+					pos: position{line: 1176, col: 30, offset: 43933},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1160, col: 30, offset: 43337},
+							pos:        position{line: 1176, col: 30, offset: 43933},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1160, col: 34, offset: 43341},
+							pos:   position{line: 1176, col: 34, offset: 43937},
 							label: "text",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1160, col: 39, offset: 43346},
+								pos: position{line: 1176, col: 39, offset: 43942},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1160, col: 40, offset: 43347},
+									pos:  position{line: 1176, col: 40, offset: 43943},
 									name: "LinkTextAttribute",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1160, col: 60, offset: 43367},
+							pos: position{line: 1176, col: 60, offset: 43963},
 							expr: &litMatcher{
-								pos:        position{line: 1160, col: 60, offset: 43367},
+								pos:        position{line: 1176, col: 60, offset: 43963},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1160, col: 65, offset: 43372},
+							pos: position{line: 1176, col: 65, offset: 43968},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1160, col: 65, offset: 43372},
+								pos:  position{line: 1176, col: 65, offset: 43968},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1160, col: 69, offset: 43376},
+							pos:   position{line: 1176, col: 69, offset: 43972},
 							label: "otherattrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1160, col: 80, offset: 43387},
+								pos: position{line: 1176, col: 80, offset: 43983},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1160, col: 81, offset: 43388},
+									pos:  position{line: 1176, col: 81, offset: 43984},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1160, col: 100, offset: 43407},
+							pos:        position{line: 1176, col: 100, offset: 44003},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -9097,83 +9282,83 @@ var g = &grammar{
 		},
 		{
 			name: "LinkTextAttribute",
-			pos:  position{line: 1164, col: 1, offset: 43492},
+			pos:  position{line: 1180, col: 1, offset: 44088},
 			expr: &choiceExpr{
-				pos: position{line: 1166, col: 5, offset: 43555},
+				pos: position{line: 1182, col: 5, offset: 44151},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1166, col: 5, offset: 43555},
+						pos: position{line: 1182, col: 5, offset: 44151},
 						run: (*parser).callonLinkTextAttribute2,
 						expr: &seqExpr{
-							pos: position{line: 1166, col: 5, offset: 43555},
+							pos: position{line: 1182, col: 5, offset: 44151},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1166, col: 5, offset: 43555},
+									pos:        position{line: 1182, col: 5, offset: 44151},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 1166, col: 10, offset: 43560},
+									pos:   position{line: 1182, col: 10, offset: 44156},
 									label: "elements",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1166, col: 19, offset: 43569},
+										pos: position{line: 1182, col: 19, offset: 44165},
 										expr: &seqExpr{
-											pos: position{line: 1166, col: 20, offset: 43570},
+											pos: position{line: 1182, col: 20, offset: 44166},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 1166, col: 20, offset: 43570},
+													pos: position{line: 1182, col: 20, offset: 44166},
 													expr: &litMatcher{
-														pos:        position{line: 1166, col: 21, offset: 43571},
+														pos:        position{line: 1182, col: 21, offset: 44167},
 														val:        "=",
 														ignoreCase: false,
 													},
 												},
 												&notExpr{
-													pos: position{line: 1166, col: 25, offset: 43575},
+													pos: position{line: 1182, col: 25, offset: 44171},
 													expr: &litMatcher{
-														pos:        position{line: 1166, col: 26, offset: 43576},
+														pos:        position{line: 1182, col: 26, offset: 44172},
 														val:        "]",
 														ignoreCase: false,
 													},
 												},
 												&notExpr{
-													pos: position{line: 1166, col: 30, offset: 43580},
+													pos: position{line: 1182, col: 30, offset: 44176},
 													expr: &litMatcher{
-														pos:        position{line: 1166, col: 31, offset: 43581},
+														pos:        position{line: 1182, col: 31, offset: 44177},
 														val:        "\"",
 														ignoreCase: false,
 													},
 												},
 												&choiceExpr{
-													pos: position{line: 1166, col: 37, offset: 43587},
+													pos: position{line: 1182, col: 37, offset: 44183},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 1166, col: 37, offset: 43587},
+															pos:  position{line: 1182, col: 37, offset: 44183},
 															name: "QuotedText",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1166, col: 50, offset: 43600},
+															pos:  position{line: 1182, col: 50, offset: 44196},
 															name: "SimpleWord",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1166, col: 63, offset: 43613},
+															pos:  position{line: 1182, col: 63, offset: 44209},
 															name: "Spaces",
 														},
 														&actionExpr{
-															pos: position{line: 1166, col: 73, offset: 43623},
+															pos: position{line: 1182, col: 73, offset: 44219},
 															run: (*parser).callonLinkTextAttribute18,
 															expr: &seqExpr{
-																pos: position{line: 1166, col: 74, offset: 43624},
+																pos: position{line: 1182, col: 74, offset: 44220},
 																exprs: []interface{}{
 																	&notExpr{
-																		pos: position{line: 1166, col: 74, offset: 43624},
+																		pos: position{line: 1182, col: 74, offset: 44220},
 																		expr: &ruleRefExpr{
-																			pos:  position{line: 1166, col: 75, offset: 43625},
+																			pos:  position{line: 1182, col: 75, offset: 44221},
 																			name: "QuotedTextPrefix",
 																		},
 																	},
 																	&anyMatcher{
-																		line: 1166, col: 92, offset: 43642,
+																		line: 1182, col: 92, offset: 44238,
 																	},
 																},
 															},
@@ -9185,23 +9370,23 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1168, col: 11, offset: 43711},
+									pos:        position{line: 1184, col: 11, offset: 44307},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1168, col: 16, offset: 43716},
+									pos: position{line: 1184, col: 16, offset: 44312},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1168, col: 16, offset: 43716},
+										pos:  position{line: 1184, col: 16, offset: 44312},
 										name: "Spaces",
 									},
 								},
 								&andExpr{
-									pos: position{line: 1168, col: 24, offset: 43724},
+									pos: position{line: 1184, col: 24, offset: 44320},
 									expr: &notExpr{
-										pos: position{line: 1168, col: 26, offset: 43726},
+										pos: position{line: 1184, col: 26, offset: 44322},
 										expr: &litMatcher{
-											pos:        position{line: 1168, col: 27, offset: 43727},
+											pos:        position{line: 1184, col: 27, offset: 44323},
 											val:        "=",
 											ignoreCase: false,
 										},
@@ -9211,73 +9396,73 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1172, col: 5, offset: 43852},
+						pos: position{line: 1188, col: 5, offset: 44448},
 						run: (*parser).callonLinkTextAttribute29,
 						expr: &seqExpr{
-							pos: position{line: 1172, col: 5, offset: 43852},
+							pos: position{line: 1188, col: 5, offset: 44448},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1172, col: 5, offset: 43852},
+									pos:   position{line: 1188, col: 5, offset: 44448},
 									label: "elements",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1172, col: 14, offset: 43861},
+										pos: position{line: 1188, col: 14, offset: 44457},
 										expr: &seqExpr{
-											pos: position{line: 1172, col: 15, offset: 43862},
+											pos: position{line: 1188, col: 15, offset: 44458},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 1172, col: 15, offset: 43862},
+													pos: position{line: 1188, col: 15, offset: 44458},
 													expr: &litMatcher{
-														pos:        position{line: 1172, col: 16, offset: 43863},
+														pos:        position{line: 1188, col: 16, offset: 44459},
 														val:        "=",
 														ignoreCase: false,
 													},
 												},
 												&notExpr{
-													pos: position{line: 1172, col: 20, offset: 43867},
+													pos: position{line: 1188, col: 20, offset: 44463},
 													expr: &litMatcher{
-														pos:        position{line: 1172, col: 21, offset: 43868},
+														pos:        position{line: 1188, col: 21, offset: 44464},
 														val:        ",",
 														ignoreCase: false,
 													},
 												},
 												&notExpr{
-													pos: position{line: 1172, col: 25, offset: 43872},
+													pos: position{line: 1188, col: 25, offset: 44468},
 													expr: &litMatcher{
-														pos:        position{line: 1172, col: 26, offset: 43873},
+														pos:        position{line: 1188, col: 26, offset: 44469},
 														val:        "]",
 														ignoreCase: false,
 													},
 												},
 												&choiceExpr{
-													pos: position{line: 1172, col: 31, offset: 43878},
+													pos: position{line: 1188, col: 31, offset: 44474},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 1172, col: 31, offset: 43878},
+															pos:  position{line: 1188, col: 31, offset: 44474},
 															name: "QuotedText",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1172, col: 44, offset: 43891},
+															pos:  position{line: 1188, col: 44, offset: 44487},
 															name: "SimpleWord",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1172, col: 57, offset: 43904},
+															pos:  position{line: 1188, col: 57, offset: 44500},
 															name: "Spaces",
 														},
 														&actionExpr{
-															pos: position{line: 1172, col: 67, offset: 43914},
+															pos: position{line: 1188, col: 67, offset: 44510},
 															run: (*parser).callonLinkTextAttribute44,
 															expr: &seqExpr{
-																pos: position{line: 1172, col: 68, offset: 43915},
+																pos: position{line: 1188, col: 68, offset: 44511},
 																exprs: []interface{}{
 																	&notExpr{
-																		pos: position{line: 1172, col: 68, offset: 43915},
+																		pos: position{line: 1188, col: 68, offset: 44511},
 																		expr: &ruleRefExpr{
-																			pos:  position{line: 1172, col: 69, offset: 43916},
+																			pos:  position{line: 1188, col: 69, offset: 44512},
 																			name: "QuotedTextPrefix",
 																		},
 																	},
 																	&anyMatcher{
-																		line: 1172, col: 86, offset: 43933,
+																		line: 1188, col: 86, offset: 44529,
 																	},
 																},
 															},
@@ -9289,11 +9474,11 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 1174, col: 11, offset: 44002},
+									pos: position{line: 1190, col: 11, offset: 44598},
 									expr: &notExpr{
-										pos: position{line: 1174, col: 13, offset: 44004},
+										pos: position{line: 1190, col: 13, offset: 44600},
 										expr: &litMatcher{
-											pos:        position{line: 1174, col: 14, offset: 44005},
+											pos:        position{line: 1190, col: 14, offset: 44601},
 											val:        "=",
 											ignoreCase: false,
 										},
@@ -9307,43 +9492,43 @@ var g = &grammar{
 		},
 		{
 			name: "InlineLinks",
-			pos:  position{line: 1179, col: 1, offset: 44155},
+			pos:  position{line: 1195, col: 1, offset: 44751},
 			expr: &actionExpr{
-				pos: position{line: 1180, col: 5, offset: 44175},
+				pos: position{line: 1196, col: 5, offset: 44771},
 				run: (*parser).callonInlineLinks1,
 				expr: &seqExpr{
-					pos: position{line: 1180, col: 5, offset: 44175},
+					pos: position{line: 1196, col: 5, offset: 44771},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1180, col: 5, offset: 44175},
+							pos:   position{line: 1196, col: 5, offset: 44771},
 							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1180, col: 14, offset: 44184},
+								pos: position{line: 1196, col: 14, offset: 44780},
 								expr: &choiceExpr{
-									pos: position{line: 1180, col: 15, offset: 44185},
+									pos: position{line: 1196, col: 15, offset: 44781},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1180, col: 15, offset: 44185},
+											pos:  position{line: 1196, col: 15, offset: 44781},
 											name: "SimpleWord",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1181, col: 11, offset: 44206},
+											pos:  position{line: 1197, col: 11, offset: 44802},
 											name: "Spaces",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1182, col: 11, offset: 44224},
+											pos:  position{line: 1198, col: 11, offset: 44820},
 											name: "ResolvedLink",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1183, col: 11, offset: 44248},
+											pos:  position{line: 1199, col: 11, offset: 44844},
 											name: "OtherWord",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1184, col: 11, offset: 44268},
+											pos:  position{line: 1200, col: 11, offset: 44864},
 											name: "Parenthesis",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1185, col: 11, offset: 44290},
+											pos:  position{line: 1201, col: 11, offset: 44886},
 											name: "NEWLINE",
 										},
 									},
@@ -9351,7 +9536,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1185, col: 21, offset: 44300},
+							pos:  position{line: 1201, col: 21, offset: 44896},
 							name: "EOF",
 						},
 					},
@@ -9360,16 +9545,16 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedLink",
-			pos:  position{line: 1189, col: 1, offset: 44370},
+			pos:  position{line: 1205, col: 1, offset: 44966},
 			expr: &choiceExpr{
-				pos: position{line: 1189, col: 17, offset: 44386},
+				pos: position{line: 1205, col: 17, offset: 44982},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1189, col: 17, offset: 44386},
+						pos:  position{line: 1205, col: 17, offset: 44982},
 						name: "ResolvedRelativeLink",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1189, col: 40, offset: 44409},
+						pos:  position{line: 1205, col: 40, offset: 45005},
 						name: "ResolvedExternalLink",
 					},
 				},
@@ -9377,40 +9562,40 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedRelativeLink",
-			pos:  position{line: 1192, col: 1, offset: 44545},
+			pos:  position{line: 1208, col: 1, offset: 45141},
 			expr: &actionExpr{
-				pos: position{line: 1192, col: 25, offset: 44569},
+				pos: position{line: 1208, col: 25, offset: 45165},
 				run: (*parser).callonResolvedRelativeLink1,
 				expr: &seqExpr{
-					pos: position{line: 1192, col: 25, offset: 44569},
+					pos: position{line: 1208, col: 25, offset: 45165},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1192, col: 25, offset: 44569},
+							pos:        position{line: 1208, col: 25, offset: 45165},
 							val:        "link:",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1192, col: 33, offset: 44577},
+							pos:   position{line: 1208, col: 33, offset: 45173},
 							label: "url",
 							expr: &choiceExpr{
-								pos: position{line: 1192, col: 38, offset: 44582},
+								pos: position{line: 1208, col: 38, offset: 45178},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1192, col: 38, offset: 44582},
+										pos:  position{line: 1208, col: 38, offset: 45178},
 										name: "ResolvedLocation",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1192, col: 57, offset: 44601},
+										pos:  position{line: 1208, col: 57, offset: 45197},
 										name: "ResolvedFileLocation",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1192, col: 79, offset: 44623},
+							pos:   position{line: 1208, col: 79, offset: 45219},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1192, col: 97, offset: 44641},
+								pos:  position{line: 1208, col: 97, offset: 45237},
 								name: "LinkAttributes",
 							},
 						},
@@ -9420,28 +9605,28 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedExternalLink",
-			pos:  position{line: 1196, col: 1, offset: 44759},
+			pos:  position{line: 1212, col: 1, offset: 45355},
 			expr: &actionExpr{
-				pos: position{line: 1196, col: 25, offset: 44783},
+				pos: position{line: 1212, col: 25, offset: 45379},
 				run: (*parser).callonResolvedExternalLink1,
 				expr: &seqExpr{
-					pos: position{line: 1196, col: 25, offset: 44783},
+					pos: position{line: 1212, col: 25, offset: 45379},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1196, col: 25, offset: 44783},
+							pos:   position{line: 1212, col: 25, offset: 45379},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1196, col: 30, offset: 44788},
+								pos:  position{line: 1212, col: 30, offset: 45384},
 								name: "ResolvedLocation",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1196, col: 48, offset: 44806},
+							pos:   position{line: 1212, col: 48, offset: 45402},
 							label: "inlineAttributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1196, col: 65, offset: 44823},
+								pos: position{line: 1212, col: 65, offset: 45419},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1196, col: 66, offset: 44824},
+									pos:  position{line: 1212, col: 66, offset: 45420},
 									name: "LinkAttributes",
 								},
 							},
@@ -9452,16 +9637,16 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedLink",
-			pos:  position{line: 1200, col: 1, offset: 44917},
+			pos:  position{line: 1216, col: 1, offset: 45513},
 			expr: &choiceExpr{
-				pos: position{line: 1200, col: 15, offset: 44931},
+				pos: position{line: 1216, col: 15, offset: 45527},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1200, col: 15, offset: 44931},
+						pos:  position{line: 1216, col: 15, offset: 45527},
 						name: "RelativeLink",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1200, col: 30, offset: 44946},
+						pos:  position{line: 1216, col: 30, offset: 45542},
 						name: "ExternalQuotedLink",
 					},
 				},
@@ -9469,26 +9654,26 @@ var g = &grammar{
 		},
 		{
 			name: "ExternalQuotedLink",
-			pos:  position{line: 1202, col: 1, offset: 44966},
+			pos:  position{line: 1218, col: 1, offset: 45562},
 			expr: &actionExpr{
-				pos: position{line: 1202, col: 23, offset: 44988},
+				pos: position{line: 1218, col: 23, offset: 45584},
 				run: (*parser).callonExternalQuotedLink1,
 				expr: &seqExpr{
-					pos: position{line: 1202, col: 23, offset: 44988},
+					pos: position{line: 1218, col: 23, offset: 45584},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1202, col: 23, offset: 44988},
+							pos:   position{line: 1218, col: 23, offset: 45584},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1202, col: 28, offset: 44993},
+								pos:  position{line: 1218, col: 28, offset: 45589},
 								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1202, col: 38, offset: 45003},
+							pos:   position{line: 1218, col: 38, offset: 45599},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1202, col: 56, offset: 45021},
+								pos:  position{line: 1218, col: 56, offset: 45617},
 								name: "LinkAttributes",
 							},
 						},
@@ -9498,56 +9683,56 @@ var g = &grammar{
 		},
 		{
 			name: "ImageBlock",
-			pos:  position{line: 1209, col: 1, offset: 45356},
+			pos:  position{line: 1225, col: 1, offset: 45952},
 			expr: &actionExpr{
-				pos: position{line: 1209, col: 15, offset: 45370},
+				pos: position{line: 1225, col: 15, offset: 45966},
 				run: (*parser).callonImageBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1209, col: 15, offset: 45370},
+					pos: position{line: 1225, col: 15, offset: 45966},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1209, col: 15, offset: 45370},
+							pos:   position{line: 1225, col: 15, offset: 45966},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1209, col: 26, offset: 45381},
+								pos: position{line: 1225, col: 26, offset: 45977},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1209, col: 27, offset: 45382},
+									pos:  position{line: 1225, col: 27, offset: 45978},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1209, col: 47, offset: 45402},
+							pos:        position{line: 1225, col: 47, offset: 45998},
 							val:        "image::",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1209, col: 57, offset: 45412},
+							pos:   position{line: 1225, col: 57, offset: 46008},
 							label: "path",
 							expr: &choiceExpr{
-								pos: position{line: 1209, col: 63, offset: 45418},
+								pos: position{line: 1225, col: 63, offset: 46014},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1209, col: 63, offset: 45418},
+										pos:  position{line: 1225, col: 63, offset: 46014},
 										name: "Location",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1209, col: 74, offset: 45429},
+										pos:  position{line: 1225, col: 74, offset: 46025},
 										name: "FileLocation",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1209, col: 88, offset: 45443},
+							pos:   position{line: 1225, col: 88, offset: 46039},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1209, col: 106, offset: 45461},
+								pos:  position{line: 1225, col: 106, offset: 46057},
 								name: "ImageAttributes",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1209, col: 123, offset: 45478},
+							pos:  position{line: 1225, col: 123, offset: 46074},
 							name: "EOLS",
 						},
 					},
@@ -9556,48 +9741,48 @@ var g = &grammar{
 		},
 		{
 			name: "InlineImage",
-			pos:  position{line: 1213, col: 1, offset: 45598},
+			pos:  position{line: 1229, col: 1, offset: 46194},
 			expr: &actionExpr{
-				pos: position{line: 1213, col: 16, offset: 45613},
+				pos: position{line: 1229, col: 16, offset: 46209},
 				run: (*parser).callonInlineImage1,
 				expr: &seqExpr{
-					pos: position{line: 1213, col: 16, offset: 45613},
+					pos: position{line: 1229, col: 16, offset: 46209},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1213, col: 16, offset: 45613},
+							pos:        position{line: 1229, col: 16, offset: 46209},
 							val:        "image:",
 							ignoreCase: false,
 						},
 						&notExpr{
-							pos: position{line: 1213, col: 25, offset: 45622},
+							pos: position{line: 1229, col: 25, offset: 46218},
 							expr: &litMatcher{
-								pos:        position{line: 1213, col: 26, offset: 45623},
+								pos:        position{line: 1229, col: 26, offset: 46219},
 								val:        ":",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1213, col: 30, offset: 45627},
+							pos:   position{line: 1229, col: 30, offset: 46223},
 							label: "path",
 							expr: &choiceExpr{
-								pos: position{line: 1213, col: 36, offset: 45633},
+								pos: position{line: 1229, col: 36, offset: 46229},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1213, col: 36, offset: 45633},
+										pos:  position{line: 1229, col: 36, offset: 46229},
 										name: "Location",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1213, col: 47, offset: 45644},
+										pos:  position{line: 1229, col: 47, offset: 46240},
 										name: "FileLocation",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1213, col: 61, offset: 45658},
+							pos:   position{line: 1229, col: 61, offset: 46254},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1213, col: 79, offset: 45676},
+								pos:  position{line: 1229, col: 79, offset: 46272},
 								name: "ImageAttributes",
 							},
 						},
@@ -9607,95 +9792,95 @@ var g = &grammar{
 		},
 		{
 			name: "ImageAttributes",
-			pos:  position{line: 1217, col: 1, offset: 45797},
+			pos:  position{line: 1233, col: 1, offset: 46393},
 			expr: &actionExpr{
-				pos: position{line: 1217, col: 20, offset: 45816},
+				pos: position{line: 1233, col: 20, offset: 46412},
 				run: (*parser).callonImageAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 1217, col: 20, offset: 45816},
+					pos: position{line: 1233, col: 20, offset: 46412},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1217, col: 20, offset: 45816},
+							pos:        position{line: 1233, col: 20, offset: 46412},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1217, col: 24, offset: 45820},
+							pos:   position{line: 1233, col: 24, offset: 46416},
 							label: "alt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1217, col: 28, offset: 45824},
+								pos: position{line: 1233, col: 28, offset: 46420},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1217, col: 29, offset: 45825},
+									pos:  position{line: 1233, col: 29, offset: 46421},
 									name: "AttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1217, col: 46, offset: 45842},
+							pos: position{line: 1233, col: 46, offset: 46438},
 							expr: &litMatcher{
-								pos:        position{line: 1217, col: 46, offset: 45842},
+								pos:        position{line: 1233, col: 46, offset: 46438},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1217, col: 51, offset: 45847},
+							pos:   position{line: 1233, col: 51, offset: 46443},
 							label: "width",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1217, col: 57, offset: 45853},
+								pos: position{line: 1233, col: 57, offset: 46449},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1217, col: 58, offset: 45854},
+									pos:  position{line: 1233, col: 58, offset: 46450},
 									name: "AttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1217, col: 75, offset: 45871},
+							pos: position{line: 1233, col: 75, offset: 46467},
 							expr: &litMatcher{
-								pos:        position{line: 1217, col: 75, offset: 45871},
+								pos:        position{line: 1233, col: 75, offset: 46467},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1217, col: 80, offset: 45876},
+							pos:   position{line: 1233, col: 80, offset: 46472},
 							label: "height",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1217, col: 87, offset: 45883},
+								pos: position{line: 1233, col: 87, offset: 46479},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1217, col: 88, offset: 45884},
+									pos:  position{line: 1233, col: 88, offset: 46480},
 									name: "AttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 1217, col: 105, offset: 45901},
+							pos: position{line: 1233, col: 105, offset: 46497},
 							expr: &litMatcher{
-								pos:        position{line: 1217, col: 105, offset: 45901},
+								pos:        position{line: 1233, col: 105, offset: 46497},
 								val:        ",",
 								ignoreCase: false,
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1217, col: 110, offset: 45906},
+							pos: position{line: 1233, col: 110, offset: 46502},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1217, col: 110, offset: 45906},
+								pos:  position{line: 1233, col: 110, offset: 46502},
 								name: "WS",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1217, col: 114, offset: 45910},
+							pos:   position{line: 1233, col: 114, offset: 46506},
 							label: "otherattrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1217, col: 125, offset: 45921},
+								pos: position{line: 1233, col: 125, offset: 46517},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1217, col: 126, offset: 45922},
+									pos:  position{line: 1233, col: 126, offset: 46518},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1217, col: 145, offset: 45941},
+							pos:        position{line: 1233, col: 145, offset: 46537},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -9704,102 +9889,58 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "InlineFootnote",
-			pos:  position{line: 1224, col: 1, offset: 46231},
-			expr: &choiceExpr{
-				pos: position{line: 1224, col: 19, offset: 46249},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 1224, col: 19, offset: 46249},
-						run: (*parser).callonInlineFootnote2,
-						expr: &seqExpr{
-							pos: position{line: 1224, col: 19, offset: 46249},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 1224, col: 19, offset: 46249},
-									val:        "footnote:[",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 1224, col: 32, offset: 46262},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 1224, col: 41, offset: 46271},
-										name: "FootnoteContent",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 1224, col: 58, offset: 46288},
-									val:        "]",
-									ignoreCase: false,
-								},
-							},
+			name: "InlineIcon",
+			pos:  position{line: 1240, col: 1, offset: 46815},
+			expr: &actionExpr{
+				pos: position{line: 1240, col: 15, offset: 46829},
+				run: (*parser).callonInlineIcon1,
+				expr: &seqExpr{
+					pos: position{line: 1240, col: 15, offset: 46829},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1240, col: 15, offset: 46829},
+							val:        "icon:",
+							ignoreCase: false,
 						},
-					},
-					&actionExpr{
-						pos: position{line: 1226, col: 5, offset: 46356},
-						run: (*parser).callonInlineFootnote8,
-						expr: &seqExpr{
-							pos: position{line: 1226, col: 5, offset: 46356},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 1226, col: 5, offset: 46356},
-									val:        "footnoteref:[",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 1226, col: 21, offset: 46372},
-									label: "ref",
-									expr: &ruleRefExpr{
-										pos:  position{line: 1226, col: 26, offset: 46377},
-										name: "FootnoteRef",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 1226, col: 39, offset: 46390},
-									val:        ",",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 1226, col: 43, offset: 46394},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 1226, col: 52, offset: 46403},
-										name: "FootnoteContent",
+						&labeledExpr{
+							pos:   position{line: 1240, col: 23, offset: 46837},
+							label: "name",
+							expr: &actionExpr{
+								pos: position{line: 1240, col: 29, offset: 46843},
+								run: (*parser).callonInlineIcon5,
+								expr: &oneOrMoreExpr{
+									pos: position{line: 1240, col: 29, offset: 46843},
+									expr: &choiceExpr{
+										pos: position{line: 1240, col: 30, offset: 46844},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 1240, col: 30, offset: 46844},
+												val:        "[a-zA-Z0-9]",
+												ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&litMatcher{
+												pos:        position{line: 1240, col: 44, offset: 46858},
+												val:        "_",
+												ignoreCase: false,
+											},
+											&litMatcher{
+												pos:        position{line: 1240, col: 50, offset: 46864},
+												val:        "-",
+												ignoreCase: false,
+											},
+										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 1226, col: 69, offset: 46420},
-									val:        "]",
-									ignoreCase: false,
-								},
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 1228, col: 5, offset: 46498},
-						run: (*parser).callonInlineFootnote17,
-						expr: &seqExpr{
-							pos: position{line: 1228, col: 5, offset: 46498},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 1228, col: 5, offset: 46498},
-									val:        "footnoteref:[",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 1228, col: 21, offset: 46514},
-									label: "ref",
-									expr: &ruleRefExpr{
-										pos:  position{line: 1228, col: 26, offset: 46519},
-										name: "FootnoteRef",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 1228, col: 39, offset: 46532},
-									val:        "]",
-									ignoreCase: false,
-								},
+						&labeledExpr{
+							pos:   position{line: 1242, col: 8, offset: 46914},
+							label: "attrs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1242, col: 15, offset: 46921},
+								name: "IconAttributes",
 							},
 						},
 					},
@@ -9807,52 +9948,429 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "FootnoteRef",
-			pos:  position{line: 1232, col: 1, offset: 46640},
+			name: "IconAttributes",
+			pos:  position{line: 1246, col: 1, offset: 47021},
 			expr: &actionExpr{
-				pos: position{line: 1232, col: 16, offset: 46655},
-				run: (*parser).callonFootnoteRef1,
-				expr: &zeroOrMoreExpr{
-					pos: position{line: 1232, col: 16, offset: 46655},
-					expr: &choiceExpr{
-						pos: position{line: 1232, col: 17, offset: 46656},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 1232, col: 17, offset: 46656},
+				pos: position{line: 1246, col: 19, offset: 47039},
+				run: (*parser).callonIconAttributes1,
+				expr: &seqExpr{
+					pos: position{line: 1246, col: 19, offset: 47039},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1246, col: 19, offset: 47039},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1246, col: 23, offset: 47043},
+							label: "size",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1246, col: 28, offset: 47048},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1246, col: 29, offset: 47049},
+									name: "AttributeValue",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 1246, col: 46, offset: 47066},
+							expr: &litMatcher{
+								pos:        position{line: 1246, col: 46, offset: 47066},
+								val:        ",",
+								ignoreCase: false,
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1246, col: 51, offset: 47071},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1246, col: 51, offset: 47071},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1246, col: 55, offset: 47075},
+							label: "otherattrs",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1246, col: 66, offset: 47086},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1246, col: 67, offset: 47087},
+									name: "GenericAttribute",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1246, col: 86, offset: 47106},
+							val:        "]",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VideoBlock",
+			pos:  position{line: 1253, col: 1, offset: 47381},
+			expr: &actionExpr{
+				pos: position{line: 1253, col: 15, offset: 47395},
+				run: (*parser).callonVideoBlock1,
+				expr: &seqExpr{
+					pos: position{line: 1253, col: 15, offset: 47395},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1253, col: 15, offset: 47395},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1253, col: 26, offset: 47406},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1253, col: 27, offset: 47407},
+									name: "ElementAttributes",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1253, col: 47, offset: 47427},
+							val:        "video::",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1253, col: 57, offset: 47437},
+							label: "path",
+							expr: &choiceExpr{
+								pos: position{line: 1253, col: 63, offset: 47443},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1253, col: 63, offset: 47443},
+										name: "Location",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1253, col: 74, offset: 47454},
+										name: "FileLocation",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1253, col: 88, offset: 47468},
+							label: "inlineAttributes",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1253, col: 106, offset: 47486},
+								name: "VideoAttributes",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1253, col: 123, offset: 47503},
+							name: "EOLS",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VideoAttributes",
+			pos:  position{line: 1257, col: 1, offset: 47623},
+			expr: &actionExpr{
+				pos: position{line: 1257, col: 20, offset: 47642},
+				run: (*parser).callonVideoAttributes1,
+				expr: &seqExpr{
+					pos: position{line: 1257, col: 20, offset: 47642},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1257, col: 20, offset: 47642},
+							val:        "[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1257, col: 24, offset: 47646},
+							label: "poster",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1257, col: 31, offset: 47653},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1257, col: 32, offset: 47654},
+									name: "AttributeValue",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 1257, col: 49, offset: 47671},
+							expr: &litMatcher{
+								pos:        position{line: 1257, col: 49, offset: 47671},
+								val:        ",",
+								ignoreCase: false,
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1257, col: 54, offset: 47676},
+							label: "width",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1257, col: 60, offset: 47682},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1257, col: 61, offset: 47683},
+									name: "AttributeValue",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 1257, col: 78, offset: 47700},
+							expr: &litMatcher{
+								pos:        position{line: 1257, col: 78, offset: 47700},
+								val:        ",",
+								ignoreCase: false,
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1257, col: 83, offset: 47705},
+							label: "height",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1257, col: 90, offset: 47712},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1257, col: 91, offset: 47713},
+									name: "AttributeValue",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 1257, col: 108, offset: 47730},
+							expr: &litMatcher{
+								pos:        position{line: 1257, col: 108, offset: 47730},
+								val:        ",",
+								ignoreCase: false,
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1257, col: 113, offset: 47735},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1257, col: 113, offset: 47735},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1257, col: 117, offset: 47739},
+							label: "otherattrs",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1257, col: 128, offset: 47750},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1257, col: 129, offset: 47751},
+									name: "GenericAttribute",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1257, col: 148, offset: 47770},
+							val:        "]",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AudioBlock",
+			pos:  position{line: 1261, col: 1, offset: 47866},
+			expr: &actionExpr{
+				pos: position{line: 1261, col: 15, offset: 47880},
+				run: (*parser).callonAudioBlock1,
+				expr: &seqExpr{
+					pos: position{line: 1261, col: 15, offset: 47880},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1261, col: 15, offset: 47880},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1261, col: 26, offset: 47891},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1261, col: 27, offset: 47892},
+									name: "ElementAttributes",
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1261, col: 47, offset: 47912},
+							val:        "audio::",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1261, col: 57, offset: 47922},
+							label: "path",
+							expr: &choiceExpr{
+								pos: position{line: 1261, col: 63, offset: 47928},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1261, col: 63, offset: 47928},
+										name: "Location",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1261, col: 74, offset: 47939},
+										name: "FileLocation",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1261, col: 88, offset: 47953},
+							label: "inlineAttributes",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1261, col: 106, offset: 47971},
+								name: "InlineAttributes",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1261, col: 124, offset: 47989},
+							name: "EOLS",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineFootnote",
+			pos:  position{line: 1268, col: 1, offset: 48306},
+			expr: &choiceExpr{
+				pos: position{line: 1268, col: 19, offset: 48324},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1268, col: 19, offset: 48324},
+						run: (*parser).callonInlineFootnote2,
+						expr: &seqExpr{
+							pos: position{line: 1268, col: 19, offset: 48324},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1268, col: 19, offset: 48324},
+									val:        "footnote:[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1268, col: 32, offset: 48337},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1268, col: 41, offset: 48346},
+										name: "FootnoteContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1268, col: 58, offset: 48363},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1270, col: 5, offset: 48431},
+						run: (*parser).callonInlineFootnote8,
+						expr: &seqExpr{
+							pos: position{line: 1270, col: 5, offset: 48431},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1270, col: 5, offset: 48431},
+									val:        "footnoteref:[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1270, col: 21, offset: 48447},
+									label: "ref",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1270, col: 26, offset: 48452},
+										name: "FootnoteRef",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1270, col: 39, offset: 48465},
+									val:        ",",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1270, col: 43, offset: 48469},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1270, col: 52, offset: 48478},
+										name: "FootnoteContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1270, col: 69, offset: 48495},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1272, col: 5, offset: 48573},
+						run: (*parser).callonInlineFootnote17,
+						expr: &seqExpr{
+							pos: position{line: 1272, col: 5, offset: 48573},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1272, col: 5, offset: 48573},
+									val:        "footnoteref:[",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 1272, col: 21, offset: 48589},
+									label: "ref",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1272, col: 26, offset: 48594},
+										name: "FootnoteRef",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1272, col: 39, offset: 48607},
+									val:        "]",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FootnoteRef",
+			pos:  position{line: 1276, col: 1, offset: 48715},
+			expr: &actionExpr{
+				pos: position{line: 1276, col: 16, offset: 48730},
+				run: (*parser).callonFootnoteRef1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 1276, col: 16, offset: 48730},
+					expr: &choiceExpr{
+						pos: position{line: 1276, col: 17, offset: 48731},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 1276, col: 17, offset: 48731},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1232, col: 29, offset: 46668},
+								pos:  position{line: 1276, col: 29, offset: 48743},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 1232, col: 39, offset: 46678},
+								pos: position{line: 1276, col: 39, offset: 48753},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1232, col: 39, offset: 46678},
+										pos: position{line: 1276, col: 39, offset: 48753},
 										expr: &litMatcher{
-											pos:        position{line: 1232, col: 40, offset: 46679},
+											pos:        position{line: 1276, col: 40, offset: 48754},
 											val:        ",",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1232, col: 44, offset: 46683},
+										pos: position{line: 1276, col: 44, offset: 48758},
 										expr: &litMatcher{
-											pos:        position{line: 1232, col: 45, offset: 46684},
+											pos:        position{line: 1276, col: 45, offset: 48759},
 											val:        "]",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1232, col: 49, offset: 46688},
+										pos: position{line: 1276, col: 49, offset: 48763},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1232, col: 50, offset: 46689},
+											pos:  position{line: 1276, col: 50, offset: 48764},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 1232, col: 55, offset: 46694,
+										line: 1276, col: 55, offset: 48769,
 									},
 								},
 							},
@@ -9863,55 +10381,55 @@ var g = &grammar{
 		},
 		{
 			name: "FootnoteContent",
-			pos:  position{line: 1236, col: 1, offset: 46779},
+			pos:  position{line: 1280, col: 1, offset: 48854},
 			expr: &actionExpr{
-				pos: position{line: 1236, col: 20, offset: 46798},
+				pos: position{line: 1280, col: 20, offset: 48873},
 				run: (*parser).callonFootnoteContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 1236, col: 20, offset: 46798},
+					pos:   position{line: 1280, col: 20, offset: 48873},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1236, col: 29, offset: 46807},
+						pos: position{line: 1280, col: 29, offset: 48882},
 						expr: &seqExpr{
-							pos: position{line: 1236, col: 30, offset: 46808},
+							pos: position{line: 1280, col: 30, offset: 48883},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1236, col: 30, offset: 46808},
+									pos: position{line: 1280, col: 30, offset: 48883},
 									expr: &litMatcher{
-										pos:        position{line: 1236, col: 31, offset: 46809},
+										pos:        position{line: 1280, col: 31, offset: 48884},
 										val:        "]",
 										ignoreCase: false,
 									},
 								},
 								&notExpr{
-									pos: position{line: 1236, col: 35, offset: 46813},
+									pos: position{line: 1280, col: 35, offset: 48888},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1236, col: 36, offset: 46814},
+										pos:  position{line: 1280, col: 36, offset: 48889},
 										name: "EOL",
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1236, col: 40, offset: 46818},
+									pos: position{line: 1280, col: 40, offset: 48893},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1236, col: 40, offset: 46818},
+										pos:  position{line: 1280, col: 40, offset: 48893},
 										name: "WS",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1236, col: 44, offset: 46822},
+									pos: position{line: 1280, col: 44, offset: 48897},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1236, col: 45, offset: 46823},
+										pos:  position{line: 1280, col: 45, offset: 48898},
 										name: "InlineElementID",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1236, col: 61, offset: 46839},
+									pos:  position{line: 1280, col: 61, offset: 48914},
 									name: "InlineElement",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1236, col: 75, offset: 46853},
+									pos: position{line: 1280, col: 75, offset: 48928},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1236, col: 75, offset: 46853},
+										pos:  position{line: 1280, col: 75, offset: 48928},
 										name: "WS",
 									},
 								},
@@ -9923,60 +10441,60 @@ var g = &grammar{
 		},
 		{
 			name: "DelimitedBlock",
-			pos:  position{line: 1243, col: 1, offset: 47167},
+			pos:  position{line: 1287, col: 1, offset: 49242},
 			expr: &actionExpr{
-				pos: position{line: 1243, col: 19, offset: 47185},
+				pos: position{line: 1287, col: 19, offset: 49260},
 				run: (*parser).callonDelimitedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1243, col: 19, offset: 47185},
+					pos: position{line: 1287, col: 19, offset: 49260},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1243, col: 19, offset: 47185},
+							pos: position{line: 1287, col: 19, offset: 49260},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1243, col: 20, offset: 47186},
+								pos:  position{line: 1287, col: 20, offset: 49261},
 								name: "Alphanum",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1244, col: 5, offset: 47215},
+							pos:   position{line: 1288, col: 5, offset: 49290},
 							label: "block",
 							expr: &choiceExpr{
-								pos: position{line: 1244, col: 12, offset: 47222},
+								pos: position{line: 1288, col: 12, offset: 49297},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1244, col: 12, offset: 47222},
+										pos:  position{line: 1288, col: 12, offset: 49297},
 										name: "FencedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1245, col: 11, offset: 47245},
+										pos:  position{line: 1289, col: 11, offset: 49320},
 										name: "ListingBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1246, col: 11, offset: 47269},
+										pos:  position{line: 1290, col: 11, offset: 49344},
 										name: "ExampleBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1247, col: 11, offset: 47293},
+										pos:  position{line: 1291, col: 11, offset: 49368},
 										name: "VerseBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1248, col: 11, offset: 47315},
+										pos:  position{line: 1292, col: 11, offset: 49390},
 										name: "QuoteBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1249, col: 11, offset: 47337},
+										pos:  position{line: 1293, col: 11, offset: 49412},
 										name: "SidebarBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1250, col: 11, offset: 47360},
+										pos:  position{line: 1294, col: 11, offset: 49435},
 										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1251, col: 11, offset: 47388},
+										pos:  position{line: 1295, col: 11, offset: 49463},
 										name: "Table",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1252, col: 11, offset: 47404},
+										pos:  position{line: 1296, col: 11, offset: 49479},
 										name: "CommentBlock",
 									},
 								},
@@ -9988,36 +10506,36 @@ var g = &grammar{
 		},
 		{
 			name: "BlockDelimiter",
-			pos:  position{line: 1256, col: 1, offset: 47445},
+			pos:  position{line: 1300, col: 1, offset: 49520},
 			expr: &choiceExpr{
-				pos: position{line: 1256, col: 19, offset: 47463},
+				pos: position{line: 1300, col: 19, offset: 49538},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1256, col: 19, offset: 47463},
+						pos:  position{line: 1300, col: 19, offset: 49538},
 						name: "LiteralBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1257, col: 19, offset: 47504},
+						pos:  position{line: 1301, col: 19, offset: 49579},
 						name: "FencedBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1258, col: 19, offset: 47544},
+						pos:  position{line: 1302, col: 19, offset: 49619},
 						name: "ListingBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1259, col: 19, offset: 47585},
+						pos:  position{line: 1303, col: 19, offset: 49660},
 						name: "ExampleBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1260, col: 19, offset: 47626},
+						pos:  position{line: 1304, col: 19, offset: 49701},
 						name: "CommentBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1261, col: 19, offset: 47667},
+						pos:  position{line: 1305, col: 19, offset: 49742},
 						name: "QuoteBlockDelimiter",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1262, col: 19, offset: 47705},
+						pos:  position{line: 1306, col: 19, offset: 49780},
 						name: "SidebarBlockDelimiter",
 					},
 				},
@@ -10025,17 +10543,17 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockDelimiter",
-			pos:  position{line: 1268, col: 1, offset: 47924},
+			pos:  position{line: 1312, col: 1, offset: 49999},
 			expr: &seqExpr{
-				pos: position{line: 1268, col: 25, offset: 47948},
+				pos: position{line: 1312, col: 25, offset: 50023},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1268, col: 25, offset: 47948},
+						pos:        position{line: 1312, col: 25, offset: 50023},
 						val:        "```",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1268, col: 31, offset: 47954},
+						pos:  position{line: 1312, col: 31, offset: 50029},
 						name: "EOLS",
 					},
 				},
@@ -10043,48 +10561,48 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlock",
-			pos:  position{line: 1270, col: 1, offset: 47960},
+			pos:  position{line: 1314, col: 1, offset: 50035},
 			expr: &actionExpr{
-				pos: position{line: 1270, col: 16, offset: 47975},
+				pos: position{line: 1314, col: 16, offset: 50050},
 				run: (*parser).callonFencedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1270, col: 16, offset: 47975},
+					pos: position{line: 1314, col: 16, offset: 50050},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1270, col: 16, offset: 47975},
+							pos:   position{line: 1314, col: 16, offset: 50050},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1270, col: 27, offset: 47986},
+								pos: position{line: 1314, col: 27, offset: 50061},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1270, col: 28, offset: 47987},
+									pos:  position{line: 1314, col: 28, offset: 50062},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1270, col: 48, offset: 48007},
+							pos:  position{line: 1314, col: 48, offset: 50082},
 							name: "FencedBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1270, col: 69, offset: 48028},
+							pos:   position{line: 1314, col: 69, offset: 50103},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1270, col: 77, offset: 48036},
+								pos: position{line: 1314, col: 77, offset: 50111},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1270, col: 78, offset: 48037},
+									pos:  position{line: 1314, col: 78, offset: 50112},
 									name: "FencedBlockContent",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1270, col: 100, offset: 48059},
+							pos: position{line: 1314, col: 100, offset: 50134},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1270, col: 100, offset: 48059},
+									pos:  position{line: 1314, col: 100, offset: 50134},
 									name: "FencedBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1270, col: 123, offset: 48082},
+									pos:  position{line: 1314, col: 123, offset: 50157},
 									name: "EOF",
 								},
 							},
@@ -10095,24 +10613,24 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockContent",
-			pos:  position{line: 1274, col: 1, offset: 48190},
+			pos:  position{line: 1318, col: 1, offset: 50265},
 			expr: &choiceExpr{
-				pos: position{line: 1274, col: 23, offset: 48212},
+				pos: position{line: 1318, col: 23, offset: 50287},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1274, col: 23, offset: 48212},
+						pos:  position{line: 1318, col: 23, offset: 50287},
 						name: "BlankLine",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1274, col: 35, offset: 48224},
+						pos:  position{line: 1318, col: 35, offset: 50299},
 						name: "FileInclusion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1274, col: 51, offset: 48240},
+						pos:  position{line: 1318, col: 51, offset: 50315},
 						name: "ListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1274, col: 62, offset: 48251},
+						pos:  position{line: 1318, col: 62, offset: 50326},
 						name: "FencedBlockParagraph",
 					},
 				},
@@ -10120,17 +10638,17 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockParagraph",
-			pos:  position{line: 1277, col: 1, offset: 48291},
+			pos:  position{line: 1321, col: 1, offset: 50366},
 			expr: &actionExpr{
-				pos: position{line: 1277, col: 25, offset: 48315},
+				pos: position{line: 1321, col: 25, offset: 50390},
 				run: (*parser).callonFencedBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1277, col: 25, offset: 48315},
+					pos:   position{line: 1321, col: 25, offset: 50390},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1277, col: 31, offset: 48321},
+						pos: position{line: 1321, col: 31, offset: 50396},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1277, col: 32, offset: 48322},
+							pos:  position{line: 1321, col: 32, offset: 50397},
 							name: "FencedBlockParagraphLine",
 						},
 					},
@@ -10139,32 +10657,32 @@ var g = &grammar{
 		},
 		{
 			name: "FencedBlockParagraphLine",
-			pos:  position{line: 1281, col: 1, offset: 48435},
+			pos:  position{line: 1325, col: 1, offset: 50510},
 			expr: &actionExpr{
-				pos: position{line: 1281, col: 29, offset: 48463},
+				pos: position{line: 1325, col: 29, offset: 50538},
 				run: (*parser).callonFencedBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1281, col: 29, offset: 48463},
+					pos: position{line: 1325, col: 29, offset: 50538},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1281, col: 29, offset: 48463},
+							pos: position{line: 1325, col: 29, offset: 50538},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1281, col: 30, offset: 48464},
+								pos:  position{line: 1325, col: 30, offset: 50539},
 								name: "FencedBlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1281, col: 51, offset: 48485},
+							pos: position{line: 1325, col: 51, offset: 50560},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1281, col: 52, offset: 48486},
+								pos:  position{line: 1325, col: 52, offset: 50561},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1281, col: 62, offset: 48496},
+							pos:   position{line: 1325, col: 62, offset: 50571},
 							label: "line",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1281, col: 68, offset: 48502},
+								pos:  position{line: 1325, col: 68, offset: 50577},
 								name: "InlineElements",
 							},
 						},
@@ -10174,17 +10692,17 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlockDelimiter",
-			pos:  position{line: 1288, col: 1, offset: 48740},
+			pos:  position{line: 1332, col: 1, offset: 50815},
 			expr: &seqExpr{
-				pos: position{line: 1288, col: 26, offset: 48765},
+				pos: position{line: 1332, col: 26, offset: 50840},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1288, col: 26, offset: 48765},
+						pos:        position{line: 1332, col: 26, offset: 50840},
 						val:        "----",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1288, col: 33, offset: 48772},
+						pos:  position{line: 1332, col: 33, offset: 50847},
 						name: "EOLS",
 					},
 				},
@@ -10192,48 +10710,48 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlock",
-			pos:  position{line: 1290, col: 1, offset: 48778},
+			pos:  position{line: 1334, col: 1, offset: 50853},
 			expr: &actionExpr{
-				pos: position{line: 1290, col: 17, offset: 48794},
+				pos: position{line: 1334, col: 17, offset: 50869},
 				run: (*parser).callonListingBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1290, col: 17, offset: 48794},
+					pos: position{line: 1334, col: 17, offset: 50869},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1290, col: 17, offset: 48794},
+							pos:   position{line: 1334, col: 17, offset: 50869},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1290, col: 28, offset: 48805},
+								pos: position{line: 1334, col: 28, offset: 50880},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1290, col: 29, offset: 48806},
+									pos:  position{line: 1334, col: 29, offset: 50881},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1290, col: 49, offset: 48826},
+							pos:  position{line: 1334, col: 49, offset: 50901},
 							name: "ListingBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1290, col: 71, offset: 48848},
+							pos:   position{line: 1334, col: 71, offset: 50923},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1290, col: 79, offset: 48856},
+								pos: position{line: 1334, col: 79, offset: 50931},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1290, col: 80, offset: 48857},
+									pos:  position{line: 1334, col: 80, offset: 50932},
 									name: "ListingBlockElement",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1290, col: 103, offset: 48880},
+							pos: position{line: 1334, col: 103, offset: 50955},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1290, col: 103, offset: 48880},
+									pos:  position{line: 1334, col: 103, offset: 50955},
 									name: "ListingBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1290, col: 127, offset: 48904},
+									pos:  position{line: 1334, col: 127, offset: 50979},
 									name: "EOF",
 								},
 							},
@@ -10244,20 +10762,20 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlockElement",
-			pos:  position{line: 1294, col: 1, offset: 49013},
+			pos:  position{line: 1338, col: 1, offset: 51088},
 			expr: &choiceExpr{
-				pos: position{line: 1294, col: 24, offset: 49036},
+				pos: position{line: 1338, col: 24, offset: 51111},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1294, col: 24, offset: 49036},
+						pos:  position{line: 1338, col: 24, offset: 51111},
 						name: "FileInclusion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1294, col: 40, offset: 49052},
+						pos:  position{line: 1338, col: 40, offset: 51127},
 						name: "ListingBlockParagraph",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1294, col: 64, offset: 49076},
+						pos:  position{line: 1338, col: 64, offset: 51151},
 						name: "BlankLine",
 					},
 				},
@@ -10265,17 +10783,17 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlockParagraph",
-			pos:  position{line: 1296, col: 1, offset: 49087},
+			pos:  position{line: 1340, col: 1, offset: 51162},
 			expr: &actionExpr{
-				pos: position{line: 1296, col: 26, offset: 49112},
+				pos: position{line: 1340, col: 26, offset: 51187},
 				run: (*parser).callonListingBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1296, col: 26, offset: 49112},
+					pos:   position{line: 1340, col: 26, offset: 51187},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1296, col: 32, offset: 49118},
+						pos: position{line: 1340, col: 32, offset: 51193},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1296, col: 33, offset: 49119},
+							pos:  position{line: 1340, col: 33, offset: 51194},
 							name: "ListingBlockParagraphLine",
 						},
 					},
@@ -10284,61 +10802,61 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlockParagraphLine",
-			pos:  position{line: 1300, col: 1, offset: 49238},
+			pos:  position{line: 1344, col: 1, offset: 51313},
 			expr: &actionExpr{
-				pos: position{line: 1300, col: 30, offset: 49267},
+				pos: position{line: 1344, col: 30, offset: 51342},
 				run: (*parser).callonListingBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1300, col: 30, offset: 49267},
+					pos: position{line: 1344, col: 30, offset: 51342},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1300, col: 30, offset: 49267},
+							pos: position{line: 1344, col: 30, offset: 51342},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1300, col: 31, offset: 49268},
+								pos:  position{line: 1344, col: 31, offset: 51343},
 								name: "ListingBlockDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1300, col: 53, offset: 49290},
+							pos:   position{line: 1344, col: 53, offset: 51365},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 1300, col: 59, offset: 49296},
+								pos: position{line: 1344, col: 59, offset: 51371},
 								run: (*parser).callonListingBlockParagraphLine6,
 								expr: &seqExpr{
-									pos: position{line: 1300, col: 59, offset: 49296},
+									pos: position{line: 1344, col: 59, offset: 51371},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1300, col: 59, offset: 49296},
+											pos: position{line: 1344, col: 59, offset: 51371},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1300, col: 60, offset: 49297},
+												pos:  position{line: 1344, col: 60, offset: 51372},
 												name: "EOF",
 											},
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1300, col: 64, offset: 49301},
+											pos: position{line: 1344, col: 64, offset: 51376},
 											expr: &choiceExpr{
-												pos: position{line: 1300, col: 65, offset: 49302},
+												pos: position{line: 1344, col: 65, offset: 51377},
 												alternatives: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 1300, col: 65, offset: 49302},
+														pos:  position{line: 1344, col: 65, offset: 51377},
 														name: "Alphanums",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1300, col: 77, offset: 49314},
+														pos:  position{line: 1344, col: 77, offset: 51389},
 														name: "Spaces",
 													},
 													&seqExpr{
-														pos: position{line: 1300, col: 87, offset: 49324},
+														pos: position{line: 1344, col: 87, offset: 51399},
 														exprs: []interface{}{
 															&notExpr{
-																pos: position{line: 1300, col: 87, offset: 49324},
+																pos: position{line: 1344, col: 87, offset: 51399},
 																expr: &ruleRefExpr{
-																	pos:  position{line: 1300, col: 88, offset: 49325},
+																	pos:  position{line: 1344, col: 88, offset: 51400},
 																	name: "EOL",
 																},
 															},
 															&anyMatcher{
-																line: 1300, col: 92, offset: 49329,
+																line: 1344, col: 92, offset: 51404,
 															},
 														},
 													},
@@ -10350,7 +10868,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1300, col: 128, offset: 49365},
+							pos:  position{line: 1344, col: 128, offset: 51440},
 							name: "EOL",
 						},
 					},
@@ -10359,17 +10877,17 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlockDelimiter",
-			pos:  position{line: 1307, col: 1, offset: 49691},
+			pos:  position{line: 1351, col: 1, offset: 51766},
 			expr: &seqExpr{
-				pos: position{line: 1307, col: 26, offset: 49716},
+				pos: position{line: 1351, col: 26, offset: 51791},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1307, col: 26, offset: 49716},
+						pos:        position{line: 1351, col: 26, offset: 51791},
 						val:        "====",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1307, col: 33, offset: 49723},
+						pos:  position{line: 1351, col: 33, offset: 51798},
 						name: "EOLS",
 					},
 				},
@@ -10377,50 +10895,50 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlock",
-			pos:  position{line: 1309, col: 1, offset: 49729},
+			pos:  position{line: 1353, col: 1, offset: 51804},
 			expr: &actionExpr{
-				pos: position{line: 1309, col: 17, offset: 49745},
+				pos: position{line: 1353, col: 17, offset: 51820},
 				run: (*parser).callonExampleBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1309, col: 17, offset: 49745},
+					pos: position{line: 1353, col: 17, offset: 51820},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1309, col: 17, offset: 49745},
+							pos:   position{line: 1353, col: 17, offset: 51820},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1309, col: 28, offset: 49756},
+								pos: position{line: 1353, col: 28, offset: 51831},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1309, col: 29, offset: 49757},
+									pos:  position{line: 1353, col: 29, offset: 51832},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1309, col: 49, offset: 49777},
+							pos:  position{line: 1353, col: 49, offset: 51852},
 							name: "ExampleBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1309, col: 71, offset: 49799},
+							pos:   position{line: 1353, col: 71, offset: 51874},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1309, col: 79, offset: 49807},
+								pos: position{line: 1353, col: 79, offset: 51882},
 								expr: &choiceExpr{
-									pos: position{line: 1309, col: 80, offset: 49808},
+									pos: position{line: 1353, col: 80, offset: 51883},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1309, col: 80, offset: 49808},
+											pos:  position{line: 1353, col: 80, offset: 51883},
 											name: "BlankLine",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1309, col: 92, offset: 49820},
+											pos:  position{line: 1353, col: 92, offset: 51895},
 											name: "FileInclusion",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1309, col: 108, offset: 49836},
+											pos:  position{line: 1353, col: 108, offset: 51911},
 											name: "ListItem",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1309, col: 119, offset: 49847},
+											pos:  position{line: 1353, col: 119, offset: 51922},
 											name: "ExampleBlockParagraph",
 										},
 									},
@@ -10428,14 +10946,14 @@ var g = &grammar{
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1309, col: 145, offset: 49873},
+							pos: position{line: 1353, col: 145, offset: 51948},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1309, col: 145, offset: 49873},
+									pos:  position{line: 1353, col: 145, offset: 51948},
 									name: "ExampleBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1309, col: 169, offset: 49897},
+									pos:  position{line: 1353, col: 169, offset: 51972},
 									name: "EOF",
 								},
 							},
@@ -10446,17 +10964,17 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlockParagraph",
-			pos:  position{line: 1314, col: 1, offset: 50024},
+			pos:  position{line: 1358, col: 1, offset: 52099},
 			expr: &actionExpr{
-				pos: position{line: 1314, col: 26, offset: 50049},
+				pos: position{line: 1358, col: 26, offset: 52124},
 				run: (*parser).callonExampleBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1314, col: 26, offset: 50049},
+					pos:   position{line: 1358, col: 26, offset: 52124},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1314, col: 32, offset: 50055},
+						pos: position{line: 1358, col: 32, offset: 52130},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1314, col: 33, offset: 50056},
+							pos:  position{line: 1358, col: 33, offset: 52131},
 							name: "ExampleBlockParagraphLine",
 						},
 					},
@@ -10465,32 +10983,32 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlockParagraphLine",
-			pos:  position{line: 1318, col: 1, offset: 50170},
+			pos:  position{line: 1362, col: 1, offset: 52245},
 			expr: &actionExpr{
-				pos: position{line: 1318, col: 30, offset: 50199},
+				pos: position{line: 1362, col: 30, offset: 52274},
 				run: (*parser).callonExampleBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1318, col: 30, offset: 50199},
+					pos: position{line: 1362, col: 30, offset: 52274},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1318, col: 30, offset: 50199},
+							pos: position{line: 1362, col: 30, offset: 52274},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1318, col: 31, offset: 50200},
+								pos:  position{line: 1362, col: 31, offset: 52275},
 								name: "ExampleBlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1318, col: 53, offset: 50222},
+							pos: position{line: 1362, col: 53, offset: 52297},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1318, col: 54, offset: 50223},
+								pos:  position{line: 1362, col: 54, offset: 52298},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1318, col: 64, offset: 50233},
+							pos:   position{line: 1362, col: 64, offset: 52308},
 							label: "line",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1318, col: 70, offset: 50239},
+								pos:  position{line: 1362, col: 70, offset: 52314},
 								name: "InlineElements",
 							},
 						},
@@ -10500,17 +11018,17 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockDelimiter",
-			pos:  position{line: 1325, col: 1, offset: 50475},
+			pos:  position{line: 1369, col: 1, offset: 52550},
 			expr: &seqExpr{
-				pos: position{line: 1325, col: 24, offset: 50498},
+				pos: position{line: 1369, col: 24, offset: 52573},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1325, col: 24, offset: 50498},
+						pos:        position{line: 1369, col: 24, offset: 52573},
 						val:        "____",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1325, col: 31, offset: 50505},
+						pos:  position{line: 1369, col: 31, offset: 52580},
 						name: "EOLS",
 					},
 				},
@@ -10518,48 +11036,48 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlock",
-			pos:  position{line: 1327, col: 1, offset: 50536},
+			pos:  position{line: 1371, col: 1, offset: 52611},
 			expr: &actionExpr{
-				pos: position{line: 1327, col: 15, offset: 50550},
+				pos: position{line: 1371, col: 15, offset: 52625},
 				run: (*parser).callonQuoteBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1327, col: 15, offset: 50550},
+					pos: position{line: 1371, col: 15, offset: 52625},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1327, col: 15, offset: 50550},
+							pos:   position{line: 1371, col: 15, offset: 52625},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1327, col: 26, offset: 50561},
+								pos: position{line: 1371, col: 26, offset: 52636},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1327, col: 27, offset: 50562},
+									pos:  position{line: 1371, col: 27, offset: 52637},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1327, col: 47, offset: 50582},
+							pos:  position{line: 1371, col: 47, offset: 52657},
 							name: "QuoteBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1327, col: 67, offset: 50602},
+							pos:   position{line: 1371, col: 67, offset: 52677},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1327, col: 75, offset: 50610},
+								pos: position{line: 1371, col: 75, offset: 52685},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1327, col: 76, offset: 50611},
+									pos:  position{line: 1371, col: 76, offset: 52686},
 									name: "QuoteBlockElement",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1327, col: 97, offset: 50632},
+							pos: position{line: 1371, col: 97, offset: 52707},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1327, col: 97, offset: 50632},
+									pos:  position{line: 1371, col: 97, offset: 52707},
 									name: "QuoteBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1327, col: 119, offset: 50654},
+									pos:  position{line: 1371, col: 119, offset: 52729},
 									name: "EOF",
 								},
 							},
@@ -10570,99 +11088,99 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockElement",
-			pos:  position{line: 1331, col: 1, offset: 50761},
+			pos:  position{line: 1375, col: 1, offset: 52836},
 			expr: &actionExpr{
-				pos: position{line: 1332, col: 5, offset: 50787},
+				pos: position{line: 1376, col: 5, offset: 52862},
 				run: (*parser).callonQuoteBlockElement1,
 				expr: &seqExpr{
-					pos: position{line: 1332, col: 5, offset: 50787},
+					pos: position{line: 1376, col: 5, offset: 52862},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1332, col: 5, offset: 50787},
+							pos: position{line: 1376, col: 5, offset: 52862},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1332, col: 6, offset: 50788},
+								pos:  position{line: 1376, col: 6, offset: 52863},
 								name: "QuoteBlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1332, col: 26, offset: 50808},
+							pos: position{line: 1376, col: 26, offset: 52883},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1332, col: 27, offset: 50809},
+								pos:  position{line: 1376, col: 27, offset: 52884},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1332, col: 31, offset: 50813},
+							pos:   position{line: 1376, col: 31, offset: 52888},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1332, col: 40, offset: 50822},
+								pos: position{line: 1376, col: 40, offset: 52897},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1332, col: 40, offset: 50822},
+										pos:  position{line: 1376, col: 40, offset: 52897},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1333, col: 15, offset: 50847},
+										pos:  position{line: 1377, col: 15, offset: 52922},
 										name: "FileInclusion",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1334, col: 15, offset: 50875},
+										pos:  position{line: 1378, col: 15, offset: 52950},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1335, col: 15, offset: 50901},
+										pos:  position{line: 1379, col: 15, offset: 52976},
 										name: "ListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1336, col: 15, offset: 50924},
+										pos:  position{line: 1380, col: 15, offset: 52999},
 										name: "FencedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1337, col: 15, offset: 50950},
+										pos:  position{line: 1381, col: 15, offset: 53025},
 										name: "ListingBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1338, col: 15, offset: 50977},
+										pos:  position{line: 1382, col: 15, offset: 53052},
 										name: "ExampleBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1339, col: 15, offset: 51004},
+										pos:  position{line: 1383, col: 15, offset: 53079},
 										name: "CommentBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1340, col: 15, offset: 51031},
+										pos:  position{line: 1384, col: 15, offset: 53106},
 										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1341, col: 15, offset: 51063},
+										pos:  position{line: 1385, col: 15, offset: 53138},
 										name: "QuoteBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1342, col: 15, offset: 51089},
+										pos:  position{line: 1386, col: 15, offset: 53164},
 										name: "SidebarBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1343, col: 15, offset: 51116},
+										pos:  position{line: 1387, col: 15, offset: 53191},
 										name: "Table",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1344, col: 15, offset: 51137},
+										pos:  position{line: 1388, col: 15, offset: 53212},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1345, col: 15, offset: 51165},
+										pos:  position{line: 1389, col: 15, offset: 53240},
 										name: "DocumentAttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1346, col: 15, offset: 51209},
+										pos:  position{line: 1390, col: 15, offset: 53284},
 										name: "DocumentAttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1347, col: 15, offset: 51247},
+										pos:  position{line: 1391, col: 15, offset: 53322},
 										name: "TableOfContentsMacro",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1348, col: 15, offset: 51282},
+										pos:  position{line: 1392, col: 15, offset: 53357},
 										name: "QuoteBlockParagraph",
 									},
 								},
@@ -10674,17 +11192,17 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteBlockParagraph",
-			pos:  position{line: 1352, col: 1, offset: 51341},
+			pos:  position{line: 1396, col: 1, offset: 53416},
 			expr: &actionExpr{
-				pos: position{line: 1352, col: 24, offset: 51364},
+				pos: position{line: 1396, col: 24, offset: 53439},
 				run: (*parser).callonQuoteBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1352, col: 24, offset: 51364},
+					pos:   position{line: 1396, col: 24, offset: 53439},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1352, col: 30, offset: 51370},
+						pos: position{line: 1396, col: 30, offset: 53445},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1352, col: 31, offset: 51371},
+							pos:  position{line: 1396, col: 31, offset: 53446},
 							name: "InlineElements",
 						},
 					},
@@ -10693,49 +11211,49 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlock",
-			pos:  position{line: 1361, col: 1, offset: 51717},
+			pos:  position{line: 1405, col: 1, offset: 53792},
 			expr: &actionExpr{
-				pos: position{line: 1361, col: 15, offset: 51731},
+				pos: position{line: 1405, col: 15, offset: 53806},
 				run: (*parser).callonVerseBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1361, col: 15, offset: 51731},
+					pos: position{line: 1405, col: 15, offset: 53806},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1361, col: 15, offset: 51731},
+							pos:   position{line: 1405, col: 15, offset: 53806},
 							label: "attributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1361, col: 27, offset: 51743},
+								pos:  position{line: 1405, col: 27, offset: 53818},
 								name: "ElementAttributes",
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 1362, col: 5, offset: 51767},
+							pos: position{line: 1406, col: 5, offset: 53842},
 							run: (*parser).callonVerseBlock5,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1366, col: 5, offset: 51953},
+							pos:  position{line: 1410, col: 5, offset: 54028},
 							name: "QuoteBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1366, col: 25, offset: 51973},
+							pos:   position{line: 1410, col: 25, offset: 54048},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1366, col: 33, offset: 51981},
+								pos: position{line: 1410, col: 33, offset: 54056},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1366, col: 34, offset: 51982},
+									pos:  position{line: 1410, col: 34, offset: 54057},
 									name: "VerseBlockElement",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1366, col: 55, offset: 52003},
+							pos: position{line: 1410, col: 55, offset: 54078},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1366, col: 55, offset: 52003},
+									pos:  position{line: 1410, col: 55, offset: 54078},
 									name: "QuoteBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1366, col: 77, offset: 52025},
+									pos:  position{line: 1410, col: 77, offset: 54100},
 									name: "EOF",
 								},
 							},
@@ -10746,20 +11264,20 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockElement",
-			pos:  position{line: 1370, col: 1, offset: 52140},
+			pos:  position{line: 1414, col: 1, offset: 54215},
 			expr: &choiceExpr{
-				pos: position{line: 1370, col: 22, offset: 52161},
+				pos: position{line: 1414, col: 22, offset: 54236},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1370, col: 22, offset: 52161},
+						pos:  position{line: 1414, col: 22, offset: 54236},
 						name: "VerseFileInclude",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1370, col: 41, offset: 52180},
+						pos:  position{line: 1414, col: 41, offset: 54255},
 						name: "BlankLine",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1370, col: 53, offset: 52192},
+						pos:  position{line: 1414, col: 53, offset: 54267},
 						name: "VerseBlockParagraph",
 					},
 				},
@@ -10767,25 +11285,25 @@ var g = &grammar{
 		},
 		{
 			name: "VerseFileInclude",
-			pos:  position{line: 1372, col: 1, offset: 52213},
+			pos:  position{line: 1416, col: 1, offset: 54288},
 			expr: &actionExpr{
-				pos: position{line: 1372, col: 21, offset: 52233},
+				pos: position{line: 1416, col: 21, offset: 54308},
 				run: (*parser).callonVerseFileInclude1,
 				expr: &seqExpr{
-					pos: position{line: 1372, col: 21, offset: 52233},
+					pos: position{line: 1416, col: 21, offset: 54308},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1372, col: 21, offset: 52233},
+							pos: position{line: 1416, col: 21, offset: 54308},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1372, col: 22, offset: 52234},
+								pos:  position{line: 1416, col: 22, offset: 54309},
 								name: "QuoteBlockDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1372, col: 42, offset: 52254},
+							pos:   position{line: 1416, col: 42, offset: 54329},
 							label: "include",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1372, col: 51, offset: 52263},
+								pos:  position{line: 1416, col: 51, offset: 54338},
 								name: "FileInclusion",
 							},
 						},
@@ -10795,17 +11313,17 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockParagraph",
-			pos:  position{line: 1377, col: 1, offset: 52325},
+			pos:  position{line: 1421, col: 1, offset: 54400},
 			expr: &actionExpr{
-				pos: position{line: 1377, col: 24, offset: 52348},
+				pos: position{line: 1421, col: 24, offset: 54423},
 				run: (*parser).callonVerseBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1377, col: 24, offset: 52348},
+					pos:   position{line: 1421, col: 24, offset: 54423},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1377, col: 30, offset: 52354},
+						pos: position{line: 1421, col: 30, offset: 54429},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1377, col: 31, offset: 52355},
+							pos:  position{line: 1421, col: 31, offset: 54430},
 							name: "VerseBlockParagraphLine",
 						},
 					},
@@ -10814,49 +11332,49 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockParagraphLine",
-			pos:  position{line: 1381, col: 1, offset: 52445},
+			pos:  position{line: 1425, col: 1, offset: 54520},
 			expr: &actionExpr{
-				pos: position{line: 1381, col: 28, offset: 52472},
+				pos: position{line: 1425, col: 28, offset: 54547},
 				run: (*parser).callonVerseBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1381, col: 28, offset: 52472},
+					pos: position{line: 1425, col: 28, offset: 54547},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1381, col: 28, offset: 52472},
+							pos: position{line: 1425, col: 28, offset: 54547},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1381, col: 29, offset: 52473},
+								pos:  position{line: 1425, col: 29, offset: 54548},
 								name: "QuoteBlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1381, col: 49, offset: 52493},
+							pos: position{line: 1425, col: 49, offset: 54568},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1381, col: 50, offset: 52494},
+								pos:  position{line: 1425, col: 50, offset: 54569},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1381, col: 60, offset: 52504},
+							pos:   position{line: 1425, col: 60, offset: 54579},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 1381, col: 66, offset: 52510},
+								pos: position{line: 1425, col: 66, offset: 54585},
 								run: (*parser).callonVerseBlockParagraphLine8,
 								expr: &seqExpr{
-									pos: position{line: 1381, col: 66, offset: 52510},
+									pos: position{line: 1425, col: 66, offset: 54585},
 									exprs: []interface{}{
 										&labeledExpr{
-											pos:   position{line: 1381, col: 66, offset: 52510},
+											pos:   position{line: 1425, col: 66, offset: 54585},
 											label: "elements",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 1381, col: 75, offset: 52519},
+												pos: position{line: 1425, col: 75, offset: 54594},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1381, col: 76, offset: 52520},
+													pos:  position{line: 1425, col: 76, offset: 54595},
 													name: "VerseBlockParagraphLineElement",
 												},
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1381, col: 109, offset: 52553},
+											pos:  position{line: 1425, col: 109, offset: 54628},
 											name: "EOL",
 										},
 									},
@@ -10869,79 +11387,79 @@ var g = &grammar{
 		},
 		{
 			name: "VerseBlockParagraphLineElement",
-			pos:  position{line: 1387, col: 1, offset: 52649},
+			pos:  position{line: 1431, col: 1, offset: 54724},
 			expr: &actionExpr{
-				pos: position{line: 1387, col: 35, offset: 52683},
+				pos: position{line: 1431, col: 35, offset: 54758},
 				run: (*parser).callonVerseBlockParagraphLineElement1,
 				expr: &seqExpr{
-					pos: position{line: 1387, col: 35, offset: 52683},
+					pos: position{line: 1431, col: 35, offset: 54758},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1387, col: 35, offset: 52683},
+							pos: position{line: 1431, col: 35, offset: 54758},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1387, col: 36, offset: 52684},
+								pos:  position{line: 1431, col: 36, offset: 54759},
 								name: "EOL",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1387, col: 40, offset: 52688},
+							pos: position{line: 1431, col: 40, offset: 54763},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1387, col: 41, offset: 52689},
+								pos:  position{line: 1431, col: 41, offset: 54764},
 								name: "LineBreak",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1388, col: 5, offset: 52704},
+							pos:   position{line: 1432, col: 5, offset: 54779},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1388, col: 14, offset: 52713},
+								pos: position{line: 1432, col: 14, offset: 54788},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1388, col: 14, offset: 52713},
+										pos:  position{line: 1432, col: 14, offset: 54788},
 										name: "Spaces",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1389, col: 11, offset: 52731},
+										pos:  position{line: 1433, col: 11, offset: 54806},
 										name: "InlineImage",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1390, col: 11, offset: 52754},
+										pos:  position{line: 1434, col: 11, offset: 54829},
 										name: "Link",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1391, col: 11, offset: 52770},
+										pos:  position{line: 1435, col: 11, offset: 54845},
 										name: "Passthrough",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1392, col: 11, offset: 52793},
+										pos:  position{line: 1436, col: 11, offset: 54868},
 										name: "InlineFootnote",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1393, col: 11, offset: 52819},
+										pos:  position{line: 1437, col: 11, offset: 54894},
 										name: "QuotedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1394, col: 11, offset: 52841},
+										pos:  position{line: 1438, col: 11, offset: 54916},
 										name: "CrossReference",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1395, col: 11, offset: 52867},
+										pos:  position{line: 1439, col: 11, offset: 54942},
 										name: "InlineUserMacro",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1396, col: 11, offset: 52894},
+										pos:  position{line: 1440, col: 11, offset: 54969},
 										name: "DocumentAttributeSubstitution",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1397, col: 11, offset: 52935},
+										pos:  position{line: 1441, col: 11, offset: 55010},
 										name: "InlineElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1398, col: 11, offset: 52962},
+										pos:  position{line: 1442, col: 11, offset: 55037},
 										name: "OtherWord",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1399, col: 11, offset: 52982},
+										pos:  position{line: 1443, col: 11, offset: 55057},
 										name: "Parenthesis",
 									},
 								},
@@ -10953,17 +11471,17 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockDelimiter",
-			pos:  position{line: 1406, col: 1, offset: 53214},
+			pos:  position{line: 1450, col: 1, offset: 55289},
 			expr: &seqExpr{
-				pos: position{line: 1406, col: 26, offset: 53239},
+				pos: position{line: 1450, col: 26, offset: 55314},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1406, col: 26, offset: 53239},
+						pos:        position{line: 1450, col: 26, offset: 55314},
 						val:        "****",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1406, col: 33, offset: 53246},
+						pos:  position{line: 1450, col: 33, offset: 55321},
 						name: "EOLS",
 					},
 				},
@@ -10971,48 +11489,48 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlock",
-			pos:  position{line: 1408, col: 1, offset: 53252},
+			pos:  position{line: 1452, col: 1, offset: 55327},
 			expr: &actionExpr{
-				pos: position{line: 1408, col: 17, offset: 53268},
+				pos: position{line: 1452, col: 17, offset: 55343},
 				run: (*parser).callonSidebarBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1408, col: 17, offset: 53268},
+					pos: position{line: 1452, col: 17, offset: 55343},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1408, col: 17, offset: 53268},
+							pos:   position{line: 1452, col: 17, offset: 55343},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1408, col: 28, offset: 53279},
+								pos: position{line: 1452, col: 28, offset: 55354},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1408, col: 29, offset: 53280},
+									pos:  position{line: 1452, col: 29, offset: 55355},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1408, col: 49, offset: 53300},
+							pos:  position{line: 1452, col: 49, offset: 55375},
 							name: "SidebarBlockDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1408, col: 71, offset: 53322},
+							pos:   position{line: 1452, col: 71, offset: 55397},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1408, col: 79, offset: 53330},
+								pos: position{line: 1452, col: 79, offset: 55405},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1408, col: 80, offset: 53331},
+									pos:  position{line: 1452, col: 80, offset: 55406},
 									name: "SidebarBlockContent",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1408, col: 104, offset: 53355},
+							pos: position{line: 1452, col: 104, offset: 55430},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1408, col: 104, offset: 53355},
+									pos:  position{line: 1452, col: 104, offset: 55430},
 									name: "SidebarBlockDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1408, col: 128, offset: 53379},
+									pos:  position{line: 1452, col: 128, offset: 55454},
 									name: "EOF",
 								},
 							},
@@ -11023,28 +11541,28 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockContent",
-			pos:  position{line: 1412, col: 1, offset: 53488},
+			pos:  position{line: 1456, col: 1, offset: 55563},
 			expr: &choiceExpr{
-				pos: position{line: 1412, col: 24, offset: 53511},
+				pos: position{line: 1456, col: 24, offset: 55586},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1412, col: 24, offset: 53511},
+						pos:  position{line: 1456, col: 24, offset: 55586},
 						name: "BlankLine",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1412, col: 36, offset: 53523},
+						pos:  position{line: 1456, col: 36, offset: 55598},
 						name: "FileInclusion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1412, col: 52, offset: 53539},
+						pos:  position{line: 1456, col: 52, offset: 55614},
 						name: "ListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1412, col: 63, offset: 53550},
+						pos:  position{line: 1456, col: 63, offset: 55625},
 						name: "NonSidebarBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1412, col: 81, offset: 53568},
+						pos:  position{line: 1456, col: 81, offset: 55643},
 						name: "SidebarBlockParagraph",
 					},
 				},
@@ -11052,25 +11570,25 @@ var g = &grammar{
 		},
 		{
 			name: "NonSidebarBlock",
-			pos:  position{line: 1414, col: 1, offset: 53591},
+			pos:  position{line: 1458, col: 1, offset: 55666},
 			expr: &actionExpr{
-				pos: position{line: 1414, col: 20, offset: 53610},
+				pos: position{line: 1458, col: 20, offset: 55685},
 				run: (*parser).callonNonSidebarBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1414, col: 20, offset: 53610},
+					pos: position{line: 1458, col: 20, offset: 55685},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1414, col: 20, offset: 53610},
+							pos: position{line: 1458, col: 20, offset: 55685},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1414, col: 21, offset: 53611},
+								pos:  position{line: 1458, col: 21, offset: 55686},
 								name: "SidebarBlock",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1414, col: 34, offset: 53624},
+							pos:   position{line: 1458, col: 34, offset: 55699},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1414, col: 43, offset: 53633},
+								pos:  position{line: 1458, col: 43, offset: 55708},
 								name: "DelimitedBlock",
 							},
 						},
@@ -11080,17 +11598,17 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockParagraph",
-			pos:  position{line: 1419, col: 1, offset: 53696},
+			pos:  position{line: 1463, col: 1, offset: 55771},
 			expr: &actionExpr{
-				pos: position{line: 1419, col: 26, offset: 53721},
+				pos: position{line: 1463, col: 26, offset: 55796},
 				run: (*parser).callonSidebarBlockParagraph1,
 				expr: &labeledExpr{
-					pos:   position{line: 1419, col: 26, offset: 53721},
+					pos:   position{line: 1463, col: 26, offset: 55796},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1419, col: 32, offset: 53727},
+						pos: position{line: 1463, col: 32, offset: 55802},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1419, col: 33, offset: 53728},
+							pos:  position{line: 1463, col: 33, offset: 55803},
 							name: "SidebarBlockParagraphLine",
 						},
 					},
@@ -11099,32 +11617,32 @@ var g = &grammar{
 		},
 		{
 			name: "SidebarBlockParagraphLine",
-			pos:  position{line: 1423, col: 1, offset: 53842},
+			pos:  position{line: 1467, col: 1, offset: 55917},
 			expr: &actionExpr{
-				pos: position{line: 1423, col: 30, offset: 53871},
+				pos: position{line: 1467, col: 30, offset: 55946},
 				run: (*parser).callonSidebarBlockParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1423, col: 30, offset: 53871},
+					pos: position{line: 1467, col: 30, offset: 55946},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1423, col: 30, offset: 53871},
+							pos: position{line: 1467, col: 30, offset: 55946},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1423, col: 31, offset: 53872},
+								pos:  position{line: 1467, col: 31, offset: 55947},
 								name: "SidebarBlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 1423, col: 53, offset: 53894},
+							pos: position{line: 1467, col: 53, offset: 55969},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1423, col: 54, offset: 53895},
+								pos:  position{line: 1467, col: 54, offset: 55970},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1423, col: 64, offset: 53905},
+							pos:   position{line: 1467, col: 64, offset: 55980},
 							label: "line",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1423, col: 70, offset: 53911},
+								pos:  position{line: 1467, col: 70, offset: 55986},
 								name: "InlineElements",
 							},
 						},
@@ -11134,59 +11652,59 @@ var g = &grammar{
 		},
 		{
 			name: "Table",
-			pos:  position{line: 1431, col: 1, offset: 54142},
+			pos:  position{line: 1475, col: 1, offset: 56217},
 			expr: &actionExpr{
-				pos: position{line: 1431, col: 10, offset: 54151},
+				pos: position{line: 1475, col: 10, offset: 56226},
 				run: (*parser).callonTable1,
 				expr: &seqExpr{
-					pos: position{line: 1431, col: 10, offset: 54151},
+					pos: position{line: 1475, col: 10, offset: 56226},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1431, col: 10, offset: 54151},
+							pos:   position{line: 1475, col: 10, offset: 56226},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1431, col: 21, offset: 54162},
+								pos: position{line: 1475, col: 21, offset: 56237},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1431, col: 22, offset: 54163},
+									pos:  position{line: 1475, col: 22, offset: 56238},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1431, col: 42, offset: 54183},
+							pos:  position{line: 1475, col: 42, offset: 56258},
 							name: "TableDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1432, col: 5, offset: 54202},
+							pos:   position{line: 1476, col: 5, offset: 56277},
 							label: "header",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1432, col: 12, offset: 54209},
+								pos: position{line: 1476, col: 12, offset: 56284},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1432, col: 13, offset: 54210},
+									pos:  position{line: 1476, col: 13, offset: 56285},
 									name: "TableLineHeader",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1433, col: 5, offset: 54232},
+							pos:   position{line: 1477, col: 5, offset: 56307},
 							label: "lines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1433, col: 11, offset: 54238},
+								pos: position{line: 1477, col: 11, offset: 56313},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1433, col: 12, offset: 54239},
+									pos:  position{line: 1477, col: 12, offset: 56314},
 									name: "TableLine",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1434, col: 6, offset: 54256},
+							pos: position{line: 1478, col: 6, offset: 56331},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1434, col: 6, offset: 54256},
+									pos:  position{line: 1478, col: 6, offset: 56331},
 									name: "TableDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1434, col: 23, offset: 54273},
+									pos:  position{line: 1478, col: 23, offset: 56348},
 									name: "EOF",
 								},
 							},
@@ -11197,19 +11715,19 @@ var g = &grammar{
 		},
 		{
 			name: "TableCellSeparator",
-			pos:  position{line: 1438, col: 1, offset: 54388},
+			pos:  position{line: 1482, col: 1, offset: 56463},
 			expr: &seqExpr{
-				pos: position{line: 1438, col: 23, offset: 54410},
+				pos: position{line: 1482, col: 23, offset: 56485},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1438, col: 23, offset: 54410},
+						pos:        position{line: 1482, col: 23, offset: 56485},
 						val:        "|",
 						ignoreCase: false,
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1438, col: 27, offset: 54414},
+						pos: position{line: 1482, col: 27, offset: 56489},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1438, col: 27, offset: 54414},
+							pos:  position{line: 1482, col: 27, offset: 56489},
 							name: "WS",
 						},
 					},
@@ -11218,17 +11736,17 @@ var g = &grammar{
 		},
 		{
 			name: "TableDelimiter",
-			pos:  position{line: 1440, col: 1, offset: 54419},
+			pos:  position{line: 1484, col: 1, offset: 56494},
 			expr: &seqExpr{
-				pos: position{line: 1440, col: 19, offset: 54437},
+				pos: position{line: 1484, col: 19, offset: 56512},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1440, col: 19, offset: 54437},
+						pos:        position{line: 1484, col: 19, offset: 56512},
 						val:        "|===",
 						ignoreCase: false,
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1440, col: 26, offset: 54444},
+						pos:  position{line: 1484, col: 26, offset: 56519},
 						name: "EOLS",
 					},
 				},
@@ -11236,37 +11754,37 @@ var g = &grammar{
 		},
 		{
 			name: "TableLineHeader",
-			pos:  position{line: 1443, col: 1, offset: 54513},
+			pos:  position{line: 1487, col: 1, offset: 56588},
 			expr: &actionExpr{
-				pos: position{line: 1443, col: 20, offset: 54532},
+				pos: position{line: 1487, col: 20, offset: 56607},
 				run: (*parser).callonTableLineHeader1,
 				expr: &seqExpr{
-					pos: position{line: 1443, col: 20, offset: 54532},
+					pos: position{line: 1487, col: 20, offset: 56607},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1443, col: 20, offset: 54532},
+							pos: position{line: 1487, col: 20, offset: 56607},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1443, col: 21, offset: 54533},
+								pos:  position{line: 1487, col: 21, offset: 56608},
 								name: "TableDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1443, col: 36, offset: 54548},
+							pos:   position{line: 1487, col: 36, offset: 56623},
 							label: "cells",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1443, col: 42, offset: 54554},
+								pos: position{line: 1487, col: 42, offset: 56629},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1443, col: 43, offset: 54555},
+									pos:  position{line: 1487, col: 43, offset: 56630},
 									name: "TableCell",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1443, col: 55, offset: 54567},
+							pos:  position{line: 1487, col: 55, offset: 56642},
 							name: "EOL",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1443, col: 59, offset: 54571},
+							pos:  position{line: 1487, col: 59, offset: 56646},
 							name: "BlankLine",
 						},
 					},
@@ -11275,39 +11793,39 @@ var g = &grammar{
 		},
 		{
 			name: "TableLine",
-			pos:  position{line: 1447, col: 1, offset: 54639},
+			pos:  position{line: 1491, col: 1, offset: 56714},
 			expr: &actionExpr{
-				pos: position{line: 1447, col: 14, offset: 54652},
+				pos: position{line: 1491, col: 14, offset: 56727},
 				run: (*parser).callonTableLine1,
 				expr: &seqExpr{
-					pos: position{line: 1447, col: 14, offset: 54652},
+					pos: position{line: 1491, col: 14, offset: 56727},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1447, col: 14, offset: 54652},
+							pos: position{line: 1491, col: 14, offset: 56727},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1447, col: 15, offset: 54653},
+								pos:  position{line: 1491, col: 15, offset: 56728},
 								name: "TableDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1447, col: 30, offset: 54668},
+							pos:   position{line: 1491, col: 30, offset: 56743},
 							label: "cells",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1447, col: 36, offset: 54674},
+								pos: position{line: 1491, col: 36, offset: 56749},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1447, col: 37, offset: 54675},
+									pos:  position{line: 1491, col: 37, offset: 56750},
 									name: "TableCell",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1447, col: 49, offset: 54687},
+							pos:  position{line: 1491, col: 49, offset: 56762},
 							name: "EOL",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1447, col: 53, offset: 54691},
+							pos: position{line: 1491, col: 53, offset: 56766},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1447, col: 53, offset: 54691},
+								pos:  position{line: 1491, col: 53, offset: 56766},
 								name: "BlankLine",
 							},
 						},
@@ -11317,54 +11835,54 @@ var g = &grammar{
 		},
 		{
 			name: "TableCell",
-			pos:  position{line: 1451, col: 1, offset: 54760},
+			pos:  position{line: 1495, col: 1, offset: 56835},
 			expr: &actionExpr{
-				pos: position{line: 1451, col: 14, offset: 54773},
+				pos: position{line: 1495, col: 14, offset: 56848},
 				run: (*parser).callonTableCell1,
 				expr: &seqExpr{
-					pos: position{line: 1451, col: 14, offset: 54773},
+					pos: position{line: 1495, col: 14, offset: 56848},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1451, col: 14, offset: 54773},
+							pos:  position{line: 1495, col: 14, offset: 56848},
 							name: "TableCellSeparator",
 						},
 						&labeledExpr{
-							pos:   position{line: 1451, col: 33, offset: 54792},
+							pos:   position{line: 1495, col: 33, offset: 56867},
 							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1451, col: 42, offset: 54801},
+								pos: position{line: 1495, col: 42, offset: 56876},
 								expr: &seqExpr{
-									pos: position{line: 1451, col: 43, offset: 54802},
+									pos: position{line: 1495, col: 43, offset: 56877},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1451, col: 43, offset: 54802},
+											pos: position{line: 1495, col: 43, offset: 56877},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1451, col: 44, offset: 54803},
+												pos:  position{line: 1495, col: 44, offset: 56878},
 												name: "TableCellSeparator",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1451, col: 63, offset: 54822},
+											pos: position{line: 1495, col: 63, offset: 56897},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1451, col: 64, offset: 54823},
+												pos:  position{line: 1495, col: 64, offset: 56898},
 												name: "EOL",
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1451, col: 68, offset: 54827},
+											pos: position{line: 1495, col: 68, offset: 56902},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1451, col: 68, offset: 54827},
+												pos:  position{line: 1495, col: 68, offset: 56902},
 												name: "WS",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1451, col: 72, offset: 54831},
+											pos:  position{line: 1495, col: 72, offset: 56906},
 											name: "InlineElement",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 1451, col: 86, offset: 54845},
+											pos: position{line: 1495, col: 86, offset: 56920},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1451, col: 86, offset: 54845},
+												pos:  position{line: 1495, col: 86, offset: 56920},
 												name: "WS",
 											},
 										},
@@ -11378,66 +11896,66 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockDelimiter",
-			pos:  position{line: 1458, col: 1, offset: 55091},
+			pos:  position{line: 1502, col: 1, offset: 57166},
 			expr: &litMatcher{
-				pos:        position{line: 1458, col: 26, offset: 55116},
+				pos:        position{line: 1502, col: 26, offset: 57191},
 				val:        "////",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "CommentBlock",
-			pos:  position{line: 1460, col: 1, offset: 55124},
+			pos:  position{line: 1504, col: 1, offset: 57199},
 			expr: &actionExpr{
-				pos: position{line: 1460, col: 17, offset: 55140},
+				pos: position{line: 1504, col: 17, offset: 57215},
 				run: (*parser).callonCommentBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1460, col: 17, offset: 55140},
+					pos: position{line: 1504, col: 17, offset: 57215},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1460, col: 17, offset: 55140},
+							pos:  position{line: 1504, col: 17, offset: 57215},
 							name: "CommentBlockDelimiter",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1460, col: 39, offset: 55162},
+							pos: position{line: 1504, col: 39, offset: 57237},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1460, col: 39, offset: 55162},
+								pos:  position{line: 1504, col: 39, offset: 57237},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1460, col: 43, offset: 55166},
+							pos:  position{line: 1504, col: 43, offset: 57241},
 							name: "NEWLINE",
 						},
 						&labeledExpr{
-							pos:   position{line: 1460, col: 51, offset: 55174},
+							pos:   position{line: 1504, col: 51, offset: 57249},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1460, col: 59, offset: 55182},
+								pos: position{line: 1504, col: 59, offset: 57257},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1460, col: 60, offset: 55183},
+									pos:  position{line: 1504, col: 60, offset: 57258},
 									name: "CommentBlockLine",
 								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1460, col: 81, offset: 55204},
+							pos: position{line: 1504, col: 81, offset: 57279},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 1460, col: 82, offset: 55205},
+									pos: position{line: 1504, col: 82, offset: 57280},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1460, col: 82, offset: 55205},
+											pos:  position{line: 1504, col: 82, offset: 57280},
 											name: "CommentBlockDelimiter",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1460, col: 104, offset: 55227},
+											pos:  position{line: 1504, col: 104, offset: 57302},
 											name: "EOLS",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1460, col: 112, offset: 55235},
+									pos:  position{line: 1504, col: 112, offset: 57310},
 									name: "EOF",
 								},
 							},
@@ -11448,45 +11966,45 @@ var g = &grammar{
 		},
 		{
 			name: "CommentBlockLine",
-			pos:  position{line: 1464, col: 1, offset: 55341},
+			pos:  position{line: 1508, col: 1, offset: 57416},
 			expr: &actionExpr{
-				pos: position{line: 1464, col: 21, offset: 55361},
+				pos: position{line: 1508, col: 21, offset: 57436},
 				run: (*parser).callonCommentBlockLine1,
 				expr: &seqExpr{
-					pos: position{line: 1464, col: 21, offset: 55361},
+					pos: position{line: 1508, col: 21, offset: 57436},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 1464, col: 21, offset: 55361},
+							pos: position{line: 1508, col: 21, offset: 57436},
 							expr: &choiceExpr{
-								pos: position{line: 1464, col: 22, offset: 55362},
+								pos: position{line: 1508, col: 22, offset: 57437},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1464, col: 22, offset: 55362},
+										pos:  position{line: 1508, col: 22, offset: 57437},
 										name: "Alphanums",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1464, col: 34, offset: 55374},
+										pos:  position{line: 1508, col: 34, offset: 57449},
 										name: "Spaces",
 									},
 									&seqExpr{
-										pos: position{line: 1464, col: 44, offset: 55384},
+										pos: position{line: 1508, col: 44, offset: 57459},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1464, col: 44, offset: 55384},
+												pos: position{line: 1508, col: 44, offset: 57459},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1464, col: 45, offset: 55385},
+													pos:  position{line: 1508, col: 45, offset: 57460},
 													name: "CommentBlockDelimiter",
 												},
 											},
 											&notExpr{
-												pos: position{line: 1464, col: 67, offset: 55407},
+												pos: position{line: 1508, col: 67, offset: 57482},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1464, col: 68, offset: 55408},
+													pos:  position{line: 1508, col: 68, offset: 57483},
 													name: "EOL",
 												},
 											},
 											&anyMatcher{
-												line: 1464, col: 72, offset: 55412,
+												line: 1508, col: 72, offset: 57487,
 											},
 										},
 									},
@@ -11494,7 +12012,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1464, col: 77, offset: 55417},
+							pos:  position{line: 1508, col: 77, offset: 57492},
 							name: "EOL",
 						},
 					},
@@ -11503,42 +12021,42 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineComment",
-			pos:  position{line: 1468, col: 1, offset: 55457},
+			pos:  position{line: 1512, col: 1, offset: 57532},
 			expr: &actionExpr{
-				pos: position{line: 1468, col: 22, offset: 55478},
+				pos: position{line: 1512, col: 22, offset: 57553},
 				run: (*parser).callonSingleLineComment1,
 				expr: &seqExpr{
-					pos: position{line: 1468, col: 22, offset: 55478},
+					pos: position{line: 1512, col: 22, offset: 57553},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1468, col: 22, offset: 55478},
+							pos: position{line: 1512, col: 22, offset: 57553},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1468, col: 23, offset: 55479},
+								pos:  position{line: 1512, col: 23, offset: 57554},
 								name: "CommentBlockDelimiter",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1468, col: 45, offset: 55501},
+							pos: position{line: 1512, col: 45, offset: 57576},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1468, col: 45, offset: 55501},
+								pos:  position{line: 1512, col: 45, offset: 57576},
 								name: "WS",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1468, col: 49, offset: 55505},
+							pos:        position{line: 1512, col: 49, offset: 57580},
 							val:        "//",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1468, col: 54, offset: 55510},
+							pos:   position{line: 1512, col: 54, offset: 57585},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1468, col: 63, offset: 55519},
+								pos:  position{line: 1512, col: 63, offset: 57594},
 								name: "SingleLineCommentContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1468, col: 89, offset: 55545},
+							pos:  position{line: 1512, col: 89, offset: 57620},
 							name: "EOL",
 						},
 					},
@@ -11547,35 +12065,35 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineCommentContent",
-			pos:  position{line: 1472, col: 1, offset: 55610},
+			pos:  position{line: 1516, col: 1, offset: 57685},
 			expr: &actionExpr{
-				pos: position{line: 1472, col: 29, offset: 55638},
+				pos: position{line: 1516, col: 29, offset: 57713},
 				run: (*parser).callonSingleLineCommentContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 1472, col: 29, offset: 55638},
+					pos: position{line: 1516, col: 29, offset: 57713},
 					expr: &choiceExpr{
-						pos: position{line: 1472, col: 30, offset: 55639},
+						pos: position{line: 1516, col: 30, offset: 57714},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1472, col: 30, offset: 55639},
+								pos:  position{line: 1516, col: 30, offset: 57714},
 								name: "Alphanums",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1472, col: 42, offset: 55651},
+								pos:  position{line: 1516, col: 42, offset: 57726},
 								name: "Spaces",
 							},
 							&seqExpr{
-								pos: position{line: 1472, col: 52, offset: 55661},
+								pos: position{line: 1516, col: 52, offset: 57736},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1472, col: 52, offset: 55661},
+										pos: position{line: 1516, col: 52, offset: 57736},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1472, col: 53, offset: 55662},
+											pos:  position{line: 1516, col: 53, offset: 57737},
 											name: "EOL",
 										},
 									},
 									&anyMatcher{
-										line: 1472, col: 58, offset: 55667,
+										line: 1516, col: 58, offset: 57742,
 									},
 								},
 							},
@@ -11586,20 +12104,20 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralBlock",
-			pos:  position{line: 1480, col: 1, offset: 55976},
+			pos:  position{line: 1524, col: 1, offset: 58051},
 			expr: &choiceExpr{
-				pos: position{line: 1480, col: 17, offset: 55992},
+				pos: position{line: 1524, col: 17, offset: 58067},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1480, col: 17, offset: 55992},
+						pos:  position{line: 1524, col: 17, offset: 58067},
 						name: "ParagraphWithLiteralAttribute",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1480, col: 49, offset: 56024},
+						pos:  position{line: 1524, col: 49, offset: 58099},
 						name: "ParagraphWithHeadingSpaces",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1480, col: 78, offset: 56053},
+						pos:  position{line: 1524, col: 78, offset: 58128},
 						name: "ParagraphWithLiteralBlockDelimiter",
 					},
 				},
@@ -11607,38 +12125,38 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralBlockDelimiter",
-			pos:  position{line: 1482, col: 1, offset: 56089},
+			pos:  position{line: 1526, col: 1, offset: 58164},
 			expr: &litMatcher{
-				pos:        position{line: 1482, col: 26, offset: 56114},
+				pos:        position{line: 1526, col: 26, offset: 58189},
 				val:        "....",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "ParagraphWithHeadingSpaces",
-			pos:  position{line: 1485, col: 1, offset: 56186},
+			pos:  position{line: 1529, col: 1, offset: 58261},
 			expr: &actionExpr{
-				pos: position{line: 1485, col: 31, offset: 56216},
+				pos: position{line: 1529, col: 31, offset: 58291},
 				run: (*parser).callonParagraphWithHeadingSpaces1,
 				expr: &seqExpr{
-					pos: position{line: 1485, col: 31, offset: 56216},
+					pos: position{line: 1529, col: 31, offset: 58291},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1485, col: 31, offset: 56216},
+							pos:   position{line: 1529, col: 31, offset: 58291},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1485, col: 42, offset: 56227},
+								pos: position{line: 1529, col: 42, offset: 58302},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1485, col: 43, offset: 56228},
+									pos:  position{line: 1529, col: 43, offset: 58303},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1485, col: 63, offset: 56248},
+							pos:   position{line: 1529, col: 63, offset: 58323},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1485, col: 70, offset: 56255},
+								pos:  position{line: 1529, col: 70, offset: 58330},
 								name: "ParagraphWithHeadingSpacesLines",
 							},
 						},
@@ -11648,54 +12166,54 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithHeadingSpacesLines",
-			pos:  position{line: 1490, col: 1, offset: 56485},
+			pos:  position{line: 1534, col: 1, offset: 58560},
 			expr: &actionExpr{
-				pos: position{line: 1491, col: 5, offset: 56525},
+				pos: position{line: 1535, col: 5, offset: 58600},
 				run: (*parser).callonParagraphWithHeadingSpacesLines1,
 				expr: &seqExpr{
-					pos: position{line: 1491, col: 5, offset: 56525},
+					pos: position{line: 1535, col: 5, offset: 58600},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1491, col: 5, offset: 56525},
+							pos:   position{line: 1535, col: 5, offset: 58600},
 							label: "firstLine",
 							expr: &actionExpr{
-								pos: position{line: 1491, col: 16, offset: 56536},
+								pos: position{line: 1535, col: 16, offset: 58611},
 								run: (*parser).callonParagraphWithHeadingSpacesLines4,
 								expr: &seqExpr{
-									pos: position{line: 1491, col: 16, offset: 56536},
+									pos: position{line: 1535, col: 16, offset: 58611},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1491, col: 16, offset: 56536},
+											pos:  position{line: 1535, col: 16, offset: 58611},
 											name: "WS",
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1491, col: 19, offset: 56539},
+											pos: position{line: 1535, col: 19, offset: 58614},
 											expr: &choiceExpr{
-												pos: position{line: 1491, col: 20, offset: 56540},
+												pos: position{line: 1535, col: 20, offset: 58615},
 												alternatives: []interface{}{
 													&ruleRefExpr{
-														pos:  position{line: 1491, col: 20, offset: 56540},
+														pos:  position{line: 1535, col: 20, offset: 58615},
 														name: "Alphanums",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1491, col: 32, offset: 56552},
+														pos:  position{line: 1535, col: 32, offset: 58627},
 														name: "Spaces",
 													},
 													&actionExpr{
-														pos: position{line: 1491, col: 41, offset: 56561},
+														pos: position{line: 1535, col: 41, offset: 58636},
 														run: (*parser).callonParagraphWithHeadingSpacesLines11,
 														expr: &seqExpr{
-															pos: position{line: 1491, col: 42, offset: 56562},
+															pos: position{line: 1535, col: 42, offset: 58637},
 															exprs: []interface{}{
 																&notExpr{
-																	pos: position{line: 1491, col: 42, offset: 56562},
+																	pos: position{line: 1535, col: 42, offset: 58637},
 																	expr: &ruleRefExpr{
-																		pos:  position{line: 1491, col: 43, offset: 56563},
+																		pos:  position{line: 1535, col: 43, offset: 58638},
 																		name: "EOL",
 																	},
 																},
 																&anyMatcher{
-																	line: 1491, col: 48, offset: 56568,
+																	line: 1535, col: 48, offset: 58643,
 																},
 															},
 														},
@@ -11708,58 +12226,58 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1495, col: 8, offset: 56659},
+							pos:  position{line: 1539, col: 8, offset: 58734},
 							name: "EOL",
 						},
 						&labeledExpr{
-							pos:   position{line: 1496, col: 5, offset: 56722},
+							pos:   position{line: 1540, col: 5, offset: 58797},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1496, col: 16, offset: 56733},
+								pos: position{line: 1540, col: 16, offset: 58808},
 								expr: &actionExpr{
-									pos: position{line: 1497, col: 9, offset: 56743},
+									pos: position{line: 1541, col: 9, offset: 58818},
 									run: (*parser).callonParagraphWithHeadingSpacesLines19,
 									expr: &seqExpr{
-										pos: position{line: 1497, col: 9, offset: 56743},
+										pos: position{line: 1541, col: 9, offset: 58818},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1497, col: 9, offset: 56743},
+												pos: position{line: 1541, col: 9, offset: 58818},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1497, col: 10, offset: 56744},
+													pos:  position{line: 1541, col: 10, offset: 58819},
 													name: "BlankLine",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 1498, col: 9, offset: 56763},
+												pos:   position{line: 1542, col: 9, offset: 58838},
 												label: "otherLine",
 												expr: &actionExpr{
-													pos: position{line: 1498, col: 20, offset: 56774},
+													pos: position{line: 1542, col: 20, offset: 58849},
 													run: (*parser).callonParagraphWithHeadingSpacesLines24,
 													expr: &oneOrMoreExpr{
-														pos: position{line: 1498, col: 20, offset: 56774},
+														pos: position{line: 1542, col: 20, offset: 58849},
 														expr: &choiceExpr{
-															pos: position{line: 1498, col: 21, offset: 56775},
+															pos: position{line: 1542, col: 21, offset: 58850},
 															alternatives: []interface{}{
 																&ruleRefExpr{
-																	pos:  position{line: 1498, col: 21, offset: 56775},
+																	pos:  position{line: 1542, col: 21, offset: 58850},
 																	name: "Alphanums",
 																},
 																&ruleRefExpr{
-																	pos:  position{line: 1498, col: 33, offset: 56787},
+																	pos:  position{line: 1542, col: 33, offset: 58862},
 																	name: "Spaces",
 																},
 																&seqExpr{
-																	pos: position{line: 1498, col: 43, offset: 56797},
+																	pos: position{line: 1542, col: 43, offset: 58872},
 																	exprs: []interface{}{
 																		&notExpr{
-																			pos: position{line: 1498, col: 43, offset: 56797},
+																			pos: position{line: 1542, col: 43, offset: 58872},
 																			expr: &ruleRefExpr{
-																				pos:  position{line: 1498, col: 44, offset: 56798},
+																				pos:  position{line: 1542, col: 44, offset: 58873},
 																				name: "EOL",
 																			},
 																		},
 																		&anyMatcher{
-																			line: 1498, col: 49, offset: 56803,
+																			line: 1542, col: 49, offset: 58878,
 																		},
 																	},
 																},
@@ -11769,7 +12287,7 @@ var g = &grammar{
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1500, col: 12, offset: 56860},
+												pos:  position{line: 1544, col: 12, offset: 58935},
 												name: "EOL",
 											},
 										},
@@ -11783,65 +12301,65 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiter",
-			pos:  position{line: 1507, col: 1, offset: 57090},
+			pos:  position{line: 1551, col: 1, offset: 59165},
 			expr: &actionExpr{
-				pos: position{line: 1507, col: 39, offset: 57128},
+				pos: position{line: 1551, col: 39, offset: 59203},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiter1,
 				expr: &seqExpr{
-					pos: position{line: 1507, col: 39, offset: 57128},
+					pos: position{line: 1551, col: 39, offset: 59203},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1507, col: 39, offset: 57128},
+							pos:   position{line: 1551, col: 39, offset: 59203},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1507, col: 50, offset: 57139},
+								pos: position{line: 1551, col: 50, offset: 59214},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1507, col: 51, offset: 57140},
+									pos:  position{line: 1551, col: 51, offset: 59215},
 									name: "ElementAttributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1508, col: 9, offset: 57168},
+							pos:  position{line: 1552, col: 9, offset: 59243},
 							name: "LiteralBlockDelimiter",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1508, col: 31, offset: 57190},
+							pos: position{line: 1552, col: 31, offset: 59265},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1508, col: 31, offset: 57190},
+								pos:  position{line: 1552, col: 31, offset: 59265},
 								name: "WS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1508, col: 35, offset: 57194},
+							pos:  position{line: 1552, col: 35, offset: 59269},
 							name: "NEWLINE",
 						},
 						&labeledExpr{
-							pos:   position{line: 1508, col: 43, offset: 57202},
+							pos:   position{line: 1552, col: 43, offset: 59277},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1508, col: 50, offset: 57209},
+								pos:  position{line: 1552, col: 50, offset: 59284},
 								name: "ParagraphWithLiteralBlockDelimiterLines",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 1508, col: 92, offset: 57251},
+							pos: position{line: 1552, col: 92, offset: 59326},
 							alternatives: []interface{}{
 								&seqExpr{
-									pos: position{line: 1508, col: 93, offset: 57252},
+									pos: position{line: 1552, col: 93, offset: 59327},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1508, col: 93, offset: 57252},
+											pos:  position{line: 1552, col: 93, offset: 59327},
 											name: "LiteralBlockDelimiter",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1508, col: 115, offset: 57274},
+											pos:  position{line: 1552, col: 115, offset: 59349},
 											name: "EOLS",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1508, col: 123, offset: 57282},
+									pos:  position{line: 1552, col: 123, offset: 59357},
 									name: "EOF",
 								},
 							},
@@ -11852,17 +12370,17 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiterLines",
-			pos:  position{line: 1513, col: 1, offset: 57441},
+			pos:  position{line: 1557, col: 1, offset: 59516},
 			expr: &actionExpr{
-				pos: position{line: 1513, col: 44, offset: 57484},
+				pos: position{line: 1557, col: 44, offset: 59559},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiterLines1,
 				expr: &labeledExpr{
-					pos:   position{line: 1513, col: 44, offset: 57484},
+					pos:   position{line: 1557, col: 44, offset: 59559},
 					label: "lines",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 1513, col: 50, offset: 57490},
+						pos: position{line: 1557, col: 50, offset: 59565},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1513, col: 51, offset: 57491},
+							pos:  position{line: 1557, col: 51, offset: 59566},
 							name: "ParagraphWithLiteralBlockDelimiterLine",
 						},
 					},
@@ -11871,197 +12389,541 @@ var g = &grammar{
 		},
 		{
 			name: "ParagraphWithLiteralBlockDelimiterLine",
-			pos:  position{line: 1517, col: 1, offset: 57575},
+			pos:  position{line: 1561, col: 1, offset: 59650},
 			expr: &actionExpr{
-				pos: position{line: 1518, col: 5, offset: 57630},
+				pos: position{line: 1562, col: 5, offset: 59705},
 				run: (*parser).callonParagraphWithLiteralBlockDelimiterLine1,
 				expr: &seqExpr{
-					pos: position{line: 1518, col: 5, offset: 57630},
+					pos: position{line: 1562, col: 5, offset: 59705},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1518, col: 5, offset: 57630},
+							pos:   position{line: 1562, col: 5, offset: 59705},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 1518, col: 11, offset: 57636},
+								pos: position{line: 1562, col: 11, offset: 59711},
 								run: (*parser).callonParagraphWithLiteralBlockDelimiterLine4,
 								expr: &zeroOrMoreExpr{
-									pos: position{line: 1518, col: 11, offset: 57636},
+									pos: position{line: 1562, col: 11, offset: 59711},
 									expr: &choiceExpr{
-										pos: position{line: 1518, col: 12, offset: 57637},
+										pos: position{line: 1562, col: 12, offset: 59712},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1518, col: 12, offset: 57637},
+												pos:  position{line: 1562, col: 12, offset: 59712},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1518, col: 24, offset: 57649},
+												pos:  position{line: 1562, col: 24, offset: 59724},
 												name: "Spaces",
 											},
 											&seqExpr{
-												pos: position{line: 1518, col: 34, offset: 57659},
+												pos: position{line: 1562, col: 34, offset: 59734},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 1518, col: 34, offset: 57659},
+														pos: position{line: 1562, col: 34, offset: 59734},
 														expr: &ruleRefExpr{
-															pos:  position{line: 1518, col: 35, offset: 57660},
+															pos:  position{line: 1562, col: 35, offset: 59735},
 															name: "LiteralBlockDelimiter",
 														},
 													},
 													&notExpr{
-														pos: position{line: 1518, col: 57, offset: 57682},
+														pos: position{line: 1562, col: 57, offset: 59757},
+														expr: &ruleRefExpr{
+															pos:  position{line: 1562, col: 58, offset: 59758},
+															name: "EOL",
+														},
+													},
+													&anyMatcher{
+														line: 1562, col: 62, offset: 59762,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1564, col: 8, offset: 59811},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ParagraphWithLiteralAttribute",
+			pos:  position{line: 1569, col: 1, offset: 59937},
+			expr: &actionExpr{
+				pos: position{line: 1570, col: 5, offset: 59975},
+				run: (*parser).callonParagraphWithLiteralAttribute1,
+				expr: &seqExpr{
+					pos: position{line: 1570, col: 5, offset: 59975},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1570, col: 5, offset: 59975},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1570, col: 16, offset: 59986},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1570, col: 17, offset: 59987},
+									name: "ElementAttributes",
+								},
+							},
+						},
+						&andCodeExpr{
+							pos: position{line: 1571, col: 5, offset: 60011},
+							run: (*parser).callonParagraphWithLiteralAttribute6,
+						},
+						&labeledExpr{
+							pos:   position{line: 1578, col: 5, offset: 60225},
+							label: "lines",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1578, col: 12, offset: 60232},
+								name: "ParagraphWithLiteralAttributeLines",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LiteralKind",
+			pos:  position{line: 1582, col: 1, offset: 60382},
+			expr: &actionExpr{
+				pos: position{line: 1582, col: 16, offset: 60397},
+				run: (*parser).callonLiteralKind1,
+				expr: &litMatcher{
+					pos:        position{line: 1582, col: 16, offset: 60397},
+					val:        "literal",
+					ignoreCase: false,
+				},
+			},
+		},
+		{
+			name: "ParagraphWithLiteralAttributeLines",
+			pos:  position{line: 1587, col: 1, offset: 60480},
+			expr: &actionExpr{
+				pos: position{line: 1587, col: 39, offset: 60518},
+				run: (*parser).callonParagraphWithLiteralAttributeLines1,
+				expr: &labeledExpr{
+					pos:   position{line: 1587, col: 39, offset: 60518},
+					label: "lines",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 1587, col: 45, offset: 60524},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1587, col: 46, offset: 60525},
+							name: "ParagraphWithLiteralAttributeLine",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ParagraphWithLiteralAttributeLine",
+			pos:  position{line: 1591, col: 1, offset: 60605},
+			expr: &actionExpr{
+				pos: position{line: 1591, col: 38, offset: 60642},
+				run: (*parser).callonParagraphWithLiteralAttributeLine1,
+				expr: &seqExpr{
+					pos: position{line: 1591, col: 38, offset: 60642},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1591, col: 38, offset: 60642},
+							label: "line",
+							expr: &actionExpr{
+								pos: position{line: 1591, col: 44, offset: 60648},
+								run: (*parser).callonParagraphWithLiteralAttributeLine4,
+								expr: &seqExpr{
+									pos: position{line: 1591, col: 44, offset: 60648},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 1591, col: 44, offset: 60648},
+											expr: &ruleRefExpr{
+												pos:  position{line: 1591, col: 46, offset: 60650},
+												name: "BlankLine",
+											},
+										},
+										&oneOrMoreExpr{
+											pos: position{line: 1591, col: 57, offset: 60661},
+											expr: &choiceExpr{
+												pos: position{line: 1591, col: 58, offset: 60662},
+												alternatives: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 1591, col: 58, offset: 60662},
+														name: "Alphanums",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1591, col: 70, offset: 60674},
+														name: "Spaces",
+													},
+													&seqExpr{
+														pos: position{line: 1591, col: 80, offset: 60684},
+														exprs: []interface{}{
+															&notExpr{
+																pos: position{line: 1591, col: 80, offset: 60684},
+																expr: &ruleRefExpr{
+																	pos:  position{line: 1591, col: 81, offset: 60685},
+																	name: "EOL",
+																},
+															},
+															&anyMatcher{
+																line: 1591, col: 86, offset: 60690,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1593, col: 4, offset: 60731},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StemNotation",
+			pos:  position{line: 1600, col: 1, offset: 60942},
+			expr: &actionExpr{
+				pos: position{line: 1600, col: 17, offset: 60958},
+				run: (*parser).callonStemNotation1,
+				expr: &choiceExpr{
+					pos: position{line: 1600, col: 18, offset: 60959},
+					alternatives: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1600, col: 18, offset: 60959},
+							val:        "stem",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 1600, col: 27, offset: 60968},
+							val:        "asciimath",
+							ignoreCase: false,
+						},
+						&litMatcher{
+							pos:        position{line: 1600, col: 41, offset: 60982},
+							val:        "latexmath",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineStem",
+			pos:  position{line: 1604, col: 1, offset: 61031},
+			expr: &actionExpr{
+				pos: position{line: 1604, col: 15, offset: 61045},
+				run: (*parser).callonInlineStem1,
+				expr: &seqExpr{
+					pos: position{line: 1604, col: 15, offset: 61045},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1604, col: 15, offset: 61045},
+							label: "notation",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1604, col: 25, offset: 61055},
+								name: "StemNotation",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1604, col: 39, offset: 61069},
+							val:        ":[",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 1604, col: 44, offset: 61074},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1604, col: 53, offset: 61083},
+								name: "InlineStemContent",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1604, col: 72, offset: 61102},
+							val:        "]",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineStemContent",
+			pos:  position{line: 1608, col: 1, offset: 61179},
+			expr: &actionExpr{
+				pos: position{line: 1608, col: 22, offset: 61200},
+				run: (*parser).callonInlineStemContent1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 1608, col: 22, offset: 61200},
+					expr: &choiceExpr{
+						pos: position{line: 1608, col: 23, offset: 61201},
+						alternatives: []interface{}{
+							&litMatcher{
+								pos:        position{line: 1608, col: 23, offset: 61201},
+								val:        "\\]",
+								ignoreCase: false,
+							},
+							&seqExpr{
+								pos: position{line: 1608, col: 31, offset: 61209},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1608, col: 31, offset: 61209},
+										expr: &litMatcher{
+											pos:        position{line: 1608, col: 32, offset: 61210},
+											val:        "]",
+											ignoreCase: false,
+										},
+									},
+									&notExpr{
+										pos: position{line: 1608, col: 36, offset: 61214},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1608, col: 37, offset: 61215},
+											name: "EOL",
+										},
+									},
+									&anyMatcher{
+										line: 1608, col: 41, offset: 61219,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StemBlock",
+			pos:  position{line: 1613, col: 1, offset: 61386},
+			expr: &actionExpr{
+				pos: position{line: 1613, col: 14, offset: 61399},
+				run: (*parser).callonStemBlock1,
+				expr: &seqExpr{
+					pos: position{line: 1613, col: 14, offset: 61399},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1613, col: 14, offset: 61399},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1613, col: 25, offset: 61410},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1613, col: 26, offset: 61411},
+									name: "ElementAttributes",
+								},
+							},
+						},
+						&andCodeExpr{
+							pos: position{line: 1614, col: 5, offset: 61435},
+							run: (*parser).callonStemBlock6,
+						},
+						&labeledExpr{
+							pos:   position{line: 1621, col: 5, offset: 61646},
+							label: "lines",
+							expr: &choiceExpr{
+								pos: position{line: 1621, col: 12, offset: 61653},
+								alternatives: []interface{}{
+									&actionExpr{
+										pos: position{line: 1621, col: 12, offset: 61653},
+										run: (*parser).callonStemBlock9,
+										expr: &seqExpr{
+											pos: position{line: 1621, col: 12, offset: 61653},
+											exprs: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 1621, col: 12, offset: 61653},
+													name: "StemBlockDelimiter",
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 1621, col: 31, offset: 61672},
+													expr: &ruleRefExpr{
+														pos:  position{line: 1621, col: 31, offset: 61672},
+														name: "WS",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 1621, col: 35, offset: 61676},
+													name: "NEWLINE",
+												},
+												&labeledExpr{
+													pos:   position{line: 1621, col: 43, offset: 61684},
+													label: "lines",
+													expr: &zeroOrMoreExpr{
+														pos: position{line: 1621, col: 49, offset: 61690},
 														expr: &ruleRefExpr{
-															pos:  position{line: 1518, col: 58, offset: 57683},
-															name: "EOL",
+															pos:  position{line: 1621, col: 50, offset: 61691},
+															name: "StemBlockLine",
 														},
 													},
-													&anyMatcher{
-														line: 1518, col: 62, offset: 57687,
+												},
+												&choiceExpr{
+													pos: position{line: 1621, col: 67, offset: 61708},
+													alternatives: []interface{}{
+														&seqExpr{
+															pos: position{line: 1621, col: 68, offset: 61709},
+															exprs: []interface{}{
+																&ruleRefExpr{
+																	pos:  position{line: 1621, col: 68, offset: 61709},
+																	name: "StemBlockDelimiter",
+																},
+																&ruleRefExpr{
+																	pos:  position{line: 1621, col: 87, offset: 61728},
+																	name: "EOLS",
+																},
+															},
+														},
+														&ruleRefExpr{
+															pos:  position{line: 1621, col: 95, offset: 61736},
+															name: "EOF",
+														},
 													},
 												},
 											},
 										},
 									},
+									&ruleRefExpr{
+										pos:  position{line: 1623, col: 9, offset: 61777},
+										name: "StemParagraphLines",
+									},
 								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 1520, col: 8, offset: 57736},
-							name: "EOL",
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "ParagraphWithLiteralAttribute",
-			pos:  position{line: 1525, col: 1, offset: 57862},
+			name: "StemBlockDelimiter",
+			pos:  position{line: 1627, col: 1, offset: 61867},
+			expr: &litMatcher{
+				pos:        position{line: 1627, col: 23, offset: 61889},
+				val:        "++++",
+				ignoreCase: false,
+			},
+		},
+		{
+			name: "StemBlockLine",
+			pos:  position{line: 1629, col: 1, offset: 61897},
 			expr: &actionExpr{
-				pos: position{line: 1526, col: 5, offset: 57900},
-				run: (*parser).callonParagraphWithLiteralAttribute1,
+				pos: position{line: 1629, col: 18, offset: 61914},
+				run: (*parser).callonStemBlockLine1,
 				expr: &seqExpr{
-					pos: position{line: 1526, col: 5, offset: 57900},
+					pos: position{line: 1629, col: 18, offset: 61914},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 1526, col: 5, offset: 57900},
-							label: "attributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 1526, col: 16, offset: 57911},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1526, col: 17, offset: 57912},
-									name: "ElementAttributes",
-								},
+						&notExpr{
+							pos: position{line: 1629, col: 18, offset: 61914},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1629, col: 19, offset: 61915},
+								name: "EOF",
 							},
 						},
-						&andCodeExpr{
-							pos: position{line: 1527, col: 5, offset: 57936},
-							run: (*parser).callonParagraphWithLiteralAttribute6,
+						&notExpr{
+							pos: position{line: 1629, col: 23, offset: 61919},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1629, col: 24, offset: 61920},
+								name: "StemBlockDelimiter",
+							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1534, col: 5, offset: 58150},
-							label: "lines",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1534, col: 12, offset: 58157},
-								name: "ParagraphWithLiteralAttributeLines",
+							pos:   position{line: 1629, col: 43, offset: 61939},
+							label: "line",
+							expr: &actionExpr{
+								pos: position{line: 1629, col: 49, offset: 61945},
+								run: (*parser).callonStemBlockLine8,
+								expr: &zeroOrMoreExpr{
+									pos: position{line: 1629, col: 49, offset: 61945},
+									expr: &seqExpr{
+										pos: position{line: 1629, col: 50, offset: 61946},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 1629, col: 50, offset: 61946},
+												expr: &ruleRefExpr{
+													pos:  position{line: 1629, col: 51, offset: 61947},
+													name: "EOL",
+												},
+											},
+											&anyMatcher{
+												line: 1629, col: 55, offset: 61951,
+											},
+										},
+									},
+								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 1631, col: 4, offset: 61991},
+							name: "EOL",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "LiteralKind",
-			pos:  position{line: 1538, col: 1, offset: 58307},
-			expr: &actionExpr{
-				pos: position{line: 1538, col: 16, offset: 58322},
-				run: (*parser).callonLiteralKind1,
-				expr: &litMatcher{
-					pos:        position{line: 1538, col: 16, offset: 58322},
-					val:        "literal",
-					ignoreCase: false,
-				},
-			},
-		},
-		{
-			name: "ParagraphWithLiteralAttributeLines",
-			pos:  position{line: 1543, col: 1, offset: 58405},
+			name: "StemParagraphLines",
+			pos:  position{line: 1636, col: 1, offset: 62095},
 			expr: &actionExpr{
-				pos: position{line: 1543, col: 39, offset: 58443},
-				run: (*parser).callonParagraphWithLiteralAttributeLines1,
+				pos: position{line: 1636, col: 23, offset: 62117},
+				run: (*parser).callonStemParagraphLines1,
 				expr: &labeledExpr{
-					pos:   position{line: 1543, col: 39, offset: 58443},
+					pos:   position{line: 1636, col: 23, offset: 62117},
 					label: "lines",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1543, col: 45, offset: 58449},
+						pos: position{line: 1636, col: 29, offset: 62123},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1543, col: 46, offset: 58450},
-							name: "ParagraphWithLiteralAttributeLine",
+							pos:  position{line: 1636, col: 30, offset: 62124},
+							name: "StemParagraphLine",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ParagraphWithLiteralAttributeLine",
-			pos:  position{line: 1547, col: 1, offset: 58530},
+			name: "StemParagraphLine",
+			pos:  position{line: 1640, col: 1, offset: 62187},
 			expr: &actionExpr{
-				pos: position{line: 1547, col: 38, offset: 58567},
-				run: (*parser).callonParagraphWithLiteralAttributeLine1,
+				pos: position{line: 1640, col: 22, offset: 62208},
+				run: (*parser).callonStemParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 1547, col: 38, offset: 58567},
+					pos: position{line: 1640, col: 22, offset: 62208},
 					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1640, col: 22, offset: 62208},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1640, col: 23, offset: 62209},
+								name: "BlankLine",
+							},
+						},
 						&labeledExpr{
-							pos:   position{line: 1547, col: 38, offset: 58567},
+							pos:   position{line: 1640, col: 33, offset: 62219},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 1547, col: 44, offset: 58573},
-								run: (*parser).callonParagraphWithLiteralAttributeLine4,
-								expr: &seqExpr{
-									pos: position{line: 1547, col: 44, offset: 58573},
-									exprs: []interface{}{
-										&notExpr{
-											pos: position{line: 1547, col: 44, offset: 58573},
-											expr: &ruleRefExpr{
-												pos:  position{line: 1547, col: 46, offset: 58575},
-												name: "BlankLine",
-											},
-										},
-										&oneOrMoreExpr{
-											pos: position{line: 1547, col: 57, offset: 58586},
-											expr: &choiceExpr{
-												pos: position{line: 1547, col: 58, offset: 58587},
-												alternatives: []interface{}{
-													&ruleRefExpr{
-														pos:  position{line: 1547, col: 58, offset: 58587},
-														name: "Alphanums",
-													},
-													&ruleRefExpr{
-														pos:  position{line: 1547, col: 70, offset: 58599},
-														name: "Spaces",
-													},
-													&seqExpr{
-														pos: position{line: 1547, col: 80, offset: 58609},
-														exprs: []interface{}{
-															&notExpr{
-																pos: position{line: 1547, col: 80, offset: 58609},
-																expr: &ruleRefExpr{
-																	pos:  position{line: 1547, col: 81, offset: 58610},
-																	name: "EOL",
-																},
-															},
-															&anyMatcher{
-																line: 1547, col: 86, offset: 58615,
-															},
-														},
-													},
+								pos: position{line: 1640, col: 39, offset: 62225},
+								run: (*parser).callonStemParagraphLine6,
+								expr: &oneOrMoreExpr{
+									pos: position{line: 1640, col: 39, offset: 62225},
+									expr: &seqExpr{
+										pos: position{line: 1640, col: 40, offset: 62226},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 1640, col: 40, offset: 62226},
+												expr: &ruleRefExpr{
+													pos:  position{line: 1640, col: 41, offset: 62227},
+													name: "EOL",
 												},
 											},
+											&anyMatcher{
+												line: 1640, col: 45, offset: 62231,
+											},
 										},
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1549, col: 4, offset: 58656},
+							pos:  position{line: 1642, col: 4, offset: 62271},
 							name: "EOL",
 						},
 					},
@@ -12070,61 +12932,61 @@ var g = &grammar{
 		},
 		{
 			name: "ConceleadIndexTerm",
-			pos:  position{line: 1556, col: 1, offset: 58830},
+			pos:  position{line: 1649, col: 1, offset: 62445},
 			expr: &actionExpr{
-				pos: position{line: 1556, col: 23, offset: 58852},
+				pos: position{line: 1649, col: 23, offset: 62467},
 				run: (*parser).callonConceleadIndexTerm1,
 				expr: &seqExpr{
-					pos: position{line: 1556, col: 23, offset: 58852},
+					pos: position{line: 1649, col: 23, offset: 62467},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1556, col: 23, offset: 58852},
+							pos:        position{line: 1649, col: 23, offset: 62467},
 							val:        "(((",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 1556, col: 29, offset: 58858},
+							pos:   position{line: 1649, col: 29, offset: 62473},
 							label: "term1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1556, col: 35, offset: 58864},
+								pos:  position{line: 1649, col: 35, offset: 62479},
 								name: "Alphanums",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1556, col: 45, offset: 58874},
+							pos:   position{line: 1649, col: 45, offset: 62489},
 							label: "term2",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1556, col: 51, offset: 58880},
+								pos: position{line: 1649, col: 51, offset: 62495},
 								expr: &actionExpr{
-									pos: position{line: 1556, col: 52, offset: 58881},
+									pos: position{line: 1649, col: 52, offset: 62496},
 									run: (*parser).callonConceleadIndexTerm8,
 									expr: &seqExpr{
-										pos: position{line: 1556, col: 52, offset: 58881},
+										pos: position{line: 1649, col: 52, offset: 62496},
 										exprs: []interface{}{
 											&zeroOrMoreExpr{
-												pos: position{line: 1556, col: 52, offset: 58881},
+												pos: position{line: 1649, col: 52, offset: 62496},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1556, col: 52, offset: 58881},
+													pos:  position{line: 1649, col: 52, offset: 62496},
 													name: "WS",
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 1556, col: 56, offset: 58885},
+												pos:        position{line: 1649, col: 56, offset: 62500},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 1556, col: 60, offset: 58889},
+												pos: position{line: 1649, col: 60, offset: 62504},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1556, col: 60, offset: 58889},
+													pos:  position{line: 1649, col: 60, offset: 62504},
 													name: "WS",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 1556, col: 64, offset: 58893},
+												pos:   position{line: 1649, col: 64, offset: 62508},
 												label: "content",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1556, col: 72, offset: 58901},
+													pos:  position{line: 1649, col: 72, offset: 62516},
 													name: "Alphanums",
 												},
 											},
@@ -12134,40 +12996,40 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1558, col: 9, offset: 58949},
+							pos:   position{line: 1651, col: 9, offset: 62564},
 							label: "term3",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1558, col: 15, offset: 58955},
+								pos: position{line: 1651, col: 15, offset: 62570},
 								expr: &actionExpr{
-									pos: position{line: 1558, col: 16, offset: 58956},
+									pos: position{line: 1651, col: 16, offset: 62571},
 									run: (*parser).callonConceleadIndexTerm19,
 									expr: &seqExpr{
-										pos: position{line: 1558, col: 16, offset: 58956},
+										pos: position{line: 1651, col: 16, offset: 62571},
 										exprs: []interface{}{
 											&zeroOrMoreExpr{
-												pos: position{line: 1558, col: 16, offset: 58956},
+												pos: position{line: 1651, col: 16, offset: 62571},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1558, col: 16, offset: 58956},
+													pos:  position{line: 1651, col: 16, offset: 62571},
 													name: "WS",
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 1558, col: 20, offset: 58960},
+												pos:        position{line: 1651, col: 20, offset: 62575},
 												val:        ",",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 1558, col: 24, offset: 58964},
+												pos: position{line: 1651, col: 24, offset: 62579},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1558, col: 24, offset: 58964},
+													pos:  position{line: 1651, col: 24, offset: 62579},
 													name: "WS",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 1558, col: 28, offset: 58968},
+												pos:   position{line: 1651, col: 28, offset: 62583},
 												label: "content",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1558, col: 36, offset: 58976},
+													pos:  position{line: 1651, col: 36, offset: 62591},
 													name: "Alphanums",
 												},
 											},
@@ -12177,7 +13039,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1560, col: 9, offset: 59024},
+							pos:        position{line: 1653, col: 9, offset: 62639},
 							val:        ")))",
 							ignoreCase: false,
 						},
@@ -12187,22 +13049,22 @@ var g = &grammar{
 		},
 		{
 			name: "BlankLine",
-			pos:  position{line: 1567, col: 1, offset: 59208},
+			pos:  position{line: 1660, col: 1, offset: 62823},
 			expr: &actionExpr{
-				pos: position{line: 1567, col: 14, offset: 59221},
+				pos: position{line: 1660, col: 14, offset: 62836},
 				run: (*parser).callonBlankLine1,
 				expr: &seqExpr{
-					pos: position{line: 1567, col: 14, offset: 59221},
+					pos: position{line: 1660, col: 14, offset: 62836},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1567, col: 14, offset: 59221},
+							pos: position{line: 1660, col: 14, offset: 62836},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1567, col: 15, offset: 59222},
+								pos:  position{line: 1660, col: 15, offset: 62837},
 								name: "EOF",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1567, col: 19, offset: 59226},
+							pos:  position{line: 1660, col: 19, offset: 62841},
 							name: "EOLS",
 						},
 					},
@@ -12211,9 +13073,9 @@ var g = &grammar{
 		},
 		{
 			name: "Alphanum",
-			pos:  position{line: 1574, col: 1, offset: 59374},
+			pos:  position{line: 1667, col: 1, offset: 62989},
 			expr: &charClassMatcher{
-				pos:        position{line: 1574, col: 13, offset: 59386},
+				pos:        position{line: 1667, col: 13, offset: 63001},
 				val:        "[\\pL0-9]",
 				ranges:     []rune{'0', '9'},
 				classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -12223,37 +13085,37 @@ var g = &grammar{
 		},
 		{
 			name: "Parenthesis",
-			pos:  position{line: 1576, col: 1, offset: 59396},
+			pos:  position{line: 1669, col: 1, offset: 63011},
 			expr: &choiceExpr{
-				pos: position{line: 1576, col: 16, offset: 59411},
+				pos: position{line: 1669, col: 16, offset: 63026},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1576, col: 16, offset: 59411},
+						pos:        position{line: 1669, col: 16, offset: 63026},
 						val:        "(",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1576, col: 22, offset: 59417},
+						pos:        position{line: 1669, col: 22, offset: 63032},
 						val:        ")",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1576, col: 28, offset: 59423},
+						pos:        position{line: 1669, col: 28, offset: 63038},
 						val:        "[",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1576, col: 34, offset: 59429},
+						pos:        position{line: 1669, col: 34, offset: 63044},
 						val:        "]",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1576, col: 40, offset: 59435},
+						pos:        position{line: 1669, col: 40, offset: 63050},
 						val:        "{",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1576, col: 46, offset: 59441},
+						pos:        position{line: 1669, col: 46, offset: 63056},
 						val:        "}",
 						ignoreCase: false,
 					},
@@ -12262,14 +13124,14 @@ var g = &grammar{
 		},
 		{
 			name: "Alphanums",
-			pos:  position{line: 1578, col: 1, offset: 59447},
+			pos:  position{line: 1671, col: 1, offset: 63062},
 			expr: &actionExpr{
-				pos: position{line: 1578, col: 14, offset: 59460},
+				pos: position{line: 1671, col: 14, offset: 63075},
 				run: (*parser).callonAlphanums1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1578, col: 14, offset: 59460},
+					pos: position{line: 1671, col: 14, offset: 63075},
 					expr: &charClassMatcher{
-						pos:        position{line: 1578, col: 14, offset: 59460},
+						pos:        position{line: 1671, col: 14, offset: 63075},
 						val:        "[\\pL0-9]",
 						ranges:     []rune{'0', '9'},
 						classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -12281,37 +13143,37 @@ var g = &grammar{
 		},
 		{
 			name: "Dot",
-			pos:  position{line: 1582, col: 1, offset: 59506},
+			pos:  position{line: 1675, col: 1, offset: 63121},
 			expr: &litMatcher{
-				pos:        position{line: 1582, col: 8, offset: 59513},
+				pos:        position{line: 1675, col: 8, offset: 63128},
 				val:        ".",
 				ignoreCase: false,
 			},
 		},
 		{
 			name: "SimpleWord",
-			pos:  position{line: 1584, col: 1, offset: 59518},
+			pos:  position{line: 1677, col: 1, offset: 63133},
 			expr: &actionExpr{
-				pos: position{line: 1584, col: 15, offset: 59532},
+				pos: position{line: 1677, col: 15, offset: 63147},
 				run: (*parser).callonSimpleWord1,
 				expr: &seqExpr{
-					pos: position{line: 1584, col: 15, offset: 59532},
+					pos: position{line: 1677, col: 15, offset: 63147},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1584, col: 15, offset: 59532},
+							pos:  position{line: 1677, col: 15, offset: 63147},
 							name: "Alphanums",
 						},
 						&andExpr{
-							pos: position{line: 1584, col: 25, offset: 59542},
+							pos: position{line: 1677, col: 25, offset: 63157},
 							expr: &choiceExpr{
-								pos: position{line: 1584, col: 27, offset: 59544},
+								pos: position{line: 1677, col: 27, offset: 63159},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1584, col: 27, offset: 59544},
+										pos:  position{line: 1677, col: 27, offset: 63159},
 										name: "WS",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1584, col: 32, offset: 59549},
+										pos:  position{line: 1677, col: 32, offset: 63164},
 										name: "EOL",
 									},
 								},
@@ -12323,83 +13185,83 @@ var g = &grammar{
 		},
 		{
 			name: "OtherWord",
-			pos:  position{line: 1589, col: 1, offset: 59814},
+			pos:  position{line: 1682, col: 1, offset: 63429},
 			expr: &actionExpr{
-				pos: position{line: 1589, col: 14, offset: 59827},
+				pos: position{line: 1682, col: 14, offset: 63442},
 				run: (*parser).callonOtherWord1,
 				expr: &choiceExpr{
-					pos: position{line: 1589, col: 15, offset: 59828},
+					pos: position{line: 1682, col: 15, offset: 63443},
 					alternatives: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1589, col: 15, offset: 59828},
+							pos:  position{line: 1682, col: 15, offset: 63443},
 							name: "Alphanums",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1589, col: 27, offset: 59840},
+							pos:  position{line: 1682, col: 27, offset: 63455},
 							name: "QuotedTextPrefix",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1589, col: 46, offset: 59859},
+							pos: position{line: 1682, col: 46, offset: 63474},
 							expr: &actionExpr{
-								pos: position{line: 1589, col: 47, offset: 59860},
+								pos: position{line: 1682, col: 47, offset: 63475},
 								run: (*parser).callonOtherWord6,
 								expr: &seqExpr{
-									pos: position{line: 1589, col: 47, offset: 59860},
+									pos: position{line: 1682, col: 47, offset: 63475},
 									exprs: []interface{}{
 										&seqExpr{
-											pos: position{line: 1589, col: 48, offset: 59861},
+											pos: position{line: 1682, col: 48, offset: 63476},
 											exprs: []interface{}{
 												&notExpr{
-													pos: position{line: 1589, col: 48, offset: 59861},
+													pos: position{line: 1682, col: 48, offset: 63476},
 													expr: &ruleRefExpr{
-														pos:  position{line: 1589, col: 49, offset: 59862},
+														pos:  position{line: 1682, col: 49, offset: 63477},
 														name: "NEWLINE",
 													},
 												},
 												&notExpr{
-													pos: position{line: 1589, col: 57, offset: 59870},
+													pos: position{line: 1682, col: 57, offset: 63485},
 													expr: &ruleRefExpr{
-														pos:  position{line: 1589, col: 58, offset: 59871},
+														pos:  position{line: 1682, col: 58, offset: 63486},
 														name: "WS",
 													},
 												},
 												&notExpr{
-													pos: position{line: 1589, col: 61, offset: 59874},
+													pos: position{line: 1682, col: 61, offset: 63489},
 													expr: &ruleRefExpr{
-														pos:  position{line: 1589, col: 62, offset: 59875},
+														pos:  position{line: 1682, col: 62, offset: 63490},
 														name: "Dot",
 													},
 												},
 												&notExpr{
-													pos: position{line: 1589, col: 66, offset: 59879},
+													pos: position{line: 1682, col: 66, offset: 63494},
 													expr: &ruleRefExpr{
-														pos:  position{line: 1589, col: 67, offset: 59880},
+														pos:  position{line: 1682, col: 67, offset: 63495},
 														name: "QuotedTextPrefix",
 													},
 												},
 												&notExpr{
-													pos: position{line: 1589, col: 84, offset: 59897},
+													pos: position{line: 1682, col: 84, offset: 63512},
 													expr: &ruleRefExpr{
-														pos:  position{line: 1589, col: 85, offset: 59898},
+														pos:  position{line: 1682, col: 85, offset: 63513},
 														name: "LabeledListItemSeparator",
 													},
 												},
 												&notExpr{
-													pos: position{line: 1589, col: 110, offset: 59923},
+													pos: position{line: 1682, col: 110, offset: 63538},
 													expr: &ruleRefExpr{
-														pos:  position{line: 1589, col: 111, offset: 59924},
+														pos:  position{line: 1682, col: 111, offset: 63539},
 														name: "Parenthesis",
 													},
 												},
 												&anyMatcher{
-													line: 1589, col: 123, offset: 59936,
+													line: 1682, col: 123, offset: 63551,
 												},
 											},
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 1589, col: 126, offset: 59939},
+											pos: position{line: 1682, col: 126, offset: 63554},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1589, col: 126, offset: 59939},
+												pos:  position{line: 1682, col: 126, offset: 63554},
 												name: "Dot",
 											},
 										},
@@ -12408,9 +13270,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1591, col: 7, offset: 60048},
+							pos: position{line: 1684, col: 7, offset: 63663},
 							expr: &litMatcher{
-								pos:        position{line: 1591, col: 7, offset: 60048},
+								pos:        position{line: 1684, col: 7, offset: 63663},
 								val:        ".",
 								ignoreCase: false,
 							},
@@ -12421,35 +13283,35 @@ var g = &grammar{
 		},
 		{
 			name: "Spaces",
-			pos:  position{line: 1595, col: 1, offset: 60229},
+			pos:  position{line: 1688, col: 1, offset: 63844},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1595, col: 11, offset: 60239},
+				pos: position{line: 1688, col: 11, offset: 63854},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1595, col: 11, offset: 60239},
+					pos:  position{line: 1688, col: 11, offset: 63854},
 					name: "WS",
 				},
 			},
 		},
 		{
 			name: "FileLocation",
-			pos:  position{line: 1597, col: 1, offset: 60245},
+			pos:  position{line: 1690, col: 1, offset: 63860},
 			expr: &actionExpr{
-				pos: position{line: 1597, col: 17, offset: 60261},
+				pos: position{line: 1690, col: 17, offset: 63876},
 				run: (*parser).callonFileLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1597, col: 17, offset: 60261},
+					pos:   position{line: 1690, col: 17, offset: 63876},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1597, col: 26, offset: 60270},
+						pos: position{line: 1690, col: 26, offset: 63885},
 						expr: &choiceExpr{
-							pos: position{line: 1597, col: 27, offset: 60271},
+							pos: position{line: 1690, col: 27, offset: 63886},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1597, col: 27, offset: 60271},
+									pos:  position{line: 1690, col: 27, offset: 63886},
 									name: "FILENAME",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1597, col: 38, offset: 60282},
+									pos:  position{line: 1690, col: 38, offset: 63897},
 									name: "DocumentAttributeSubstitution",
 								},
 							},
@@ -12460,42 +13322,42 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedFileLocation",
-			pos:  position{line: 1601, col: 1, offset: 60374},
+			pos:  position{line: 1694, col: 1, offset: 63989},
 			expr: &actionExpr{
-				pos: position{line: 1601, col: 25, offset: 60398},
+				pos: position{line: 1694, col: 25, offset: 64013},
 				run: (*parser).callonResolvedFileLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1601, col: 25, offset: 60398},
+					pos:   position{line: 1694, col: 25, offset: 64013},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1601, col: 34, offset: 60407},
+						pos: position{line: 1694, col: 34, offset: 64022},
 						expr: &seqExpr{
-							pos: position{line: 1601, col: 35, offset: 60408},
+							pos: position{line: 1694, col: 35, offset: 64023},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1601, col: 35, offset: 60408},
+									pos: position{line: 1694, col: 35, offset: 64023},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1601, col: 36, offset: 60409},
+										pos:  position{line: 1694, col: 36, offset: 64024},
 										name: "EOL",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1601, col: 40, offset: 60413},
+									pos: position{line: 1694, col: 40, offset: 64028},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1601, col: 41, offset: 60414},
+										pos:  position{line: 1694, col: 41, offset: 64029},
 										name: "WS",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1601, col: 44, offset: 60417},
+									pos: position{line: 1694, col: 44, offset: 64032},
 									expr: &litMatcher{
-										pos:        position{line: 1601, col: 45, offset: 60418},
+										pos:        position{line: 1694, col: 45, offset: 64033},
 										val:        "[",
 										ignoreCase: false,
 									},
 								},
 								&anyMatcher{
-									line: 1601, col: 49, offset: 60422,
+									line: 1694, col: 49, offset: 64037,
 								},
 							},
 						},
@@ -12505,31 +13367,31 @@ var g = &grammar{
 		},
 		{
 			name: "Location",
-			pos:  position{line: 1605, col: 1, offset: 60486},
+			pos:  position{line: 1698, col: 1, offset: 64101},
 			expr: &actionExpr{
-				pos: position{line: 1605, col: 13, offset: 60498},
+				pos: position{line: 1698, col: 13, offset: 64113},
 				run: (*parser).callonLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1605, col: 13, offset: 60498},
+					pos:   position{line: 1698, col: 13, offset: 64113},
 					label: "elements",
 					expr: &seqExpr{
-						pos: position{line: 1605, col: 23, offset: 60508},
+						pos: position{line: 1698, col: 23, offset: 64123},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1605, col: 23, offset: 60508},
+								pos:  position{line: 1698, col: 23, offset: 64123},
 								name: "URL_SCHEME",
 							},
 							&oneOrMoreExpr{
-								pos: position{line: 1605, col: 34, offset: 60519},
+								pos: position{line: 1698, col: 34, offset: 64134},
 								expr: &choiceExpr{
-									pos: position{line: 1605, col: 35, offset: 60520},
+									pos: position{line: 1698, col: 35, offset: 64135},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1605, col: 35, offset: 60520},
+											pos:  position{line: 1698, col: 35, offset: 64135},
 											name: "FILENAME",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1605, col: 46, offset: 60531},
+											pos:  position{line: 1698, col: 46, offset: 64146},
 											name: "DocumentAttributeSubstitution",
 										},
 									},
@@ -12542,26 +13404,26 @@ var g = &grammar{
 		},
 		{
 			name: "FILENAME",
-			pos:  position{line: 1609, col: 1, offset: 60624},
+			pos:  position{line: 1702, col: 1, offset: 64239},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1609, col: 13, offset: 60636},
+				pos: position{line: 1702, col: 13, offset: 64251},
 				expr: &choiceExpr{
-					pos: position{line: 1609, col: 14, offset: 60637},
+					pos: position{line: 1702, col: 14, offset: 64252},
 					alternatives: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 1609, col: 14, offset: 60637},
+							pos:        position{line: 1702, col: 14, offset: 64252},
 							val:        "[ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789~:/?#@!$&;=()*+,_%]",
 							chars:      []rune{'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z', 'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '~', ':', '/', '?', '#', '@', '!', '$', '&', ';', '=', '(', ')', '*', '+', ',', '_', '%'},
 							ignoreCase: false,
 							inverted:   false,
 						},
 						&litMatcher{
-							pos:        position{line: 1609, col: 99, offset: 60722},
+							pos:        position{line: 1702, col: 99, offset: 64337},
 							val:        "-",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1609, col: 105, offset: 60728},
+							pos:        position{line: 1702, col: 105, offset: 64343},
 							val:        ".",
 							ignoreCase: false,
 						},
@@ -12571,22 +13433,22 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedLocation",
-			pos:  position{line: 1611, col: 1, offset: 60849},
+			pos:  position{line: 1704, col: 1, offset: 64464},
 			expr: &actionExpr{
-				pos: position{line: 1611, col: 21, offset: 60869},
+				pos: position{line: 1704, col: 21, offset: 64484},
 				run: (*parser).callonResolvedLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 1611, col: 21, offset: 60869},
+					pos:   position{line: 1704, col: 21, offset: 64484},
 					label: "elements",
 					expr: &seqExpr{
-						pos: position{line: 1611, col: 31, offset: 60879},
+						pos: position{line: 1704, col: 31, offset: 64494},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1611, col: 31, offset: 60879},
+								pos:  position{line: 1704, col: 31, offset: 64494},
 								name: "URL_SCHEME",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1611, col: 42, offset: 60890},
+								pos:  position{line: 1704, col: 42, offset: 64505},
 								name: "RESOLVED_FILENAME",
 							},
 						},
@@ -12596,26 +13458,26 @@ var g = &grammar{
 		},
 		{
 			name: "RESOLVED_FILENAME",
-			pos:  position{line: 1615, col: 1, offset: 60969},
+			pos:  position{line: 1708, col: 1, offset: 64584},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1615, col: 22, offset: 60990},
+				pos: position{line: 1708, col: 22, offset: 64605},
 				expr: &choiceExpr{
-					pos: position{line: 1615, col: 23, offset: 60991},
+					pos: position{line: 1708, col: 23, offset: 64606},
 					alternatives: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 1615, col: 23, offset: 60991},
+							pos:        position{line: 1708, col: 23, offset: 64606},
 							val:        "[ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789~:/?#@!$&;=()*+_,%{}]",
 							chars:      []rune{'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z', 'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '~', ':', '/', '?', '#', '@', '!', '$', '&', ';', '=', '(', ')', '*', '+', '_', ',', '%', '{', '}'},
 							ignoreCase: false,
 							inverted:   false,
 						},
 						&litMatcher{
-							pos:        position{line: 1615, col: 110, offset: 61078},
+							pos:        position{line: 1708, col: 110, offset: 64693},
 							val:        "-",
 							ignoreCase: false,
 						},
 						&litMatcher{
-							pos:        position{line: 1615, col: 116, offset: 61084},
+							pos:        position{line: 1708, col: 116, offset: 64699},
 							val:        ".",
 							ignoreCase: false,
 						},
@@ -12625,54 +13487,54 @@ var g = &grammar{
 		},
 		{
 			name: "URL",
-			pos:  position{line: 1617, col: 1, offset: 61133},
+			pos:  position{line: 1710, col: 1, offset: 64748},
 			expr: &actionExpr{
-				pos: position{line: 1617, col: 8, offset: 61140},
+				pos: position{line: 1710, col: 8, offset: 64755},
 				run: (*parser).callonURL1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1617, col: 8, offset: 61140},
+					pos: position{line: 1710, col: 8, offset: 64755},
 					expr: &choiceExpr{
-						pos: position{line: 1617, col: 9, offset: 61141},
+						pos: position{line: 1710, col: 9, offset: 64756},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1617, col: 9, offset: 61141},
+								pos:  position{line: 1710, col: 9, offset: 64756},
 								name: "Alphanums",
 							},
 							&seqExpr{
-								pos: position{line: 1617, col: 22, offset: 61154},
+								pos: position{line: 1710, col: 22, offset: 64769},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1617, col: 22, offset: 61154},
+										pos: position{line: 1710, col: 22, offset: 64769},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1617, col: 23, offset: 61155},
+											pos:  position{line: 1710, col: 23, offset: 64770},
 											name: "NEWLINE",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1617, col: 31, offset: 61163},
+										pos: position{line: 1710, col: 31, offset: 64778},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1617, col: 32, offset: 61164},
+											pos:  position{line: 1710, col: 32, offset: 64779},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1617, col: 35, offset: 61167},
+										pos: position{line: 1710, col: 35, offset: 64782},
 										expr: &litMatcher{
-											pos:        position{line: 1617, col: 36, offset: 61168},
+											pos:        position{line: 1710, col: 36, offset: 64783},
 											val:        "[",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1617, col: 40, offset: 61172},
+										pos: position{line: 1710, col: 40, offset: 64787},
 										expr: &litMatcher{
-											pos:        position{line: 1617, col: 41, offset: 61173},
+											pos:        position{line: 1710, col: 41, offset: 64788},
 											val:        "]",
 											ignoreCase: false,
 										},
 									},
 									&anyMatcher{
-										line: 1617, col: 46, offset: 61178,
+										line: 1710, col: 46, offset: 64793,
 									},
 								},
 							},
@@ -12683,32 +13545,32 @@ var g = &grammar{
 		},
 		{
 			name: "URL_SCHEME",
-			pos:  position{line: 1621, col: 1, offset: 61219},
+			pos:  position{line: 1714, col: 1, offset: 64834},
 			expr: &choiceExpr{
-				pos: position{line: 1621, col: 15, offset: 61233},
+				pos: position{line: 1714, col: 15, offset: 64848},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1621, col: 15, offset: 61233},
+						pos:        position{line: 1714, col: 15, offset: 64848},
 						val:        "http://",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1621, col: 27, offset: 61245},
+						pos:        position{line: 1714, col: 27, offset: 64860},
 						val:        "https://",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1621, col: 40, offset: 61258},
+						pos:        position{line: 1714, col: 40, offset: 64873},
 						val:        "ftp://",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1621, col: 51, offset: 61269},
+						pos:        position{line: 1714, col: 51, offset: 64884},
 						val:        "irc://",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1621, col: 62, offset: 61280},
+						pos:        position{line: 1714, col: 62, offset: 64895},
 						val:        "mailto:",
 						ignoreCase: false,
 					},
@@ -12717,78 +13579,78 @@ var g = &grammar{
 		},
 		{
 			name: "ID",
-			pos:  position{line: 1623, col: 1, offset: 61291},
+			pos:  position{line: 1716, col: 1, offset: 64906},
 			expr: &actionExpr{
-				pos: position{line: 1623, col: 7, offset: 61297},
+				pos: position{line: 1716, col: 7, offset: 64912},
 				run: (*parser).callonID1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1623, col: 7, offset: 61297},
+					pos: position{line: 1716, col: 7, offset: 64912},
 					expr: &choiceExpr{
-						pos: position{line: 1623, col: 8, offset: 61298},
+						pos: position{line: 1716, col: 8, offset: 64913},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1623, col: 8, offset: 61298},
+								pos:  position{line: 1716, col: 8, offset: 64913},
 								name: "Alphanums",
 							},
 							&seqExpr{
-								pos: position{line: 1623, col: 21, offset: 61311},
+								pos: position{line: 1716, col: 21, offset: 64926},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1623, col: 21, offset: 61311},
+										pos: position{line: 1716, col: 21, offset: 64926},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1623, col: 22, offset: 61312},
+											pos:  position{line: 1716, col: 22, offset: 64927},
 											name: "NEWLINE",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1623, col: 30, offset: 61320},
+										pos: position{line: 1716, col: 30, offset: 64935},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1623, col: 31, offset: 61321},
+											pos:  position{line: 1716, col: 31, offset: 64936},
 											name: "WS",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1623, col: 34, offset: 61324},
+										pos: position{line: 1716, col: 34, offset: 64939},
 										expr: &litMatcher{
-											pos:        position{line: 1623, col: 35, offset: 61325},
+											pos:        position{line: 1716, col: 35, offset: 64940},
 											val:        "[",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1623, col: 39, offset: 61329},
+										pos: position{line: 1716, col: 39, offset: 64944},
 										expr: &litMatcher{
-											pos:        position{line: 1623, col: 40, offset: 61330},
+											pos:        position{line: 1716, col: 40, offset: 64945},
 											val:        "]",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1623, col: 44, offset: 61334},
+										pos: position{line: 1716, col: 44, offset: 64949},
 										expr: &litMatcher{
-											pos:        position{line: 1623, col: 45, offset: 61335},
+											pos:        position{line: 1716, col: 45, offset: 64950},
 											val:        "<<",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1623, col: 50, offset: 61340},
+										pos: position{line: 1716, col: 50, offset: 64955},
 										expr: &litMatcher{
-											pos:        position{line: 1623, col: 51, offset: 61341},
+											pos:        position{line: 1716, col: 51, offset: 64956},
 											val:        ">>",
 											ignoreCase: false,
 										},
 									},
 									&notExpr{
-										pos: position{line: 1623, col: 56, offset: 61346},
+										pos: position{line: 1716, col: 56, offset: 64961},
 										expr: &litMatcher{
-											pos:        position{line: 1623, col: 57, offset: 61347},
+											pos:        position{line: 1716, col: 57, offset: 64962},
 											val:        ",",
 											ignoreCase: false,
 										},
 									},
 									&anyMatcher{
-										line: 1623, col: 62, offset: 61352,
+										line: 1716, col: 62, offset: 64967,
 									},
 								},
 							},
@@ -12799,12 +13661,12 @@ var g = &grammar{
 		},
 		{
 			name: "DIGIT",
-			pos:  position{line: 1627, col: 1, offset: 61393},
+			pos:  position{line: 1720, col: 1, offset: 65008},
 			expr: &actionExpr{
-				pos: position{line: 1627, col: 10, offset: 61402},
+				pos: position{line: 1720, col: 10, offset: 65017},
 				run: (*parser).callonDIGIT1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1627, col: 10, offset: 61402},
+					pos:        position{line: 1720, col: 10, offset: 65017},
 					val:        "[0-9]",
 					ranges:     []rune{'0', '9'},
 					ignoreCase: false,
@@ -12814,25 +13676,25 @@ var g = &grammar{
 		},
 		{
 			name: "NUMBER",
-			pos:  position{line: 1631, col: 1, offset: 61444},
+			pos:  position{line: 1724, col: 1, offset: 65059},
 			expr: &actionExpr{
-				pos: position{line: 1631, col: 11, offset: 61454},
+				pos: position{line: 1724, col: 11, offset: 65069},
 				run: (*parser).callonNUMBER1,
 				expr: &seqExpr{
-					pos: position{line: 1631, col: 11, offset: 61454},
+					pos: position{line: 1724, col: 11, offset: 65069},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 1631, col: 11, offset: 61454},
+							pos: position{line: 1724, col: 11, offset: 65069},
 							expr: &litMatcher{
-								pos:        position{line: 1631, col: 11, offset: 61454},
+								pos:        position{line: 1724, col: 11, offset: 65069},
 								val:        "-",
 								ignoreCase: false,
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1631, col: 16, offset: 61459},
+							pos: position{line: 1724, col: 16, offset: 65074},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1631, col: 16, offset: 61459},
+								pos:  position{line: 1724, col: 16, offset: 65074},
 								name: "DIGIT",
 							},
 						},
@@ -12842,20 +13704,20 @@ var g = &grammar{
 		},
 		{
 			name: "WS",
-			pos:  position{line: 1635, col: 1, offset: 61511},
+			pos:  position{line: 1728, col: 1, offset: 65126},
 			expr: &choiceExpr{
-				pos: position{line: 1635, col: 7, offset: 61517},
+				pos: position{line: 1728, col: 7, offset: 65132},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1635, col: 7, offset: 61517},
+						pos:        position{line: 1728, col: 7, offset: 65132},
 						val:        " ",
 						ignoreCase: false,
 					},
 					&actionExpr{
-						pos: position{line: 1635, col: 13, offset: 61523},
+						pos: position{line: 1728, col: 13, offset: 65138},
 						run: (*parser).callonWS3,
 						expr: &litMatcher{
-							pos:        position{line: 1635, col: 13, offset: 61523},
+							pos:        position{line: 1728, col: 13, offset: 65138},
 							val:        "\t",
 							ignoreCase: false,
 						},
@@ -12865,22 +13727,22 @@ var g = &grammar{
 		},
 		{
 			name: "NEWLINE",
-			pos:  position{line: 1639, col: 1, offset: 61564},
+			pos:  position{line: 1732, col: 1, offset: 65179},
 			expr: &choiceExpr{
-				pos: position{line: 1639, col: 12, offset: 61575},
+				pos: position{line: 1732, col: 12, offset: 65190},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1639, col: 12, offset: 61575},
+						pos:        position{line: 1732, col: 12, offset: 65190},
 						val:        "\r\n",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1639, col: 21, offset: 61584},
+						pos:        position{line: 1732, col: 21, offset: 65199},
 						val:        "\r",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 1639, col: 28, offset: 61591},
+						pos:        position{line: 1732, col: 28, offset: 65206},
 						val:        "\n",
 						ignoreCase: false,
 					},
@@ -12889,26 +13751,26 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 1641, col: 1, offset: 61597},
+			pos:  position{line: 1734, col: 1, offset: 65212},
 			expr: &notExpr{
-				pos: position{line: 1641, col: 8, offset: 61604},
+				pos: position{line: 1734, col: 8, offset: 65219},
 				expr: &anyMatcher{
-					line: 1641, col: 9, offset: 61605,
+					line: 1734, col: 9, offset: 65220,
 				},
 			},
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 1643, col: 1, offset: 61608},
+			pos:  position{line: 1736, col: 1, offset: 65223},
 			expr: &choiceExpr{
-				pos: position{line: 1643, col: 8, offset: 61615},
+				pos: position{line: 1736, col: 8, offset: 65230},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1643, col: 8, offset: 61615},
+						pos:  position{line: 1736, col: 8, offset: 65230},
 						name: "NEWLINE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1643, col: 18, offset: 61625},
+						pos:  position{line: 1736, col: 18, offset: 65240},
 						name: "EOF",
 					},
 				},
@@ -12916,19 +13778,19 @@ var g = &grammar{
 		},
 		{
 			name: "EOLS",
-			pos:  position{line: 1645, col: 1, offset: 61630},
+			pos:  position{line: 1738, col: 1, offset: 65245},
 			expr: &seqExpr{
-				pos: position{line: 1645, col: 9, offset: 61638},
+				pos: position{line: 1738, col: 9, offset: 65253},
 				exprs: []interface{}{
 					&zeroOrMoreExpr{
-						pos: position{line: 1645, col: 9, offset: 61638},
+						pos: position{line: 1738, col: 9, offset: 65253},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1645, col: 9, offset: 61638},
+							pos:  position{line: 1738, col: 9, offset: 65253},
 							name: "WS",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1645, col: 13, offset: 61642},
+						pos:  position{line: 1738, col: 13, offset: 65257},
 						name: "EOL",
 					},
 				},
@@ -13355,15 +14217,15 @@ func (p *parser) callonAdmonitionMarkerAttribute1() (interface{}, error) {
 	return p.cur.onAdmonitionMarkerAttribute1(stack["k"])
 }
 
-func (c *current) onSourceAttributes8() (interface{}, error) {
+func (c *current) onSourceAttributes12() (interface{}, error) {
 	return string(c.text), nil
 
 }
 
-func (p *parser) callonSourceAttributes8() (interface{}, error) {
+func (p *parser) callonSourceAttributes12() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSourceAttributes8()
+	return p.cur.onSourceAttributes12()
 }
 
 func (c *current) onSourceAttributes1(language interface{}) (interface{}, error) {
@@ -13376,6 +14238,16 @@ func (p *parser) callonSourceAttributes1() (interface{}, error) {
 	return p.cur.onSourceAttributes1(stack["language"])
 }
 
+func (c *current) onStemAttributes1(notation interface{}) (interface{}, error) {
+	return types.NewStemAttributes(notation.(string))
+}
+
+func (p *parser) callonStemAttributes1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStemAttributes1(stack["notation"])
+}
+
 func (c *current) onAttributeGroup1(attributes interface{}) (interface{}, error) {
 	return types.NewAttributeGroup(attributes.([]interface{}))
 }
@@ -13398,16 +14270,16 @@ func (p *parser) callonGenericAttribute2() (interface{}, error) {
 	return p.cur.onGenericAttribute2(stack["key"], stack["value"])
 }
 
-func (c *current) onGenericAttribute14(key interface{}) (interface{}, error) {
+func (c *current) onGenericAttribute16(key interface{}) (interface{}, error) {
 	// value is not set
 	return types.NewGenericAttribute(key.(string), nil)
 
 }
 
-func (p *parser) callonGenericAttribute14() (interface{}, error) {
+func (p *parser) callonGenericAttribute16() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onGenericAttribute14(stack["key"])
+	return p.cur.onGenericAttribute16(stack["key"])
 }
 
 func (c *current) onAttributeKey1(key interface{}) (interface{}, error) {
@@ -13420,15 +14292,36 @@ func (p *parser) callonAttributeKey1() (interface{}, error) {
 	return p.cur.onAttributeKey1(stack["key"])
 }
 
-func (c *current) onAttributeValue1(value interface{}) (interface{}, error) {
+func (c *current) onAttributeValue6() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonAttributeValue6() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAttributeValue6()
+}
+
+func (c *current) onAttributeValue2(value interface{}) (interface{}, error) {
+	// quoted value, which may contain commas (surrounding quotes are removed)
+	return value, nil
+}
+
+func (p *parser) callonAttributeValue2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAttributeValue2(stack["value"])
+}
+
+func (c *current) onAttributeValue22(value interface{}) (interface{}, error) {
 	// attribute value cannot be followed by a `=`, otherwise it causes troubles for standalone values (eg: in images)
 	return string(c.text), nil
 }
 
-func (p *parser) callonAttributeValue1() (interface{}, error) {
+func (p *parser) callonAttributeValue22() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAttributeValue1(stack["value"])
+	return p.cur.onAttributeValue22(stack["value"])
 }
 
 func (c *current) onHorizontalLayout1() (interface{}, error) {
@@ -14765,7 +15658,7 @@ func (p *parser) callonNonSingleQuoteBoldText1() (interface{}, error) {
 
 func (c *current) onEscapedBoldText2(backslashes, content interface{}) (interface{}, error) {
 	// double punctuation must be evaluated first
-	return types.NewEscapedQuotedText(backslashes.(string), "**", content.([]interface{}))
+	return c.newEscapedQuotedText(backslashes.(string), "**", content.([]interface{}))
 
 }
 
@@ -14778,7 +15671,7 @@ func (p *parser) callonEscapedBoldText2() (interface{}, error) {
 func (c *current) onEscapedBoldText10(backslashes, content interface{}) (interface{}, error) {
 	// unbalanced `**` vs `*` punctuation
 	result := append([]interface{}{"*"}, content.([]interface{}))
-	return types.NewEscapedQuotedText(backslashes.(string), "*", result)
+	return c.newEscapedQuotedText(backslashes.(string), "*", result)
 
 }
 
@@ -14790,7 +15683,7 @@ func (p *parser) callonEscapedBoldText10() (interface{}, error) {
 
 func (c *current) onEscapedBoldText18(backslashes, content interface{}) (interface{}, error) {
 	// simple punctuation must be evaluated last
-	return types.NewEscapedQuotedText(backslashes.(string), "*", content.([]interface{}))
+	return c.newEscapedQuotedText(backslashes.(string), "*", content.([]interface{}))
 }
 
 func (p *parser) callonEscapedBoldText18() (interface{}, error) {
@@ -14899,7 +15792,7 @@ func (p *parser) callonNonSingleQuoteItalicText1() (interface{}, error) {
 
 func (c *current) onEscapedItalicText2(backslashes, content interface{}) (interface{}, error) {
 	// double punctuation must be evaluated first
-	return types.NewEscapedQuotedText(backslashes.(string), "__", content.([]interface{}))
+	return c.newEscapedQuotedText(backslashes.(string), "__", content.([]interface{}))
 
 }
 
@@ -14912,7 +15805,7 @@ func (p *parser) callonEscapedItalicText2() (interface{}, error) {
 func (c *current) onEscapedItalicText10(backslashes, content interface{}) (interface{}, error) {
 	// unbalanced `__` vs `_` punctuation
 	result := append([]interface{}{"_"}, content.([]interface{}))
-	return types.NewEscapedQuotedText(backslashes.(string), "_", result)
+	return c.newEscapedQuotedText(backslashes.(string), "_", result)
 
 }
 
@@ -14924,7 +15817,7 @@ func (p *parser) callonEscapedItalicText10() (interface{}, error) {
 
 func (c *current) onEscapedItalicText18(backslashes, content interface{}) (interface{}, error) {
 	// simple punctuation must be evaluated last
-	return types.NewEscapedQuotedText(backslashes.(string), "_", content.([]interface{}))
+	return c.newEscapedQuotedText(backslashes.(string), "_", content.([]interface{}))
 }
 
 func (p *parser) callonEscapedItalicText18() (interface{}, error) {
@@ -15033,7 +15926,7 @@ func (p *parser) callonNonSingleQuoteMonospaceText1() (interface{}, error) {
 
 func (c *current) onEscapedMonospaceText2(backslashes, content interface{}) (interface{}, error) {
 	// double punctuation must be evaluated first
-	return types.NewEscapedQuotedText(backslashes.(string), "``", content.([]interface{}))
+	return c.newEscapedQuotedText(backslashes.(string), "``", content.([]interface{}))
 
 }
 
@@ -15046,7 +15939,7 @@ func (p *parser) callonEscapedMonospaceText2() (interface{}, error) {
 func (c *current) onEscapedMonospaceText10(backslashes, content interface{}) (interface{}, error) {
 	// unbalanced "``" vs "`" punctuation
 	result := append([]interface{}{"`"}, content.([]interface{}))
-	return types.NewEscapedQuotedText(backslashes.(string), "`", result)
+	return c.newEscapedQuotedText(backslashes.(string), "`", result)
 
 }
 
@@ -15058,7 +15951,7 @@ func (p *parser) callonEscapedMonospaceText10() (interface{}, error) {
 
 func (c *current) onEscapedMonospaceText18(backslashes, content interface{}) (interface{}, error) {
 	// simple punctuation must be evaluated last
-	return types.NewEscapedQuotedText(backslashes.(string), "`", content.([]interface{}))
+	return c.newEscapedQuotedText(backslashes.(string), "`", content.([]interface{}))
 }
 
 func (p *parser) callonEscapedMonospaceText18() (interface{}, error) {
@@ -15091,7 +15984,7 @@ func (p *parser) callonNonSubscriptText1() (interface{}, error) {
 
 func (c *current) onEscapedSubscriptText1(backslashes, content interface{}) (interface{}, error) {
 	// simple punctuation must be evaluated last
-	return types.NewEscapedQuotedText(backslashes.(string), "~", content)
+	return c.newEscapedQuotedText(backslashes.(string), "~", content)
 }
 
 func (p *parser) callonEscapedSubscriptText1() (interface{}, error) {
@@ -15124,7 +16017,7 @@ func (p *parser) callonNonSuperscriptText1() (interface{}, error) {
 
 func (c *current) onEscapedSuperscriptText1(backslashes, content interface{}) (interface{}, error) {
 	// simple punctuation must be evaluated last
-	return types.NewEscapedQuotedText(backslashes.(string), "^", content)
+	return c.newEscapedQuotedText(backslashes.(string), "^", content)
 }
 
 func (p *parser) callonEscapedSuperscriptText1() (interface{}, error) {
@@ -15466,6 +16359,67 @@ func (p *parser) callonImageAttributes1() (interface{}, error) {
 	return p.cur.onImageAttributes1(stack["alt"], stack["width"], stack["height"], stack["otherattrs"])
 }
 
+func (c *current) onInlineIcon5() (interface{}, error) {
+	return string(c.text), nil
+
+}
+
+func (p *parser) callonInlineIcon5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineIcon5()
+}
+
+func (c *current) onInlineIcon1(name, attrs interface{}) (interface{}, error) {
+	return types.NewInlineIcon(name.(string), attrs.(types.ElementAttributes))
+}
+
+func (p *parser) callonInlineIcon1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineIcon1(stack["name"], stack["attrs"])
+}
+
+func (c *current) onIconAttributes1(size, otherattrs interface{}) (interface{}, error) {
+	return types.NewIconAttributes(size, otherattrs.([]interface{}))
+}
+
+func (p *parser) callonIconAttributes1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIconAttributes1(stack["size"], stack["otherattrs"])
+}
+
+func (c *current) onVideoBlock1(attributes, path, inlineAttributes interface{}) (interface{}, error) {
+	return types.NewVideoBlock(path.(types.Location), inlineAttributes.(types.ElementAttributes), attributes)
+}
+
+func (p *parser) callonVideoBlock1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVideoBlock1(stack["attributes"], stack["path"], stack["inlineAttributes"])
+}
+
+func (c *current) onVideoAttributes1(poster, width, height, otherattrs interface{}) (interface{}, error) {
+	return types.NewVideoAttributes(poster, width, height, otherattrs.([]interface{}))
+}
+
+func (p *parser) callonVideoAttributes1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVideoAttributes1(stack["poster"], stack["width"], stack["height"], stack["otherattrs"])
+}
+
+func (c *current) onAudioBlock1(attributes, path, inlineAttributes interface{}) (interface{}, error) {
+	return types.NewAudioBlock(path.(types.Location), inlineAttributes.(types.ElementAttributes), attributes)
+}
+
+func (p *parser) callonAudioBlock1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAudioBlock1(stack["attributes"], stack["path"], stack["inlineAttributes"])
+}
+
 func (c *current) onInlineFootnote2(content interface{}) (interface{}, error) {
 	return types.NewFootnote("", content.([]interface{}))
 }
@@ -16031,6 +16985,122 @@ func (p *parser) callonParagraphWithLiteralAttributeLine1() (interface{}, error)
 	return p.cur.onParagraphWithLiteralAttributeLine1(stack["line"])
 }
 
+func (c *current) onStemNotation1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonStemNotation1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStemNotation1()
+}
+
+func (c *current) onInlineStem1(notation, content interface{}) (interface{}, error) {
+	return types.NewInlineStem(notation.(string), content.(string))
+}
+
+func (p *parser) callonInlineStem1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineStem1(stack["notation"], stack["content"])
+}
+
+func (c *current) onInlineStemContent1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonInlineStemContent1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineStemContent1()
+}
+
+func (c *current) onStemBlock6(attributes interface{}) (bool, error) {
+	if attrs, ok := attributes.(types.ElementAttributes); ok {
+		kind, ok := attrs[types.AttrKind]
+		return ok && kind == types.Stem, nil
+	}
+	return false, nil
+
+}
+
+func (p *parser) callonStemBlock6() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStemBlock6(stack["attributes"])
+}
+
+func (c *current) onStemBlock9(lines interface{}) (interface{}, error) {
+	return lines, nil
+
+}
+
+func (p *parser) callonStemBlock9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStemBlock9(stack["lines"])
+}
+
+func (c *current) onStemBlock1(attributes, lines interface{}) (interface{}, error) {
+	return types.NewStemBlock(lines.([]interface{}), attributes)
+}
+
+func (p *parser) callonStemBlock1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStemBlock1(stack["attributes"], stack["lines"])
+}
+
+func (c *current) onStemBlockLine8() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonStemBlockLine8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStemBlockLine8()
+}
+
+func (c *current) onStemBlockLine1(line interface{}) (interface{}, error) {
+	return line, nil // do not include the trailing 'EOL'
+}
+
+func (p *parser) callonStemBlockLine1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStemBlockLine1(stack["line"])
+}
+
+func (c *current) onStemParagraphLines1(lines interface{}) (interface{}, error) {
+	return lines.([]interface{}), nil
+}
+
+func (p *parser) callonStemParagraphLines1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStemParagraphLines1(stack["lines"])
+}
+
+func (c *current) onStemParagraphLine6() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonStemParagraphLine6() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStemParagraphLine6()
+}
+
+func (c *current) onStemParagraphLine1(line interface{}) (interface{}, error) {
+	return line, nil // do not include the trailing 'EOL'
+}
+
+func (p *parser) callonStemParagraphLine1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStemParagraphLine1(stack["line"])
+}
+
 func (c *current) onConceleadIndexTerm8(content interface{}) (interface{}, error) {
 	return content, nil
 
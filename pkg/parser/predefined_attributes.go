@@ -38,5 +38,6 @@ func init() {
 		"two-colons":     "::",
 		"two-semicolons": ";",
 		"cpp":            "C++",
+		"figure-caption": "Figure",
 	}
 }
@@ -40,4 +40,5 @@ var _ = DescribeTable("predefined attributes",
 	Entry("two-colons", "two-colons", "::"),
 	Entry("two-semicolons", "two-semicolons", ";"),
 	Entry("cpp", "cpp", "C++"),
+	Entry("figure-caption", "figure-caption", "Figure"),
 )
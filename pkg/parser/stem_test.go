@@ -0,0 +1,171 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("stem - draft", func() {
+
+	Context("inline stem", func() {
+
+		It("stem macro", func() {
+			source := `the formula stem:[sqrt(4) = 2] is true`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: [][]interface{}{
+					{
+						types.StringElement{Content: "the formula "},
+						types.InlineStem{
+							Notation: types.StemDefault,
+							Content:  "sqrt(4) = 2",
+						},
+						types.StringElement{Content: " is true"},
+					},
+				},
+			}
+			Expect(source).To(BecomeDocumentBlock(expected))
+		})
+
+		It("asciimath macro", func() {
+			source := `asciimath:[x^2]`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: [][]interface{}{
+					{
+						types.InlineStem{
+							Notation: types.AsciiMath,
+							Content:  "x^2",
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomeDocumentBlock(expected))
+		})
+
+		It("latexmath macro with escaped closing bracket", func() {
+			source := `latexmath:[[a, b\]]`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: [][]interface{}{
+					{
+						types.InlineStem{
+							Notation: types.LaTeXMath,
+							Content:  "[a, b]",
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomeDocumentBlock(expected))
+		})
+
+		It("empty stem macro", func() {
+			source := `stem:[]`
+			expected := types.Paragraph{
+				Attributes: types.ElementAttributes{},
+				Lines: [][]interface{}{
+					{
+						types.InlineStem{
+							Notation: types.StemDefault,
+							Content:  "",
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomeDocumentBlock(expected))
+		})
+	})
+
+	Context("stem blocks", func() {
+
+		It("delimited stem block", func() {
+			source := `[stem]
+++++
+sqrt(4) = 2
+x = y
+++++`
+			expected := types.StemBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrKind: types.Stem,
+				},
+				Notation: types.StemDefault,
+				Lines: []string{
+					"sqrt(4) = 2",
+					"x = y",
+				},
+			}
+			Expect(source).To(BecomeDocumentBlock(expected))
+		})
+
+		It("delimited latexmath block with title and id", func() {
+			source := `[[formula]]
+.The formula
+[latexmath]
+++++
+\frac{1}{2}
+++++`
+			expected := types.StemBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrKind:     types.Stem,
+					types.AttrID:       "formula",
+					types.AttrCustomID: true,
+					types.AttrTitle:    "The formula",
+				},
+				Notation: types.LaTeXMath,
+				Lines: []string{
+					`\frac{1}{2}`,
+				},
+			}
+			Expect(source).To(BecomeDocumentBlock(expected))
+		})
+
+		It("stem paragraph", func() {
+			source := `[asciimath]
+a + b = c
+
+a paragraph`
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.StemBlock{
+						Attributes: types.ElementAttributes{
+							types.AttrKind: types.Stem,
+						},
+						Notation: types.AsciiMath,
+						Lines: []string{
+							"a + b = c",
+						},
+					},
+					types.BlankLine{},
+					types.Paragraph{
+						Attributes: types.ElementAttributes{},
+						Lines: [][]interface{}{
+							{
+								types.StringElement{Content: "a paragraph"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomeDraftDocument(expected))
+		})
+
+		It("unclosed delimited stem block", func() {
+			source := `[stem]
+++++
+x = y`
+			expected := types.StemBlock{
+				Attributes: types.ElementAttributes{
+					types.AttrKind: types.Stem,
+				},
+				Notation: types.StemDefault,
+				Lines: []string{
+					"x = y",
+				},
+			}
+			Expect(source).To(BecomeDocumentBlock(expected))
+		})
+	})
+})
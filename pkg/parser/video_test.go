@@ -0,0 +1,153 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("videos", func() {
+
+	Context("draft document", func() {
+
+		It("video block without attributes", func() {
+			source := "video::videos/foo.mp4[]"
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.VideoBlock{
+						Attributes: types.ElementAttributes{},
+						Location: types.Location{
+							Elements: []interface{}{
+								types.StringElement{Content: "videos/foo.mp4"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomeDraftDocument(expected))
+		})
+
+		It("video block with poster, width, height and options", func() {
+			source := `.A video
+video::videos/foo.mp4[foo.png, 640, 480, start=10, end=20, options="autoplay,loop"]`
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.VideoBlock{
+						Attributes: types.ElementAttributes{
+							types.AttrTitle:       "A video",
+							types.AttrVideoPoster: "foo.png",
+							types.AttrImageWidth:  "640",
+							types.AttrImageHeight: "480",
+							types.AttrStart:       "10",
+							types.AttrEnd:         "20",
+							types.AttrOptions:     "autoplay,loop",
+						},
+						Location: types.Location{
+							Elements: []interface{}{
+								types.StringElement{Content: "videos/foo.mp4"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomeDraftDocument(expected))
+		})
+
+		It("video block with quoted poster containing a comma", func() {
+			source := `video::videos/foo.mp4["foo, bar.png", 640]`
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.VideoBlock{
+						Attributes: types.ElementAttributes{
+							types.AttrVideoPoster: "foo, bar.png",
+							types.AttrImageWidth:  "640",
+						},
+						Location: types.Location{
+							Elements: []interface{}{
+								types.StringElement{Content: "videos/foo.mp4"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomeDraftDocument(expected))
+		})
+
+		It("youtube video block with named attributes only", func() {
+			source := "video::rPQoq7ThGAU[poster=youtube, width=640]"
+			expected := types.DraftDocument{
+				Blocks: []interface{}{
+					types.VideoBlock{
+						Attributes: types.ElementAttributes{
+							types.AttrVideoPoster: "youtube",
+							types.AttrImageWidth:  "640",
+						},
+						Location: types.Location{
+							Elements: []interface{}{
+								types.StringElement{Content: "rPQoq7ThGAU"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomeDraftDocument(expected))
+		})
+	})
+
+	Context("final document", func() {
+
+		It("video block with imagesdir", func() {
+			source := `:imagesdir: media
+
+video::foo.mp4[]`
+			expected := types.Document{
+				Attributes: types.DocumentAttributes{
+					"imagesdir": "media",
+				},
+				ElementReferences:  types.ElementReferences{},
+				Footnotes:          types.Footnotes{},
+				FootnoteReferences: types.FootnoteReferences{},
+				Elements: []interface{}{
+					types.VideoBlock{
+						Attributes: types.ElementAttributes{},
+						Location: types.Location{
+							Elements: []interface{}{
+								types.StringElement{Content: "media/foo.mp4"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomeDocument(expected))
+		})
+
+		It("youtube video block with imagesdir", func() {
+			source := `:imagesdir: media
+
+video::rPQoq7ThGAU[youtube]`
+			expected := types.Document{
+				Attributes: types.DocumentAttributes{
+					"imagesdir": "media",
+				},
+				ElementReferences:  types.ElementReferences{},
+				Footnotes:          types.Footnotes{},
+				FootnoteReferences: types.FootnoteReferences{},
+				Elements: []interface{}{
+					types.VideoBlock{
+						Attributes: types.ElementAttributes{
+							types.AttrVideoPoster: "youtube",
+						},
+						Location: types.Location{
+							Elements: []interface{}{
+								types.StringElement{Content: "rPQoq7ThGAU"},
+							},
+						},
+					},
+				},
+			}
+			Expect(source).To(BecomeDocument(expected))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package processor
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os/exec"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Command returns a block processor which runs the given local command with the raw lines of the block
+// on its standard input, and which embeds the SVG image written on its standard output in an image block
+// (eg: `processor.Command("plantuml", "-tsvg", "-pipe")` for the `[plantuml]` blocks).
+// The ID, title and role of the block are retained on the image, whose `alt` attribute is the name of the command
+// unless it was set on the block.
+func Command(name string, args ...string) renderer.BlockProcessor {
+	return func(ctx *renderer.Context, lines []string, attrs types.ElementAttributes) ([]interface{}, error) {
+		log.Debugf("running '%s' with %d line(s) on its standard input", name, len(lines))
+		cmd := exec.CommandContext(ctx, name, args...)
+		cmd.Stdin = strings.NewReader(strings.Join(lines, "\n") + "\n")
+		stderr := bytes.NewBuffer(nil)
+		cmd.Stderr = stderr
+		svg, err := cmd.Output()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to run '%s': %s", name, strings.TrimSpace(stderr.String()))
+		}
+		return []interface{}{
+			types.ImageBlock{
+				Location: types.Location{
+					Elements: []interface{}{
+						types.StringElement{
+							Content: "data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString(svg),
+						},
+					},
+				},
+				Attributes: imageAttributes(name, attrs),
+			},
+		}, nil
+	}
+}
+
+// imageAttributes returns the attributes of the image which replaces a block with the given attributes
+func imageAttributes(name string, attrs types.ElementAttributes) types.ElementAttributes {
+	result := types.ElementAttributes{
+		types.AttrImageAlt: name,
+	}
+	for _, k := range []string{types.AttrID, types.AttrCustomID, types.AttrTitle, types.AttrRole, types.AttrImageAlt, types.AttrImageWidth, types.AttrImageHeight} {
+		if v, found := attrs[k]; found {
+			result[k] = v
+		}
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package processor_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/processor"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("command block processor", func() {
+
+	It("should embed the SVG output of the command", func() {
+		// `cat` writes the SVG content of the block on its standard output
+		source := `[#diagram]
+.A diagram
+[diagram]
+----
+<svg></svg>
+----`
+		expected := `<div id="diagram" class="imageblock">
+<div class="content">
+<img src="data:image/svg+xml;base64,PHN2Zz48L3N2Zz4K" alt="cat">
+</div>
+<div class="title">Figure 1. A diagram</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, renderer.DefineBlockProcessor("diagram", processor.Command("cat"))))
+	})
+
+	It("should fail when the command fails", func() {
+		source := `[diagram]
+----
+A -> B
+----`
+		_, err := libasciidoc.ConvertToHTML(context.Background(), "", strings.NewReader(source), bytes.NewBuffer(nil),
+			renderer.DefineBlockProcessor("diagram", processor.Command("false")))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unable to process block with style 'diagram': failed to run 'false'"))
+	})
+})
@@ -0,0 +1,277 @@
+package processor
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// ProcessMacros replaces the user macros of the document of the given context which are handled by a macro processor,
+// with the elements returned by the processor. The macros in the footnotes and in the section titles which can be
+// referenced (eg: in the table of contents) are also replaced, and the elements with an ID returned by the processors
+// (eg: the sections) are referenced, so that they can be the targets of the cross references.
+func ProcessMacros(ctx *renderer.Context) error {
+	if !ctx.HasMacroProcessors() {
+		return nil
+	}
+	if ctx.Document.ElementReferences == nil {
+		ctx.Document.ElementReferences = types.ElementReferences{}
+	}
+	elements, err := processMacros(ctx, ctx.Document.Elements)
+	if err != nil {
+		return errors.Wrap(err, "error while processing the macros")
+	}
+	ctx.Document.Elements = elements
+	footnotes := make(types.Footnotes, len(ctx.Document.Footnotes))
+	for i, f := range ctx.Document.Footnotes {
+		if f.Elements, err = processInlineMacros(ctx, f.Elements); err != nil {
+			return errors.Wrap(err, "error while processing the macros")
+		}
+		footnotes[i] = f
+	}
+	ctx.Document.Footnotes = footnotes
+	references := make(types.ElementReferences, len(ctx.Document.ElementReferences))
+	for id, ref := range ctx.Document.ElementReferences {
+		if title, ok := ref.([]interface{}); ok {
+			if ref, err = processInlineMacros(ctx, title); err != nil {
+				return errors.Wrap(err, "error while processing the macros")
+			}
+		}
+		references[id] = ref
+	}
+	ctx.Document.ElementReferences = references
+	return nil
+}
+
+// processMacros processes the block macros in the given blocks, and the inline macros in their content
+// nolint: gocyclo
+func processMacros(ctx *renderer.Context, elements []interface{}) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(elements))
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.UserMacro:
+			processed, err := processMacro(ctx, e)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, processed...)
+		case types.Section:
+			title, err := processInlineMacros(ctx, e.Title)
+			if err != nil {
+				return nil, err
+			}
+			e.Title = title
+			blocks, err := processMacros(ctx, e.Elements)
+			if err != nil {
+				return nil, err
+			}
+			e.Elements = blocks
+			result = append(result, e)
+		case types.Preamble:
+			blocks, err := processMacros(ctx, e.Elements)
+			if err != nil {
+				return nil, err
+			}
+			e.Elements = blocks
+			result = append(result, e)
+		case types.Paragraph:
+			lines, err := processLines(ctx, e.Lines)
+			if err != nil {
+				return nil, err
+			}
+			e.Lines = lines
+			result = append(result, e)
+		case types.DelimitedBlock:
+			blocks, err := processMacros(ctx, e.Elements)
+			if err != nil {
+				return nil, err
+			}
+			e.Elements = blocks
+			result = append(result, e)
+		case types.Table:
+			header, err := processLines(ctx, e.Header.Cells)
+			if err != nil {
+				return nil, err
+			}
+			e.Header.Cells = header
+			lines := make([]types.TableLine, len(e.Lines))
+			for i, l := range e.Lines {
+				cells, err := processLines(ctx, l.Cells)
+				if err != nil {
+					return nil, err
+				}
+				lines[i] = types.TableLine{Cells: cells}
+			}
+			e.Lines = lines
+			result = append(result, e)
+		case types.OrderedList:
+			items := make([]types.OrderedListItem, len(e.Items))
+			for i, item := range e.Items {
+				blocks, err := processMacros(ctx, item.Elements)
+				if err != nil {
+					return nil, err
+				}
+				item.Elements = blocks
+				items[i] = item
+			}
+			e.Items = items
+			result = append(result, e)
+		case types.UnorderedList:
+			items := make([]types.UnorderedListItem, len(e.Items))
+			for i, item := range e.Items {
+				blocks, err := processMacros(ctx, item.Elements)
+				if err != nil {
+					return nil, err
+				}
+				item.Elements = blocks
+				items[i] = item
+			}
+			e.Items = items
+			result = append(result, e)
+		case types.LabeledList:
+			items := make([]types.LabeledListItem, len(e.Items))
+			for i, item := range e.Items {
+				term, err := processInlineMacros(ctx, item.Term)
+				if err != nil {
+					return nil, err
+				}
+				item.Term = term
+				blocks, err := processMacros(ctx, item.Elements)
+				if err != nil {
+					return nil, err
+				}
+				item.Elements = blocks
+				items[i] = item
+			}
+			e.Items = items
+			result = append(result, e)
+		default:
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+// processLines processes the inline macros in the given lines (or table cells)
+func processLines(ctx *renderer.Context, lines [][]interface{}) ([][]interface{}, error) {
+	if lines == nil {
+		return nil, nil
+	}
+	result := make([][]interface{}, len(lines))
+	for i, l := range lines {
+		line, err := processInlineMacros(ctx, l)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = line
+	}
+	return result, nil
+}
+
+// processInlineMacros processes the inline macros in the given inline elements, including in the quoted texts
+// and in the footnotes
+func processInlineMacros(ctx *renderer.Context, elements []interface{}) ([]interface{}, error) {
+	if elements == nil {
+		return nil, nil
+	}
+	result := make([]interface{}, 0, len(elements))
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.UserMacro:
+			processed, err := processMacro(ctx, e)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, processed...)
+		case types.QuotedText:
+			elements, err := processInlineMacros(ctx, e.Elements)
+			if err != nil {
+				return nil, err
+			}
+			e.Elements = elements
+			result = append(result, e)
+		case types.Footnote:
+			elements, err := processInlineMacros(ctx, e.Elements)
+			if err != nil {
+				return nil, err
+			}
+			e.Elements = elements
+			result = append(result, e)
+		default:
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+// processMacro returns the elements returned by the processor defined for the given macro,
+// or the macro itself if there is no such processor (so that it can be rendered with its template, if any)
+func processMacro(ctx *renderer.Context, macro types.UserMacro) ([]interface{}, error) {
+	p, found := ctx.MacroProcessor(macro)
+	if !found {
+		return []interface{}{macro}, nil
+	}
+	log.Debugf("processing %s macro '%s'", macro.Kind, macro.Name)
+	elements, err := p(ctx, macro)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to process %s macro '%s'", macro.Kind, macro.Name)
+	}
+	referenceElements(ctx.Document.ElementReferences, elements)
+	return elements, nil
+}
+
+// referenceElements references the given elements which have an ID and a label (ie, the sections with their title,
+// the links with their text, and the blocks with their title), unless another element with the same ID was
+// already referenced
+func referenceElements(refs types.ElementReferences, elements []interface{}) {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.Section:
+			referenceElement(refs, e.Attributes, e.Title)
+			referenceElements(refs, e.Elements)
+		case types.InlineLink:
+			text, ok := e.Attributes[types.AttrInlineLinkText].([]interface{})
+			if !ok {
+				text = []interface{}{
+					types.StringElement{Content: e.Location.String()},
+				}
+			}
+			referenceElement(refs, e.Attributes, text)
+		case types.QuotedText:
+			referenceElements(refs, e.Elements)
+		case types.Paragraph:
+			referenceElement(refs, e.Attributes, blockTitle(e.Attributes))
+			for _, l := range e.Lines {
+				referenceElements(refs, l)
+			}
+		case types.DelimitedBlock:
+			referenceElement(refs, e.Attributes, blockTitle(e.Attributes))
+			referenceElements(refs, e.Elements)
+		case types.LiteralBlock:
+			referenceElement(refs, e.Attributes, blockTitle(e.Attributes))
+		}
+	}
+}
+
+func referenceElement(refs types.ElementReferences, attrs types.ElementAttributes, label []interface{}) {
+	id := attrs.GetAsString(types.AttrID)
+	if id == "" || len(label) == 0 {
+		return
+	}
+	if _, found := refs[id]; !found {
+		log.Debugf("referencing element with ID '%s'", id)
+		refs[id] = label
+	}
+}
+
+// blockTitle returns the title of the block with the given attributes, or nil if the block has no title
+func blockTitle(attrs types.ElementAttributes) []interface{} {
+	title := attrs.GetAsString(types.AttrTitle)
+	if title == "" {
+		return nil
+	}
+	return []interface{}{
+		types.StringElement{Content: title},
+	}
+}
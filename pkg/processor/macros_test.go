@@ -0,0 +1,177 @@
+package processor_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("macro processors", func() {
+
+	// a processor which replaces the `issue:1234[]` macros with a link to the issue
+	issue := renderer.DefineInlineMacroProcessor("issue", func(ctx *renderer.Context, macro types.UserMacro) ([]interface{}, error) {
+		return []interface{}{
+			types.InlineLink{
+				Location: types.Location{
+					Elements: []interface{}{
+						types.StringElement{Content: "https://example.com/issues/" + macro.Value},
+					},
+				},
+				Attributes: types.ElementAttributes{
+					types.AttrInlineLinkText: []interface{}{
+						types.StringElement{Content: "#" + macro.Value},
+					},
+				},
+			},
+		}, nil
+	})
+
+	// a processor which replaces the `note::text[]` macros with a paragraph
+	note := renderer.DefineBlockMacroProcessor("note", func(ctx *renderer.Context, macro types.UserMacro) ([]interface{}, error) {
+		return []interface{}{
+			types.Paragraph{
+				Attributes: types.ElementAttributes{
+					types.AttrRole: "note",
+				},
+				Lines: [][]interface{}{
+					{
+						types.StringElement{Content: "Note: " + macro.Value},
+					},
+				},
+			},
+		}, nil
+	})
+
+	It("should replace the inline macros in the HTML output", func() {
+		source := `fixed in issue:1234[] and issue:1235[]`
+		expected := `<div class="paragraph">
+<p>fixed in <a href="https://example.com/issues/1234">#1234</a> and <a href="https://example.com/issues/1235">#1235</a></p>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, issue))
+	})
+
+	It("should replace the inline macros in the Markdown output", func() {
+		source := `fixed in issue:1234[]`
+		expected := `fixed in [#1234](https://example.com/issues/1234)`
+		Expect(source).To(RenderMarkdownBody(expected, issue))
+	})
+
+	It("should replace the inline macros in the footnotes and list items", func() {
+		source := `* an item with issue:1234[]
+
+a paragraph with a footnote:[see issue:1235[]]`
+		expected := `<div class="ulist">
+<ul>
+<li>
+<p>an item with <a href="https://example.com/issues/1234">#1234</a></p>
+</li>
+</ul>
+</div>
+<div class="paragraph">
+<p>a paragraph with a <sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup></p>
+</div>
+<div id="footnotes">
+<hr>
+<div class="footnote" id="_footnotedef_1">
+<a href="#_footnoteref_1">1</a>. see <a href="https://example.com/issues/1235">#1235</a>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, issue))
+	})
+
+	It("should replace the block macros", func() {
+		source := `note::hello[]
+
+issue:1234[]`
+		expected := `<div class="paragraph note">
+<p>Note: hello</p>
+</div>
+<div class="paragraph">
+<p><a href="https://example.com/issues/1234">#1234</a></p>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, note, issue))
+	})
+
+	It("should reference the sections returned by the block macros", func() {
+		// a processor which replaces the `appendix::title[]` macros with a section
+		appendix := renderer.DefineBlockMacroProcessor("appendix", func(ctx *renderer.Context, macro types.UserMacro) ([]interface{}, error) {
+			return []interface{}{
+				types.Section{
+					Level: 1,
+					Attributes: types.ElementAttributes{
+						types.AttrID: "_" + macro.Value,
+					},
+					Title: []interface{}{
+						types.StringElement{Content: "Appendix: " + macro.Value},
+					},
+					Elements: []interface{}{},
+				},
+			}, nil
+		})
+		source := `see <<_licenses>>
+
+appendix::licenses[]`
+		expected := `<div class="paragraph">
+<p>see <a href="#_licenses">Appendix: licenses</a></p>
+</div>
+<div class="sect1">
+<h2 id="_licenses">Appendix: licenses</h2>
+<div class="sectionbody">
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, appendix))
+	})
+
+	It("should reference the links with an ID returned by the inline macros", func() {
+		// a processor which replaces the `def:term[]` macros with a link to the definition of the term, with an ID
+		def := renderer.DefineInlineMacroProcessor("def", func(ctx *renderer.Context, macro types.UserMacro) ([]interface{}, error) {
+			return []interface{}{
+				types.InlineLink{
+					Location: types.Location{
+						Elements: []interface{}{
+							types.StringElement{Content: "https://example.com/glossary#" + macro.Value},
+						},
+					},
+					Attributes: types.ElementAttributes{
+						types.AttrID: "def-" + macro.Value,
+						types.AttrInlineLinkText: []interface{}{
+							types.StringElement{Content: macro.Value},
+						},
+					},
+				},
+			}, nil
+		})
+		source := `a def:macro[] is replaced (see <<def-macro>>)`
+		expected := `<div class="paragraph">
+<p>a <a href="https://example.com/glossary#macro" id="def-macro">macro</a> is replaced (see <a href="#def-macro">macro</a>)</p>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, def))
+	})
+
+	It("should not replace the macros without processor", func() {
+		source := `fixed in bug:1234[]`
+		expected := `<div class="paragraph">
+<p>fixed in bug:1234[]</p>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, issue))
+	})
+
+	It("should fail when a processor fails", func() {
+		source := `fixed in issue:1234[]`
+		_, err := libasciidoc.ConvertToHTML(context.Background(), "", strings.NewReader(source), bytes.NewBuffer(nil),
+			renderer.DefineInlineMacroProcessor("issue", func(ctx *renderer.Context, macro types.UserMacro) ([]interface{}, error) {
+				return nil, fmt.Errorf("unknown issue")
+			}))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unable to process inline macro 'issue': unknown issue"))
+	})
+})
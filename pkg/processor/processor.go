@@ -0,0 +1,179 @@
+// Package processor applies the processors defined in the renderer context on the parsed documents,
+// before they are rendered, so that all the backends benefit from the elements that they produce.
+//
+// The block processors (see the `renderer.DefineBlockProcessor` option) replace the delimited blocks and the
+// paragraphs with a given style (eg: `[plantuml]`) with the elements that they return, given the raw lines
+// and the attributes of the block. When converting a document, they are applied on the draft document, so that
+// the raw lines are not attribute-substituted yet.
+//
+// The macro processors (see the `renderer.DefineInlineMacroProcessor` and `renderer.DefineBlockMacroProcessor`
+// options) replace the user macros with a given name (eg: `issue:1234[]`) with the elements that they return.
+package processor
+
+import (
+	"sort"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/asciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// ProcessBlocks replaces the delimited blocks and the paragraphs of the document of the given context
+// which have a style handled by a block processor, with the elements returned by the processor
+func ProcessBlocks(ctx *renderer.Context) error {
+	if !ctx.HasBlockProcessors() {
+		return nil
+	}
+	elements, err := processBlocks(ctx, ctx.Document.Elements)
+	if err != nil {
+		return errors.Wrap(err, "error while processing the blocks")
+	}
+	ctx.Document.Elements = elements
+	return nil
+}
+
+// ProcessDraftBlocks replaces the blocks of the given draft document which have a style handled by a block processor,
+// with the elements returned by the processor. Since the document attribute substitutions are not applied yet in a
+// draft document, the processors receive the source lines of the blocks (eg: with the `{name}` attribute references)
+func ProcessDraftBlocks(ctx *renderer.Context, doc types.DraftDocument) (types.DraftDocument, error) {
+	if !ctx.HasBlockProcessors() {
+		return doc, nil
+	}
+	blocks, err := processBlocks(ctx, doc.Blocks)
+	if err != nil {
+		return types.DraftDocument{}, errors.Wrap(err, "error while processing the blocks")
+	}
+	doc.Blocks = blocks
+	return doc, nil
+}
+
+// nolint: gocyclo
+func processBlocks(ctx *renderer.Context, elements []interface{}) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(elements))
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.Paragraph, types.LiteralBlock, types.DelimitedBlock:
+			if p, style, found := blockProcessor(ctx, e); found {
+				log.Debugf("processing block with style '%s'", style)
+				processed, err := processBlock(ctx, p, e)
+				if err != nil {
+					return nil, errors.Wrapf(err, "unable to process block with style '%s'", style)
+				}
+				result = append(result, processed...)
+				continue
+			}
+			if b, ok := e.(types.DelimitedBlock); ok {
+				// look for the blocks to process in the delimited block (eg: an example block)
+				blocks, err := processBlocks(ctx, b.Elements)
+				if err != nil {
+					return nil, err
+				}
+				b.Elements = blocks
+				result = append(result, b)
+				continue
+			}
+			result = append(result, e)
+		case types.ContinuedListItemElement:
+			// in a draft document, the blocks attached to a list item are not in the list item yet
+			blocks, err := processBlocks(ctx, []interface{}{e.Element})
+			if err != nil {
+				return nil, err
+			}
+			for _, b := range blocks {
+				result = append(result, types.ContinuedListItemElement{
+					Offset:  e.Offset,
+					Element: b,
+				})
+			}
+		case types.Section:
+			blocks, err := processBlocks(ctx, e.Elements)
+			if err != nil {
+				return nil, err
+			}
+			e.Elements = blocks
+			result = append(result, e)
+		case types.OrderedList:
+			items := make([]types.OrderedListItem, len(e.Items))
+			for i, item := range e.Items {
+				blocks, err := processBlocks(ctx, item.Elements)
+				if err != nil {
+					return nil, err
+				}
+				item.Elements = blocks
+				items[i] = item
+			}
+			e.Items = items
+			result = append(result, e)
+		case types.UnorderedList:
+			items := make([]types.UnorderedListItem, len(e.Items))
+			for i, item := range e.Items {
+				blocks, err := processBlocks(ctx, item.Elements)
+				if err != nil {
+					return nil, err
+				}
+				item.Elements = blocks
+				items[i] = item
+			}
+			e.Items = items
+			result = append(result, e)
+		case types.LabeledList:
+			items := make([]types.LabeledListItem, len(e.Items))
+			for i, item := range e.Items {
+				blocks, err := processBlocks(ctx, item.Elements)
+				if err != nil {
+					return nil, err
+				}
+				item.Elements = blocks
+				items[i] = item
+			}
+			e.Items = items
+			result = append(result, e)
+		default:
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+// blockProcessor returns the processor defined for the style of the given block, if any.
+// The style of the block is an attribute without value (eg: `plantuml` in `[plantuml,format=svg]`)
+func blockProcessor(ctx *renderer.Context, block interface{}) (renderer.BlockProcessor, string, bool) {
+	attrs := blockAttributes(block)
+	styles := make([]string, 0, len(attrs))
+	for k, v := range attrs {
+		if v == nil {
+			styles = append(styles, k)
+		}
+	}
+	// sort the styles to always select the same processor if several styles have one
+	sort.Strings(styles)
+	for _, style := range styles {
+		if p, found := ctx.BlockProcessor(style); found {
+			return p, style, true
+		}
+	}
+	return nil, "", false
+}
+
+func processBlock(ctx *renderer.Context, p renderer.BlockProcessor, block interface{}) ([]interface{}, error) {
+	lines, err := asciidoc.RawLines(block)
+	if err != nil {
+		return nil, err
+	}
+	return p(ctx, lines, blockAttributes(block))
+}
+
+func blockAttributes(block interface{}) types.ElementAttributes {
+	switch b := block.(type) {
+	case types.Paragraph:
+		return b.Attributes
+	case types.LiteralBlock:
+		return b.Attributes
+	case types.DelimitedBlock:
+		return b.Attributes
+	default:
+		return types.ElementAttributes{}
+	}
+}
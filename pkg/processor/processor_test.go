@@ -0,0 +1,120 @@
+package processor_test
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("block processors", func() {
+
+	// a processor which renders the lines of the block in upper case, in a paragraph with the same ID and role
+	shout := renderer.DefineBlockProcessor("shout", func(ctx *renderer.Context, lines []string, attrs types.ElementAttributes) ([]interface{}, error) {
+		paragraph := types.Paragraph{
+			Attributes: types.ElementAttributes{},
+			Lines:      [][]interface{}{},
+		}
+		for _, k := range []string{types.AttrID, types.AttrRole} {
+			if v, found := attrs[k]; found {
+				paragraph.Attributes[k] = v
+			}
+		}
+		for _, l := range lines {
+			paragraph.Lines = append(paragraph.Lines, []interface{}{
+				types.StringElement{Content: strings.ToUpper(l)},
+			})
+		}
+		return []interface{}{paragraph}, nil
+	})
+
+	It("should process a paragraph with the raw lines", func() {
+		source := `[shout]
+hello *world*`
+		expected := `<div class="paragraph">
+<p>HELLO *WORLD*</p>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, shout))
+	})
+
+	It("should process a delimited block with its attributes", func() {
+		source := `[#loud]
+[.big]
+[shout]
+----
+hello, world
+----`
+		expected := `<div id="loud" class="paragraph big">
+<p>HELLO, WORLD</p>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, shout))
+	})
+
+	It("should process the blocks in sections and lists", func() {
+		source := `== Section
+
+[shout]
+in a section
+
+* item
++
+[shout]
+in a list item`
+		expected := `<div class="sect1">
+<h2 id="_section">Section</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>IN A SECTION</p>
+</div>
+<div class="ulist">
+<ul>
+<li>
+<p>item</p>
+<div class="paragraph">
+<p>IN A LIST ITEM</p>
+</div>
+</li>
+</ul>
+</div>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, shout))
+	})
+
+	It("should process a paragraph with the attribute references", func() {
+		source := `:foo: bar
+
+[shout]
+hello {foo}`
+		expected := `<div class="paragraph">
+<p>HELLO {FOO}</p>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, shout))
+	})
+
+	It("should process a delimited block with the attribute references", func() {
+		source := `:foo: bar
+
+[shout]
+----
+hello {foo}
+----`
+		expected := `<div class="paragraph">
+<p>HELLO {FOO}</p>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, shout))
+	})
+
+	It("should not process the blocks without processor", func() {
+		source := `[whisper]
+hello, world`
+		expected := `<div class="paragraph">
+<p>hello, world</p>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, shout))
+	})
+})
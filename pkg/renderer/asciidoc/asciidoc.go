@@ -0,0 +1,203 @@
+// Package asciidoc renders the documents back into (normalized) AsciiDoc source, so that they can be
+// modified programmatically (eg: to rename IDs or rewrite links) and written back.
+//
+// A `types.DraftDocument` retains the document attribute declarations, the blank lines, the comments
+// and the individual list items, so its rendering is as close as possible to the original source.
+// A `types.Document` has been processed, so the attribute declarations of the header are rendered after
+// the document title, the blocks are separated by a single blank line, and the comments are lost.
+package asciidoc
+
+import (
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Render renders the given document in AsciiDoc and writes the result in the given `writer`
+func Render(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	return renderDocument(ctx, output)
+}
+
+// RenderDraft renders the given draft document in AsciiDoc and writes the result in the given `writer`
+func RenderDraft(doc types.DraftDocument, output io.Writer) error {
+	return renderDraftDocument(doc, output)
+}
+
+// RawLines returns the source lines of the content of the given paragraph, delimited block or literal block,
+// without its attributes and delimiters. The elements of a draft document are not attribute-substituted yet,
+// so their lines retain the attribute references (eg: `{name}`)
+func RawLines(element interface{}) ([]string, error) {
+	var content string
+	var err error
+	switch e := element.(type) {
+	case types.Paragraph:
+		content, err = renderLines(e.Lines)
+	case types.DelimitedBlock:
+		content, err = renderElements(e.Elements, true)
+	case types.LiteralBlock:
+		return e.Lines, nil
+	default:
+		return nil, errors.Errorf("unable to render the raw lines of element of type '%T'", element)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render the raw lines")
+	}
+	if content == "" {
+		return []string{}, nil
+	}
+	return strings.Split(content, "\n"), nil
+}
+
+// renderElements renders the given blocks, one after the other. In draft mode, the blocks are separated by the
+// blank lines which are part of the given elements, otherwise, a blank line is inserted between each block
+func renderElements(elements []interface{}, draft bool) (string, error) {
+	log.Debugf("rendering %d elements(s)...", len(elements))
+	result := strings.Builder{}
+	var previous interface{}
+	for i, element := range elements {
+		if draft && isBlankLine(element) && isBlockMacro(previous) {
+			// the end of the line of a block macro is parsed as a blank line, which must not be rendered twice
+			previous = nil
+			continue
+		}
+		renderedElement, err := renderElement(element, draft)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to render an element")
+		}
+		if i > 0 {
+			result.WriteString(separator(previous, element, draft))
+		}
+		result.WriteString(renderedElement)
+		previous = element
+	}
+	return result.String(), nil
+}
+
+// separator returns the separator to write between the given consecutive blocks
+func separator(previous, next interface{}, draft bool) string {
+	if isBlankLine(previous) || isBlankLine(next) {
+		return "\n"
+	}
+	if isList(previous) {
+		// a block immediately following a list would be attached to the last item
+		return "\n\n"
+	}
+	if draft {
+		if item, ok := previous.(types.LabeledListItem); ok && len(item.Elements) == 0 && !isListItem(next) {
+			// the next block would be the description of the item otherwise (the blank line was consumed by the item)
+			return "\n\n"
+		}
+		return "\n"
+	}
+	return "\n\n"
+}
+
+func isBlankLine(element interface{}) bool {
+	_, ok := element.(types.BlankLine)
+	return ok
+}
+
+func isBlockMacro(element interface{}) bool {
+	m, ok := element.(types.UserMacro)
+	return ok && m.Kind == types.BlockMacro
+}
+
+func isListItem(element interface{}) bool {
+	switch element.(type) {
+	case types.OrderedListItem, types.UnorderedListItem, types.LabeledListItem, types.ContinuedListItemElement:
+		return true
+	default:
+		return false
+	}
+}
+
+func isList(element interface{}) bool {
+	switch element.(type) {
+	case types.OrderedList, types.UnorderedList, types.LabeledList:
+		return true
+	default:
+		return false
+	}
+}
+
+// renderElement renders the given block
+// nolint: gocyclo
+func renderElement(element interface{}, draft bool) (string, error) {
+	switch e := element.(type) {
+	case []interface{}:
+		return renderRawElements(e)
+	case types.BlankLine:
+		return "", nil
+	case types.TableOfContentsMacro:
+		return "toc::[]", nil
+	case types.DocumentAttributeDeclaration:
+		return renderDocumentAttributeDeclaration(e.Name, e.Value), nil
+	case types.DocumentAttributeReset:
+		return ":" + e.Name + "!:", nil
+	case types.SingleLineComment:
+		return "//" + e.Content, nil
+	case types.FileInclusion:
+		return strings.TrimRight(e.RawText, "\r\n"), nil
+	case types.Section:
+		return renderSection(e, draft)
+	case types.Preamble:
+		return renderElements(e.Elements, draft)
+	case types.LabeledList:
+		return renderLabeledList(e, draft)
+	case types.OrderedList:
+		return renderOrderedList(e, draft)
+	case types.UnorderedList:
+		return renderUnorderedList(e, draft)
+	case types.LabeledListItem:
+		return renderLabeledListItem(e, draft)
+	case types.OrderedListItem:
+		return renderOrderedListItem(e, draft)
+	case types.UnorderedListItem:
+		return renderUnorderedListItem(e, draft)
+	case types.ContinuedListItemElement:
+		return renderContinuedListItemElement(e, draft)
+	case types.Paragraph:
+		return renderParagraph(e)
+	case types.ImageBlock:
+		return renderImageBlock(e), nil
+	case types.VideoBlock:
+		return renderVideoBlock(e), nil
+	case types.AudioBlock:
+		return renderAudioBlock(e), nil
+	case types.DelimitedBlock:
+		return renderDelimitedBlock(e)
+	case types.Table:
+		return renderTable(e)
+	case types.LiteralBlock:
+		return renderLiteralBlock(e), nil
+	case types.StemBlock:
+		return renderStemBlock(e), nil
+	case types.UserMacro:
+		return e.RawText, nil
+	default:
+		// inline element
+		return renderInlineElement(element)
+	}
+}
+
+// renderRawElements renders the given raw content (eg: the `toc::[]` macro, which is not converted by the parser)
+func renderRawElements(elements []interface{}) (string, error) {
+	result := strings.Builder{}
+	for _, element := range elements {
+		switch e := element.(type) {
+		case []byte:
+			result.Write(e)
+		default:
+			renderedElement, err := renderInlineElement(e)
+			if err != nil {
+				return "", err
+			}
+			result.WriteString(renderedElement)
+		}
+	}
+	return strings.TrimRight(result.String(), "\r\n"), nil
+}
@@ -0,0 +1,15 @@
+package asciidoc_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/testsupport"
+)
+
+func TestAsciiDoc(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AsciiDoc Suite")
+}
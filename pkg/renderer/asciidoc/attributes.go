@@ -0,0 +1,160 @@
+package asciidoc
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// the attributes which are rendered in a specific form (eg: in the style of the block),
+// or which are computed by the parser and must not be rendered at all
+var reservedAttributes = map[string]bool{
+	types.AttrCustomID:         true,
+	types.AttrKind:             true,
+	types.AttrLanguage:         true,
+	types.AttrAdmonitionKind:   true,
+	types.AttrQuoteAuthor:      true,
+	types.AttrQuoteTitle:       true,
+	types.AttrStemNotation:     true,
+	types.AttrLiteralBlockType: true,
+	types.AttrCheckStyle:       true,
+	"layout":                   true,
+}
+
+// renderBlockAttributes renders the attributes of a block, one group per line, followed by a newline
+// (or returns an empty string if there is no attribute to render).
+// The given style (eg: `source,go` or `NOTE`) is rendered in its own group, and the given keys are excluded.
+// An ID which was set in an attribute group (eg: `[NOTE,id=foo]`) is rendered in the group, along with the other attributes
+func renderBlockAttributes(attrs types.ElementAttributes, style string, excluded ...string) string {
+	lines := []string{}
+	if attrs.GetAsBool(types.AttrCustomID) {
+		lines = append(lines, "[["+attrs.GetAsString(types.AttrID)+"]]")
+	}
+	if title := attrs.GetAsString(types.AttrTitle); title != "" {
+		lines = append(lines, "."+title)
+	}
+	if role := attrs.GetAsString(types.AttrRole); role != "" {
+		lines = append(lines, "[."+role+"]")
+	}
+	if style != "" {
+		lines = append(lines, "["+style+"]")
+	}
+	if attrs.GetAsString("layout") == "horizontal" {
+		lines = append(lines, "[horizontal]")
+	}
+	excluded = append(excluded, types.AttrTitle, types.AttrRole)
+	if attrs.GetAsBool(types.AttrCustomID) {
+		excluded = append(excluded, types.AttrID)
+	}
+	if group := renderAttributeGroup(attrs, excluded...); group != "" {
+		lines = append(lines, "["+group+"]")
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// renderBlockStyle returns the style of the block, ie, the first positional attribute
+// which changes the way the block is rendered (eg: `source,go`, `quote, author, title`, `NOTE`)
+func renderBlockStyle(attrs types.ElementAttributes) string {
+	switch attrs[types.AttrKind] {
+	case types.Source:
+		if language := attrs.GetAsString(types.AttrLanguage); language != "" {
+			return "source," + language
+		}
+		return "source"
+	case types.Quote:
+		return renderQuoteStyle("quote", attrs)
+	case types.Verse:
+		return renderQuoteStyle("verse", attrs)
+	}
+	if k, ok := attrs[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		return strings.ToUpper(string(k))
+	}
+	return ""
+}
+
+// renderQuoteStyle renders the style of a quote or verse block, with its optional author and title
+func renderQuoteStyle(kind string, attrs types.ElementAttributes) string {
+	author := attrs.GetAsString(types.AttrQuoteAuthor)
+	title := attrs.GetAsString(types.AttrQuoteTitle)
+	switch {
+	case title != "":
+		return kind + ", " + author + ", " + title
+	case author != "":
+		return kind + ", " + author
+	default:
+		return kind
+	}
+}
+
+// renderAttributeGroup renders the content of an attribute group, ie, all the attributes which are not reserved
+// nor excluded, separated by commas: the attributes without value first, then the `key=value` ones,
+// each of them sorted by name
+func renderAttributeGroup(attrs types.ElementAttributes, excluded ...string) string {
+	keys := []string{}
+	pairs := []string{}
+attributes:
+	for k, v := range attrs {
+		if reservedAttributes[k] {
+			continue
+		}
+		for _, e := range excluded {
+			if k == e {
+				continue attributes
+			}
+		}
+		switch v := v.(type) {
+		case nil:
+			keys = append(keys, k)
+		case string:
+			if k == types.AttrNumberingStyle {
+				// rendered as a positional attribute, eg: `[upperroman]`
+				keys = append(keys, v)
+				continue
+			}
+			pairs = append(pairs, k+"="+renderAttributeValue(v))
+		default:
+			log.Debugf("skipping attribute '%s' of type %T", k, v)
+		}
+	}
+	sort.Strings(keys)
+	sort.Strings(pairs)
+	return strings.Join(append(keys, pairs...), ",")
+}
+
+// renderAttributeValue renders the given value, surrounded with double quotes if needed
+func renderAttributeValue(value string) string {
+	if value == "" || strings.ContainsAny(value, ",]=\"") || strings.TrimSpace(value) != value {
+		return `"` + value + `"`
+	}
+	return value
+}
+
+// renderPositionalAttributes renders the given positional attributes (eg: alt, width and height of an image)
+// followed by the other attributes which are not excluded, without the trailing empty positional attributes
+func renderPositionalAttributes(attrs types.ElementAttributes, positional []string, excluded ...string) string {
+	values := make([]string, 0, len(positional)+1)
+	for _, key := range positional {
+		values = append(values, renderPositionalValue(attrs.GetAsString(key)))
+	}
+	if group := renderAttributeGroup(attrs, append(excluded, positional...)...); group != "" {
+		values = append(values, group)
+	}
+	// remove the trailing empty values
+	for len(values) > 0 && values[len(values)-1] == "" {
+		values = values[:len(values)-1]
+	}
+	return strings.Join(values, ",")
+}
+
+// renderPositionalValue renders the given positional value, surrounded with double quotes if needed
+func renderPositionalValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	return renderAttributeValue(value)
+}
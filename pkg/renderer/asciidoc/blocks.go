@@ -0,0 +1,154 @@
+package asciidoc
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderSection(s types.Section, draft bool) (string, error) {
+	var result string
+	if s.Level == 0 {
+		header, err := renderDocumentHeader(s)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render section")
+		}
+		result = header
+	} else {
+		title, err := renderSectionTitle(s)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render section")
+		}
+		result = title
+	}
+	content, err := renderElements(s.Elements, draft)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render section")
+	}
+	if content != "" {
+		result += "\n\n" + content
+	}
+	return result, nil
+}
+
+// renderSectionTitle renders the attributes and the title of the given section, eg: `== Section 1`
+func renderSectionTitle(s types.Section) (string, error) {
+	title, err := renderInlineElements(s.Title)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render section title")
+	}
+	attrs := s.Attributes
+	if !attrs.GetAsBool(types.AttrCustomID) {
+		// the ID is generated from the title
+		attrs = withoutID(attrs)
+	} else if strings.HasSuffix(title, " ") {
+		// the title was followed by an inline ID, eg: `== Section 1 [[section_1]]`
+		title += "[[" + attrs.GetAsString(types.AttrID) + "]]"
+		attrs = withoutID(attrs)
+	}
+	return renderBlockAttributes(attrs, "") + strings.Repeat("=", s.Level+1) + " " + title, nil
+}
+
+// withoutID returns a copy of the given attributes, without the ID
+func withoutID(attrs types.ElementAttributes) types.ElementAttributes {
+	result := types.ElementAttributes{}
+	for k, v := range attrs {
+		if k != types.AttrID && k != types.AttrCustomID {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+func renderParagraph(p types.Paragraph) (string, error) {
+	content, err := renderLines(p.Lines)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render paragraph")
+	}
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		log.Debug("rendering an admonition paragraph")
+		return renderBlockAttributes(p.Attributes, "") + strings.ToUpper(string(k)) + ": " + content, nil
+	}
+	return renderBlockAttributes(p.Attributes, renderBlockStyle(p.Attributes)) + content, nil
+}
+
+// the delimiters of the blocks, by kind
+var blockDelimiters = map[types.BlockKind]string{
+	types.Fenced:  "```",
+	types.Listing: "----",
+	types.Source:  "----",
+	types.Example: "====",
+	types.Comment: "////",
+	types.Quote:   "____",
+	types.Verse:   "____",
+	types.Sidebar: "****",
+}
+
+func renderDelimitedBlock(b types.DelimitedBlock) (string, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	delimiter, ok := blockDelimiters[b.Kind]
+	if !ok {
+		return "", errors.Errorf("unsupported kind of delimited block: '%v'", b.Kind)
+	}
+	// the blank lines are retained in the delimited blocks, even in the final document
+	content, err := renderElements(b.Elements, true)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render delimited block")
+	}
+	return renderDelimitedContent(renderBlockAttributes(b.Attributes, renderBlockStyle(b.Attributes)), delimiter, content), nil
+}
+
+// renderDelimitedContent renders the given content between the given delimiters, preceded by the given attributes
+func renderDelimitedContent(attributes, delimiter, content string) string {
+	if content == "" {
+		return attributes + delimiter + "\n" + delimiter
+	}
+	return attributes + delimiter + "\n" + content + "\n" + delimiter
+}
+
+func renderLiteralBlock(b types.LiteralBlock) string {
+	content := strings.Join(b.Lines, "\n")
+	switch b.Attributes[types.AttrLiteralBlockType] {
+	case types.LiteralBlockWithDelimiter:
+		return renderDelimitedContent(renderBlockAttributes(b.Attributes, ""), "....", content)
+	case types.LiteralBlockWithSpacesOnFirstLine:
+		return renderBlockAttributes(b.Attributes, "") + content
+	default:
+		return renderBlockAttributes(b.Attributes, "literal") + content
+	}
+}
+
+func renderStemBlock(b types.StemBlock) string {
+	return renderDelimitedContent(renderBlockAttributes(b.Attributes, string(b.Notation)), "++++", strings.Join(b.Lines, "\n"))
+}
+
+// blockAttributes returns the attributes which are rendered before a block macro (ie, its ID, title and role)
+func blockAttributes(attrs types.ElementAttributes) types.ElementAttributes {
+	result := types.ElementAttributes{}
+	for _, k := range []string{types.AttrID, types.AttrCustomID, types.AttrTitle, types.AttrRole} {
+		if v, ok := attrs[k]; ok {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+func renderImageBlock(b types.ImageBlock) string {
+	return renderBlockAttributes(blockAttributes(b.Attributes), "") +
+		"image::" + renderLocation(b.Location) +
+		"[" + renderPositionalAttributes(b.Attributes, []string{types.AttrImageAlt, types.AttrImageWidth, types.AttrImageHeight}, types.AttrID, types.AttrTitle, types.AttrRole) + "]"
+}
+
+func renderVideoBlock(b types.VideoBlock) string {
+	return renderBlockAttributes(blockAttributes(b.Attributes), "") +
+		"video::" + renderLocation(b.Location) +
+		"[" + renderPositionalAttributes(b.Attributes, []string{types.AttrVideoPoster, types.AttrImageWidth, types.AttrImageHeight}, types.AttrID, types.AttrTitle, types.AttrRole) + "]"
+}
+
+func renderAudioBlock(b types.AudioBlock) string {
+	return renderBlockAttributes(blockAttributes(b.Attributes), "") +
+		"audio::" + renderLocation(b.Location) +
+		"[" + renderAttributeGroup(b.Attributes, types.AttrID, types.AttrTitle, types.AttrRole) + "]"
+}
@@ -0,0 +1,238 @@
+package asciidoc_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("blocks", func() {
+
+	Context("sections", func() {
+
+		It("sections with attributes", func() {
+			source := `[[first]]
+== Section 1
+
+[.role]
+=== Section 1.1 [[inline_id]]`
+			expected := `[[first]]
+== Section 1
+
+[.role]
+=== Section 1.1 [[inline_id]]
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+	})
+
+	Context("paragraphs", func() {
+
+		It("admonition paragraphs", func() {
+			source := `NOTE: a note
+
+[WARNING]
+a warning`
+			expected := `NOTE: a note
+
+WARNING: a warning
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("paragraph with title and role", func() {
+			source := `.a title
+[.role1.role2]
+a paragraph`
+			expected := `.a title
+[.role1.role2]
+a paragraph
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("quote and verse paragraphs", func() {
+			source := `[quote, john doe, quote title]
+some *quote* content
+
+[verse, john doe]
+some verse`
+			expected := `[quote, john doe, quote title]
+some *quote* content
+
+[verse, john doe]
+some verse
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("paragraph with style and ID in an attribute group", func() {
+			source := `[NOTE , id=x]
+a paragraph`
+			expected := `[NOTE,id=x]
+a paragraph
+`
+			Expect(source).To(RenderDraftAsciiDoc(expected))
+		})
+	})
+
+	Context("delimited blocks", func() {
+
+		It("listing and source blocks", func() {
+			source := `----
+some listing
+
+content
+----
+
+[source,go]
+----
+func main() {}
+----`
+			expected := `----
+some listing
+
+content
+----
+
+[source,go]
+----
+func main() {}
+----
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("source block with spaces around the language", func() {
+			source := `[source , go]
+----
+func main() {}
+----`
+			expected := `[source,go]
+----
+func main() {}
+----
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("example block with admonition", func() {
+			source := `[NOTE]
+.a title
+====
+a note
+
+* an item
+====`
+			expected := `.a title
+[NOTE]
+====
+a note
+
+* an item
+====
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("empty sidebar block", func() {
+			source := `****
+****`
+			expected := `****
+****
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("comment block", func() {
+			source := `////
+a comment
+////`
+			expected := `////
+a comment
+////
+`
+			Expect(source).To(RenderDraftAsciiDoc(expected))
+		})
+	})
+
+	Context("literal and stem blocks", func() {
+
+		It("literal blocks", func() {
+			source := `....
+a literal
+....
+
+  some spaces
+
+[literal]
+an attribute`
+			expected := `....
+a literal
+....
+
+  some spaces
+
+[literal]
+an attribute
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("stem block", func() {
+			source := `[stem]
+++++
+sqrt(4) = 2
+++++`
+			expected := `[stem]
+++++
+sqrt(4) = 2
+++++
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+	})
+
+	Context("block macros", func() {
+
+		It("image block", func() {
+			source := `[[img]]
+[.role]
+.a title
+image::foo.png[the foo, 100, 50, float=left]`
+			expected := `[[img]]
+.a title
+[.role]
+image::foo.png[the foo,100,50,float=left]
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("video and audio blocks", func() {
+			source := `video::video.mp4[poster=cover.png, width=640]
+
+audio::audio.mp3[options="autoplay,loop"]`
+			expected := `video::video.mp4[cover.png,640]
+
+audio::audio.mp3[options="autoplay,loop"]
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("user macro and table of contents", func() {
+			source := `toc::[]
+
+custom::target[a,b]
+
+a paragraph`
+			expected := `toc::[]
+
+custom::target[a,b]
+
+a paragraph
+`
+			Expect(source).To(RenderDraftAsciiDoc(expected))
+		})
+	})
+})
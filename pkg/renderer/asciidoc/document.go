@@ -0,0 +1,163 @@
+package asciidoc
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// renderDocument renders the whole document: its header (title, authors, revision and document attributes)
+// followed by all its blocks
+func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	log.Debugf("rendering document in AsciiDoc...")
+	result := strings.Builder{}
+	elements := ctx.Document.Elements
+	header, found := ctx.Document.Header()
+	if found {
+		renderedHeader, err := renderDocumentHeader(header)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render document")
+		}
+		result.WriteString(renderedHeader)
+		// retain the elements of the header, followed by the other elements, if any
+		elements = append(append([]interface{}{}, header.Elements...), ctx.Document.Elements[1:]...)
+	}
+	if attrs := renderDocumentAttributes(ctx.Document.Attributes); attrs != "" {
+		if result.Len() > 0 {
+			result.WriteString("\n")
+		}
+		result.WriteString(attrs)
+	}
+	renderedElements, err := renderElements(elements, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render document")
+	}
+	if renderedElements != "" {
+		if result.Len() > 0 {
+			result.WriteString("\n\n")
+		}
+		result.WriteString(renderedElements)
+	}
+	if err := writeContent(output, result.String()); err != nil {
+		return nil, errors.Wrap(err, "unable to render document")
+	}
+	metadata := map[string]interface{}{}
+	for k, v := range ctx.Document.Attributes {
+		metadata[k] = v
+	}
+	if found {
+		title, err := renderInlineElements(header.Title)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render document")
+		}
+		metadata[types.AttrTitle] = strings.TrimSpace(title)
+	}
+	metadata[types.AttrLastUpdated] = ctx.LastUpdated()
+	return metadata, nil
+}
+
+// renderDraftDocument renders the front-matter and all the blocks of the given draft document
+func renderDraftDocument(doc types.DraftDocument, output io.Writer) error {
+	log.Debugf("rendering draft document in AsciiDoc...")
+	result := strings.Builder{}
+	if len(doc.FrontMatter.Content) > 0 {
+		frontMatter, err := yaml.Marshal(doc.FrontMatter.Content)
+		if err != nil {
+			return errors.Wrap(err, "unable to render front-matter")
+		}
+		result.WriteString("---\n")
+		result.Write(frontMatter)
+		result.WriteString("---\n")
+	}
+	renderedElements, err := renderElements(doc.Blocks, true)
+	if err != nil {
+		return errors.Wrap(err, "unable to render draft document")
+	}
+	result.WriteString(renderedElements)
+	return errors.Wrap(writeContent(output, result.String()), "unable to render draft document")
+}
+
+// writeContent writes the given content in the output, followed by a single newline
+func writeContent(output io.Writer, content string) error {
+	if content == "" {
+		return nil
+	}
+	_, err := io.WriteString(output, content+"\n")
+	return err
+}
+
+// renderDocumentHeader renders the document title, followed by the authors and the revision, if any
+func renderDocumentHeader(header types.Section) (string, error) {
+	result := strings.Builder{}
+	title, err := renderSectionTitle(header)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render document header")
+	}
+	result.WriteString(title)
+	if authors, ok := header.Attributes[types.AttrAuthors].([]types.DocumentAuthor); ok && len(authors) > 0 {
+		result.WriteString("\n" + renderDocumentAuthors(authors))
+	}
+	if revision, ok := header.Attributes[types.AttrRevision].(types.DocumentRevision); ok {
+		result.WriteString("\n" + renderDocumentRevision(revision))
+	}
+	return result.String(), nil
+}
+
+// renderDocumentAuthors renders the authors on a single line, eg: `Jane Doe <jane@example.com>; John Doe`
+func renderDocumentAuthors(authors []types.DocumentAuthor) string {
+	result := make([]string, len(authors))
+	for i, author := range authors {
+		result[i] = strings.TrimSpace(author.FullName)
+		if author.Email != "" {
+			result[i] += " <" + author.Email + ">"
+		}
+	}
+	return strings.Join(result, "; ")
+}
+
+// renderDocumentRevision renders the revision, eg: `v1.0, October 2, 2013: First incarnation`
+func renderDocumentRevision(revision types.DocumentRevision) string {
+	result := strings.Builder{}
+	if revision.Revnumber != "" {
+		result.WriteString("v" + revision.Revnumber)
+	}
+	if revision.Revdate != "" {
+		if result.Len() > 0 {
+			result.WriteString(", ")
+		}
+		result.WriteString(revision.Revdate)
+	}
+	if revision.Revremark != "" {
+		result.WriteString(": " + revision.Revremark)
+	}
+	return result.String()
+}
+
+// renderDocumentAttributes renders a declaration for each document attribute with a string value, sorted by name
+func renderDocumentAttributes(attrs types.DocumentAttributes) string {
+	names := make([]string, 0, len(attrs))
+	for name, value := range attrs {
+		if _, ok := value.(string); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	result := make([]string, len(names))
+	for i, name := range names {
+		result[i] = renderDocumentAttributeDeclaration(name, attrs[name].(string))
+	}
+	return strings.Join(result, "\n")
+}
+
+func renderDocumentAttributeDeclaration(name, value string) string {
+	if value == "" {
+		return ":" + name + ":"
+	}
+	return ":" + name + ": " + value
+}
@@ -0,0 +1,121 @@
+package asciidoc_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/asciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("documents", func() {
+
+	source := `= Document Title
+Jane Doe  <jane@example.com>; John Doe
+v1.0, 2019-12-01: First draft
+:toc:
+:description: a description
+// a comment
+
+== Section 1 [[first]]
+
+
+:foo: bar
+
+a paragraph
+with {foo}.`
+
+	It("document with header", func() {
+		expected := `= Document Title
+Jane Doe <jane@example.com>; John Doe
+v1.0, 2019-12-01: First draft
+:description: a description
+:toc:
+
+== Section 1 [[first]]
+
+a paragraph
+with bar.
+`
+		Expect(source).To(RenderAsciiDoc(expected))
+	})
+
+	It("draft document with header", func() {
+		expected := `= Document Title
+Jane Doe <jane@example.com>; John Doe
+v1.0, 2019-12-01: First draft
+:toc:
+:description: a description
+// a comment
+
+== Section 1 [[first]]
+
+
+:foo: bar
+
+a paragraph
+with {foo}.
+`
+		Expect(source).To(RenderDraftAsciiDoc(expected))
+	})
+
+	It("draft document with front-matter", func() {
+		source := `---
+author: Jane Doe
+---
+
+a paragraph`
+		expected := `---
+author: Jane Doe
+---
+
+a paragraph
+`
+		Expect(source).To(RenderDraftAsciiDoc(expected))
+	})
+
+	It("document with attributes but without header", func() {
+		source := `:foo: bar
+:baz:
+
+a paragraph`
+		expected := `:baz:
+:foo: bar
+
+a paragraph
+`
+		Expect(source).To(RenderAsciiDoc(expected))
+	})
+
+	It("modified document", func() {
+		doc, err := parser.ParseDocument("test.adoc", strings.NewReader(`== Section 1
+
+see https://example.com/old[the docs]`))
+		Expect(err).ToNot(HaveOccurred())
+		// rewrite the link
+		section := doc.Elements[0].(types.Section)
+		paragraph := section.Elements[0].(types.Paragraph)
+		link := paragraph.Lines[0][1].(types.InlineLink)
+		link.Location = types.Location{
+			Elements: []interface{}{
+				types.StringElement{Content: "https://example.com/new"},
+			},
+		}
+		paragraph.Lines[0][1] = link
+		output := &bytes.Buffer{}
+		metadata, err := asciidoc.Render(renderer.Wrap(context.Background(), doc), output)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.String()).To(Equal(`== Section 1
+
+see https://example.com/new[the docs]
+`))
+		Expect(metadata).To(HaveKey(types.AttrLastUpdated))
+	})
+})
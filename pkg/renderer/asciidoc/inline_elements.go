@@ -0,0 +1,253 @@
+package asciidoc
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// renderInlineElements renders the given inline elements. The quoted texts are rendered with a single
+// punctuation (eg: `*bold*`) unless they are next to an alphanumeric character, in which case they
+// need a double punctuation (eg: `**bold**ly`)
+func renderInlineElements(elements []interface{}) (string, error) {
+	rendered := make([]string, len(elements))
+	for i, element := range elements {
+		if _, ok := element.(types.QuotedText); ok {
+			continue // rendered in a second pass, once the surrounding elements are known
+		}
+		r, err := renderInlineElement(element)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to render inline elements")
+		}
+		rendered[i] = r
+	}
+	result := strings.Builder{}
+	for i, element := range elements {
+		if q, ok := element.(types.QuotedText); ok {
+			previous, _ := utf8.DecodeLastRuneInString(result.String())
+			var next rune
+			if i+1 < len(elements) {
+				if _, ok := elements[i+1].(types.QuotedText); !ok {
+					next, _ = utf8.DecodeRuneInString(rendered[i+1])
+				}
+			}
+			r, err := renderQuotedText(q, previous, next)
+			if err != nil {
+				return "", errors.Wrapf(err, "unable to render inline elements")
+			}
+			rendered[i] = r
+		}
+		result.WriteString(rendered[i])
+	}
+	return result.String(), nil
+}
+
+// nolint: gocyclo
+func renderInlineElement(element interface{}) (string, error) {
+	switch e := element.(type) {
+	case []interface{}:
+		return renderInlineElements(e)
+	case types.StringElement:
+		return e.Content, nil
+	case types.QuotedText:
+		return renderQuotedText(e, utf8.RuneError, utf8.RuneError)
+	case types.Passthrough:
+		return renderPassthrough(e)
+	case types.InlineLink:
+		return renderLink(e)
+	case types.InternalCrossReference:
+		if e.Label != "" {
+			return "<<" + e.ID + "," + e.Label + ">>", nil
+		}
+		return "<<" + e.ID + ">>", nil
+	case types.ExternalCrossReference:
+		label, err := renderInlineElements(e.Label)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render cross reference")
+		}
+		return "xref:" + renderLocation(e.Location) + "[" + label + "]", nil
+	case types.Footnote:
+		return renderFootnote(e)
+	case types.InlineImage:
+		return "image:" + renderLocation(e.Location) + "[" + renderPositionalAttributes(e.Attributes, []string{types.AttrImageAlt, types.AttrImageWidth, types.AttrImageHeight}) + "]", nil
+	case types.InlineIcon:
+		return "icon:" + e.Name + "[" + renderPositionalAttributes(e.Attributes, []string{types.AttrIconSize}) + "]", nil
+	case types.InlineStem:
+		return string(e.Notation) + ":[" + strings.Replace(e.Content, "]", `\]`, -1) + "]", nil
+	case types.LineBreak:
+		return " +", nil
+	case types.UserMacro:
+		return e.RawText, nil
+	case types.DocumentAttributeSubstitution:
+		return "{" + e.Name + "}", nil
+	case types.ConceleadIndexTerm:
+		return renderConcealedIndexTerm(e), nil
+	case types.ElementAttributes:
+		// inline element ID
+		return "[[" + e.GetAsString(types.AttrID) + "]]", nil
+	case types.SingleLineComment:
+		return "//" + e.Content, nil
+	default:
+		return "", errors.Errorf("unsupported type of element: %T", element)
+	}
+}
+
+// renderLines renders the given lines, one after the other
+func renderLines(lines [][]interface{}) (string, error) {
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		l, err := renderInlineElements(line)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render lines")
+		}
+		result[i] = l
+	}
+	return strings.Join(result, "\n"), nil
+}
+
+// the single punctuation of the quoted texts, by kind
+var quotedTextPunctuations = map[types.QuotedTextKind]string{
+	types.Bold:        "*",
+	types.Italic:      "_",
+	types.Monospace:   "`",
+	types.Subscript:   "~",
+	types.Superscript: "^",
+}
+
+// renderQuotedText renders the given quoted text, given the characters before and after it
+func renderQuotedText(q types.QuotedText, previous, next rune) (string, error) {
+	content, err := renderInlineElements(q.Elements)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render quoted text")
+	}
+	punctuation, ok := quotedTextPunctuations[q.Kind]
+	if !ok {
+		return "", errors.Errorf("unsupported kind of quoted text: %v", q.Kind)
+	}
+	switch q.Kind {
+	case types.Subscript, types.Superscript:
+		// no double punctuation for these kinds
+	default:
+		if isAlphanumeric(previous) || isAlphanumeric(next) ||
+			content == "" || strings.TrimSpace(content) != content ||
+			containsQuotedText(q.Elements, q.Kind) {
+			punctuation = punctuation + punctuation
+		}
+	}
+	return punctuation + content + punctuation, nil
+}
+
+func isAlphanumeric(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// containsQuotedText returns `true` if the given elements contain a quoted text of the given kind
+func containsQuotedText(elements []interface{}, kind types.QuotedTextKind) bool {
+	for _, element := range elements {
+		if q, ok := element.(types.QuotedText); ok && q.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func renderPassthrough(p types.Passthrough) (string, error) {
+	content, err := renderInlineElements(p.Elements)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render passthrough")
+	}
+	switch p.Kind {
+	case types.SinglePlusPassthrough:
+		return "+" + content + "+", nil
+	case types.TriplePlusPassthrough:
+		return "+++" + content + "+++", nil
+	default:
+		for _, element := range p.Elements {
+			if _, ok := element.(types.QuotedText); ok {
+				return "pass:q[" + content + "]", nil
+			}
+		}
+		return "pass:[" + content + "]", nil
+	}
+}
+
+// the schemes of the locations which do not need the `link:` prefix
+var urlSchemes = []string{"http://", "https://", "ftp://", "irc://", "mailto:"}
+
+func renderLink(l types.InlineLink) (string, error) {
+	location := renderLocation(l.Location)
+	prefix := "link:"
+	for _, scheme := range urlSchemes {
+		if strings.HasPrefix(location, scheme) {
+			prefix = ""
+			break
+		}
+	}
+	var text string
+	if t, ok := l.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+		var err error
+		if text, err = renderInlineElements(t); err != nil {
+			return "", errors.Wrap(err, "unable to render link")
+		}
+	}
+	others := renderAttributeGroup(l.Attributes, types.AttrInlineLinkText)
+	if prefix == "" && text == "" && others == "" {
+		return location, nil
+	}
+	if strings.Contains(text, "=") || (others != "" && strings.Contains(text, ",")) {
+		text = `"` + text + `"`
+	}
+	switch {
+	case text != "" && others != "":
+		return prefix + location + "[" + text + "," + others + "]", nil
+	case others != "":
+		return prefix + location + "[" + others + "]", nil
+	default:
+		return prefix + location + "[" + text + "]", nil
+	}
+}
+
+// renderLocation renders the given location, including its document attribute substitutions
+func renderLocation(l types.Location) string {
+	result := strings.Builder{}
+	for _, element := range l.Elements {
+		switch e := element.(type) {
+		case types.StringElement:
+			result.WriteString(e.Content)
+		case types.DocumentAttributeSubstitution:
+			result.WriteString("{" + e.Name + "}")
+		default:
+			result.WriteString(fmt.Sprintf("%s", e))
+		}
+	}
+	return result.String()
+}
+
+func renderFootnote(f types.Footnote) (string, error) {
+	content, err := renderInlineElements(f.Elements)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render footnote")
+	}
+	switch {
+	case f.Ref == "":
+		return "footnote:[" + content + "]", nil
+	case len(f.Elements) > 0:
+		return "footnoteref:[" + f.Ref + "," + content + "]", nil
+	default:
+		return "footnoteref:[" + f.Ref + "]", nil
+	}
+}
+
+func renderConcealedIndexTerm(t types.ConceleadIndexTerm) string {
+	terms := []string{}
+	for _, term := range []interface{}{t.Term1, t.Term2, t.Term3} {
+		if term, ok := term.(string); ok {
+			terms = append(terms, term)
+		}
+	}
+	return "(((" + strings.Join(terms, ", ") + ")))"
+}
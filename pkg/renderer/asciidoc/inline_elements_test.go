@@ -0,0 +1,92 @@
+package asciidoc_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("inline elements", func() {
+
+	Context("quoted texts", func() {
+
+		It("constrained and unconstrained quoted texts", func() {
+			source := `some *bold*, _italic_ and ` + "`monospace`" + ` content, and **b**old, __i__talic and ` + "``m``" + `onospace.`
+			expected := `some *bold*, _italic_ and ` + "`monospace`" + ` content, and **b**old, __i__talic and ` + "``m``" + `onospace.
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("nested quoted texts", func() {
+			source := `some *bold and _italic_* and ^super^ and ~sub~ content.`
+			expected := `some *bold and _italic_* and ^super^ and ~sub~ content.
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+	})
+
+	Context("passthroughs", func() {
+
+		It("passthroughs", func() {
+			source := `+*a*+ and +++<b>b</b>+++ and pass:[<u>c</u>] and pass:q[*d*]`
+			expected := `+*a*+ and +++<b>b</b>+++ and pass:[<u>c</u>] and pass:q[*d*]
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+	})
+
+	Context("links and cross references", func() {
+
+		It("links", func() {
+			source := `https://example.com[] and https://example.com[example, window=_blank] and link:foo.html[foo]`
+			expected := `https://example.com and https://example.com[example,window=_blank] and link:foo.html[foo]
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("cross references", func() {
+			source := `<<section>> and <<section,the section>> and xref:other.adoc[the other]`
+			expected := `<<section>> and <<section,the section>> and xref:other.adoc[the other]
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+	})
+
+	Context("other inline elements", func() {
+
+		It("footnotes", func() {
+			source := `a footnote:[a *note*] and a footnoteref:[ref,another note] and footnoteref:[ref]`
+			expected := `a footnote:[a *note*] and a footnoteref:[ref,another note] and footnoteref:[ref]
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("inline images, icons and line breaks", func() {
+			source := `an image:foo.png[foo, 10, 20] and icon:tip[size=2x] +
+on two lines`
+			expected := `an image:foo.png[foo,10,20] and icon:tip[2x] +
+on two lines
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("attribute substitutions in draft document", func() {
+			source := `:name: value
+
+a {name} and [[anchor]]an anchor`
+			expected := `:name: value
+
+a {name} and [[anchor]]an anchor
+`
+			Expect(source).To(RenderDraftAsciiDoc(expected))
+		})
+
+		It("concealed index terms in draft document", func() {
+			source := `a paragraph (((primary, secondary))) with index terms`
+			expected := `a paragraph (((primary, secondary))) with index terms
+`
+			Expect(source).To(RenderDraftAsciiDoc(expected))
+		})
+	})
+})
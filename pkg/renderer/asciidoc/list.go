@@ -0,0 +1,219 @@
+package asciidoc
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderOrderedList(l types.OrderedList, draft bool) (string, error) {
+	result := strings.Builder{}
+	for i, item := range l.Items {
+		renderedItem, err := renderOrderedListItem(item, draft)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render ordered list")
+		}
+		if i > 0 {
+			result.WriteString(itemSeparator(l.Items[i-1].Elements, draft))
+		}
+		result.WriteString(renderedItem)
+	}
+	return renderBlockAttributes(l.Attributes, "") + result.String(), nil
+}
+
+func renderOrderedListItem(item types.OrderedListItem, draft bool) (string, error) {
+	content, err := renderListItemElements(item.Elements, draft)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render ordered list item")
+	}
+	return renderBlockAttributes(item.Attributes, "") + orderedListItemPrefix(item.Level, item.NumberingStyle) + " " + content, nil
+}
+
+// the numbering styles which are implied by the level of the ordered list items, when using the `.` prefix
+var defaultNumberingStyles = []types.NumberingStyle{types.Arabic, types.LowerAlpha, types.LowerRoman, types.UpperAlpha, types.UpperRoman}
+
+// the explicit prefixes of the ordered list items, by numbering style
+var explicitNumberingPrefixes = map[types.NumberingStyle]string{
+	types.Arabic:     "1.",
+	types.LowerAlpha: "a.",
+	types.UpperAlpha: "A.",
+	types.LowerRoman: "i)",
+	types.UpperRoman: "I)",
+}
+
+// orderedListItemPrefix returns the prefix of an ordered list item, ie, `.` to `.....` when the numbering style
+// is the default one for the given level, or an explicit prefix (eg: `a.`) otherwise
+func orderedListItemPrefix(level int, style types.NumberingStyle) string {
+	if level >= 1 && level <= len(defaultNumberingStyles) && defaultNumberingStyles[level-1] == style {
+		return strings.Repeat(".", level)
+	}
+	if prefix, ok := explicitNumberingPrefixes[style]; ok {
+		return prefix
+	}
+	return strings.Repeat(".", level)
+}
+
+func renderUnorderedList(l types.UnorderedList, draft bool) (string, error) {
+	result := strings.Builder{}
+	for i, item := range l.Items {
+		renderedItem, err := renderUnorderedListItem(item, draft)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render unordered list")
+		}
+		if i > 0 {
+			result.WriteString(itemSeparator(l.Items[i-1].Elements, draft))
+		}
+		result.WriteString(renderedItem)
+	}
+	return renderBlockAttributes(l.Attributes, "") + result.String(), nil
+}
+
+func renderUnorderedListItem(item types.UnorderedListItem, draft bool) (string, error) {
+	content, err := renderListItemElements(item.Elements, draft)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render unordered list item")
+	}
+	prefix := unorderedListItemPrefix(item.Level, item.BulletStyle)
+	switch item.CheckStyle {
+	case types.Checked:
+		prefix += " [x]"
+	case types.Unchecked:
+		prefix += " [ ]"
+	}
+	return renderBlockAttributes(item.Attributes, "") + prefix + " " + content, nil
+}
+
+// the prefixes of the unordered list items, by bullet style
+var bulletPrefixes = map[types.BulletStyle]string{
+	types.Dash:           "-",
+	types.OneAsterisk:    "*",
+	types.TwoAsterisks:   "**",
+	types.ThreeAsterisks: "***",
+	types.FourAsterisks:  "****",
+	types.FiveAsterisks:  "*****",
+}
+
+func unorderedListItemPrefix(level int, style types.BulletStyle) string {
+	if prefix, ok := bulletPrefixes[style]; ok {
+		return prefix
+	}
+	return strings.Repeat("*", level)
+}
+
+func renderLabeledList(l types.LabeledList, draft bool) (string, error) {
+	result := strings.Builder{}
+	for i, item := range l.Items {
+		renderedItem, err := renderLabeledListItem(item, draft)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render labeled list")
+		}
+		if i > 0 {
+			result.WriteString(itemSeparator(l.Items[i-1].Elements, draft))
+		}
+		result.WriteString(renderedItem)
+	}
+	return renderBlockAttributes(l.Attributes, "") + result.String(), nil
+}
+
+func renderLabeledListItem(item types.LabeledListItem, draft bool) (string, error) {
+	term, err := renderInlineElements(item.Term)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render labeled list item")
+	}
+	result := renderBlockAttributes(item.Attributes, "") + term + strings.Repeat(":", item.Level+1)
+	if len(item.Elements) == 0 {
+		return result, nil
+	}
+	content, err := renderListItemElements(item.Elements, draft)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render labeled list item")
+	}
+	if _, ok := item.Elements[0].(types.Paragraph); ok {
+		return result + " " + content, nil
+	}
+	// the content starts with a separator (eg: a nested list on the next line)
+	return result + content, nil
+}
+
+// itemSeparator returns the separator to write after a list item with the given elements: a blank line
+// if the last element was attached with a list continuation, since it would absorb the next item otherwise
+func itemSeparator(elements []interface{}, draft bool) string {
+	if !draft && len(elements) > 1 && !isList(elements[len(elements)-1]) {
+		return "\n\n"
+	}
+	return "\n"
+}
+
+// renderListItemElements renders the elements of a list item. In a draft document, these elements are
+// only the paragraphs and comments which immediately follow the item prefix. In a final document, they also
+// include the nested lists (rendered on the next line) and the other blocks, which need a list continuation (`+`)
+func renderListItemElements(elements []interface{}, draft bool) (string, error) {
+	result := strings.Builder{}
+	var previous interface{}
+	continued := false // whether the previous element was attached with a list continuation
+	for i, element := range elements {
+		if i == 0 {
+			if p, ok := element.(types.Paragraph); ok {
+				// the first paragraph is rendered on the same line as the item prefix, without attributes
+				content, err := renderLines(p.Lines)
+				if err != nil {
+					return "", errors.Wrap(err, "unable to render list item elements")
+				}
+				result.WriteString(content)
+				previous = element
+				continue
+			}
+		}
+		switch {
+		case draft:
+			result.WriteString("\n")
+		case isList(element) && continued:
+			// the nested list would be absorbed by the previous block otherwise
+			result.WriteString("\n\n")
+		case isList(element):
+			result.WriteString("\n")
+		case isList(previous):
+			// a blank line for each nested level, to attach the element to the current item
+			result.WriteString("\n" + strings.Repeat("\n", listDepth(previous)) + "+\n")
+		default:
+			result.WriteString("\n+\n")
+		}
+		continued = !isList(element)
+		renderedElement, err := renderElement(element, draft)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render list item elements")
+		}
+		result.WriteString(renderedElement)
+		previous = element
+	}
+	return result.String(), nil
+}
+
+// listDepth returns the number of nested lists which end with the last item of the given list (including itself)
+func listDepth(list interface{}) int {
+	var elements []interface{}
+	switch l := list.(type) {
+	case types.OrderedList:
+		elements = l.Items[len(l.Items)-1].Elements
+	case types.UnorderedList:
+		elements = l.Items[len(l.Items)-1].Elements
+	case types.LabeledList:
+		elements = l.Items[len(l.Items)-1].Elements
+	default:
+		return 0
+	}
+	if len(elements) == 0 {
+		return 1
+	}
+	return 1 + listDepth(elements[len(elements)-1])
+}
+
+func renderContinuedListItemElement(e types.ContinuedListItemElement, draft bool) (string, error) {
+	element, err := renderElement(e.Element, draft)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render continued list item element")
+	}
+	// the offset is the (negative) number of blank lines before the list continuation
+	return strings.Repeat("\n", -e.Offset) + "+\n" + element, nil
+}
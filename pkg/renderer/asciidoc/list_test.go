@@ -0,0 +1,157 @@
+package asciidoc_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lists", func() {
+
+	Context("unordered lists", func() {
+
+		It("nested unordered lists", func() {
+			source := `* item 1
+** item 1.1
+- item 1.1.1
+* item 2`
+			expected := `* item 1
+** item 1.1
+*** item 1.1.1
+* item 2
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("checklist", func() {
+			source := `* [x] done
+* [ ] todo`
+			expected := `* [x] done
+* [ ] todo
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+	})
+
+	Context("ordered lists", func() {
+
+		It("ordered lists with default and explicit numbering styles", func() {
+			source := `. item 1
+.. item 1.1
+. item 2
+
+[upperroman]
+. item I
+. item II`
+			expected := `. item 1
+.. item 1.1
+. item 2
+
+[upperroman]
+. item I
+. item II
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("ordered list with explicit prefixes", func() {
+			source := `a. item a
+b. item b`
+			expected := `a. item a
+a. item b
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+	})
+
+	Context("labeled lists", func() {
+
+		It("labeled lists", func() {
+			source := `term 1:: description 1
+term 2::: description 2
+
+[horizontal]
+term 3:: description 3`
+			expected := `term 1:: description 1
+term 2::: description 2
+
+[horizontal]
+term 3:: description 3
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("labeled list item without description in draft document", func() {
+			source := `term 1::
+term 2::
+
+[quote, john doe]
+a quote`
+			expected := `term 1::
+term 2::
+
+[quote, john doe]
+a quote
+`
+			Expect(source).To(RenderDraftAsciiDoc(expected))
+		})
+
+		It("labeled list with nested list", func() {
+			source := `term::
+* item 1
+* item 2`
+			expected := `term::
+* item 1
+* item 2
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+	})
+
+	Context("list continuations", func() {
+
+		It("list items with attached blocks", func() {
+			source := `* item 1
++
+----
+a listing
+----
+* item 2
+** item 2.1
+
++
+another paragraph`
+			expected := `* item 1
++
+----
+a listing
+----
+
+* item 2
+** item 2.1
+
++
+another paragraph
+`
+			Expect(source).To(RenderAsciiDoc(expected))
+		})
+
+		It("list items with attached blocks in draft document", func() {
+			source := `* item 1
++
+----
+a listing
+----
+* item 2`
+			expected := `* item 1
++
+----
+a listing
+----
+* item 2
+`
+			Expect(source).To(RenderDraftAsciiDoc(expected))
+		})
+	})
+})
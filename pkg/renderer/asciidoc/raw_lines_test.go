@@ -0,0 +1,96 @@
+package asciidoc_test
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/asciidoc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("raw lines", func() {
+
+	rawLines := func(source string) ([]string, error) {
+		doc, err := parser.ParseDocument("", strings.NewReader(source))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(doc.Elements).To(HaveLen(1))
+		return asciidoc.RawLines(doc.Elements[0])
+	}
+
+	draftRawLines := func(source string) ([]string, error) {
+		doc, err := parser.ParseDraftDocument("", strings.NewReader(source))
+		Expect(err).NotTo(HaveOccurred())
+		return asciidoc.RawLines(doc.Blocks[len(doc.Blocks)-1])
+	}
+
+	It("paragraph with quoted text", func() {
+		source := `[shout]
+hello *world*
+and _everyone_`
+		Expect(rawLines(source)).To(Equal([]string{
+			"hello *world*",
+			"and _everyone_",
+		}))
+	})
+
+	It("listing block", func() {
+		source := `[plantuml]
+----
+A -> B
+
+B -> C
+----`
+		Expect(rawLines(source)).To(Equal([]string{
+			"A -> B",
+			"",
+			"B -> C",
+		}))
+	})
+
+	It("literal block", func() {
+		source := `[mermaid]
+....
+graph TD
+  A --> B
+....`
+		Expect(rawLines(source)).To(Equal([]string{
+			"graph TD",
+			"  A --> B",
+		}))
+	})
+
+	It("empty listing block", func() {
+		source := `----
+----`
+		Expect(rawLines(source)).To(BeEmpty())
+	})
+
+	Context("draft document", func() {
+
+		It("paragraph with attribute references", func() {
+			source := `:foo: bar
+
+[shout]
+hello {foo}
+and {foo}`
+			Expect(draftRawLines(source)).To(Equal([]string{
+				"hello {foo}",
+				"and {foo}",
+			}))
+		})
+
+		It("listing block with attribute references", func() {
+			source := `:foo: bar
+
+[plantuml]
+----
+A -> {foo}
+----`
+			Expect(draftRawLines(source)).To(Equal([]string{
+				"A -> {foo}",
+			}))
+		})
+	})
+})
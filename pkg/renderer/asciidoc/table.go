@@ -0,0 +1,45 @@
+package asciidoc
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// renderTable renders the given table, with a line per row. The header row, if any,
+// is followed by a blank line (otherwise, the first row would be treated as the header)
+func renderTable(t types.Table) (string, error) {
+	lines := []string{}
+	if len(t.Header.Cells) > 0 {
+		header, err := renderTableLine(t.Header)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render table header")
+		}
+		lines = append(lines, header, "")
+	}
+	for _, line := range t.Lines {
+		l, err := renderTableLine(line)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render table line")
+		}
+		lines = append(lines, l)
+	}
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return renderDelimitedContent(renderBlockAttributes(t.Attributes, ""), "|===", strings.Join(lines, "\n")), nil
+}
+
+// renderTableLine renders the cells of the given line, each one being prefixed with `| `
+func renderTableLine(l types.TableLine) (string, error) {
+	result := strings.Builder{}
+	for _, cell := range l.Cells {
+		content, err := renderInlineElements(cell)
+		if err != nil {
+			return "", err
+		}
+		result.WriteString("| " + content)
+	}
+	return result.String(), nil
+}
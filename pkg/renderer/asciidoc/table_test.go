@@ -0,0 +1,50 @@
+package asciidoc_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tables", func() {
+
+	It("table with header", func() {
+		source := `.a table
+|===
+|Column 1 |Column 2
+
+|*a* |b
+|c |d
+|===`
+		expected := `.a table
+|===
+| Column 1 | Column 2
+
+| *a* | b
+| c | d
+|===
+`
+		Expect(source).To(RenderAsciiDoc(expected))
+	})
+
+	It("table without header", func() {
+		source := `|===
+|a |b
+|===`
+		expected := `|===
+| a | b
+|===
+`
+		Expect(source).To(RenderAsciiDoc(expected))
+	})
+
+	It("empty table", func() {
+		source := `|===
+|===`
+		expected := `|===
+|===
+`
+		Expect(source).To(RenderAsciiDoc(expected))
+	})
+})
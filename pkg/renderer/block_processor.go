@@ -0,0 +1,29 @@
+package renderer
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// BlockProcessor a function which processes the raw lines of a delimited block or a paragraph with a given style
+// (eg: the `A -> B` line of a `[plantuml]` listing block), along with its attributes, and which returns the elements
+// to render instead of the block
+type BlockProcessor func(ctx *Context, lines []string, attrs types.ElementAttributes) ([]interface{}, error)
+
+// DefineBlockProcessor defines the given processor for the delimited blocks and paragraphs with the given style
+// (eg: `plantuml` for the `[plantuml]` blocks)
+func DefineBlockProcessor(style string, p BlockProcessor) Option {
+	return func(ctx *Context) {
+		ctx.blockProcessors[style] = p
+	}
+}
+
+// BlockProcessor finds and returns the block processor defined for the given style
+func (ctx *Context) BlockProcessor(style string) (BlockProcessor, bool) {
+	p, found := ctx.blockProcessors[style]
+	return p, found
+}
+
+// HasBlockProcessors returns `true` if at least one block processor was defined
+func (ctx *Context) HasBlockProcessors() bool {
+	return len(ctx.blockProcessors) > 0
+}
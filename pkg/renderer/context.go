@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"io"
+	"reflect"
+	texttemplate "text/template"
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -18,19 +20,26 @@ type MacroTemplate interface {
 // Context is a custom implementation of the standard golang context.Context interface,
 // which carries the types.Document which is being processed
 type Context struct {
-	context  context.Context
-	Document types.Document
-	options  map[string]interface{}
-	macros   map[string]MacroTemplate
+	context         context.Context
+	Document        types.Document
+	options         map[string]interface{}
+	macros          map[string]MacroTemplate
+	hooks           map[reflect.Type]RenderHook
+	treeProcessors  []TreeProcessor
+	blockProcessors map[string]BlockProcessor
+	macroProcessors map[macroProcessorKey]MacroProcessor
 }
 
 // Wrap wraps the given `ctx` context into a new context which will contain the given `document` document.
 func Wrap(ctx context.Context, document types.Document, options ...Option) *Context {
 	result := &Context{
-		context:  ctx,
-		Document: document,
-		options:  make(map[string]interface{}),
-		macros:   make(map[string]MacroTemplate),
+		context:         ctx,
+		Document:        document,
+		options:         make(map[string]interface{}),
+		macros:          make(map[string]MacroTemplate),
+		hooks:           make(map[reflect.Type]RenderHook),
+		blockProcessors: make(map[string]BlockProcessor),
+		macroProcessors: make(map[macroProcessorKey]MacroProcessor),
 	}
 	for _, option := range options {
 		option(result)
@@ -154,6 +163,45 @@ func (ctx *Context) getAndIncrementCounter(counter string) int {
 	return 1
 }
 
+// StemNotation resolves the given `stem` notation using the `stem` document attribute (AsciiMath by default)
+func (ctx *Context) StemNotation(notation types.StemNotation) types.StemNotation {
+	if notation != types.StemDefault {
+		return notation
+	}
+	switch n, _ := ctx.Document.Attributes.GetAsString(types.AttrStem); n {
+	case "latexmath", "latex", "tex":
+		return types.LaTeXMath
+	default:
+		return types.AsciiMath
+	}
+}
+
+// WithDocument returns a new context for the given `document`, with the same options and user macros
+// as this context, overridden by the given `options`.
+func (ctx *Context) WithDocument(document types.Document, options ...Option) *Context {
+	result := Wrap(ctx.context, document)
+	for k, v := range ctx.options {
+		result.options[k] = v
+	}
+	for k, v := range ctx.macros {
+		result.macros[k] = v
+	}
+	for k, v := range ctx.hooks {
+		result.hooks[k] = v
+	}
+	result.treeProcessors = append(result.treeProcessors, ctx.treeProcessors...)
+	for k, v := range ctx.blockProcessors {
+		result.blockProcessors[k] = v
+	}
+	for k, v := range ctx.macroProcessors {
+		result.macroProcessors[k] = v
+	}
+	for _, option := range options {
+		option(result)
+	}
+	return result
+}
+
 // MacroTemplate finds and returns a user macro function by specified name.
 func (ctx *Context) MacroTemplate(name string) (MacroTemplate, error) {
 	macro, ok := ctx.macros[name]
@@ -163,6 +211,26 @@ func (ctx *Context) MacroTemplate(name string) (MacroTemplate, error) {
 	return nil, errors.New("unknown user macro: " + name)
 }
 
+const overrideTemplates string = "overrideTemplates"
+
+// SetOverrideTemplates sets the templates which override the default templates of the backend, by kind of element
+func (ctx *Context) SetOverrideTemplates(templates map[string]*texttemplate.Template) {
+	ctx.options[overrideTemplates] = templates
+}
+
+// OverrideTemplates returns the templates which override the default templates of the backend, by kind of element,
+// or `false` if they were not set
+func (ctx *Context) OverrideTemplates() (map[string]*texttemplate.Template, bool) {
+	templates, found := ctx.options[overrideTemplates].(map[string]*texttemplate.Template)
+	return templates, found
+}
+
+// RenderHook finds and returns the render hook defined for the type of the given element
+func (ctx *Context) RenderHook(element interface{}) (RenderHook, bool) {
+	hook, found := ctx.hooks[reflect.TypeOf(element)]
+	return hook, found
+}
+
 // -----------------------
 // context.Context methods
 // -----------------------
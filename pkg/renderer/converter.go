@@ -0,0 +1,79 @@
+package renderer
+
+import (
+	"io"
+	"sort"
+	"sync"
+)
+
+// Converter renders the documents with a given backend
+type Converter interface {
+	// Convert renders the document of the given context and writes the result in the given `output`.
+	// Returns the document metadata (title, etc.)
+	Convert(ctx *Context, output io.Writer) (map[string]interface{}, error)
+	// BaseBackend returns the family of output formats of the backend (eg: `html` for the `html5` backend)
+	BaseBackend() string
+	// OutFileSuffix returns the extension of the files produced by the backend, including the leading dot (eg: `.html`)
+	OutFileSuffix() string
+}
+
+// ConvertFunc a function which renders the document of the given context and writes the result in the given `output`
+type ConvertFunc func(ctx *Context, output io.Writer) (map[string]interface{}, error)
+
+// NewConverter returns a converter which renders the documents with the given function
+func NewConverter(convert ConvertFunc, basebackend, outfilesuffix string) Converter {
+	return converter{
+		convert:       convert,
+		basebackend:   basebackend,
+		outfilesuffix: outfilesuffix,
+	}
+}
+
+type converter struct {
+	convert       ConvertFunc
+	basebackend   string
+	outfilesuffix string
+}
+
+func (c converter) Convert(ctx *Context, output io.Writer) (map[string]interface{}, error) {
+	return c.convert(ctx, output)
+}
+
+func (c converter) BaseBackend() string {
+	return c.basebackend
+}
+
+func (c converter) OutFileSuffix() string {
+	return c.outfilesuffix
+}
+
+var converters = map[string]Converter{}
+var convertersMutex sync.RWMutex
+
+// RegisterConverter registers the given converter for the given backend (eg: `html5`),
+// replacing the converter which was previously registered for the same backend, if any
+func RegisterConverter(backend string, c Converter) {
+	convertersMutex.Lock()
+	defer convertersMutex.Unlock()
+	converters[backend] = c
+}
+
+// LookupConverter returns the converter registered for the given backend, or `false` if there is none
+func LookupConverter(backend string) (Converter, bool) {
+	convertersMutex.RLock()
+	defer convertersMutex.RUnlock()
+	c, found := converters[backend]
+	return c, found
+}
+
+// Backends returns the names of all the registered backends, in alphabetical order
+func Backends() []string {
+	convertersMutex.RLock()
+	defer convertersMutex.RUnlock()
+	result := make([]string, 0, len(converters))
+	for backend := range converters {
+		result = append(result, backend)
+	}
+	sort.Strings(result)
+	return result
+}
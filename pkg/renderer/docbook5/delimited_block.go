@@ -0,0 +1,248 @@
+package docbook5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var listingTmpl texttemplate.Template
+var exampleBlockTmpl texttemplate.Template
+var admonitionBlockTmpl texttemplate.Template
+var quoteBlockTmpl texttemplate.Template
+var verseBlockTmpl texttemplate.Template
+var sidebarBlockTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	listingTmpl = renderer.NewTextTemplate("listing", `{{ with .Data }}{{ if .Title }}<formalpara{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>
+<title>{{ escape .Title }}</title>
+<para>
+{{ end }}<{{ .Element }}{{ if and .ID (not .Title) }} xml:id="{{ .ID }}"{{ end }}{{ if .Language }} language="{{ .Language }}" linenumbering="unnumbered"{{ end }}>{{ .Content }}</{{ .Element }}>{{ if .Title }}
+</para>
+</formalpara>{{ end }}{{ end }}`,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+
+	exampleBlockTmpl = renderer.NewTextTemplate("example block", `{{ $ctx := .Context }}{{ with .Data }}{{ if .Title }}<example{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>
+<title>{{ escape .Title }}</title>
+{{ renderElements $ctx .Elements | printf "%s" }}
+</example>{{ else }}<informalexample{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>
+{{ renderElements $ctx .Elements | printf "%s" }}
+</informalexample>{{ end }}{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElements": renderElements,
+			"escape":         EscapeString,
+		})
+
+	admonitionBlockTmpl = renderer.NewTextTemplate("admonition block", `{{ $ctx := .Context }}{{ with .Data }}<{{ .Kind }}{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>{{ if .Title }}
+<title>{{ escape .Title }}</title>{{ end }}
+{{ renderElements $ctx .Elements | printf "%s" }}
+</{{ .Kind }}>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElements": renderElements,
+			"escape":         EscapeString,
+		})
+
+	quoteBlockTmpl = renderer.NewTextTemplate("quote block", `{{ $ctx := .Context }}{{ with .Data }}<blockquote{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>{{ if .Title }}
+<title>{{ escape .Title }}</title>{{ end }}{{ template "attribution" .Attribution }}
+{{ renderElements $ctx .Elements | printf "%s" }}
+</blockquote>{{ end }}`+attributionTmpl,
+		texttemplate.FuncMap{
+			"renderElements": renderElements,
+			"escape":         EscapeString,
+		})
+
+	verseBlockTmpl = renderer.NewTextTemplate("verse block", `{{ with .Data }}<blockquote{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>{{ if .Title }}
+<title>{{ escape .Title }}</title>{{ end }}{{ template "attribution" .Attribution }}
+<literallayout>{{ .Content }}</literallayout>
+</blockquote>{{ end }}`+attributionTmpl,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+
+	sidebarBlockTmpl = renderer.NewTextTemplate("sidebar block", `{{ $ctx := .Context }}{{ with .Data }}<sidebar{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>{{ if .Title }}
+<title>{{ escape .Title }}</title>{{ end }}
+{{ renderElements $ctx .Elements | printf "%s" }}
+</sidebar>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElements": renderElements,
+			"escape":         EscapeString,
+		})
+}
+
+func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	switch b.Kind {
+	case types.Fenced, types.Source:
+		return renderListingBlock(ctx, b, "programlisting")
+	case types.Listing:
+		return renderListingBlock(ctx, b, "screen")
+	case types.Example:
+		return renderExampleBlock(ctx, b)
+	case types.Quote:
+		return renderQuoteBlock(ctx, b)
+	case types.Verse:
+		return renderVerseBlock(ctx, b)
+	case types.Sidebar:
+		return renderSidebarBlock(ctx, b)
+	default:
+		return nil, errors.Errorf("unable to render delimited block of kind '%v'", b.Kind)
+	}
+}
+
+func renderListingBlock(ctx *renderer.Context, b types.DelimitedBlock, element string) ([]byte, error) {
+	previouslyWithin := ctx.SetWithinDelimitedBlock(true)
+	previouslyInclude := ctx.SetIncludeBlankLine(true)
+	defer func() {
+		ctx.SetWithinDelimitedBlock(previouslyWithin)
+		ctx.SetIncludeBlankLine(previouslyInclude)
+	}()
+	content, err := renderPlainText(ctx, discardTrailingBlankLines(b.Elements))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render listing block")
+	}
+	return renderListing(ctx, b.Attributes, element, string(content))
+}
+
+// renderListing renders the given content in a `<programlisting>` or a `<screen>` element,
+// wrapped in a `<formalpara>` element if the block has a title
+func renderListing(ctx *renderer.Context, attrs types.ElementAttributes, element string, content string) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := listingTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID       string
+			Title    string
+			Element  string
+			Language string
+			Content  string
+		}{
+			ID:       renderElementID(attrs),
+			Title:    renderTitle(attrs),
+			Element:  element,
+			Language: attrs.GetAsString(types.AttrLanguage),
+			Content:  content,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render listing")
+	}
+	return result.Bytes(), nil
+}
+
+func renderExampleBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		err := admonitionBlockTmpl.Execute(result, renderer.ContextualPipeline{
+			Context: ctx,
+			Data: struct {
+				ID       string
+				Title    string
+				Kind     string
+				Elements []interface{}
+			}{
+				ID:       renderElementID(b.Attributes),
+				Title:    renderTitle(b.Attributes),
+				Kind:     string(k),
+				Elements: discardTrailingBlankLines(b.Elements),
+			},
+		})
+		return result.Bytes(), err
+	}
+	// default, example block (numbered by the DocBook toolchain)
+	err := exampleBlockTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID       string
+			Title    string
+			Elements []interface{}
+		}{
+			ID:       renderElementID(b.Attributes),
+			Title:    renderTitle(b.Attributes),
+			Elements: discardTrailingBlankLines(b.Elements),
+		},
+	})
+	return result.Bytes(), err
+}
+
+func renderQuoteBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := quoteBlockTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID          string
+			Title       string
+			Attribution attribution
+			Elements    []interface{}
+		}{
+			ID:          renderElementID(b.Attributes),
+			Title:       renderTitle(b.Attributes),
+			Attribution: newAttribution(b.Attributes),
+			Elements:    discardTrailingBlankLines(b.Elements),
+		},
+	})
+	return result.Bytes(), err
+}
+
+func renderVerseBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	previouslyInclude := ctx.SetIncludeBlankLine(true)
+	defer ctx.SetIncludeBlankLine(previouslyInclude)
+	content := bytes.NewBuffer(nil)
+	for _, element := range discardTrailingBlankLines(b.Elements) {
+		switch e := element.(type) {
+		case types.Paragraph:
+			renderedLines, err := renderLines(ctx, e.Lines, false)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render verse block")
+			}
+			content.Write(renderedLines)
+		case types.BlankLine:
+			renderedBlankLine, err := renderBlankLine(ctx, e)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to render verse block")
+			}
+			content.Write(renderedBlankLine)
+		default:
+			return nil, errors.Errorf("unexpected type of element to include in verse block: %T", element)
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	err := verseBlockTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID          string
+			Title       string
+			Attribution attribution
+			Content     string
+		}{
+			ID:          renderElementID(b.Attributes),
+			Title:       renderTitle(b.Attributes),
+			Attribution: newAttribution(b.Attributes),
+			Content:     content.String(),
+		},
+	})
+	return result.Bytes(), err
+}
+
+func renderSidebarBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := sidebarBlockTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID       string
+			Title    string
+			Elements []interface{}
+		}{
+			ID:       renderElementID(b.Attributes),
+			Title:    renderTitle(b.Attributes),
+			Elements: discardTrailingBlankLines(b.Elements),
+		},
+	})
+	return result.Bytes(), err
+}
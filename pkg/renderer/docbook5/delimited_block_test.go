@@ -0,0 +1,132 @@
+package docbook5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("delimited blocks", func() {
+
+	It("source block with language", func() {
+		source := `[source,go]
+----
+func main() {
+  a < b
+}
+----`
+		expected := `<programlisting language="go" linenumbering="unnumbered">func main() {
+  a &lt; b
+}</programlisting>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("listing block with title", func() {
+		source := `.a title
+----
+some listing
+----`
+		expected := `<formalpara>
+<title>a title</title>
+<para>
+<screen>some listing</screen>
+</para>
+</formalpara>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("literal blocks", func() {
+		source := `....
+some literal
+....
+
+  indented
+   literal`
+		expected := `<literallayout class="monospaced">some literal</literallayout>
+<literallayout class="monospaced">indented
+ literal</literallayout>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("example blocks", func() {
+		source := `====
+an example
+====
+
+.a title
+====
+another example
+====`
+		expected := `<informalexample>
+<simpara>an example</simpara>
+</informalexample>
+<example>
+<title>a title</title>
+<simpara>another example</simpara>
+</example>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("admonition block", func() {
+		source := `[WARNING]
+====
+a warning
+====`
+		expected := `<warning>
+<simpara>a warning</simpara>
+</warning>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("quote and verse blocks", func() {
+		source := `[quote, Author, Source]
+____
+some quote
+____
+
+[verse, Poet]
+____
+first line
+second line
+____`
+		expected := `<blockquote>
+<attribution>
+Author
+<citetitle>Source</citetitle>
+</attribution>
+<simpara>some quote</simpara>
+</blockquote>
+<blockquote>
+<attribution>
+Poet
+</attribution>
+<literallayout>first line
+second line</literallayout>
+</blockquote>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("sidebar block", func() {
+		source := `.a title
+****
+some content
+****`
+		expected := `<sidebar>
+<title>a title</title>
+<simpara>some content</simpara>
+</sidebar>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("stem block", func() {
+		source := `[stem]
+++++
+sqrt(4) = 2
+++++`
+		expected := `<informalequation>
+<mathphrase><![CDATA[sqrt(4) = 2]]></mathphrase>
+</informalequation>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+})
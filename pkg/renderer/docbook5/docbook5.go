@@ -0,0 +1,181 @@
+// Package docbook5 renders the documents in the DocBook 5 format
+package docbook5
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Render renders the given document in DocBook 5 and writes the result in the given `writer`
+func Render(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	return renderDocument(ctx, output)
+}
+
+func renderElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	log.Debugf("rendering %d elements(s)...", len(elements))
+	buff := bytes.NewBuffer(nil)
+	hasContent := false
+	for _, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render an element")
+		}
+		// insert new line if there's already some content (except for BlankLine)
+		_, isBlankline := element.(types.BlankLine)
+		if !isBlankline && hasContent && len(renderedElement) > 0 {
+			buff.WriteString("\n")
+		}
+		buff.Write(renderedElement)
+		if len(renderedElement) > 0 {
+			hasContent = true
+		}
+	}
+	return buff.Bytes(), nil
+}
+
+// nolint: gocyclo
+func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
+	switch e := element.(type) {
+	case []interface{}:
+		return renderElements(ctx, e)
+	case types.TableOfContentsMacro:
+		// the table of contents is generated by the DocBook toolchain
+		return []byte{}, nil
+	case types.Section:
+		return renderSection(ctx, e)
+	case types.Preamble:
+		return renderElements(ctx, e.Elements)
+	case types.BlankLine:
+		return renderBlankLine(ctx, e)
+	case types.LabeledList:
+		return renderLabeledList(ctx, e)
+	case types.OrderedList:
+		return renderOrderedList(ctx, e)
+	case types.UnorderedList:
+		return renderUnorderedList(ctx, e)
+	case types.Paragraph:
+		return renderParagraph(ctx, e)
+	case types.InternalCrossReference:
+		return renderInternalCrossReference(ctx, e)
+	case types.ExternalCrossReference:
+		return renderExternalCrossReference(ctx, e)
+	case types.QuotedText:
+		return renderQuotedText(ctx, e)
+	case types.Passthrough:
+		return renderPassthrough(ctx, e)
+	case types.ImageBlock:
+		return renderImageBlock(ctx, e)
+	case types.InlineImage:
+		return renderInlineImage(ctx, e)
+	case types.VideoBlock:
+		return renderVideoBlock(ctx, e)
+	case types.AudioBlock:
+		return renderAudioBlock(ctx, e)
+	case types.DelimitedBlock:
+		return renderDelimitedBlock(ctx, e)
+	case types.Table:
+		return renderTable(ctx, e)
+	case types.LiteralBlock:
+		return renderLiteralBlock(ctx, e)
+	case types.InlineLink:
+		return renderLink(ctx, e)
+	case types.StringElement:
+		return renderStringElement(ctx, e)
+	case types.Footnote:
+		return renderFootnote(ctx, e)
+	case types.LineBreak:
+		return []byte("<?asciidoc-br?>"), nil
+	case types.UserMacro:
+		return renderUserMacro(ctx, e)
+	case types.InlineStem:
+		return renderInlineStem(ctx, e)
+	case types.InlineIcon:
+		return renderInlineIcon(ctx, e)
+	case types.StemBlock:
+		return renderStemBlock(ctx, e)
+	default:
+		return nil, errors.Errorf("unsupported type of element: %T", element)
+	}
+}
+
+// renderPlainText renders the given element without any markup (but with the XML special characters escaped)
+func renderPlainText(ctx *renderer.Context, element interface{}) ([]byte, error) {
+	switch element := element.(type) {
+	case []interface{}:
+		buff := bytes.NewBuffer(nil)
+		for _, e := range element {
+			renderedElement, err := renderPlainText(ctx, e)
+			if err != nil {
+				return nil, err
+			}
+			buff.Write(renderedElement)
+		}
+		return buff.Bytes(), nil
+	case [][]interface{}:
+		lines := make([]string, len(element))
+		for i, l := range element {
+			renderedLine, err := renderPlainText(ctx, l)
+			if err != nil {
+				return nil, err
+			}
+			lines[i] = string(renderedLine)
+		}
+		return []byte(strings.Join(lines, "\n")), nil
+	case types.QuotedText:
+		return renderPlainText(ctx, element.Elements)
+	case types.InlineImage:
+		return []byte(EscapeString(element.Attributes.GetAsString(types.AttrImageAlt))), nil
+	case types.InlineLink:
+		if alt, ok := element.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+			return renderPlainText(ctx, alt)
+		}
+		return []byte(EscapeString(element.Location.String())), nil
+	case types.BlankLine:
+		return []byte("\n\n"), nil
+	case types.StringElement:
+		return []byte(EscapeString(element.Content)), nil
+	case types.InlineStem:
+		return []byte(EscapeString(element.Content)), nil
+	case types.InlineIcon:
+		return []byte("[" + EscapeString(element.Name) + "]"), nil
+	case types.Paragraph:
+		return renderPlainText(ctx, element.Lines)
+	default:
+		return nil, errors.Errorf("unable to render plain string for element of type '%T'", element)
+	}
+}
+
+func renderBlankLine(ctx *renderer.Context, l types.BlankLine) ([]byte, error) { //nolint:unparam
+	if ctx.IncludeBlankLine() {
+		return []byte("\n\n"), nil
+	}
+	return []byte{}, nil
+}
+
+func renderElementID(attrs types.ElementAttributes) string {
+	if id, ok := attrs[types.AttrID].(string); ok {
+		return id
+	}
+	return ""
+}
+
+func renderTitle(attrs types.ElementAttributes) string {
+	return strings.TrimSpace(attrs.GetAsString(types.AttrTitle))
+}
+
+func discardTrailingBlankLines(elements []interface{}) []interface{} {
+	result := elements
+	for len(result) > 0 {
+		if _, ok := result[len(result)-1].(types.BlankLine); !ok {
+			break
+		}
+		result = result[:len(result)-1]
+	}
+	return result
+}
@@ -0,0 +1,151 @@
+package docbook5
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var documentTmpl texttemplate.Template
+
+func init() {
+	documentTmpl = renderer.NewTextTemplate("root document",
+		`<?xml version="1.0" encoding="UTF-8"?>
+<article xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="{{ .Lang }}">
+<info>{{ if .Title }}
+<title>{{ .Title }}</title>{{ end }}
+<date>{{ .Date }}</date>{{ if eq (len .Authors) 1 }}
+{{ template "author" index .Authors 0 }}{{ else if .Authors }}
+<authorgroup>{{ range .Authors }}
+{{ template "author" . }}{{ end }}
+</authorgroup>{{ end }}{{ if .Revision.Revnumber }}
+<revhistory>
+<revision>
+<revnumber>{{ escape .Revision.Revnumber }}</revnumber>{{ if .Revision.Revdate }}
+<date>{{ escape .Revision.Revdate }}</date>{{ end }}{{ if .Revision.Revremark }}
+<revremark>{{ escape .Revision.Revremark }}</revremark>{{ end }}
+</revision>
+</revhistory>{{ end }}
+</info>{{ if .Content }}
+{{ .Content }}{{ end }}
+</article>{{ define "author" }}<author>
+<personname>{{ if .FirstName }}
+<firstname>{{ escape .FirstName }}</firstname>{{ end }}{{ if .OtherName }}
+<othername>{{ escape .OtherName }}</othername>{{ end }}{{ if .Surname }}
+<surname>{{ escape .Surname }}</surname>{{ end }}
+</personname>{{ if .Email }}
+<email>{{ escape .Email }}</email>{{ end }}
+</author>{{ end }}`,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+}
+
+// author the name and email of an author, split in the parts expected by DocBook
+type author struct {
+	FirstName string
+	OtherName string
+	Surname   string
+	Email     string
+}
+
+func newAuthor(a types.DocumentAuthor) author {
+	result := author{
+		Email: a.Email,
+	}
+	names := strings.Fields(a.FullName)
+	switch len(names) {
+	case 0:
+	case 1:
+		result.FirstName = names[0]
+	default:
+		result.FirstName = names[0]
+		result.OtherName = strings.Join(names[1:len(names)-1], " ")
+		result.Surname = names[len(names)-1]
+	}
+	return result
+}
+
+// renderDocument renders the whole document, including the `<article>` root element and the `<info>` element if needed
+func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	renderedTitle, err := renderDocumentTitle(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render full document")
+	}
+	renderedElements, err := renderDocumentElements(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render full document")
+	}
+	if ctx.IncludeHeaderFooter() {
+		log.Debugf("Rendering full document...")
+		authors := []author{}
+		if docAuthors, found := ctx.Document.Authors(); found {
+			for _, a := range docAuthors {
+				authors = append(authors, newAuthor(a))
+			}
+		}
+		revision, _ := ctx.Document.Revision()
+		err = documentTmpl.Execute(output, struct {
+			Lang     string
+			Title    string
+			Date     string
+			Authors  []author
+			Revision types.DocumentRevision
+			Content  string
+		}{
+			Lang:     ctx.Document.Attributes.GetAsStringWithDefault("lang", "en"),
+			Title:    string(renderedTitle),
+			Date:     ctx.LastUpdated(),
+			Authors:  authors,
+			Revision: revision,
+			Content:  string(renderedElements),
+		})
+	} else {
+		_, err = output.Write(renderedElements)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render full document")
+	}
+	// copy all document attributes, and override the title with its rendered value instead of the `types.Section` struct
+	metadata := ctx.Document.Attributes
+	if documentTitle, hasTitle := ctx.Document.Title(); hasTitle {
+		plainTitle, err := renderPlainText(ctx, documentTitle)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render full document")
+		}
+		metadata[types.AttrTitle] = strings.TrimSpace(string(plainTitle))
+	}
+	metadata["LastUpdated"] = ctx.LastUpdated()
+	return metadata, nil
+}
+
+// renderDocumentElements renders all document elements (the sections of the top-level section
+// and the elements which follow it), but not the `<article>` and `<info>` elements
+func renderDocumentElements(ctx *renderer.Context) ([]byte, error) {
+	elements := ctx.Document.Elements
+	if header, found := ctx.Document.Header(); found {
+		elements = append(append([]interface{}{}, header.Elements...), elements[1:]...)
+	}
+	result, err := renderElements(ctx, elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to render document elements")
+	}
+	return result, nil
+}
+
+func renderDocumentTitle(ctx *renderer.Context) ([]byte, error) {
+	if documentTitle, hasTitle := ctx.Document.Title(); hasTitle {
+		title, err := renderInlineElements(ctx, documentTitle)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render document title")
+		}
+		return bytes.TrimSpace(title), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,101 @@
+package docbook5_test
+
+import (
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("documents", func() {
+
+	It("document with header and sections", func() {
+		source := `= Document Title
+John Foo Doe <john@example.com>
+v1.0, 2020-01-01: first draft
+
+== Section A
+
+content
+
+=== Section A.1
+
+more content`
+		expected := `<?xml version="1.0" encoding="UTF-8"?>
+<article xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="en">
+<info>
+<title>Document Title</title>
+<date>{{.LastUpdated}}</date>
+<author>
+<personname>
+<firstname>John</firstname>
+<othername>Foo</othername>
+<surname>Doe</surname>
+</personname>
+<email>john@example.com</email>
+</author>
+<revhistory>
+<revision>
+<revnumber>1.0</revnumber>
+<date>2020-01-01</date>
+<revremark>first draft</revremark>
+</revision>
+</revhistory>
+</info>
+<section xml:id="_section_a">
+<title>Section A</title>
+<simpara>content</simpara>
+<section xml:id="_section_a_1">
+<title>Section A.1</title>
+<simpara>more content</simpara>
+</section>
+</section>
+</article>`
+		Expect(source).To(RenderDocBook5Body(expected, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now())))
+	})
+
+	It("document with multiple authors and a language", func() {
+		source := `= Document Title
+John Doe; Jane Doe
+:lang: fr
+
+content`
+		expected := `<?xml version="1.0" encoding="UTF-8"?>
+<article xmlns="http://docbook.org/ns/docbook" xmlns:xl="http://www.w3.org/1999/xlink" version="5.0" xml:lang="fr">
+<info>
+<title>Document Title</title>
+<date>{{.LastUpdated}}</date>
+<authorgroup>
+<author>
+<personname>
+<firstname>John</firstname>
+<surname>Doe</surname>
+</personname>
+</author>
+<author>
+<personname>
+<firstname>Jane</firstname>
+<surname>Doe</surname>
+</personname>
+</author>
+</authorgroup>
+</info>
+<simpara>content</simpara>
+</article>`
+		Expect(source).To(RenderDocBook5Body(expected, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now())))
+	})
+
+	It("document without header", func() {
+		source := `== Section A
+
+content`
+		expected := `<section xml:id="_section_a">
+<title>Section A</title>
+<simpara>content</simpara>
+</section>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+})
@@ -0,0 +1,251 @@
+package docbook5
+
+import (
+	"bytes"
+	"path/filepath"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var linkTmpl texttemplate.Template
+var internalCrossReferenceTmpl texttemplate.Template
+var footnoteTmpl texttemplate.Template
+var inlineIconTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	linkTmpl = renderer.NewTextTemplate("link", `<link xl:href="{{ .URL }}">{{ .Text }}</link>`)
+	internalCrossReferenceTmpl = renderer.NewTextTemplate("internal cross reference", `{{ if .Label }}<link linkend="{{ .ID }}">{{ .Label }}</link>{{ else }}<xref linkend="{{ .ID }}"/>{{ end }}`)
+	footnoteTmpl = renderer.NewTextTemplate("footnote", `<footnote{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>
+<simpara>{{ .Content }}</simpara>
+</footnote>`)
+	inlineIconTmpl = renderer.NewTextTemplate("inline icon", `<inlinemediaobject>
+<imageobject>
+<imagedata fileref="{{ .Path }}"/>
+</imageobject>
+<textobject><phrase>{{ .Alt }}</phrase></textobject>
+</inlinemediaobject>`)
+}
+
+func renderInlineElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	for i, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render line")
+		}
+		if _, ok := element.(types.StringElement); ok && i == len(elements)-1 {
+			// trim trailing spaces before returning the line
+			renderedElement = bytes.TrimRight(renderedElement, " ")
+		}
+		buf.Write(renderedElement)
+	}
+	return buf.Bytes(), nil
+}
+
+// renderLines renders all lines and includes an `\n` character in-between, until the last one.
+func renderLines(ctx *renderer.Context, lines [][]interface{}, hardbreaks bool) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	for i, line := range lines {
+		renderedLine, err := renderInlineElements(ctx, line)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render lines")
+		}
+		buf.Write(renderedLine)
+		if i < len(lines)-1 && (len(renderedLine) > 0 || ctx.WithinDelimitedBlock()) {
+			if hardbreaks {
+				buf.WriteString("<?asciidoc-br?>")
+			}
+			buf.WriteString("\n")
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func renderStringElement(ctx *renderer.Context, str types.StringElement) ([]byte, error) { //nolint: unparam
+	return []byte(strings.Replace(EscapeString(str.Content), "...", "&#8230;&#8203;", -1)), nil
+}
+
+func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error) {
+	content, err := renderInlineElements(ctx, t.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quoted text")
+	}
+	var start, end string
+	switch t.Kind {
+	case types.Bold:
+		start, end = `<emphasis role="strong">`, "</emphasis>"
+	case types.Italic:
+		start, end = "<emphasis>", "</emphasis>"
+	case types.Monospace:
+		start, end = "<literal>", "</literal>"
+	case types.Subscript:
+		start, end = "<subscript>", "</subscript>"
+	case types.Superscript:
+		start, end = "<superscript>", "</superscript>"
+	default:
+		return nil, errors.Errorf("unsupported quoted text kind: '%v'", t.Kind)
+	}
+	return []byte(start + string(content) + end), nil
+}
+
+func renderPassthrough(ctx *renderer.Context, p types.Passthrough) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	for _, element := range p.Elements {
+		switch element := element.(type) {
+		case types.StringElement:
+			if p.Kind == types.SinglePlusPassthrough {
+				// content is escaped, but not substituted
+				buf.WriteString(EscapeString(element.Content))
+			} else {
+				// content is rendered as-is
+				buf.WriteString(element.Content)
+			}
+		default:
+			renderedElement, err := renderElement(ctx, element)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render passthrough")
+			}
+			buf.Write(renderedElement)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func renderLink(ctx *renderer.Context, l types.InlineLink) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	location := EscapeString(l.Location.String())
+	text := []byte(location)
+	if t, ok := l.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+		var err error
+		text, err = renderInlineElements(ctx, t)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render link")
+		}
+	}
+	err := linkTmpl.Execute(result, struct {
+		URL  string
+		Text string
+	}{
+		URL:  location,
+		Text: string(text),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render link")
+	}
+	return result.Bytes(), nil
+}
+
+func renderInternalCrossReference(ctx *renderer.Context, xref types.InternalCrossReference) ([]byte, error) {
+	log.Debugf("rendering cross reference with ID: %s", xref.ID)
+	result := bytes.NewBuffer(nil)
+	// without label, the DocBook toolchain uses the title of the target element
+	err := internalCrossReferenceTmpl.Execute(result, struct {
+		ID    string
+		Label string
+	}{
+		ID:    xref.ID,
+		Label: EscapeString(xref.Label),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render internal cross reference")
+	}
+	return result.Bytes(), nil
+}
+
+func renderExternalCrossReference(ctx *renderer.Context, xref types.ExternalCrossReference) ([]byte, error) {
+	label, err := renderInlineElements(ctx, xref.Label)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render external cross reference")
+	}
+	// the target document is expected to be converted into DocBook, too
+	loc := xref.Location.String()
+	loc = loc[:len(loc)-len(filepath.Ext(loc))] + ".xml"
+	result := bytes.NewBuffer(nil)
+	err = linkTmpl.Execute(result, struct {
+		URL  string
+		Text string
+	}{
+		URL:  EscapeString(loc),
+		Text: string(label),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render external cross reference")
+	}
+	return result.Bytes(), nil
+}
+
+// renderFootnote renders the footnote in place (as expected by DocBook), with an ID if
+// it is referenced elsewhere in the document. Subsequent references to the same footnote are
+// rendered as `<footnoteref>` elements
+func renderFootnote(ctx *renderer.Context, note types.Footnote) ([]byte, error) {
+	noteRef, hasRef := ctx.Document.FootnoteReferences[note.Ref]
+	id, found := ctx.Document.Footnotes.IndexOf(note)
+	switch {
+	case found:
+		content, err := renderInlineElements(ctx, note.Elements)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render footnote")
+		}
+		footnoteID := ""
+		if hasRef {
+			footnoteID = footnoteDefID(id)
+		}
+		result := bytes.NewBuffer(nil)
+		err = footnoteTmpl.Execute(result, struct {
+			ID      string
+			Content string
+		}{
+			ID:      footnoteID,
+			Content: string(bytes.TrimSpace(content)),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render footnote")
+		}
+		return result.Bytes(), nil
+	case hasRef:
+		// the ID of the referenced footnote is its position in the document, not its sequence number
+		refID, _ := ctx.Document.Footnotes.IndexOf(noteRef)
+		return []byte(`<footnoteref linkend="` + footnoteDefID(refID) + `"/>`), nil
+	default:
+		// invalid footnote
+		return []byte("[" + EscapeString(note.Ref) + "]"), nil
+	}
+}
+
+func footnoteDefID(id int) string {
+	return "_footnotedef_" + strconv.Itoa(id+1)
+}
+
+func renderInlineIcon(ctx *renderer.Context, icon types.InlineIcon) ([]byte, error) {
+	alt := icon.Attributes.GetAsString(types.AttrImageAlt)
+	if alt == "" {
+		alt = icon.Name
+	}
+	if icons, found := ctx.Document.Attributes.GetAsString(types.AttrIcons); !found || icons == "font" {
+		// font icons are not supported in DocBook, so they are rendered as text
+		return []byte("[" + EscapeString(alt) + "]"), nil
+	}
+	iconsdir, found := ctx.Document.Attributes.GetAsString("iconsdir")
+	if !found {
+		iconsdir = ctx.Document.Attributes.GetAsStringWithDefault("imagesdir", "./images") + "/icons"
+	}
+	result := bytes.NewBuffer(nil)
+	err := inlineIconTmpl.Execute(result, struct {
+		Path string
+		Alt  string
+	}{
+		Path: EscapeString(iconsdir + "/" + icon.Name + "." + ctx.Document.Attributes.GetAsStringWithDefault("icontype", "png")),
+		Alt:  EscapeString(alt),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render inline icon")
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,167 @@
+package docbook5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var orderedListTmpl texttemplate.Template
+var unorderedListTmpl texttemplate.Template
+var labeledListTmpl texttemplate.Template
+var qandaLabeledListTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	orderedListTmpl = renderer.NewTextTemplate("ordered list",
+		`{{ $ctx := .Context }}{{ with .Data }}<orderedlist{{ if .ID }} xml:id="{{ .ID }}"{{ end }}{{ if .Role }} role="{{ .Role }}"{{ end }}{{ if .Numeration }} numeration="{{ .Numeration }}"{{ end }}{{ if .Start }} startingnumber="{{ .Start }}"{{ end }}>{{ if .Title }}
+<title>{{ escape .Title }}</title>{{ end }}{{ range .Items }}
+<listitem>
+{{ renderElements $ctx .Elements | printf "%s" }}
+</listitem>{{ end }}
+</orderedlist>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElements": renderElements,
+			"escape":         EscapeString,
+		})
+
+	unorderedListTmpl = renderer.NewTextTemplate("unordered list",
+		`{{ $ctx := .Context }}{{ with .Data }}<itemizedlist{{ if .ID }} xml:id="{{ .ID }}"{{ end }}{{ if .Role }} role="{{ .Role }}"{{ end }}>{{ if .Title }}
+<title>{{ escape .Title }}</title>{{ end }}{{ range .Items }}
+<listitem>
+{{ renderElements $ctx .Elements | printf "%s" }}
+</listitem>{{ end }}
+</itemizedlist>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElements": renderElements,
+			"escape":         EscapeString,
+		})
+
+	labeledListTmpl = renderer.NewTextTemplate("labeled list",
+		`{{ $ctx := .Context }}{{ with .Data }}<variablelist{{ if .ID }} xml:id="{{ .ID }}"{{ end }}{{ if .Role }} role="{{ .Role }}"{{ end }}>{{ if .Title }}
+<title>{{ escape .Title }}</title>{{ end }}{{ range .Items }}
+<varlistentry>
+<term>{{ renderInlineElements $ctx .Term | printf "%s" }}</term>
+<listitem>{{ if .Elements }}
+{{ renderElements $ctx .Elements | printf "%s" }}{{ end }}
+</listitem>
+</varlistentry>{{ end }}
+</variablelist>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderInlineElements": renderInlineElements,
+			"renderElements":       renderElements,
+			"escape":               EscapeString,
+		})
+
+	qandaLabeledListTmpl = renderer.NewTextTemplate("qanda labeled list",
+		`{{ $ctx := .Context }}{{ with .Data }}<qandaset{{ if .ID }} xml:id="{{ .ID }}"{{ end }}{{ if .Role }} role="{{ .Role }}"{{ end }}>{{ if .Title }}
+<title>{{ escape .Title }}</title>{{ end }}{{ range .Items }}
+<qandaentry>
+<question>
+<simpara>{{ renderInlineElements $ctx .Term | printf "%s" }}</simpara>
+</question>
+<answer>{{ if .Elements }}
+{{ renderElements $ctx .Elements | printf "%s" }}{{ end }}
+</answer>
+</qandaentry>{{ end }}
+</qandaset>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderInlineElements": renderInlineElements,
+			"renderElements":       renderElements,
+			"escape":               EscapeString,
+		})
+}
+
+func renderOrderedList(ctx *renderer.Context, l types.OrderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := orderedListTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID         string
+			Title      string
+			Role       string
+			Numeration string
+			Start      string
+			Items      []types.OrderedListItem
+		}{
+			ID:         renderElementID(l.Attributes),
+			Title:      renderTitle(l.Attributes),
+			Role:       l.Attributes.GetAsString(types.AttrRole),
+			Numeration: getNumeration(l),
+			Start:      l.Attributes.GetAsString(types.AttrStart),
+			Items:      l.Items,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render ordered list")
+	}
+	return result.Bytes(), nil
+}
+
+// getNumeration returns the DocBook numeration of the given list, or an empty string
+// if the numbering style of the list has no equivalent in DocBook
+func getNumeration(l types.OrderedList) string {
+	style := types.NumberingStyle(l.Attributes.GetAsString(types.AttrNumberingStyle))
+	if style == "" && len(l.Items) > 0 {
+		style = l.Items[0].NumberingStyle
+	}
+	switch style {
+	case types.Arabic, types.Decimal:
+		return string(types.Arabic)
+	case types.LowerAlpha, types.UpperAlpha, types.LowerRoman, types.UpperRoman:
+		return string(style)
+	default:
+		return ""
+	}
+}
+
+func renderUnorderedList(ctx *renderer.Context, l types.UnorderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := unorderedListTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID    string
+			Title string
+			Role  string
+			Items []types.UnorderedListItem
+		}{
+			ID:    renderElementID(l.Attributes),
+			Title: renderTitle(l.Attributes),
+			Role:  l.Attributes.GetAsString(types.AttrRole),
+			Items: l.Items,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render unordered list")
+	}
+	return result.Bytes(), nil
+}
+
+func renderLabeledList(ctx *renderer.Context, l types.LabeledList) ([]byte, error) {
+	tmpl := labeledListTmpl
+	if l.Attributes.Has(types.AttrQandA) {
+		tmpl = qandaLabeledListTmpl
+	}
+	result := bytes.NewBuffer(nil)
+	err := tmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID    string
+			Title string
+			Role  string
+			Items []types.LabeledListItem
+		}{
+			ID:    renderElementID(l.Attributes),
+			Title: renderTitle(l.Attributes),
+			Role:  l.Attributes.GetAsString(types.AttrRole),
+			Items: l.Items,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render labeled list")
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,98 @@
+package docbook5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lists", func() {
+
+	It("unordered list with nested list", func() {
+		source := `.a title
+* item 1
+** nested item
+* item 2`
+		expected := `<itemizedlist>
+<title>a title</title>
+<listitem>
+<simpara>item 1</simpara>
+<itemizedlist>
+<listitem>
+<simpara>nested item</simpara>
+</listitem>
+</itemizedlist>
+</listitem>
+<listitem>
+<simpara>item 2</simpara>
+</listitem>
+</itemizedlist>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("ordered list with numbering style and start", func() {
+		source := `[loweralpha, start=3]
+. item c
+. item d`
+		expected := `<orderedlist numeration="loweralpha" startingnumber="3">
+<listitem>
+<simpara>item c</simpara>
+</listitem>
+<listitem>
+<simpara>item d</simpara>
+</listitem>
+</orderedlist>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("checklist", func() {
+		source := `* [x] done
+* [ ] todo`
+		expected := `<itemizedlist>
+<listitem>
+<simpara>&#10003; done</simpara>
+</listitem>
+<listitem>
+<simpara>&#10063; todo</simpara>
+</listitem>
+</itemizedlist>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("labeled list", func() {
+		source := `term 1:: definition 1
+term 2::`
+		expected := `<variablelist>
+<varlistentry>
+<term>term 1</term>
+<listitem>
+<simpara>definition 1</simpara>
+</listitem>
+</varlistentry>
+<varlistentry>
+<term>term 2</term>
+<listitem>
+</listitem>
+</varlistentry>
+</variablelist>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("q and a list", func() {
+		source := `[qanda]
+What is libasciidoc?::
+	An implementation of the AsciiDoc processor in Golang.`
+		expected := `<qandaset>
+<qandaentry>
+<question>
+<simpara>What is libasciidoc?</simpara>
+</question>
+<answer>
+<simpara>An implementation of the AsciiDoc processor in Golang.</simpara>
+</answer>
+</qandaentry>
+</qandaset>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+})
@@ -0,0 +1,48 @@
+package docbook5
+
+import (
+	"bytes"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var literalBlockTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	literalBlockTmpl = renderer.NewTextTemplate("literal block", `{{ with .Data }}{{ if .Title }}<formalpara{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>
+<title>{{ escape .Title }}</title>
+<para>
+{{ end }}<literallayout{{ if and .ID (not .Title) }} xml:id="{{ .ID }}"{{ end }} class="monospaced">{{ escape .Content }}</literallayout>{{ if .Title }}
+</para>
+</formalpara>{{ end }}{{ end }}`, texttemplate.FuncMap{
+		"escape": EscapeString,
+	})
+}
+
+func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock) ([]byte, error) {
+	lines := b.Lines
+	if b.Attributes.GetAsString(types.AttrLiteralBlockType) == types.LiteralBlockWithSpacesOnFirstLine {
+		lines = renderer.TrimCommonIndentation(b.Lines)
+	}
+	result := bytes.NewBuffer(nil)
+	err := literalBlockTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID      string
+			Title   string
+			Content string
+		}{
+			ID:      renderElementID(b.Attributes),
+			Title:   renderTitle(b.Attributes),
+			Content: strings.Join(lines, "\n"),
+		}})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render literal block")
+	}
+	return result.Bytes(), nil
+}
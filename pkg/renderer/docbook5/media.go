@@ -0,0 +1,133 @@
+package docbook5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var blockImageTmpl texttemplate.Template
+var inlineImageTmpl texttemplate.Template
+var videoBlockTmpl texttemplate.Template
+var audioBlockTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	// the opening and closing elements of a block image, video or audio, which are `<figure>` if the block
+	// has a title, or `<informalfigure>` otherwise
+	figureStart := `{{ if .Title }}<figure{{ if .ID }} xml:id="{{ .ID }}"{{ end }}{{ if .Role }} role="{{ .Role }}"{{ end }}>
+<title>{{ escape .Title }}</title>{{ else }}<informalfigure{{ if .ID }} xml:id="{{ .ID }}"{{ end }}{{ if .Role }} role="{{ .Role }}"{{ end }}>{{ end }}
+`
+	figureEnd := `
+{{ if .Title }}</figure>{{ else }}</informalfigure>{{ end }}`
+	imagedata := `<imagedata fileref="{{ .Path }}"{{ if .Width }} contentwidth="{{ .Width }}"{{ end }}{{ if .Height }} contentdepth="{{ .Height }}"{{ end }}{{ if .Align }} align="{{ .Align }}"{{ end }}/>`
+	blockImageTmpl = renderer.NewTextTemplate("block image", figureStart+`<mediaobject>
+<imageobject>
+`+imagedata+`
+</imageobject>
+<textobject><phrase>{{ escape .Alt }}</phrase></textobject>
+</mediaobject>`+figureEnd,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+	inlineImageTmpl = renderer.NewTextTemplate("inline image", `<inlinemediaobject>
+<imageobject>
+`+imagedata+`
+</imageobject>
+<textobject><phrase>{{ escape .Alt }}</phrase></textobject>
+</inlinemediaobject>`,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+	videoBlockTmpl = renderer.NewTextTemplate("video block", figureStart+`<mediaobject>
+<videoobject>
+<videodata fileref="{{ .Path }}"{{ if .Width }} contentwidth="{{ .Width }}"{{ end }}{{ if .Height }} contentdepth="{{ .Height }}"{{ end }}/>
+</videoobject>
+</mediaobject>`+figureEnd,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+	audioBlockTmpl = renderer.NewTextTemplate("audio block", figureStart+`<mediaobject>
+<audioobject>
+<audiodata fileref="{{ .Path }}"/>
+</audioobject>
+</mediaobject>`+figureEnd,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+}
+
+// mediaData the data of an image, a video or an audio
+type mediaData struct {
+	ID     string
+	Title  string
+	Role   string
+	Path   string
+	Alt    string
+	Width  string
+	Height string
+	Align  string
+}
+
+func newMediaData(attrs types.ElementAttributes, location types.Location) mediaData {
+	return mediaData{
+		Role:   attrs.GetAsString(types.AttrRole),
+		Path:   EscapeString(location.String()),
+		Alt:    attrs.GetAsString(types.AttrImageAlt),
+		Width:  attrs.GetAsString(types.AttrImageWidth),
+		Height: attrs.GetAsString(types.AttrImageHeight),
+	}
+}
+
+func renderImageBlock(ctx *renderer.Context, img types.ImageBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	data := newMediaData(img.Attributes, img.Location)
+	data.ID = renderElementID(img.Attributes)
+	// the figure is numbered by the DocBook toolchain, so its caption is ignored
+	data.Title = renderTitle(img.Attributes)
+	data.Align = img.Attributes.GetAsString(types.AttrImageAlign)
+	err := blockImageTmpl.Execute(result, data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render block image")
+	}
+	return result.Bytes(), nil
+}
+
+func renderInlineImage(ctx *renderer.Context, img types.InlineImage) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := inlineImageTmpl.Execute(result, newMediaData(img.Attributes, img.Location))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render inline image")
+	}
+	return result.Bytes(), nil
+}
+
+func renderVideoBlock(ctx *renderer.Context, v types.VideoBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	data := newMediaData(v.Attributes, v.Location)
+	data.ID = renderElementID(v.Attributes)
+	data.Title = renderTitle(v.Attributes)
+	if v.Provider() != "" {
+		data.Path = EscapeString(v.URL())
+	}
+	err := videoBlockTmpl.Execute(result, data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render video block")
+	}
+	return result.Bytes(), nil
+}
+
+func renderAudioBlock(ctx *renderer.Context, a types.AudioBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	data := newMediaData(a.Attributes, a.Location)
+	data.ID = renderElementID(a.Attributes)
+	data.Title = renderTitle(a.Attributes)
+	err := audioBlockTmpl.Execute(result, data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render audio block")
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,86 @@
+package docbook5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("images, videos and audios", func() {
+
+	It("block image with alt and dimensions", func() {
+		source := `image::foo.png[alt text,100,200]`
+		expected := `<informalfigure>
+<mediaobject>
+<imageobject>
+<imagedata fileref="foo.png" contentwidth="100" contentdepth="200"/>
+</imageobject>
+<textobject><phrase>alt text</phrase></textobject>
+</mediaobject>
+</informalfigure>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("block image with title", func() {
+		source := `[#img-foo]
+.a title
+image::foo.png[]`
+		expected := `<figure xml:id="img-foo">
+<title>a title</title>
+<mediaobject>
+<imageobject>
+<imagedata fileref="foo.png"/>
+</imageobject>
+<textobject><phrase>foo</phrase></textobject>
+</mediaobject>
+</figure>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("inline image and stem", func() {
+		source := `an image:foo.png[inline] and stem:[x^2]`
+		expected := `<simpara>an <inlinemediaobject>
+<imageobject>
+<imagedata fileref="foo.png"/>
+</imageobject>
+<textobject><phrase>inline</phrase></textobject>
+</inlinemediaobject> and <inlineequation><mathphrase><![CDATA[x^2]]></mathphrase></inlineequation></simpara>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("local and youtube videos", func() {
+		source := `video::video.mp4[]
+
+video::abcd[youtube]`
+		expected := `<informalfigure>
+<mediaobject>
+<videoobject>
+<videodata fileref="video.mp4"/>
+</videoobject>
+</mediaobject>
+</informalfigure>
+<informalfigure>
+<mediaobject>
+<videoobject>
+<videodata fileref="https://www.youtube.com/watch?v=abcd"/>
+</videoobject>
+</mediaobject>
+</informalfigure>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("audio", func() {
+		source := `.a title
+audio::song.mp3[]`
+		expected := `<figure>
+<title>a title</title>
+<mediaobject>
+<audioobject>
+<audiodata fileref="song.mp3"/>
+</audioobject>
+</mediaobject>
+</figure>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+})
@@ -0,0 +1,214 @@
+package docbook5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var paragraphTmpl texttemplate.Template
+var admonitionParagraphTmpl texttemplate.Template
+var verseParagraphTmpl texttemplate.Template
+var quoteParagraphTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	paragraphTmpl = renderer.NewTextTemplate("paragraph",
+		`{{ with .Data }}{{ if .Title }}<formalpara{{ if .ID }} xml:id="{{ .ID }}"{{ end }}{{ if .Role }} role="{{ .Role }}"{{ end }}>
+<title>{{ escape .Title }}</title>
+<para>{{ .Content }}</para>
+</formalpara>{{ else }}<simpara{{ if .ID }} xml:id="{{ .ID }}"{{ end }}{{ if .Role }} role="{{ .Role }}"{{ end }}>{{ .Content }}</simpara>{{ end }}{{ end }}`,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+	admonitionParagraphTmpl = renderer.NewTextTemplate("admonition paragraph",
+		`{{ with .Data }}<{{ .Kind }}{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>{{ if .Title }}
+<title>{{ escape .Title }}</title>{{ end }}
+<simpara>{{ .Content }}</simpara>
+</{{ .Kind }}>{{ end }}`,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+	verseParagraphTmpl = renderer.NewTextTemplate("verse paragraph",
+		`{{ with .Data }}<blockquote{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>{{ if .Title }}
+<title>{{ escape .Title }}</title>{{ end }}{{ template "attribution" .Attribution }}
+<literallayout>{{ .Content }}</literallayout>
+</blockquote>{{ end }}`+attributionTmpl,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+	quoteParagraphTmpl = renderer.NewTextTemplate("quote paragraph",
+		`{{ with .Data }}<blockquote{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>{{ if .Title }}
+<title>{{ escape .Title }}</title>{{ end }}{{ template "attribution" .Attribution }}
+<simpara>{{ .Content }}</simpara>
+</blockquote>{{ end }}`+attributionTmpl,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+}
+
+// attributionTmpl the template of the attribution of the quote and verse blocks
+const attributionTmpl = `{{ define "attribution" }}{{ if .Author }}
+<attribution>
+{{ escape .Author }}{{ if .Title }}
+<citetitle>{{ escape .Title }}</citetitle>{{ end }}
+</attribution>{{ else if .Title }}
+<attribution>
+<citetitle>{{ escape .Title }}</citetitle>
+</attribution>{{ end }}{{ end }}`
+
+// attribution the author and title of a quote or a verse
+type attribution struct {
+	Author string
+	Title  string
+}
+
+func newAttribution(attrs types.ElementAttributes) attribution {
+	return attribution{
+		Author: attrs.GetAsString(types.AttrQuoteAuthor),
+		Title:  attrs.GetAsString(types.AttrQuoteTitle),
+	}
+}
+
+func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	if _, ok := p.Attributes[types.AttrAdmonitionKind]; ok {
+		return renderAdmonitionParagraph(ctx, p)
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source:
+		return renderSourceParagraph(ctx, p)
+	case types.Verse:
+		return renderVerseParagraph(ctx, p)
+	case types.Quote:
+		return renderQuoteParagraph(ctx, p)
+	}
+	log.Debug("rendering a standalone paragraph")
+	content, err := renderLines(ctx, p.Lines, p.Attributes.Has(types.AttrHardBreaks) || ctx.Document.Attributes.Has(types.DocumentAttrHardBreaks))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render paragraph")
+	}
+	result := bytes.NewBuffer(nil)
+	err = paragraphTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID      string
+			Title   string
+			Role    string
+			Content string
+		}{
+			ID:      renderElementID(p.Attributes),
+			Title:   renderTitle(p.Attributes),
+			Role:    p.Attributes.GetAsString(types.AttrRole),
+			Content: renderCheckStyle(p.Attributes[types.AttrCheckStyle]) + string(content),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render paragraph")
+	}
+	return result.Bytes(), nil
+}
+
+func renderAdmonitionParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind)
+	if !ok {
+		return nil, errors.Errorf("failed to render admonition with unknown kind: %T", p.Attributes[types.AttrAdmonitionKind])
+	}
+	content, err := renderLines(ctx, p.Lines, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render admonition paragraph")
+	}
+	result := bytes.NewBuffer(nil)
+	err = admonitionParagraphTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID      string
+			Title   string
+			Kind    string
+			Content string
+		}{
+			ID:      renderElementID(p.Attributes),
+			Title:   renderTitle(p.Attributes),
+			Kind:    string(k),
+			Content: string(content),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render admonition paragraph")
+	}
+	return result.Bytes(), nil
+}
+
+func renderSourceParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	content, err := renderPlainText(ctx, p.Lines)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render source paragraph")
+	}
+	return renderListing(ctx, p.Attributes, "programlisting", string(content))
+}
+
+func renderVerseParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	content, err := renderLines(ctx, p.Lines, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render verse paragraph")
+	}
+	result := bytes.NewBuffer(nil)
+	err = verseParagraphTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID          string
+			Title       string
+			Attribution attribution
+			Content     string
+		}{
+			ID:          renderElementID(p.Attributes),
+			Title:       renderTitle(p.Attributes),
+			Attribution: newAttribution(p.Attributes),
+			Content:     string(content),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render verse paragraph")
+	}
+	return result.Bytes(), nil
+}
+
+func renderQuoteParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	content, err := renderLines(ctx, p.Lines, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quote paragraph")
+	}
+	result := bytes.NewBuffer(nil)
+	err = quoteParagraphTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID          string
+			Title       string
+			Attribution attribution
+			Content     string
+		}{
+			ID:          renderElementID(p.Attributes),
+			Title:       renderTitle(p.Attributes),
+			Attribution: newAttribution(p.Attributes),
+			Content:     string(content),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quote paragraph")
+	}
+	return result.Bytes(), nil
+}
+
+func renderCheckStyle(style interface{}) string {
+	switch style {
+	case types.Unchecked:
+		return "&#10063; "
+	case types.Checked:
+		return "&#10003; "
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,70 @@
+package docbook5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("paragraphs", func() {
+
+	It("paragraph with quoted text and special characters", func() {
+		source := `a *bold*, _italic_ and ` + "`monospace`" + ` content with <tags> & entities...`
+		expected := `<simpara>a <emphasis role="strong">bold</emphasis>, <emphasis>italic</emphasis> and <literal>monospace</literal> content with &lt;tags&gt; &amp; entities&#8230;&#8203;</simpara>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("paragraph with ID, title and hard breaks", func() {
+		source := `[[anchor]]
+.a title
+first line +
+second line`
+		expected := `<formalpara xml:id="anchor">
+<title>a title</title>
+<para>first line<?asciidoc-br?>
+second line</para>
+</formalpara>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("admonition paragraph", func() {
+		source := `.a title
+NOTE: a note`
+		expected := `<note>
+<title>a title</title>
+<simpara>a note</simpara>
+</note>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("quote paragraph", func() {
+		source := `[quote, Author, Source]
+some quote`
+		expected := `<blockquote>
+<attribution>
+Author
+<citetitle>Source</citetitle>
+</attribution>
+<simpara>some quote</simpara>
+</blockquote>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("paragraph with links, cross references and footnotes", func() {
+		source := `[[anchor]]
+see https://foo.com[Foo], <<anchor>>, <<anchor,here>> and xref:other.adoc[other doc]footnote:[a note].`
+		expected := `<simpara xml:id="anchor">see <link xl:href="https://foo.com">Foo</link>, <xref linkend="anchor"/>, <link linkend="anchor">here</link> and <link xl:href="other.xml">other doc</link><footnote>
+<simpara>a note</simpara>
+</footnote>.</simpara>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("paragraph with a referenced footnote", func() {
+		source := `first footnoteref:[disclaimer, a disclaimer] and second footnoteref:[disclaimer].`
+		expected := `<simpara>first <footnote xml:id="_footnotedef_1">
+<simpara>a disclaimer</simpara>
+</footnote> and second <footnoteref linkend="_footnotedef_1"/>.</simpara>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+})
@@ -0,0 +1,49 @@
+package docbook5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var sectionTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	sectionTmpl = renderer.NewTextTemplate("section",
+		`{{ $ctx := .Context }}{{ with .Data }}<section{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>
+<title>{{ .Title }}</title>{{ $elements := renderElements $ctx .Elements | printf "%s" }}{{ if $elements }}
+{{ $elements }}{{ end }}
+</section>{{ end }}`,
+		texttemplate.FuncMap{
+			"renderElements": renderElements,
+		})
+}
+
+func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
+	log.Debugf("rendering section level %d", s.Level)
+	title, err := renderInlineElements(ctx, s.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering section")
+	}
+	result := bytes.NewBuffer(nil)
+	err = sectionTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID       string
+			Title    string
+			Elements []interface{}
+		}{
+			ID:       renderElementID(s.Attributes),
+			Title:    string(bytes.TrimSpace(title)),
+			Elements: s.Elements,
+		}})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while rendering section")
+	}
+	return result.Bytes(), nil
+}
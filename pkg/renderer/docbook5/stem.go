@@ -0,0 +1,48 @@
+package docbook5
+
+import (
+	"bytes"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var stemBlockTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	stemBlockTmpl = renderer.NewTextTemplate("stem block", `{{ with .Data }}{{ if .Title }}<equation{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>
+<title>{{ escape .Title }}</title>{{ else }}<informalequation{{ if .ID }} xml:id="{{ .ID }}"{{ end }}>{{ end }}
+<mathphrase><![CDATA[{{ .Content }}]]></mathphrase>
+{{ if .Title }}</equation>{{ else }}</informalequation>{{ end }}{{ end }}`, texttemplate.FuncMap{
+		"escape": EscapeString,
+	})
+}
+
+// the STEM expressions are rendered as-is, in a CDATA section, and left to the DocBook toolchain
+
+func renderInlineStem(ctx *renderer.Context, s types.InlineStem) ([]byte, error) {
+	return []byte("<inlineequation><mathphrase><![CDATA[" + s.Content + "]]></mathphrase></inlineequation>"), nil
+}
+
+func renderStemBlock(ctx *renderer.Context, b types.StemBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := stemBlockTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID      string
+			Title   string
+			Content string
+		}{
+			ID:      renderElementID(b.Attributes),
+			Title:   renderTitle(b.Attributes),
+			Content: strings.Join(b.Lines, "\n"),
+		}})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render stem block")
+	}
+	return result.Bytes(), nil
+}
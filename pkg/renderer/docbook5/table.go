@@ -0,0 +1,68 @@
+package docbook5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var tableTmpl texttemplate.Template
+
+func init() {
+	tableTmpl = renderer.NewTextTemplate("table", `{{ $ctx := .Context }}{{ with .Data }}{{ if .Title }}<table{{ if .ID }} xml:id="{{ .ID }}"{{ end }} frame="all" rowsep="1" colsep="1">
+<title>{{ escape .Title }}</title>{{ else }}<informaltable{{ if .ID }} xml:id="{{ .ID }}"{{ end }} frame="all" rowsep="1" colsep="1">{{ end }}{{ if .Columns }}
+<tgroup cols="{{ len .Columns }}">{{ range $index, $column := .Columns }}
+<colspec colname="col_{{ inc $index }}" colwidth="1*"/>{{ end }}{{ if .Header.Cells }}
+<thead>
+<row>{{ range .Header.Cells }}
+<entry align="left" valign="top">{{ renderInlineElements $ctx . | printf "%s" }}</entry>{{ end }}
+</row>
+</thead>{{ end }}
+<tbody>{{ range .Lines }}
+<row>{{ range .Cells }}
+<entry align="left" valign="top"><simpara>{{ renderInlineElements $ctx . | printf "%s" }}</simpara></entry>{{ end }}
+</row>{{ end }}
+</tbody>
+</tgroup>{{ end }}
+{{ if .Title }}</table>{{ else }}</informaltable>{{ end }}{{ end }}`,
+		texttemplate.FuncMap{
+			"renderInlineElements": renderInlineElements,
+			"inc":                  func(i int) int { return i + 1 },
+			"escape":               EscapeString,
+		})
+}
+
+func renderTable(ctx *renderer.Context, t types.Table) ([]byte, error) {
+	// the number of columns is given by the header or by the first line
+	var columns [][]interface{}
+	if len(t.Header.Cells) > 0 {
+		columns = t.Header.Cells
+	} else if len(t.Lines) > 0 {
+		columns = t.Lines[0].Cells
+	}
+	result := bytes.NewBuffer(nil)
+	// the table is numbered by the DocBook toolchain
+	err := tableTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID      string
+			Title   string
+			Columns [][]interface{}
+			Header  types.TableLine
+			Lines   []types.TableLine
+		}{
+			ID:      renderElementID(t.Attributes),
+			Title:   renderTitle(t.Attributes),
+			Columns: columns,
+			Header:  t.Header,
+			Lines:   t.Lines,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to render table")
+	}
+	return result.Bytes(), nil
+}
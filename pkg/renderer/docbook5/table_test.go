@@ -0,0 +1,57 @@
+package docbook5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tables", func() {
+
+	It("table with title and header", func() {
+		source := `.a title
+|===
+| A | *B*
+
+| 1 | 2
+|===`
+		expected := `<table frame="all" rowsep="1" colsep="1">
+<title>a title</title>
+<tgroup cols="2">
+<colspec colname="col_1" colwidth="1*"/>
+<colspec colname="col_2" colwidth="1*"/>
+<thead>
+<row>
+<entry align="left" valign="top">A</entry>
+<entry align="left" valign="top"><emphasis role="strong">B</emphasis></entry>
+</row>
+</thead>
+<tbody>
+<row>
+<entry align="left" valign="top"><simpara>1</simpara></entry>
+<entry align="left" valign="top"><simpara>2</simpara></entry>
+</row>
+</tbody>
+</tgroup>
+</table>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+
+	It("informal table", func() {
+		source := `|===
+| 1
+|===`
+		expected := `<informaltable frame="all" rowsep="1" colsep="1">
+<tgroup cols="1">
+<colspec colname="col_1" colwidth="1*"/>
+<tbody>
+<row>
+<entry align="left" valign="top"><simpara>1</simpara></entry>
+</row>
+</tbody>
+</tgroup>
+</informaltable>`
+		Expect(source).To(RenderDocBook5Body(expected))
+	})
+})
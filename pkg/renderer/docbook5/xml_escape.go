@@ -0,0 +1,23 @@
+package docbook5
+
+import (
+	"strings"
+)
+
+// EscapeString escapes the XML special characters of the given string,
+// but keeps the character references and the predefined XML entities as-is
+func EscapeString(s string) string {
+	return xmlEscaper.Replace(s)
+}
+
+var xmlEscaper = strings.NewReplacer(
+	`&lt;`, "&lt;", // keep as-is (we do not want `&amp;lt;`)
+	`&gt;`, "&gt;", // keep as-is (we do not want `&amp;gt;`)
+	`&amp;`, "&amp;", // keep as-is (we do not want `&amp;amp;`)
+	`&#`, "&#", // assume this is for an character reference and this keep as-is
+	// standard escape combinations
+	`&`, "&amp;",
+	`<`, "&lt;",
+	`>`, "&gt;",
+	`"`, "&#34;",
+)
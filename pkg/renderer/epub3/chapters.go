@@ -0,0 +1,271 @@
+package epub3
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	htmlrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var chapterTmpl texttemplate.Template
+
+func init() {
+	chapterTmpl = renderer.NewTextTemplate("chapter", `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="{{ .Language }}" lang="{{ .Language }}">
+<head>
+<meta charset="UTF-8"/>
+<title>{{ escape .Title }}</title>
+<link rel="stylesheet" type="text/css" href="`+stylesheetFilename+`"/>
+</head>
+<body>
+<section epub:type="{{ .Kind }}">{{ if .Heading }}
+{{ .Heading }}{{ end }}{{ if .Content }}
+{{ .Content }}{{ end }}
+</section>
+</body>
+</html>
+`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+}
+
+const (
+	titlePage = "titlepage"
+	part      = "part"
+	chapter   = "chapter"
+)
+
+// chapterContent a content document of the publication
+type chapterContent struct {
+	Filename string
+	Kind     string
+	// the ID of the section from which this content document was built (empty for the title page)
+	ID       string
+	Title    string
+	Elements []interface{}
+	// whether this content document is a chapter within a part
+	InPart bool
+	// the final XHTML content
+	Content []byte
+	// the IDs of the elements in this content document, used to resolve the cross references
+	// between content documents
+	IDs map[string]bool
+}
+
+// splitChapters splits the document in content documents: a title page with the document header
+// and preamble, a page per part (level 0 section) and a page per chapter (level 1 section)
+func splitChapters(ctx *renderer.Context) []*chapterContent {
+	elements := ctx.Document.Elements
+	if header, found := ctx.Document.Header(); found {
+		elements = append(append([]interface{}{}, header.Elements...), ctx.Document.Elements[1:]...)
+	}
+	result := []*chapterContent{}
+	front := []interface{}{}
+	newChapter := func(kind string, s types.Section, elements []interface{}, inPart bool) {
+		result = append(result, &chapterContent{
+			Filename: fmt.Sprintf("chapter%02d.xhtml", len(result)),
+			Kind:     kind,
+			ID:       s.Attributes.GetAsString(types.AttrID),
+			Title:    strings.TrimSpace(renderer.PlainText(s.Title)),
+			Elements: elements,
+			InPart:   inPart,
+		})
+	}
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.TableOfContentsMacro:
+			// the navigation document replaces the table of contents
+			continue
+		case types.Section:
+			if e.Level > 0 {
+				newChapter(chapter, e, []interface{}{e}, false)
+				continue
+			}
+			// a part, with its own page for its title and introduction, followed by its chapters
+			intro := []interface{}{}
+			chapters := []types.Section{}
+			for _, pe := range e.Elements {
+				if s, ok := pe.(types.Section); ok {
+					chapters = append(chapters, s)
+					continue
+				}
+				intro = append(intro, pe)
+			}
+			newChapter(part, e, intro, false)
+			for _, s := range chapters {
+				newChapter(chapter, s, []interface{}{s}, true)
+			}
+		default:
+			if len(result) == 0 {
+				front = append(front, e)
+			} else {
+				log.Warnf("skipping element of type '%T' after the first chapter of the publication", e)
+			}
+		}
+	}
+	title, hasTitle := ctx.Document.Title()
+	if hasTitle || len(front) > 0 {
+		result = append([]*chapterContent{
+			{
+				Filename: "titlepage.xhtml",
+				Kind:     titlePage,
+				Title:    strings.TrimSpace(renderer.PlainText(title)),
+				Elements: front,
+			},
+		}, result...)
+	}
+	return result
+}
+
+// renderChapters renders all the content documents of the publication
+func renderChapters(ctx *renderer.Context, p publication) ([]*chapterContent, error) {
+	chapters := splitChapters(ctx)
+	for _, c := range chapters {
+		body, err := renderChapterBody(ctx, c)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render '%s'", c.Filename)
+		}
+		c.Content = body
+		c.IDs = map[string]bool{}
+		for _, id := range idRegexp.FindAllSubmatch(body, -1) {
+			c.IDs[string(id[1])] = true
+		}
+		if c.ID != "" {
+			// the heading of a part is not rendered with the `html5` backend
+			c.IDs[c.ID] = true
+		}
+	}
+	for _, c := range chapters {
+		body := resolveCrossReferences(c, chapters)
+		heading := ""
+		switch c.Kind {
+		case titlePage:
+			heading = renderTitlePageHeading(ctx, p)
+		case part:
+			heading = `<h1 id="` + html.EscapeString(c.ID) + `" class="sect0">` + html.EscapeString(c.Title) + `</h1>`
+		}
+		result := bytes.NewBuffer(nil)
+		err := chapterTmpl.Execute(result, struct {
+			Language string
+			Title    string
+			Kind     string
+			Heading  string
+			Content  string
+		}{
+			Language: p.Language,
+			Title:    c.Title,
+			Kind:     c.Kind,
+			Heading:  heading,
+			Content:  string(bytes.TrimSpace(body)),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render '%s'", c.Filename)
+		}
+		c.Content = result.Bytes()
+	}
+	return chapters, nil
+}
+
+// renderChapterBody renders the elements of the given content document with the `html5` backend,
+// along with the footnotes they contain, and converts the result in XHTML
+func renderChapterBody(ctx *renderer.Context, c *chapterContent) ([]byte, error) {
+	if len(c.Elements) == 0 {
+		return []byte{}, nil
+	}
+	attrs := types.DocumentAttributes{}
+	for k, v := range ctx.Document.Attributes {
+		attrs[k] = v
+	}
+	// the sub-document has no header, so it must not be processed as a manpage or with a table of contents
+	delete(attrs, types.AttrDocType)
+	delete(attrs, types.AttrTableOfContents)
+	doc := types.Document{
+		Attributes:         attrs,
+		Elements:           c.Elements,
+		ElementReferences:  ctx.Document.ElementReferences,
+		Footnotes:          renderer.FootnotesIn(ctx.Document.Footnotes, c.Elements),
+		FootnoteReferences: ctx.Document.FootnoteReferences,
+	}
+	result := bytes.NewBuffer(nil)
+	if _, err := htmlrenderer.Render(ctx.WithDocument(doc, renderer.IncludeHeaderFooter(false)), result); err != nil {
+		return nil, err
+	}
+	return toXHTML(result.Bytes()), nil
+}
+
+var idRegexp = regexp.MustCompile(`\sid="([^"]+)"`)
+var internalHrefRegexp = regexp.MustCompile(`\shref="#([^"]+)"`)
+
+// resolveCrossReferences prefixes the internal links to elements of other content documents
+// with the name of their file
+func resolveCrossReferences(c *chapterContent, chapters []*chapterContent) []byte {
+	return internalHrefRegexp.ReplaceAllFunc(c.Content, func(href []byte) []byte {
+		id := string(internalHrefRegexp.FindSubmatch(href)[1])
+		if c.IDs[id] {
+			return href
+		}
+		for _, other := range chapters {
+			if other.IDs[id] {
+				return []byte(` href="` + other.Filename + `#` + id + `"`)
+			}
+		}
+		log.Warnf("unable to resolve the cross reference to '%s' in the publication", id)
+		return href
+	})
+}
+
+var startTagRegexp = regexp.MustCompile(`<([a-zA-Z][a-zA-Z0-9]*)((?:\s+[a-zA-Z_:][-a-zA-Z0-9_:.]*(?:="[^"]*")?)*)\s*(/?)>`)
+var attributeRegexp = regexp.MustCompile(`\s+([a-zA-Z_:][-a-zA-Z0-9_:.]*)(="[^"]*")?`)
+
+var voidElements = map[string]bool{
+	"area":   true,
+	"base":   true,
+	"br":     true,
+	"col":    true,
+	"embed":  true,
+	"hr":     true,
+	"img":    true,
+	"input":  true,
+	"link":   true,
+	"meta":   true,
+	"param":  true,
+	"source": true,
+	"track":  true,
+	"wbr":    true,
+}
+
+// toXHTML converts the given HTML content in XHTML, by closing the void elements (eg: `<br>` becomes `<br/>`)
+// and by giving a value to the boolean attributes (eg: `controls` becomes `controls="controls"`)
+func toXHTML(content []byte) []byte {
+	return startTagRegexp.ReplaceAllFunc(content, func(tag []byte) []byte {
+		m := startTagRegexp.FindSubmatch(tag)
+		name := strings.ToLower(string(m[1]))
+		result := strings.Builder{}
+		result.WriteString("<")
+		result.Write(m[1])
+		for _, attr := range attributeRegexp.FindAllSubmatch(m[2], -1) {
+			result.WriteString(" ")
+			result.Write(attr[1])
+			if len(attr[2]) > 0 {
+				result.Write(attr[2])
+			} else {
+				result.WriteString(`="` + string(attr[1]) + `"`)
+			}
+		}
+		if voidElements[name] || len(m[3]) > 0 {
+			result.WriteString("/")
+		}
+		result.WriteString(">")
+		return []byte(result.String())
+	})
+}
@@ -0,0 +1,106 @@
+// Package epub3 renders the documents (typically with the `book` doctype) in an EPUB 3 publication:
+// a ZIP archive with an XHTML content document per chapter, rendered with the `html5` backend, a navigation
+// document built from the sections, the package document with the metadata of the publication, the images
+// and a stylesheet.
+package epub3
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// the directory of the publication resources in the archive
+	contentDir = "OEBPS/"
+	// the name of the navigation document
+	navFilename = "nav.xhtml"
+	// the name of the stylesheet
+	stylesheetFilename = "style.css"
+	// the name of the package document
+	packageFilename = "package.opf"
+)
+
+const containerXML = `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+<rootfiles>
+<rootfile full-path="` + contentDir + packageFilename + `" media-type="application/oebps-package+xml"/>
+</rootfiles>
+</container>
+`
+
+// Render renders the given document in an EPUB 3 archive and writes the result in the given `writer`
+func Render(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	if doctype := ctx.Document.Attributes.GetAsStringWithDefault(types.AttrDocType, "article"); doctype != "book" {
+		log.Debugf("rendering a document with the '%s' doctype in an EPUB publication", doctype)
+	}
+	publication := newPublication(ctx)
+	chapters, err := renderChapters(ctx, publication)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render EPUB publication")
+	}
+	nav, err := renderNav(ctx, chapters, publication)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render EPUB publication")
+	}
+	images := collectImages(ctx, chapters)
+	pkg, err := renderPackage(chapters, images, publication)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render EPUB publication")
+	}
+	buf := bytes.NewBuffer(nil)
+	archive := zip.NewWriter(buf)
+	// the `mimetype` file must be the first entry of the archive, and must not be compressed
+	if err := writeFile(archive, "mimetype", zip.Store, []byte("application/epub+zip")); err != nil {
+		return nil, errors.Wrap(err, "unable to render EPUB publication")
+	}
+	if err := writeFile(archive, "META-INF/container.xml", zip.Deflate, []byte(containerXML)); err != nil {
+		return nil, errors.Wrap(err, "unable to render EPUB publication")
+	}
+	if err := writeFile(archive, contentDir+packageFilename, zip.Deflate, pkg); err != nil {
+		return nil, errors.Wrap(err, "unable to render EPUB publication")
+	}
+	if err := writeFile(archive, contentDir+navFilename, zip.Deflate, nav); err != nil {
+		return nil, errors.Wrap(err, "unable to render EPUB publication")
+	}
+	if err := writeFile(archive, contentDir+stylesheetFilename, zip.Deflate, []byte(stylesheet)); err != nil {
+		return nil, errors.Wrap(err, "unable to render EPUB publication")
+	}
+	for _, c := range chapters {
+		if err := writeFile(archive, contentDir+c.Filename, zip.Deflate, c.Content); err != nil {
+			return nil, errors.Wrap(err, "unable to render EPUB publication")
+		}
+	}
+	for _, img := range images {
+		if err := writeFile(archive, contentDir+img.Href, zip.Deflate, img.Content); err != nil {
+			return nil, errors.Wrap(err, "unable to render EPUB publication")
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, errors.Wrap(err, "unable to render EPUB publication")
+	}
+	if _, err := output.Write(buf.Bytes()); err != nil {
+		return nil, errors.Wrap(err, "unable to render EPUB publication")
+	}
+	metadata := ctx.Document.Attributes
+	metadata[types.AttrTitle] = publication.Title
+	metadata["LastUpdated"] = ctx.LastUpdated()
+	return metadata, nil
+}
+
+func writeFile(archive *zip.Writer, name string, method uint16, content []byte) error {
+	w, err := archive.CreateHeader(&zip.FileHeader{
+		Name:   name,
+		Method: method,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(content)
+	return err
+}
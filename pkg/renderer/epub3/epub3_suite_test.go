@@ -0,0 +1,15 @@
+package epub3_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/testsupport"
+)
+
+func TestEPUB3(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "EPUB3 Suite")
+}
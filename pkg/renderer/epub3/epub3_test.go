@@ -0,0 +1,296 @@
+package epub3_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EPUB publications", func() {
+
+	source := `= My Book
+John Doe <john@example.com>; Jane Doe
+v1.2, 2019-05-06
+:doctype: book
+:toc:
+:lang: fr
+
+A preamble.
+
+= Part One
+
+The first part.
+
+== Chapter One
+
+Some content.footnote:[a note] +
+with a line break.
+
+image::images/dot.png[A dot]
+
+=== Section One
+
+See <<chapter_two>>.
+
+[#chapter_two]
+== Chapter Two
+
+More content.footnote:[another note]`
+
+	lastUpdated := time.Date(2019, 5, 7, 10, 30, 0, 0, time.UTC)
+
+	It("archive layout", func() {
+		files, names := renderEPUB(source, renderer.LastUpdated(lastUpdated))
+		Expect(names).To(Equal([]string{
+			"mimetype",
+			"META-INF/container.xml",
+			"OEBPS/package.opf",
+			"OEBPS/nav.xhtml",
+			"OEBPS/style.css",
+			"OEBPS/titlepage.xhtml",
+			"OEBPS/chapter00.xhtml",
+			"OEBPS/chapter01.xhtml",
+			"OEBPS/chapter02.xhtml",
+			"OEBPS/images/dot.png",
+		}))
+		Expect(files["mimetype"]).To(Equal("application/epub+zip"))
+		Expect(files["META-INF/container.xml"]).To(ContainSubstring(`<rootfile full-path="OEBPS/package.opf" media-type="application/oebps-package+xml"/>`))
+		for name, content := range files {
+			if strings.HasSuffix(name, ".xhtml") || strings.HasSuffix(name, ".xml") || strings.HasSuffix(name, ".opf") {
+				Expect(content).To(BeWellFormedXML(), name)
+			}
+		}
+	})
+
+	It("mimetype is not compressed", func() {
+		result := bytes.NewBuffer(nil)
+		_, err := libasciidoc.Convert(context.Background(), "../../../test/book.adoc", strings.NewReader(source), result, renderer.Backend("epub3"))
+		Expect(err).NotTo(HaveOccurred())
+		r, err := zip.NewReader(bytes.NewReader(result.Bytes()), int64(result.Len()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.File[0].Name).To(Equal("mimetype"))
+		Expect(r.File[0].Method).To(Equal(zip.Store))
+	})
+
+	It("package document", func() {
+		files, _ := renderEPUB(source, renderer.LastUpdated(lastUpdated))
+		Expect(files["OEBPS/package.opf"]).To(Equal(`<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="uid" xml:lang="fr">
+<metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+<dc:identifier id="uid">urn:uuid:` + uuidOf(files["OEBPS/package.opf"]) + `</dc:identifier>
+<dc:title>My Book</dc:title>
+<dc:language>fr</dc:language>
+<dc:creator>John Doe</dc:creator>
+<dc:creator>Jane Doe</dc:creator>
+<dc:date>2019-05-06</dc:date>
+<meta property="dcterms:modified">2019-05-07T10:30:00Z</meta>
+<meta property="dcterms:hasVersion">1.2</meta>
+<meta name="generator" content="libasciidoc"/>
+</metadata>
+<manifest>
+<item id="nav" href="nav.xhtml" media-type="application/xhtml+xml" properties="nav"/>
+<item id="style" href="style.css" media-type="text/css"/>
+<item id="titlepage" href="titlepage.xhtml" media-type="application/xhtml+xml"/>
+<item id="chapter00" href="chapter00.xhtml" media-type="application/xhtml+xml"/>
+<item id="chapter01" href="chapter01.xhtml" media-type="application/xhtml+xml"/>
+<item id="chapter02" href="chapter02.xhtml" media-type="application/xhtml+xml"/>
+<item id="image00" href="images/dot.png" media-type="image/png"/>
+</manifest>
+<spine>
+<itemref idref="titlepage"/>
+<itemref idref="chapter00"/>
+<itemref idref="chapter01"/>
+<itemref idref="chapter02"/>
+</spine>
+</package>
+`))
+	})
+
+	It("stable identifier", func() {
+		files1, _ := renderEPUB(source)
+		files2, _ := renderEPUB(source)
+		Expect(uuidOf(files1["OEBPS/package.opf"])).To(MatchRegexp(`^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`))
+		Expect(uuidOf(files1["OEBPS/package.opf"])).To(Equal(uuidOf(files2["OEBPS/package.opf"])))
+	})
+
+	It("identifier from the uuid attribute", func() {
+		files, _ := renderEPUB(`= My Book
+:uuid: 0d2e5e7e-7c9a-4e4b-9f2a-5d6c1b2a3f4e
+
+content`)
+		Expect(files["OEBPS/package.opf"]).To(ContainSubstring(`<dc:identifier id="uid">urn:uuid:0d2e5e7e-7c9a-4e4b-9f2a-5d6c1b2a3f4e</dc:identifier>`))
+	})
+
+	It("navigation document", func() {
+		files, _ := renderEPUB(source)
+		Expect(files["OEBPS/nav.xhtml"]).To(ContainSubstring(`<nav epub:type="toc" id="toc">
+<h1>My Book</h1>
+<ol>
+<li><a href="titlepage.xhtml">My Book</a></li>
+<li><a href="chapter00.xhtml">Part One</a>
+<ol>
+<li><a href="chapter01.xhtml">Chapter One</a>
+<ol>
+<li><a href="chapter01.xhtml#_section_one">Section One</a></li>
+</ol>
+</li>
+<li><a href="chapter02.xhtml">Chapter Two</a></li>
+</ol>
+</li>
+</ol>
+</nav>`))
+	})
+
+	It("navigation document with custom toclevels", func() {
+		files, _ := renderEPUB(`= My Book
+:toclevels: 1
+
+== Chapter One
+
+=== Section One
+
+content`)
+		Expect(files["OEBPS/nav.xhtml"]).To(ContainSubstring(`<ol>
+<li><a href="titlepage.xhtml">My Book</a></li>
+<li><a href="chapter00.xhtml">Chapter One</a></li>
+</ol>`))
+	})
+
+	It("title page", func() {
+		files, _ := renderEPUB(source)
+		Expect(files["OEBPS/titlepage.xhtml"]).To(ContainSubstring(`<section epub:type="titlepage">
+<h1>My Book</h1>
+<div class="details">
+<span class="author">John Doe</span><br/>
+<span class="author">Jane Doe</span><br/>
+<span class="revnumber">version 1.2,</span>
+<span class="revdate">2019-05-06</span>
+</div>
+<div class="paragraph">
+<p>A preamble.</p>
+</div>
+</section>`))
+	})
+
+	It("part page", func() {
+		files, _ := renderEPUB(source)
+		Expect(files["OEBPS/chapter00.xhtml"]).To(ContainSubstring(`<section epub:type="part">
+<h1 id="_part_one" class="sect0">Part One</h1>
+<div class="paragraph">
+<p>The first part.</p>
+</div>
+</section>`))
+	})
+
+	It("chapters in XHTML with their own footnotes and resolved cross references", func() {
+		files, _ := renderEPUB(source)
+		Expect(files["OEBPS/chapter01.xhtml"]).To(Equal(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="fr" lang="fr">
+<head>
+<meta charset="UTF-8"/>
+<title>Chapter One</title>
+<link rel="stylesheet" type="text/css" href="style.css"/>
+</head>
+<body>
+<section epub:type="chapter">
+<div class="sect1">
+<h2 id="_chapter_one">Chapter One</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>Some content.<sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup><br/>
+with a line break.</p>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="images/dot.png" alt="A dot"/>
+</div>
+</div>
+<div class="sect2">
+<h3 id="_section_one">Section One</h3>
+<div class="paragraph">
+<p>See <a href="chapter02.xhtml#chapter_two">Chapter Two</a>.</p>
+</div>
+</div>
+</div>
+</div>
+<div id="footnotes">
+<hr/>
+<div class="footnote" id="_footnotedef_1">
+<a href="#_footnoteref_1">1</a>. a note
+</div>
+</div>
+</section>
+</body>
+</html>
+`))
+		Expect(files["OEBPS/chapter02.xhtml"]).To(ContainSubstring(`<a href="#_footnoteref_1">1</a>. another note`))
+	})
+
+	It("boolean attributes in XHTML", func() {
+		files, _ := renderEPUB(`== Chapter
+
+video::video.mp4[opts="autoplay,loop"]`)
+		Expect(files["OEBPS/chapter00.xhtml"]).To(ContainSubstring(`<video src="video.mp4" autoplay="autoplay" loop="loop" controls="controls">`))
+	})
+
+	It("skip remote images", func() {
+		files, _ := renderEPUB(`== Chapter
+
+image::https://example.com/dot.png[]`)
+		Expect(files["OEBPS/package.opf"]).NotTo(ContainSubstring(`<item id="image00"`))
+	})
+})
+
+// renderEPUB renders the given source in an EPUB publication and returns the content of the files
+// in the archive, along with their names, in order
+func renderEPUB(source string, options ...renderer.Option) (map[string]string, []string) {
+	result := bytes.NewBuffer(nil)
+	_, err := libasciidoc.Convert(context.Background(), "../../../test/book.adoc", strings.NewReader(source), result, append(options, renderer.Backend("epub3"))...)
+	Expect(err).NotTo(HaveOccurred())
+	r, err := zip.NewReader(bytes.NewReader(result.Bytes()), int64(result.Len()))
+	Expect(err).NotTo(HaveOccurred())
+	files := map[string]string{}
+	names := []string{}
+	for _, f := range r.File {
+		rc, err := f.Open()
+		Expect(err).NotTo(HaveOccurred())
+		content, err := ioutil.ReadAll(rc)
+		Expect(err).NotTo(HaveOccurred())
+		rc.Close()
+		files[f.Name] = string(content)
+		names = append(names, f.Name)
+	}
+	return files, names
+}
+
+func uuidOf(pkg string) string {
+	start := strings.Index(pkg, "urn:uuid:") + len("urn:uuid:")
+	return pkg[start : start+strings.Index(pkg[start:], "<")]
+}
+
+// BeWellFormedXML succeeds if the actual string is a well-formed XML document
+func BeWellFormedXML() OmegaMatcher {
+	return WithTransform(func(content string) error {
+		decoder := xml.NewDecoder(strings.NewReader(content))
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+	}, Succeed())
+}
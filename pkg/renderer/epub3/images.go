@@ -0,0 +1,62 @@
+package epub3
+
+import (
+	"html"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	log "github.com/sirupsen/logrus"
+)
+
+// image an image embedded in the publication
+type image struct {
+	// the path of the image in the publication, relative to the package document
+	Href      string
+	MediaType string
+	Content   []byte
+}
+
+var imgSrcRegexp = regexp.MustCompile(`<img\s[^>]*?src="([^"]+)"`)
+
+// collectImages reads the local images referenced in the content documents, so they can be embedded
+// in the publication. The paths of the images are resolved relative to the directory of the document.
+// Remote images and images outside of the directory of the document are not embedded.
+func collectImages(ctx *renderer.Context, chapters []*chapterContent) []image {
+	result := []image{}
+	found := map[string]bool{}
+	for _, c := range chapters {
+		for _, m := range imgSrcRegexp.FindAllSubmatch(c.Content, -1) {
+			src := html.UnescapeString(string(m[1]))
+			if found[src] {
+				continue
+			}
+			found[src] = true
+			if u, err := url.Parse(src); err != nil || u.Scheme != "" {
+				if u == nil || u.Scheme != "data" {
+					log.Warnf("skipping the '%s' image in the publication, since it is not a local image", src)
+				}
+				continue
+			}
+			href := path.Clean(filepath.ToSlash(src))
+			if path.IsAbs(href) || strings.HasPrefix(href, "../") {
+				log.Warnf("skipping the '%s' image in the publication, since it is outside of the directory of the document", src)
+				continue
+			}
+			content, err := ctx.ReadFile(filepath.FromSlash(href))
+			if err != nil {
+				log.Warnf("skipping the '%s' image in the publication: %v", src, err)
+				continue
+			}
+			result = append(result, image{
+				Href:      href,
+				MediaType: mediaType(href),
+				Content:   content,
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,117 @@
+package epub3
+
+import (
+	"bytes"
+	"html"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var navTmpl texttemplate.Template
+
+func init() {
+	navTmpl = renderer.NewTextTemplate("nav", `{{ define "items" }}
+<ol>{{ range . }}
+<li><a href="{{ escape .Href }}">{{ escape .Title }}</a>{{ if .Children }}{{ template "items" .Children }}
+{{ end }}</li>{{ end }}
+</ol>{{ end }}<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="{{ .Language }}" lang="{{ .Language }}">
+<head>
+<meta charset="UTF-8"/>
+<title>{{ escape .Title }}</title>
+</head>
+<body>
+<nav epub:type="toc" id="toc">
+<h1>{{ escape .Title }}</h1>{{ template "items" .Items }}
+</nav>
+</body>
+</html>
+`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+}
+
+// navItem an entry in the table of contents of the navigation document
+type navItem struct {
+	Href     string
+	Title    string
+	Children []navItem
+}
+
+// renderNav renders the navigation document, with a table of contents built from the sections of the document
+// (up to the level specified by the `toclevels` attribute)
+func renderNav(ctx *renderer.Context, chapters []*chapterContent, p publication) ([]byte, error) {
+	maxLevel := 2
+	if l, found := ctx.Document.Attributes.GetAsString(types.AttrTableOfContentsLevels); found {
+		var err error
+		if maxLevel, err = strconv.Atoi(l); err != nil {
+			return nil, errors.Wrapf(err, "invalid value for the '%s' attribute", types.AttrTableOfContentsLevels)
+		}
+	}
+	items := []navItem{}
+	for _, c := range chapters {
+		switch c.Kind {
+		case titlePage:
+			if c.Title != "" {
+				items = append(items, navItem{
+					Href:  c.Filename,
+					Title: c.Title,
+				})
+			}
+		case part:
+			items = append(items, navItem{
+				Href:  c.Filename,
+				Title: c.Title,
+			})
+		case chapter:
+			s := c.Elements[0].(types.Section)
+			item := navItem{
+				Href:     c.Filename,
+				Title:    c.Title,
+				Children: newNavItems(c, s.Elements, maxLevel),
+			}
+			if c.InPart && len(items) > 0 {
+				// a chapter of the current part
+				items[len(items)-1].Children = append(items[len(items)-1].Children, item)
+			} else {
+				items = append(items, item)
+			}
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	err := navTmpl.Execute(result, struct {
+		Language string
+		Title    string
+		Items    []navItem
+	}{
+		Language: p.Language,
+		Title:    p.Title,
+		Items:    items,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render navigation document")
+	}
+	return result.Bytes(), nil
+}
+
+// newNavItems returns the entries for the sections in the given elements, up to the given level
+func newNavItems(c *chapterContent, elements []interface{}, maxLevel int) []navItem {
+	result := []navItem{}
+	for _, element := range elements {
+		if s, ok := element.(types.Section); ok && s.Level <= maxLevel {
+			result = append(result, navItem{
+				Href:     c.Filename + "#" + s.Attributes.GetAsString(types.AttrID),
+				Title:    strings.TrimSpace(renderer.PlainText(s.Title)),
+				Children: newNavItems(c, s.Elements, maxLevel),
+			})
+		}
+	}
+	return result
+}
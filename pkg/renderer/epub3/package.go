@@ -0,0 +1,163 @@
+package epub3
+
+import (
+	"bytes"
+	"crypto/sha1" // nolint: gosec
+	"fmt"
+	"html"
+	"mime"
+	"path"
+	"regexp"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	log "github.com/sirupsen/logrus"
+)
+
+var packageTmpl texttemplate.Template
+var titlePageHeadingTmpl texttemplate.Template
+
+func init() {
+	packageTmpl = renderer.NewTextTemplate("package", `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="uid" xml:lang="{{ escape .Publication.Language }}">
+<metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+<dc:identifier id="uid">{{ escape .Publication.Identifier }}</dc:identifier>
+<dc:title>{{ escape .Publication.Title }}</dc:title>
+<dc:language>{{ escape .Publication.Language }}</dc:language>{{ range .Publication.Authors }}
+<dc:creator>{{ escape . }}</dc:creator>{{ end }}{{ if .Publication.Date }}
+<dc:date>{{ escape .Publication.Date }}</dc:date>{{ end }}
+<meta property="dcterms:modified">{{ .Publication.Modified }}</meta>{{ if .Publication.Version }}
+<meta property="dcterms:hasVersion">{{ escape .Publication.Version }}</meta>{{ end }}
+<meta name="generator" content="libasciidoc"/>
+</metadata>
+<manifest>
+<item id="nav" href="`+navFilename+`" media-type="application/xhtml+xml" properties="nav"/>
+<item id="style" href="`+stylesheetFilename+`" media-type="text/css"/>{{ range .Chapters }}
+<item id="{{ chapterID .Filename }}" href="{{ .Filename }}" media-type="application/xhtml+xml"/>{{ end }}{{ range $index, $image := .Images }}
+<item id="{{ imageID $index }}" href="{{ escape $image.Href }}" media-type="{{ $image.MediaType }}"/>{{ end }}
+</manifest>
+<spine>{{ range .Chapters }}
+<itemref idref="{{ chapterID .Filename }}"/>{{ end }}
+</spine>
+</package>
+`,
+		texttemplate.FuncMap{
+			"escape":    html.EscapeString,
+			"chapterID": func(filename string) string { return strings.TrimSuffix(filename, path.Ext(filename)) },
+			"imageID":   func(i int) string { return fmt.Sprintf("image%02d", i) },
+		})
+	titlePageHeadingTmpl = renderer.NewTextTemplate("title page heading", `<h1>{{ escape .Title }}</h1>{{ if or .Authors .Version .Date }}
+<div class="details">{{ range .Authors }}
+<span class="author">{{ escape . }}</span><br/>{{ end }}{{ if .Version }}
+<span class="revnumber">version {{ escape .Version }}{{ if .Date }},{{ end }}</span>{{ end }}{{ if .Date }}
+<span class="revdate">{{ escape .Date }}</span>{{ end }}
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"escape": html.EscapeString,
+		})
+}
+
+// publication the metadata of the publication, retrieved from the document attributes
+type publication struct {
+	Identifier string
+	Title      string
+	Language   string
+	Authors    []string
+	Date       string
+	Modified   string
+	Version    string
+}
+
+// a date in one of the formats allowed by the W3C Date and Time Formats (eg: `2019`, `2019-05` or `2019-05-06`)
+var w3cdtfRegexp = regexp.MustCompile(`^\d{4}(-\d{2}(-\d{2})?)?$`)
+
+func newPublication(ctx *renderer.Context) publication {
+	title, _ := ctx.Document.Title()
+	p := publication{
+		Title:    strings.TrimSpace(renderer.PlainText(title)),
+		Language: ctx.Document.Attributes.GetAsStringWithDefault("lang", "en"),
+		Authors:  []string{},
+		Version:  ctx.Document.Attributes.GetAsStringWithDefault("revnumber", ""),
+	}
+	if uuid, found := ctx.Document.Attributes.GetAsString("uuid"); found && uuid != "" {
+		p.Identifier = "urn:uuid:" + strings.TrimPrefix(uuid, "urn:uuid:")
+	} else {
+		p.Identifier = "urn:uuid:" + newUUID(p.Title)
+	}
+	if authors, found := ctx.Document.Authors(); found {
+		for _, a := range authors {
+			p.Authors = append(p.Authors, strings.TrimSpace(a.FullName))
+		}
+	}
+	if revdate, found := ctx.Document.Attributes.GetAsString("revdate"); found {
+		if w3cdtfRegexp.MatchString(revdate) {
+			p.Date = revdate
+		} else {
+			log.Warnf("ignoring the '%s' revision date in the publication metadata, since it is not in the YYYY-MM-DD format", revdate)
+		}
+	}
+	modified, err := time.Parse(renderer.LastUpdatedFormat, ctx.LastUpdated())
+	if err != nil {
+		modified = time.Now()
+	}
+	p.Modified = modified.UTC().Format("2006-01-02T15:04:05Z")
+	return p
+}
+
+// newUUID returns a name-based UUID (version 5) for the given name, so that the identifier
+// of the publication remains the same when it is rendered again
+func newUUID(name string) string {
+	h := sha1.Sum([]byte("libasciidoc:" + name)) // nolint: gosec
+	h[6] = (h[6] & 0x0f) | 0x50
+	h[8] = (h[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", h[0:4], h[4:6], h[6:8], h[8:10], h[10:16])
+}
+
+func renderTitlePageHeading(ctx *renderer.Context, p publication) string {
+	if _, found := ctx.Document.Title(); !found {
+		return ""
+	}
+	result := bytes.NewBuffer(nil)
+	err := titlePageHeadingTmpl.Execute(result, struct {
+		Title   string
+		Authors []string
+		Version string
+		Date    string
+	}{
+		Title:   p.Title,
+		Authors: p.Authors,
+		Version: p.Version,
+		Date:    ctx.Document.Attributes.GetAsStringWithDefault("revdate", ""),
+	})
+	if err != nil {
+		log.Errorf("unable to render the title page heading: %v", err)
+	}
+	return result.String()
+}
+
+func renderPackage(chapters []*chapterContent, images []image, p publication) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := packageTmpl.Execute(result, struct {
+		Publication publication
+		Chapters    []*chapterContent
+		Images      []image
+	}{
+		Publication: p,
+		Chapters:    chapters,
+		Images:      images,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Bytes(), nil
+}
+
+// mediaType returns the media type of the file at the given path, based on its extension
+func mediaType(p string) string {
+	if t := mime.TypeByExtension(path.Ext(p)); t != "" {
+		return strings.Split(t, ";")[0]
+	}
+	return "application/octet-stream"
+}
@@ -0,0 +1,58 @@
+package epub3
+
+// stylesheet the default stylesheet of the content documents of the publication
+const stylesheet = `body {
+  font-family: serif;
+  line-height: 1.4;
+}
+h1, h2, h3, h4, h5, h6, .title {
+  font-family: sans-serif;
+  page-break-after: avoid;
+}
+h1.sect0 {
+  margin-top: 30%;
+  text-align: center;
+}
+section[epub|type~="titlepage"] {
+  text-align: center;
+}
+.details span {
+  display: block;
+}
+pre {
+  white-space: pre-wrap;
+  font-size: 0.85em;
+}
+code, pre, kbd {
+  font-family: monospace;
+}
+.title {
+  font-style: italic;
+  font-weight: bold;
+}
+.admonitionblock td.icon {
+  font-weight: bold;
+  vertical-align: top;
+  padding-right: 1em;
+}
+.quoteblock, .verseblock {
+  margin: 1em 2em;
+}
+.attribution {
+  text-align: right;
+}
+.imageblock img {
+  max-width: 100%;
+}
+table.tableblock {
+  border-collapse: collapse;
+  width: 100%;
+}
+table.tableblock th, table.tableblock td {
+  border: 1px solid #ccc;
+  padding: 0.2em 0.4em;
+}
+#footnotes {
+  font-size: 0.85em;
+}
+`
@@ -0,0 +1,43 @@
+package renderer
+
+import (
+	"path/filepath"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/vfs"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// ReadFile reads the content of the file at the given path (eg: an image to embed in the output) in the file system
+// of the context. The path is resolved relatively to the directory of the document being rendered.
+// Returns an error if the file cannot be read in the safe mode of the context.
+func (ctx *Context) ReadFile(path string) ([]byte, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(ctx.Filename()), path)
+	}
+	mode := ctx.SafeMode()
+	if mode >= types.SafeModeSecure {
+		return nil, errors.Errorf("the files cannot be read in %s mode", mode)
+	}
+	if base := ctx.BaseDir(); mode >= types.SafeModeSafe && !vfs.Within(base, path) {
+		return nil, errors.Errorf("the file is outside of the base directory '%s' in %s mode", base, mode)
+	}
+	return vfs.ReadFile(ctx.FileSystem(), path)
+}
+
+// LockAttributes removes the document attributes which cannot be set by the document in the safe mode of the context
+// (eg: the `backend` attribute in the `server` mode), then sets the attributes given with the `Attributes` option,
+// which take precedence over the attributes of the document
+func LockAttributes(ctx *Context) {
+	attrs := ctx.Attributes()
+	for _, k := range ctx.SafeMode().LockedAttributes() {
+		if _, found := ctx.Document.Attributes[k]; found && !attrs.Has(k) {
+			log.Warnf("ignoring the '%s' document attribute in %s mode", k, ctx.SafeMode())
+			delete(ctx.Document.Attributes, k)
+		}
+	}
+	for k, v := range attrs {
+		ctx.Document.Attributes[k] = v
+	}
+}
@@ -0,0 +1,46 @@
+package renderer
+
+import (
+	"reflect"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// FootnotesIn returns the given footnotes which are referenced in the given elements, so that a part of a document
+// rendered on its own (eg: a chapter or a slide) only lists its own footnotes
+func FootnotesIn(footnotes types.Footnotes, elements []interface{}) types.Footnotes {
+	ids := map[int]bool{}
+	collectFootnoteIDs(reflect.ValueOf(elements), ids)
+	result := types.Footnotes{}
+	for _, note := range footnotes {
+		if ids[note.ID] {
+			result = append(result, note)
+		}
+	}
+	return result
+}
+
+var footnoteType = reflect.TypeOf(types.Footnote{})
+
+// collectFootnoteIDs walks through the given value (a block, a list item, a table cell, etc.)
+// and collects the IDs of all the footnotes it contains
+func collectFootnoteIDs(v reflect.Value, ids map[int]bool) {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if !v.IsNil() {
+			collectFootnoteIDs(v.Elem(), ids)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			collectFootnoteIDs(v.Index(i), ids)
+		}
+	case reflect.Struct:
+		if v.Type() == footnoteType {
+			ids[int(v.FieldByName("ID").Int())] = true
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			collectFootnoteIDs(v.Field(i), ids)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package html5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var audioBlockTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	audioBlockTmpl = newTextTemplate("audio_block", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="audioblock{{ if .Role }} {{ .Role }}{{ end }}">{{ if .Title }}
+<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="content">
+<audio src="{{ .Path }}"{{ if .Autoplay }} autoplay{{ end }}{{ if .Loop }} loop{{ end }}{{ if .Controls }} controls{{ end }}>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>`,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+}
+
+func renderAudioBlock(ctx *renderer.Context, a types.AudioBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := executeTemplate(ctx, audioBlockTmpl, result, struct {
+		ID       string
+		Title    string
+		Role     string
+		Path     string
+		Autoplay bool
+		Loop     bool
+		Controls bool
+	}{
+		ID:       renderElementID(a.Attributes),
+		Title:    renderTitle(a.Attributes),
+		Role:     a.Attributes.GetAsString(types.AttrRole),
+		Path:     a.Location.String() + mediaFragment(a.Attributes),
+		Autoplay: a.Attributes.HasOption("autoplay"),
+		Loop:     a.Attributes.HasOption("loop"),
+		Controls: !a.Attributes.HasOption("nocontrols"),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render audio block")
+	}
+	return result.Bytes(), nil
+}
@@ -0,0 +1,37 @@
+package html5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("audios", func() {
+
+	It("audio block without attributes", func() {
+		source := "audio::sounds/ocean.wav[]"
+		expected := `<div class="audioblock">
+<div class="content">
+<audio src="sounds/ocean.wav" controls>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected))
+	})
+
+	It("audio block with title, start and options", func() {
+		source := `.Ocean waves
+audio::sounds/ocean.wav[start=5, end=15, options="autoplay,loop"]`
+		expected := `<div class="audioblock">
+<div class="title">Ocean waves</div>
+<div class="content">
+<audio src="sounds/ocean.wav#t=5,15" autoplay loop controls>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected))
+	})
+})
@@ -16,8 +16,8 @@ var externalCrossReferenceTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	internalCrossReferenceTmpl = newTextTemplate("internal cross reference", `<a href="#{{ .Href }}">{{ .Label }}</a>`)
-	externalCrossReferenceTmpl = newTextTemplate("external cross reference", `<a href="{{ .Href }}">{{ .Label }}</a>`)
+	internalCrossReferenceTmpl = newTextTemplate("internal_cross_reference", `<a href="#{{ .Href }}">{{ .Label }}</a>`)
+	externalCrossReferenceTmpl = newTextTemplate("external_cross_reference", `<a href="{{ .Href }}">{{ .Label }}</a>`)
 }
 
 func renderInternalCrossReference(ctx *renderer.Context, xref types.InternalCrossReference) ([]byte, error) {
@@ -39,7 +39,7 @@ func renderInternalCrossReference(ctx *renderer.Context, xref types.InternalCros
 	} else {
 		label = "[" + xref.ID + "]"
 	}
-	err := internalCrossReferenceTmpl.Execute(result, struct {
+	err := executeTemplate(ctx, internalCrossReferenceTmpl, result, struct {
 		Href  string
 		Label string
 	}{
@@ -59,11 +59,11 @@ func renderExternalCrossReference(ctx *renderer.Context, xref types.ExternalCros
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render external cross reference")
 	}
-	err = externalCrossReferenceTmpl.Execute(result, struct {
+	err = executeTemplate(ctx, externalCrossReferenceTmpl, result, struct {
 		Href  string
 		Label string
 	}{
-		Href:  getCrossReferenceLocation(xref),
+		Href:  getCrossReferenceLocation(ctx, xref),
 		Label: string(label),
 	})
 	if err != nil {
@@ -72,9 +72,11 @@ func renderExternalCrossReference(ctx *renderer.Context, xref types.ExternalCros
 	return result.Bytes(), nil
 }
 
-func getCrossReferenceLocation(xref types.ExternalCrossReference) string {
+// getCrossReferenceLocation returns the location of the given cross reference, with the extension of the
+// output files (ie, the `outfilesuffix` document attribute) instead of the extension of the source file
+func getCrossReferenceLocation(ctx *renderer.Context, xref types.ExternalCrossReference) string {
 	loc := xref.Location.String()
 	ext := filepath.Ext(xref.Location.String())
 	log.Debugf("ext of '%s': '%s'", loc, ext)
-	return loc[:len(loc)-len(ext)] + ".html"
+	return loc[:len(loc)-len(ext)] + ctx.Document.Attributes.GetAsStringWithDefault(types.AttrOutFileSuffix, ".html")
 }
@@ -23,7 +23,7 @@ var sidebarBlockTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	fencedBlockTmpl = newTextTemplate("listing block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="listingblock">{{ if .Title }}
+	fencedBlockTmpl = newTextTemplate("fenced_block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="listingblock">{{ if .Title }}
 <div class="title">{{ escape .Title }}</div>{{ end }}
 <div class="content">
 <pre class="highlight"><code>{{ range $index, $element := .Elements }}{{ renderPlainText $ctx $element | printf "%s" }}{{ end }}</code></pre>
@@ -34,7 +34,7 @@ func init() {
 			"escape":          EscapeString,
 		})
 
-	listingBlockTmpl = newTextTemplate("listing block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="listingblock">{{ if .Title }}
+	listingBlockTmpl = newTextTemplate("listing_block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="listingblock">{{ if .Title }}
 <div class="title">{{ escape .Title }}</div>{{ end }}
 <div class="content">
 <pre>{{ range $index, $element := .Elements }}{{ renderPlainText $ctx $element | printf "%s" | escape }}{{ end }}</pre>
@@ -45,7 +45,7 @@ func init() {
 			"escape":          EscapeString,
 		})
 
-	sourceBlockTmpl = newTextTemplate("source block",
+	sourceBlockTmpl = newTextTemplate("source_block",
 		`{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="listingblock">{{ if .Title }}
 <div class="title">{{ escape .Title }}</div>{{ end }}
 <div class="content">
@@ -57,7 +57,7 @@ func init() {
 			"escape":          EscapeString,
 		})
 
-	exampleBlockTmpl = newTextTemplate("example block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="exampleblock">{{ if .Title }}
+	exampleBlockTmpl = newTextTemplate("example_block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="exampleblock">{{ if .Title }}
 <div class="title">{{ escape .Title }}</div>{{ end }}
 <div class="content">
 {{ $elements := .Elements }}{{ renderElements $ctx $elements | printf "%s" }}
@@ -68,7 +68,7 @@ func init() {
 			"escape":         EscapeString,
 		})
 
-	quoteBlockTmpl = newTextTemplate("quote block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="quoteblock">{{ if .Title }}
+	quoteBlockTmpl = newTextTemplate("quote_block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="quoteblock">{{ if .Title }}
 <div class="title">{{ escape .Title }}</div>{{ end }}
 <blockquote>
 {{ renderElements $ctx .Elements | printf "%s" }}
@@ -83,7 +83,7 @@ func init() {
 			"escape":         EscapeString,
 		})
 
-	verseBlockTmpl = newTextTemplate("verse block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="verseblock">{{ if .Title }}
+	verseBlockTmpl = newTextTemplate("verse_block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="verseblock">{{ if .Title }}
 <div class="title">{{ escape .Title }}</div>{{ end }}
 <pre class="content">{{ range $index, $element := .Elements }}{{ renderElement $ctx $element | printf "%s" }}{{ end }}</pre>{{ if .Attribution.First }}
 <div class="attribution">
@@ -96,17 +96,17 @@ func init() {
 			"escape":        EscapeString,
 		})
 
-	verseBlockParagraphTmpl = newTextTemplate("verse block paragraph",
+	verseBlockParagraphTmpl = newTextTemplate("verse_block_paragraph",
 		`{{ $ctx := .Context }}{{ with .Data }}{{ renderLines $ctx .Lines | printf "%s" }}{{ end }}`,
 		texttemplate.FuncMap{
 			"renderLines": renderLines,
 		})
 
-	admonitionBlockTmpl = newTextTemplate("admonition block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID}}" {{ end }}class="admonitionblock {{ .Class }}">
+	admonitionBlockTmpl = newTextTemplate("admonition_block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID}}" {{ end }}class="admonitionblock {{ .Class }}">
 <table>
 <tr>
 <td class="icon">
-{{ if .IconClass }}<i class="fa icon-{{ .IconClass }}" title="{{ .IconTitle }}"></i>{{ else }}<div class="title">{{ .IconTitle }}</div>{{ end }}
+{{ if .IconClass }}<i class="fa icon-{{ .IconClass }}" title="{{ .IconTitle }}"></i>{{ else if .IconImage }}<img src="{{ .IconImage }}" alt="{{ .IconTitle }}">{{ else }}<div class="title">{{ .IconTitle }}</div>{{ end }}
 </td>
 <td class="content">
 {{ if .Title }}<div class="title">{{ escape .Title }}</div>
@@ -120,7 +120,7 @@ func init() {
 			"escape":         EscapeString,
 		})
 
-	sidebarBlockTmpl = newTextTemplate("sidebar block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="sidebarblock">
+	sidebarBlockTmpl = newTextTemplate("sidebar_block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="sidebarblock">
 <div class="content">{{ if .Title }}
 <div class="title">{{ escape .Title }}</div>{{ end }}
 {{ renderElements $ctx .Elements | printf "%s" }}
@@ -164,7 +164,7 @@ func renderFencedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, e
 		ctx.SetIncludeBlankLine(previouslyInclude)
 	}()
 	result := bytes.NewBuffer(nil)
-	err := fencedBlockTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, fencedBlockTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID       string
@@ -187,7 +187,7 @@ func renderListingBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte,
 		ctx.SetIncludeBlankLine(previouslyInclude)
 	}()
 	result := bytes.NewBuffer(nil)
-	err := listingBlockTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, listingBlockTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID       string
@@ -211,7 +211,7 @@ func renderSourceBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, e
 	}()
 	language := b.Attributes.GetAsString(types.AttrLanguage)
 	result := bytes.NewBuffer(nil)
-	err := sourceBlockTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, sourceBlockTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID       string
@@ -231,12 +231,13 @@ func renderSourceBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, e
 func renderExampleBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
 	if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
-		err := admonitionBlockTmpl.Execute(result, ContextualPipeline{
+		err := executeTemplate(ctx, admonitionBlockTmpl, result, ContextualPipeline{
 			Context: ctx,
 			Data: struct {
 				ID        string
 				Class     string
 				IconClass string
+				IconImage string
 				IconTitle string
 				Title     string
 				Elements  []interface{}
@@ -244,7 +245,8 @@ func renderExampleBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte,
 				ID:        renderElementID(b.Attributes),
 				Class:     renderClass(k),
 				IconClass: renderIconClass(ctx, k),
-				IconTitle: renderIconTitle(k),
+				IconImage: renderIconImage(ctx, k),
+				IconTitle: renderIconTitle(ctx, k),
 				Title:     renderTitle(b.Attributes),
 				Elements:  discardTrailingBlankLines(b.Elements),
 			},
@@ -256,7 +258,7 @@ func renderExampleBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte,
 	if b.Attributes.Has(types.AttrTitle) {
 		title = "Example " + strconv.Itoa(ctx.GetAndIncrementExampleBlockCounter()) + ". " + renderTitle(b.Attributes)
 	}
-	err := exampleBlockTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, exampleBlockTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID       string
@@ -273,7 +275,7 @@ func renderExampleBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte,
 
 func renderQuoteBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	err := quoteBlockTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, quoteBlockTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID          string
@@ -292,7 +294,7 @@ func renderQuoteBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, er
 
 func renderVerseBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	err := verseBlockTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, verseBlockTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID          string
@@ -325,7 +327,7 @@ func renderVerseBlockElement(ctx *renderer.Context, element interface{}) ([]byte
 func renderVerseBlockParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 	log.Debugf("rendering paragraph with %d line(s) within a delimited block or a list", len(p.Lines))
 	result := bytes.NewBuffer(nil)
-	err := verseBlockParagraphTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, verseBlockParagraphTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			Lines [][]interface{}
@@ -338,7 +340,7 @@ func renderVerseBlockParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte
 
 func renderSidebarBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	err := sidebarBlockTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, sidebarBlockTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID       string
@@ -16,7 +16,7 @@ import (
 var documentTmpl texttemplate.Template
 
 func init() {
-	documentTmpl = newTextTemplate("root document",
+	documentTmpl = newTextTemplate("document",
 		`<!DOCTYPE html>
 <html lang="en">
 <head>
@@ -26,10 +26,10 @@ func init() {
 <meta name="generator" content="{{ .Generator }}">{{ end }}
 <title>{{ escape .Title }}</title>
 </head>
-<body class="article">
+<body class="{{ .DocType }}">
 <div id="header">
-<h1>{{ .Header }}</h1>{{ if .Details }}
-{{ .Details }}{{ end }}
+{{ if .Manpage }}{{ .Manpage }}{{ else }}<h1>{{ .Header }}</h1>{{ if .Details }}
+{{ .Details }}{{ end }}{{ end }}
 </div>
 <div id="content">
 {{ .Content }}
@@ -40,7 +40,23 @@ Version {{ .RevNumber }}<br>{{ end }}
 Last updated {{ .LastUpdated }}
 </div>
 </div>
-</body>
+{{ if .MathJax }}<script type="text/x-mathjax-config">
+MathJax.Hub.Config({
+  messageStyle: "none",
+  tex2jax: {
+    inlineMath: [["\\(", "\\)"]],
+    displayMath: [["\\[", "\\]"]],
+    ignoreClass: "nostem|nolatexmath"
+  },
+  asciimath2jax: {
+    delimiters: [["\\$", "\\$"]],
+    ignoreClass: "nostem|noasciimath"
+  },
+  TeX: { equationNumbers: { autoNumber: "none" } }
+})
+</script>
+<script src="https://cdnjs.cloudflare.com/ajax/libs/mathjax/2.7.4/MathJax.js?config=TeX-MML-AM_HTMLorMML"></script>
+{{ end }}</body>
 </html>`,
 		texttemplate.FuncMap{
 			"escape": EscapeString,
@@ -59,6 +75,10 @@ func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interfa
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render full document")
 	}
+	renderedManpageHeader, err := renderManpageHeader(ctx, renderedHeader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render full document")
+	}
 	if ctx.IncludeHeaderFooter() {
 		log.Debugf("Rendering full document...")
 		// use a temporary writer for the document's content
@@ -71,22 +91,28 @@ func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interfa
 			return nil, errors.Wrapf(err, "unable to render full document")
 		}
 		revNumber, _ := ctx.Document.Attributes.GetAsString("revnumber")
-		err = documentTmpl.Execute(output, struct {
+		err = executeTemplate(ctx, documentTmpl, output, struct {
 			Generator   string
 			Title       string
+			DocType     string
 			Header      string
+			Manpage     string
 			Content     htmltemplate.HTML
 			RevNumber   string
 			LastUpdated string
 			Details     *htmltemplate.HTML
+			MathJax     bool
 		}{
 			Generator:   "libasciidoc", // TODO: externalize this value and include the lib version ?
 			Title:       string(renderedTitle),
+			DocType:     renderDocType(ctx),
 			Header:      string(renderedHeader),
+			Manpage:     string(renderedManpageHeader),
 			Content:     htmltemplate.HTML(string(renderedElements)), //nolint: gosec
 			RevNumber:   revNumber,
 			LastUpdated: ctx.LastUpdated(),
 			Details:     documentDetails,
+			MathJax:     ctx.Document.Attributes.Has(types.AttrStem) && ctx.StemRendering() == renderer.MathJax,
 		})
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to render full document")
@@ -96,6 +122,10 @@ func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interfa
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to render full document")
 		}
+		if len(renderedManpageHeader) > 0 {
+			// the header of a manpage is also rendered in the embeddable document
+			renderedElements = append(append(renderedManpageHeader, '\n'), renderedElements...)
+		}
 		_, err = output.Write(renderedElements)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to render full document")
@@ -127,8 +157,8 @@ func renderDocumentElements(ctx *renderer.Context) ([]byte, error) {
 			elements = ctx.Document.Elements
 		case types.Section:
 			if e.Level == 0 {
-				// retain the section's elements...
-				elements = append(elements, e.Elements)
+				// retain the section's elements (except the `NAME` section of a manpage, which is rendered in the header)...
+				elements = append(elements, withoutManpageNameSection(ctx, e.Elements))
 				// ... and add the other elements
 				elements = append(elements, ctx.Document.Elements[i+1:]...)
 				continue
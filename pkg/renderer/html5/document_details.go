@@ -15,14 +15,14 @@ var documentDetailsTmpl texttemplate.Template
 var documentAuthorDetailsTmpl texttemplate.Template
 
 func init() {
-	documentDetailsTmpl = newTextTemplate("document details", `<div class="details">{{ if .Authors }}
+	documentDetailsTmpl = newTextTemplate("document_details", `<div class="details">{{ if .Authors }}
 {{ .Authors }}{{ end }}{{ if .RevNumber }}
 <span id="revnumber">version {{ .RevNumber }},</span>{{ end }}{{ if .RevDate }}
 <span id="revdate">{{ .RevDate }}</span>{{ end }}{{ if .RevRemark }}
 <br><span id="revremark">{{ .RevRemark }}</span>{{ end }}
 </div>`)
 
-	documentAuthorDetailsTmpl = newTextTemplate("author details", `{{ if .Name }}<span id="author{{ .Index }}" class="author">{{ .Name }}</span><br>{{ end }}{{ if .Email }}
+	documentAuthorDetailsTmpl = newTextTemplate("document_author_details", `{{ if .Name }}<span id="author{{ .Index }}" class="author">{{ .Name }}</span><br>{{ end }}{{ if .Email }}
 <span id="email{{ .Index }}" class="email"><a href="mailto:{{ .Email }}">{{ .Email }}</a></span><br>{{ end }}`)
 }
 
@@ -36,7 +36,7 @@ func renderDocumentDetails(ctx *renderer.Context) (*htmltemplate.HTML, error) {
 		revNumber, _ := ctx.Document.Attributes.GetAsString("revnumber")
 		revDate, _ := ctx.Document.Attributes.GetAsString("revdate")
 		revRemark, _ := ctx.Document.Attributes.GetAsString("revremark")
-		err = documentDetailsTmpl.Execute(documentDetailsBuff, struct {
+		err = executeTemplate(ctx, documentDetailsTmpl, documentDetailsBuff, struct {
 			Authors   htmltemplate.HTML
 			RevNumber string
 			RevDate   string
@@ -76,7 +76,7 @@ func renderDocumentAuthorsDetails(ctx *renderer.Context) (*htmltemplate.HTML, er
 		if author, ok := ctx.Document.Attributes.GetAsString(authorKey); ok {
 			authorDetailsBuff := bytes.NewBuffer(nil)
 			email, _ := ctx.Document.Attributes.GetAsString(emailKey)
-			err := documentAuthorDetailsTmpl.Execute(authorDetailsBuff, struct {
+			err := executeTemplate(ctx, documentAuthorDetailsTmpl, authorDetailsBuff, struct {
 				Index string
 				Name  string
 				Email string
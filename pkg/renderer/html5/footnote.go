@@ -22,12 +22,12 @@ func init() {
 		texttemplate.FuncMap{
 			"renderIndex": renderFootnoteIndex,
 		})
-	footnoterefTmpl = newTextTemplate("footnote ref", `<sup class="{{ .Class }}">[<a class="footnote" href="#_footnotedef_{{ renderIndex .ID }}" title="View footnote.">{{ renderIndex .ID }}</a>]</sup>`,
+	footnoterefTmpl = newTextTemplate("footnoteref", `<sup class="{{ .Class }}">[<a class="footnote" href="#_footnotedef_{{ renderIndex .ID }}" title="View footnote.">{{ renderIndex .ID }}</a>]</sup>`,
 		texttemplate.FuncMap{
 			"renderIndex": renderFootnoteIndex,
 		})
 
-	invalidFootnoteTmpl = newTextTemplate("invalid footnote", `<sup class="{{ .Class }} red" title="Unresolved footnote reference.">[{{ .Ref }}]</sup>`)
+	invalidFootnoteTmpl = newTextTemplate("invalid_footnote", `<sup class="{{ .Class }} red" title="Unresolved footnote reference.">[{{ .Ref }}]</sup>`)
 	footnotesTmpl = newTextTemplate("footnotes", `
 <div id="footnotes">
 <hr>{{ $ctx := .Context }}{{ with .Data }}{{ $footnotes := .Footnotes }}{{ range $index, $footnote := $footnotes }}
@@ -60,7 +60,7 @@ func renderFootnote(ctx *renderer.Context, note types.Footnote) ([]byte, error)
 	}
 	if id, ok := ctx.Document.Footnotes.IndexOf(note); ok {
 		// valid case for a footnte with content, with our without an explicit reference
-		err := footnoteTmpl.Execute(result, struct {
+		err := executeTemplate(ctx, footnoteTmpl, result, struct {
 			ID    int
 			Ref   string
 			Class string
@@ -73,7 +73,7 @@ func renderFootnote(ctx *renderer.Context, note types.Footnote) ([]byte, error)
 			return nil, errors.Wrapf(err, "unable to render footnote")
 		}
 	} else if hasRef {
-		err := footnoterefTmpl.Execute(result, struct {
+		err := executeTemplate(ctx, footnoterefTmpl, result, struct {
 			ID    int
 			Ref   string
 			Class string
@@ -87,7 +87,7 @@ func renderFootnote(ctx *renderer.Context, note types.Footnote) ([]byte, error)
 		}
 	} else {
 		// invalid footnote
-		err := invalidFootnoteTmpl.Execute(result, struct {
+		err := executeTemplate(ctx, invalidFootnoteTmpl, result, struct {
 			Ref   string
 			Class string
 		}{
@@ -108,7 +108,7 @@ func renderFootnotes(ctx *renderer.Context, notes types.Footnotes) ([]byte, erro
 		return []byte{}, nil
 	}
 	result := bytes.NewBuffer(nil)
-	err := footnotesTmpl.Execute(result,
+	err := executeTemplate(ctx, footnotesTmpl, result,
 		ContextualPipeline{
 			Context: ctx,
 			Data: struct {
@@ -16,6 +16,18 @@ func Render(ctx *renderer.Context, output io.Writer) (map[string]interface{}, er
 	return renderDocument(ctx, output)
 }
 
+// RenderInlineElements renders the given inline elements (eg: the title of a section) in HTML,
+// so that they can be embedded by the other backends
+func RenderInlineElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	return renderInlineElements(ctx, elements)
+}
+
+// RenderElements renders the given block elements in HTML, without the footnotes of the document,
+// so that they can be embedded by the other backends
+func RenderElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	return renderElements(ctx, elements)
+}
+
 func renderElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
 	log.Debugf("rendering %d elements(s)...", len(elements))
 	buff := bytes.NewBuffer(nil)
@@ -79,8 +91,17 @@ func renderListElements(ctx *renderer.Context, elements []interface{}) ([]byte,
 	return buff.Bytes(), nil
 }
 
-// nolint: gocyclo
+// renderElement renders the given element with the hook defined for its type, if any,
+// or with the default renderer otherwise
 func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
+	if hook, found := ctx.RenderHook(element); found {
+		return hook(ctx, element, renderDefaultElement)
+	}
+	return renderDefaultElement(ctx, element)
+}
+
+// nolint: gocyclo
+func renderDefaultElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
 	// log.Debugf("rendering element of type `%T`", element)
 	switch e := element.(type) {
 	case []interface{}:
@@ -113,6 +134,10 @@ func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
 		return renderImageBlock(ctx, e)
 	case types.InlineImage:
 		return renderInlineImage(ctx, e)
+	case types.VideoBlock:
+		return renderVideoBlock(ctx, e)
+	case types.AudioBlock:
+		return renderAudioBlock(ctx, e)
 	case types.DelimitedBlock:
 		return renderDelimitedBlock(ctx, e)
 	case types.Table:
@@ -129,6 +154,12 @@ func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
 		return renderLineBreak()
 	case types.UserMacro:
 		return renderUserMacro(ctx, e)
+	case types.InlineStem:
+		return renderInlineStem(ctx, e)
+	case types.InlineIcon:
+		return renderInlineIcon(ctx, e)
+	case types.StemBlock:
+		return renderStemBlock(ctx, e)
 	default:
 		return nil, errors.Errorf("unsupported type of element: %T", element)
 	}
@@ -155,6 +186,10 @@ func renderPlainText(ctx *renderer.Context, element interface{}) ([]byte, error)
 		return []byte("\n\n"), nil
 	case types.StringElement:
 		return []byte(element.Content), nil
+	case types.InlineStem:
+		return []byte(element.Content), nil
+	case types.InlineIcon:
+		return []byte("[" + element.Name + "]"), nil
 	case types.Paragraph:
 		return renderLines(ctx, element.Lines, PlainText())
 	default:
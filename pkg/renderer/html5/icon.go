@@ -0,0 +1,88 @@
+package html5
+
+import (
+	"bytes"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var inlineIconTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	inlineIconTmpl = newTextTemplate("inline_icon", `<span class="icon{{ if .Role }} {{ .Role }}{{ end }}">{{ if .Href }}<a class="image" href="{{ .Href }}"{{ if .Window }} target="{{ .Window }}"{{ if eq .Window "_blank" }} rel="noopener"{{ end }}{{ end }}>{{ end }}`+
+		`{{ if eq .Mode "font" }}<i class="fa fa-{{ .Name }}{{ if .Size }} fa-{{ .Size }}{{ end }}{{ if .Flip }} fa-flip-{{ .Flip }}{{ end }}{{ if .Rotate }} fa-rotate-{{ .Rotate }}{{ end }}"{{ if .Title }} title="{{ escape .Title }}"{{ end }}></i>`+
+		`{{ else if eq .Mode "image" }}<img src="{{ .Path }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Title }} title="{{ escape .Title }}"{{ end }}>`+
+		`{{ else }}[{{ .Alt }}&#93;{{ end }}{{ if .Href }}</a>{{ end }}</span>`,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+}
+
+func renderInlineIcon(ctx *renderer.Context, icon types.InlineIcon) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	alt := icon.Attributes.GetAsString(types.AttrImageAlt)
+	if alt == "" {
+		alt = icon.Name
+	}
+	err := executeTemplate(ctx, inlineIconTmpl, result, struct {
+		Mode   string
+		Name   string
+		Role   string
+		Title  string
+		Size   string
+		Flip   string
+		Rotate string
+		Width  string
+		Alt    string
+		Path   string
+		Href   string
+		Window string
+	}{
+		Mode:   iconsMode(ctx),
+		Name:   icon.Name,
+		Role:   icon.Attributes.GetAsString(types.AttrRole),
+		Title:  icon.Attributes.GetAsString(types.AttrTitle),
+		Size:   icon.Attributes.GetAsString(types.AttrIconSize),
+		Flip:   icon.Attributes.GetAsString("flip"),
+		Rotate: icon.Attributes.GetAsString("rotate"),
+		Width:  icon.Attributes.GetAsString(types.AttrImageWidth),
+		Alt:    EscapeString(alt),
+		Path:   iconImagePath(ctx, icon.Name),
+		Href:   icon.Attributes.GetAsString(types.AttrInlineLink),
+		Window: icon.Attributes.GetAsString(types.AttrLinkWindow),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render inline icon")
+	}
+	return result.Bytes(), nil
+}
+
+// iconsMode returns the value of the `icons` document attribute:
+// `font` if the icons are rendered with Font Awesome, `image` if the icons are images
+// (ie, the attribute is set to any other value), or an empty string if the attribute is not set
+// (in which case the icons are rendered as text)
+func iconsMode(ctx *renderer.Context) string {
+	icons, found := ctx.Document.Attributes.GetAsString(types.AttrIcons)
+	switch {
+	case !found:
+		return ""
+	case icons == "font":
+		return "font"
+	default:
+		return "image"
+	}
+}
+
+// iconImagePath returns the path to the image of the icon with the given name, using the
+// `iconsdir` (default is `{imagesdir}/icons` or `./images/icons`) and `icontype` (default is `png`) document attributes
+func iconImagePath(ctx *renderer.Context, name string) string {
+	iconsdir, found := ctx.Document.Attributes.GetAsString("iconsdir")
+	if !found {
+		iconsdir = ctx.Document.Attributes.GetAsStringWithDefault("imagesdir", "./images") + "/icons"
+	}
+	return iconsdir + "/" + name + "." + ctx.Document.Attributes.GetAsStringWithDefault("icontype", "png")
+}
@@ -0,0 +1,150 @@
+package html5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("icons", func() {
+
+	Context("inline icons", func() {
+
+		It("inline icon as text", func() {
+			source := "icon:heart[2x]"
+			expected := `<div class="paragraph">
+<p><span class="icon">[heart&#93;</span></p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("inline icon with font", func() {
+			source := `:icons: font
+
+I icon:heart[2x, role=red, title="I love it"] Asciidoc`
+			expected := `<div class="paragraph">
+<p>I <span class="icon red"><i class="fa fa-heart fa-2x" title="I love it"></i></span> Asciidoc</p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("inline icon with font, flip, rotate and link", func() {
+			source := `:icons: font
+
+icon:shield[rotate=90, flip=vertical, link=https://example.com, window=_blank]`
+			expected := `<div class="paragraph">
+<p><span class="icon"><a class="image" href="https://example.com" target="_blank" rel="noopener"><i class="fa fa-shield fa-flip-vertical fa-rotate-90"></i></a></span></p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("inline icon with image and default iconsdir", func() {
+			source := `:icons: image
+
+icon:heart[]`
+			expected := `<div class="paragraph">
+<p><span class="icon"><img src="./images/icons/heart.png" alt="heart"></span></p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("inline icon with image, imagesdir and icontype", func() {
+			source := `:icons:
+:imagesdir: assets
+:icontype: svg
+
+icon:heart[width=24]`
+			expected := `<div class="paragraph">
+<p><span class="icon"><img src="assets/icons/heart.svg" alt="heart" width="24"></span></p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+	})
+
+	Context("admonition icons", func() {
+
+		It("admonition paragraph with image icon and custom iconsdir", func() {
+			source := `:icons: image
+:iconsdir: static/icons
+
+NOTE: this is a note.`
+			expected := `<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<img src="static/icons/note.png" alt="Note">
+</td>
+<td class="content">
+this is a note.
+</td>
+</tr>
+</table>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("admonition block with image icon", func() {
+			source := `:icons: image
+
+[WARNING]
+====
+this is a warning.
+====`
+			expected := `<div class="admonitionblock warning">
+<table>
+<tr>
+<td class="icon">
+<img src="./images/icons/warning.png" alt="Warning">
+</td>
+<td class="content">
+<div class="paragraph">
+<p>this is a warning.</p>
+</div>
+</td>
+</tr>
+</table>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("admonition paragraph with caption override", func() {
+			source := `:tip-caption: Pro Tip
+
+TIP: this is a tip.`
+			expected := `<div class="admonitionblock tip">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Pro Tip</div>
+</td>
+<td class="content">
+this is a tip.
+</td>
+</tr>
+</table>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("admonition paragraph with font icon and caption override", func() {
+			source := `:icons: font
+:note-caption: Nota
+
+NOTE: this is a note.`
+			expected := `<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<i class="fa icon-note" title="Nota"></i>
+</td>
+<td class="content">
+this is a note.
+</td>
+</tr>
+</table>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+	})
+})
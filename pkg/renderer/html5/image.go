@@ -2,12 +2,20 @@ package html5
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
 	texttemplate "text/template"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 )
 
 var blockImageTmpl texttemplate.Template
@@ -15,9 +23,13 @@ var inlineImageTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	blockImageTmpl = newTextTemplate("block image", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="imageblock{{ if .Role }} {{ .Role }}{{ end }}">
+	// the `<img>` element, optionally wrapped in a link, shared by the block and inline images
+	img := `{{ if ne .Href "" }}<a class="image" href="{{ .Href }}"{{ if .Window }} target="{{ .Window }}"{{ if eq .Window "_blank" }} rel="noopener"{{ end }}{{ end }}>{{ end }}` +
+		`{{ if .SVG }}{{ .SVG }}{{ else }}<img src="{{ .Src }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ if .Style }} style="{{ .Style }}"{{ end }}{{ if .ImgTitle }} title="{{ escape .ImgTitle }}"{{ end }}>{{ end }}` +
+		`{{ if ne .Href "" }}</a>{{ end }}`
+	blockImageTmpl = newTextTemplate("block_image", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="imageblock{{ if .Float }} {{ .Float }}{{ end }}{{ if .Align }} text-{{ .Align }}{{ end }}{{ if .Role }} {{ .Role }}{{ end }}">
 <div class="content">
-{{ if ne .Href "" }}<a class="image" href="{{ .Href }}">{{ end }}<img src="{{ .Path }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}>{{ if ne .Href "" }}</a>{{ end }}
+`+img+`
 </div>{{ if .Title }}
 <div class="title">{{ escape .Title }}</div>
 {{ else }}
@@ -25,38 +37,67 @@ func init() {
 		texttemplate.FuncMap{
 			"escape": EscapeString,
 		})
-	inlineImageTmpl = newTextTemplate("inline image", `<span class="image{{ if .Role }} {{ .Role }}{{ end }}"><img src="{{ .Path }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ if .Title }} title="{{ escape .Title }}"{{ end }}></span>`,
+	inlineImageTmpl = newTextTemplate("inline_image", `<span class="image{{ if .Float }} {{ .Float }}{{ end }}{{ if .Role }} {{ .Role }}{{ end }}">`+img+`</span>`,
 		texttemplate.FuncMap{
 			"escape": EscapeString,
 		})
 }
 
+type imageData struct {
+	ID       string
+	Title    string
+	ImgTitle string
+	Role     string
+	Float    string
+	Align    string
+	Href     string
+	Window   string
+	Alt      string
+	Width    string
+	Height   string
+	Style    string
+	Src      string
+	SVG      string
+}
+
+func newImageData(ctx *renderer.Context, attrs types.ElementAttributes, location types.Location) imageData {
+	path := location.String()
+	href := attrs.GetAsString(types.AttrInlineLink)
+	if href == "self" {
+		href = path
+	}
+	data := imageData{
+		Role:   attrs.GetAsString(types.AttrRole),
+		Float:  attrs.GetAsString(types.AttrImageFloat),
+		Href:   href,
+		Window: attrs.GetAsString(types.AttrLinkWindow),
+		Alt:    attrs.GetAsString(types.AttrImageAlt),
+		Width:  attrs.GetAsString(types.AttrImageWidth),
+		Height: attrs.GetAsString(types.AttrImageHeight),
+		Style:  renderImageStyle(attrs),
+		Src:    path,
+	}
+	if attrs.HasOption("inline") && isSVG(attrs, path) {
+		svg, err := readSVG(ctx, path, data.Width, data.Height)
+		if err != nil {
+			log.Warnf("unable to inline SVG image '%s': %v", path, err)
+			data.SVG = `<span class="alt">` + data.Alt + `</span>`
+		} else {
+			data.SVG = svg
+		}
+	} else if ctx.Document.Attributes.Has(types.AttrDataURI) {
+		data.Src = renderDataURI(ctx, path)
+	}
+	return data
+}
+
 func renderImageBlock(ctx *renderer.Context, img types.ImageBlock) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	title := ""
-	if t := img.Attributes.GetAsString(types.AttrTitle); t != "" {
-		title = fmt.Sprintf("Figure %d. %s", ctx.GetAndIncrementImageCounter(), EscapeString(t))
-	}
-	err := blockImageTmpl.Execute(result, struct {
-		ID     string
-		Title  string
-		Role   string
-		Href   string
-		Alt    string
-		Width  string
-		Height string
-		Path   string
-	}{
-		ID:     img.Attributes.GetAsString(types.AttrID),
-		Title:  title,
-		Role:   img.Attributes.GetAsString(types.AttrRole),
-		Href:   img.Attributes.GetAsString(types.AttrInlineLink),
-		Alt:    img.Attributes.GetAsString(types.AttrImageAlt),
-		Width:  img.Attributes.GetAsString(types.AttrImageWidth),
-		Height: img.Attributes.GetAsString(types.AttrImageHeight),
-		Path:   img.Location.String(),
-	})
-
+	data := newImageData(ctx, img.Attributes, img.Location)
+	data.ID = img.Attributes.GetAsString(types.AttrID)
+	data.Align = img.Attributes.GetAsString(types.AttrImageAlign)
+	data.Title = renderImageBlockTitle(ctx, img.Attributes)
+	err := executeTemplate(ctx, blockImageTmpl, result, data)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render block image")
 	}
@@ -64,28 +105,122 @@ func renderImageBlock(ctx *renderer.Context, img types.ImageBlock) ([]byte, erro
 	return result.Bytes(), nil
 }
 
+// renderImageBlockTitle returns the title of the image block, prefixed with its caption,
+// which is either the custom `caption` attribute of the block or the `figure-caption`
+// document attribute followed by the figure number
+func renderImageBlockTitle(ctx *renderer.Context, attrs types.ElementAttributes) string {
+	title := attrs.GetAsString(types.AttrTitle)
+	if title == "" {
+		return ""
+	}
+	if caption, found := attrs[types.AttrCaption].(string); found {
+		if caption != "" {
+			// attribute values are trimmed, so the separator between the caption and the title must be restored
+			caption += " "
+		}
+		return caption + title
+	}
+	return fmt.Sprintf("%s %d. %s", ctx.Document.Attributes.GetAsStringWithDefault(types.AttrFigureCaption, "Figure"), ctx.GetAndIncrementImageCounter(), title)
+}
+
+// renderImageStyle returns the inline style of the image, based on its `scaledwidth`
+// (if no `width` was set) and `fit` attributes
+func renderImageStyle(attrs types.ElementAttributes) string {
+	styles := []string{}
+	if scaledWidth := attrs.GetAsString(types.AttrImageScaledWidth); scaledWidth != "" && !attrs.Has(types.AttrImageWidth) {
+		// a scaled width without unit is a percentage
+		if strings.TrimLeft(scaledWidth, "0123456789.") == "" {
+			scaledWidth += "%"
+		}
+		styles = append(styles, "width: "+scaledWidth+";")
+	}
+	if fit := attrs.GetAsString(types.AttrImageFit); fit != "" {
+		styles = append(styles, "object-fit: "+fit+";")
+	}
+	return strings.Join(styles, " ")
+}
+
 func renderInlineImage(ctx *renderer.Context, img types.InlineImage) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	err := inlineImageTmpl.Execute(result, struct {
-		Role   string
-		Title  string
-		Href   string
-		Alt    string
-		Width  string
-		Height string
-		Path   string
-	}{
-		Title:  renderTitle(img.Attributes),
-		Role:   img.Attributes.GetAsString(types.AttrRole),
-		Alt:    img.Attributes.GetAsString(types.AttrImageAlt),
-		Width:  img.Attributes.GetAsString(types.AttrImageWidth),
-		Height: img.Attributes.GetAsString(types.AttrImageHeight),
-		Path:   img.Location.String(),
-	})
-
+	data := newImageData(ctx, img.Attributes, img.Location)
+	data.ImgTitle = renderTitle(img.Attributes)
+	err := executeTemplate(ctx, inlineImageTmpl, result, data)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render inline image")
 	}
 	// log.Debugf("rendered inline image: %s", result.Bytes())
 	return result.Bytes(), nil
 }
+
+// renderDataURI returns the content of the image at the given path as a base64-encoded data URI,
+// or the path itself if the image could not be read
+func renderDataURI(ctx *renderer.Context, path string) string {
+	if strings.HasPrefix(path, "data:") {
+		// already embedded (eg: by a block processor)
+		return path
+	}
+	content, err := readImage(ctx, path)
+	if err != nil {
+		log.Warnf("unable to embed image '%s': %v", path, err)
+		return path
+	}
+	mimeType := mime.TypeByExtension(filepath.Ext(path))
+	if mimeType == "" {
+		mimeType = http.DetectContentType(content)
+	}
+	// drop the optional parameters, such as `charset`
+	mimeType = strings.TrimSpace(strings.Split(mimeType, ";")[0])
+	return "data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(content)
+}
+
+// readImage reads the content of the local image at the given path,
+// which is resolved relatively to the directory of the document being rendered (see `renderer.Context.ReadFile`)
+func readImage(ctx *renderer.Context, path string) ([]byte, error) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid image path")
+	}
+	switch u.Scheme {
+	case "":
+	case "file":
+		path = u.Path
+	default:
+		return nil, errors.Errorf("remote images are not supported (scheme: '%s')", u.Scheme)
+	}
+	return ctx.ReadFile(path)
+}
+
+// isSVG returns true if the image at the given path is an SVG image, based on its extension or its `format` attribute
+func isSVG(attrs types.ElementAttributes, path string) bool {
+	return attrs.GetAsString(types.AttrImageFormat) == "svg" || strings.EqualFold(filepath.Ext(path), ".svg")
+}
+
+var svgStartTagRegexp = regexp.MustCompile(`^<svg[^>]*>`)
+var svgDimensionRegexp = regexp.MustCompile(`\s(width|height)="[^"]*"`)
+
+// readSVG returns the `<svg>` element of the SVG image at the given path (ie, without the XML preamble),
+// with the `width` and `height` of the image instead of its own dimensions, if any was specified
+func readSVG(ctx *renderer.Context, path, width, height string) (string, error) {
+	content, err := readImage(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	svg := string(content)
+	start := strings.Index(svg, "<svg")
+	if start == -1 {
+		return "", errors.New("no '<svg>' element found")
+	}
+	svg = strings.TrimSpace(svg[start:])
+	if width == "" && height == "" {
+		return svg, nil
+	}
+	startTag := svgStartTagRegexp.FindString(svg)
+	dimensions := ""
+	if width != "" {
+		dimensions += ` width="` + width + `"`
+	}
+	if height != "" {
+		dimensions += ` height="` + height + `"`
+	}
+	return "<svg" + dimensions + svgDimensionRegexp.ReplaceAllString(strings.TrimPrefix(startTag, "<svg"), "") + svg[len(startTag):], nil
+}
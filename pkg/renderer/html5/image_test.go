@@ -44,6 +44,16 @@ var _ = Describe("images", func() {
 			Expect(source).To(RenderHTML5Body(expected))
 		})
 
+		It("block image with quoted alt containing a comma", func() {
+			source := `image::foo.png["Alt, with comma",100]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="Alt, with comma" width="100">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
 		It("block image with title, alt and dimensions", func() {
 			source := `[#img-foobar]
 .A title to foobar
@@ -224,6 +234,211 @@ image::file:///bar/foo.png[]`
 <div class="content">
 <img src="file:///bar/foo.png" alt="foo">
 </div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+	})
+
+	Context("alignment, float and links", func() {
+
+		It("block image with align, float and role", func() {
+			source := `[.thumb]
+image::foo.png[foo image, align=center, float=right]`
+			expected := `<div class="imageblock right text-center thumb">
+<div class="content">
+<img src="foo.png" alt="foo image">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("block image with link to self in a new window", func() {
+			source := `:imagesdir: ./assets
+
+image::foo.png[link=self, window=_blank]`
+			expected := `<div class="imageblock">
+<div class="content">
+<a class="image" href="./assets/foo.png" target="_blank" rel="noopener"><img src="./assets/foo.png" alt="foo"></a>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("block image with scaledwidth and fit", func() {
+			source := `image::foo.png[scaledwidth=50, fit=contain]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo" style="width: 50%; object-fit: contain;">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("block image with width and scaledwidth", func() {
+			source := `image::foo.png[foo, 200, scaledwidth=75mm]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo" width="200">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("inline image with float, link and window", func() {
+			source := "an image:foo.png[foo image, float=left, link=https://example.com, window=docs] here"
+			expected := `<div class="paragraph">
+<p>an <span class="image left"><a class="image" href="https://example.com" target="docs"><img src="foo.png" alt="foo image"></a></span> here</p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("inline image with imagesdir override", func() {
+			source := `:imagesdir: ./assets
+
+an image:foo.png[imagesdir=./icons] here`
+			expected := `<div class="paragraph">
+<p>an <span class="image"><img src="./icons/foo.png" alt="foo"></span> here</p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+	})
+
+	Context("captions", func() {
+
+		It("block image with custom caption", func() {
+			source := `.A title
+[caption="Fig. A: "]
+image::foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">Fig. A: A title</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("block images with custom figure caption label", func() {
+			source := `:figure-caption: Abbildung
+
+.A title
+image::foo.png[]
+
+.Another title
+image::bar.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">Abbildung 1. A title</div>
+</div>
+<div class="imageblock">
+<div class="content">
+<img src="bar.png" alt="bar">
+</div>
+<div class="title">Abbildung 2. Another title</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("block image with figure caption disabled", func() {
+			source := `:figure-caption!:
+
+.A title
+image::foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo">
+</div>
+<div class="title">A title</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+	})
+
+	Context("embedded images", func() {
+
+		It("block image as data URI", func() {
+			source := `:data-uri:
+
+image::../../../test/images/dot.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAAAAAA6fptVAAAACklEQVR4nGNgAAAAAgABSK+kcQAAAABJRU5ErkJggg==" alt="dot">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("inline image as data URI with imagesdir", func() {
+			source := `:data-uri:
+:imagesdir: ../../../test/images
+
+an image:square.svg[] here`
+			expected := `<div class="paragraph">
+<p>an <span class="image"><img src="data:image/svg+xml;base64,PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiIHN0YW5kYWxvbmU9Im5vIj8+CjwhRE9DVFlQRSBzdmcgUFVCTElDICItLy9XM0MvL0RURCBTVkcgMS4xLy9FTiIgImh0dHA6Ly93d3cudzMub3JnL0dyYXBoaWNzL1NWRy8xLjEvRFREL3N2ZzExLmR0ZCI+CjxzdmcgeG1sbnM9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIiB3aWR0aD0iMTAwIiBoZWlnaHQ9IjEwMCIgdmlld0JveD0iMCAwIDEwMCAxMDAiPjxyZWN0IHdpZHRoPSIxMDAiIGhlaWdodD0iMTAwIiBmaWxsPSIjMDAwIi8+PC9zdmc+Cg==" alt="square"></span> here</p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("missing block image as data URI", func() {
+			source := `:data-uri:
+
+image::../../../test/images/unknown.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="../../../test/images/unknown.png" alt="unknown">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("remote block image as data URI", func() {
+			source := `:data-uri:
+
+image::https://example.com/foo.png[]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="https://example.com/foo.png" alt="foo">
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("inline SVG block image", func() {
+			source := `image::../../../test/images/square.svg[opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<svg xmlns="http://www.w3.org/2000/svg" width="100" height="100" viewBox="0 0 100 100"><rect width="100" height="100" fill="#000"/></svg>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("inline SVG block image with width", func() {
+			source := `image::../../../test/images/square.svg[square, 50, opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<svg width="50" xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100"><rect width="100" height="100" fill="#000"/></svg>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("inline SVG inline image with dimensions and link", func() {
+			source := `an image:../../../test/images/square.svg[square, 20, 20, opts=inline, link=https://example.com] here`
+			expected := `<div class="paragraph">
+<p>an <span class="image"><a class="image" href="https://example.com"><svg width="20" height="20" xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100"><rect width="100" height="100" fill="#000"/></svg></a></span> here</p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("missing inline SVG block image", func() {
+			source := `image::../../../test/images/unknown.svg[opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<span class="alt">unknown</span>
+</div>
 </div>`
 			Expect(source).To(RenderHTML5Body(expected))
 		})
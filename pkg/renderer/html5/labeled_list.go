@@ -15,7 +15,7 @@ var qandaLabeledListTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	defaultLabeledListTmpl = newTextTemplate("labeled list with default layout",
+	defaultLabeledListTmpl = newTextTemplate("default_labeled_list",
 		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="dlist{{ if .Role }} {{ .Role }}{{ end }}">
 {{ if .Title }}<div class="title">{{ escape .Title }}</div>
 {{ end }}<dl>
@@ -31,7 +31,7 @@ func init() {
 			"escape":               EscapeString,
 		})
 
-	horizontalLabeledListTmpl = newTextTemplate("labeled list with horizontal layout",
+	horizontalLabeledListTmpl = newTextTemplate("horizontal_labeled_list",
 		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="hdlist{{ if .Role }} {{ .Role }}{{ end }}">
 {{ if .Title }}<div class="title">{{ escape .Title }}</div>
 {{ end }}<table>
@@ -55,7 +55,7 @@ func init() {
 			"escape":               EscapeString,
 		})
 
-	qandaLabeledListTmpl = newTextTemplate("qanda labeled list",
+	qandaLabeledListTmpl = newTextTemplate("qanda_labeled_list",
 		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="qlist qanda">
 {{ if .Title }}<div class="title">{{ escape .Title }}</div>
 {{ end }}<ol>
@@ -82,7 +82,7 @@ func renderLabeledList(ctx *renderer.Context, l types.LabeledList) ([]byte, erro
 
 	result := bytes.NewBuffer(nil)
 	// here we must preserve the HTML tags
-	err = tmpl.Execute(result, ContextualPipeline{
+	err = executeTemplate(ctx, tmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID    string
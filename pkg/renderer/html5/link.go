@@ -14,7 +14,7 @@ var linkTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	linkTmpl = newTextTemplate("external link", `<a href="{{ .URL }}"{{if .Class}} class="{{ .Class }}"{{ end }}>{{ .Text }}</a>`)
+	linkTmpl = newTextTemplate("link", `<a href="{{ .URL }}"{{if .ID}} id="{{ .ID }}"{{ end }}{{if .Class}} class="{{ .Class }}"{{ end }}>{{ .Text }}</a>`)
 }
 
 func renderLink(ctx *renderer.Context, l types.InlineLink) ([]byte, error) { //nolint: unparam
@@ -32,12 +32,14 @@ func renderLink(ctx *renderer.Context, l types.InlineLink) ([]byte, error) { //n
 		class = "bare"
 		text = []byte(location)
 	}
-	err = linkTmpl.Execute(result, struct {
+	err = executeTemplate(ctx, linkTmpl, result, struct {
 		URL   string
+		ID    string
 		Text  string
 		Class string
 	}{
 		URL:   location,
+		ID:    l.Attributes.GetAsString(types.AttrID),
 		Text:  string(text),
 		Class: class,
 	})
@@ -16,7 +16,7 @@ var literalBlockTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	literalBlockTmpl = newTextTemplate("literal block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="literalblock">
+	literalBlockTmpl = newTextTemplate("literal_block", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="literalblock">
 {{ if .Title }}<div class="title">{{ escape .Title }}</div>
 {{ end }}<div class="content">
 <pre>{{ $lines := .Lines }}{{ range $index, $line := $lines}}{{ $line }}{{ includeNewline $ctx $index $lines }}{{ end }}</pre>
@@ -58,7 +58,7 @@ func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock) ([]byte, er
 		lines = b.Lines
 	}
 	result := bytes.NewBuffer(nil)
-	err := literalBlockTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, literalBlockTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID    string
@@ -0,0 +1,96 @@
+package html5
+
+import (
+	"bytes"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var manpageHeaderTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	manpageHeaderTmpl = newTextTemplate("manpage_header", `{{ with .Data }}<h1>{{ .Header }} Manual Page</h1>
+<h2 id="{{ .NameID }}">{{ .NameTitle }}</h2>
+<div class="sectionbody">
+<p>{{ escape .Name }} - {{ escape .Purpose }}</p>
+</div>{{ end }}`,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+}
+
+// isManpage returns true if the document has the `manpage` doctype
+// (which is reset if the document structure does not conform to this doctype)
+func isManpage(ctx *renderer.Context) bool {
+	doctype, _ := ctx.Document.Attributes.GetAsString(types.AttrDocType)
+	return doctype == types.DocTypeManpage
+}
+
+// renderDocType returns the doctype of the document, used as the class of the `<body>` element
+func renderDocType(ctx *renderer.Context) string {
+	if isManpage(ctx) {
+		return types.DocTypeManpage
+	}
+	return "article"
+}
+
+// renderManpageHeader renders the title of the manpage followed by its `NAME` section,
+// or returns an empty result if the document is not a manpage
+func renderManpageHeader(ctx *renderer.Context, renderedHeader []byte) ([]byte, error) {
+	if !isManpage(ctx) {
+		return nil, nil
+	}
+	manpage, err := renderer.NewManpage(ctx.Document)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render manpage header")
+	}
+	nameTitle, err := renderInlineElements(ctx, manpage.NameSection.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render manpage header")
+	}
+	result := bytes.NewBuffer(nil)
+	err = executeTemplate(ctx, manpageHeaderTmpl, result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			Header    string
+			NameID    string
+			NameTitle string
+			Name      string
+			Purpose   string
+		}{
+			Header:    string(renderedHeader),
+			NameID:    renderElementID(manpage.NameSection.Attributes),
+			NameTitle: string(bytes.TrimSpace(nameTitle)),
+			Name:      strings.Join(manpage.Names, ", "),
+			Purpose:   manpage.Purpose,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render manpage header")
+	}
+	return result.Bytes(), nil
+}
+
+// withoutManpageNameSection returns the given elements without the `NAME` section if the document is a manpage,
+// since this section is rendered in the header
+func withoutManpageNameSection(ctx *renderer.Context, elements []interface{}) []interface{} {
+	if !isManpage(ctx) {
+		return elements
+	}
+	result := make([]interface{}, 0, len(elements))
+	nameSectionFound := false
+	for _, element := range elements {
+		if _, ok := element.(types.Section); ok && !nameSectionFound {
+			// the `NAME` section is the first section of the document
+			nameSectionFound = true
+			continue
+		}
+		result = append(result, element)
+	}
+	return result
+}
@@ -0,0 +1,120 @@
+package html5_test
+
+import (
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("manpages", func() {
+
+	source := `= git-foo(1)
+:doctype: manpage
+
+== NAME
+
+git-foo - does *foo* things
+
+== SYNOPSIS
+
+*git foo* [_options_]`
+
+	It("full manpage", func() {
+		expected := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>git-foo(1)</title>
+</head>
+<body class="manpage">
+<div id="header">
+<h1>git-foo(1) Manual Page</h1>
+<h2 id="_name">NAME</h2>
+<div class="sectionbody">
+<p>git-foo - does foo things</p>
+</div>
+</div>
+<div id="content">
+<div class="sect1">
+<h2 id="_synopsis">SYNOPSIS</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p><strong>git foo</strong> [<em>options</em>]</p>
+</div>
+</div>
+</div>
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>`
+		Expect(source).To(RenderHTML5Body(expected, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now())))
+	})
+
+	It("embedded manpage", func() {
+		expected := `<h1>git-foo(1) Manual Page</h1>
+<h2 id="_name">NAME</h2>
+<div class="sectionbody">
+<p>git-foo - does foo things</p>
+</div>
+<div class="sect1">
+<h2 id="_synopsis">SYNOPSIS</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p><strong>git foo</strong> [<em>options</em>]</p>
+</div>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected))
+	})
+
+	It("invalid manpage rendered as an article", func() {
+		source := `= git-foo
+:doctype: manpage
+
+== NAME
+
+git-foo - does foo things`
+		expected := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title>git-foo</title>
+</head>
+<body class="article">
+<div id="header">
+<h1>git-foo</h1>
+</div>
+<div id="content">
+<div class="sect1">
+<h2 id="_name">NAME</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>git-foo - does foo things</p>
+</div>
+</div>
+</div>
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>`
+		Expect(source).To(RenderHTML5Body(expected, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now())))
+	})
+})
@@ -13,7 +13,7 @@ var orderedListTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	orderedListTmpl = newTextTemplate("ordered list",
+	orderedListTmpl = newTextTemplate("ordered_list",
 		`{{ $ctx := .Context }}{{ with .Data }}{{ $items := .Items }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="olist {{ .NumberingStyle }}{{ if .Role }} {{ .Role }}{{ end}}">
 {{ if .Title }}<div class="title">{{ escape .Title }}</div>
 {{ end }}<ol class="{{ .NumberingStyle }}"{{ style .NumberingStyle }}{{ if .Start }} start="{{ .Start }}"{{ end }}>
@@ -32,7 +32,7 @@ func init() {
 
 func renderOrderedList(ctx *renderer.Context, l types.OrderedList) ([]byte, error) {
 	result := bytes.NewBuffer(nil)
-	err := orderedListTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, orderedListTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID             string
@@ -30,12 +30,12 @@ func init() {
 			"escape":      EscapeString,
 		})
 
-	admonitionParagraphTmpl = newTextTemplate("admonition paragraph",
+	admonitionParagraphTmpl = newTextTemplate("admonition_paragraph",
 		`{{ $ctx := .Context }}{{ with .Data }}{{ $renderedLines := renderLines $ctx .Lines | printf "%s" }}{{ if ne $renderedLines "" }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="admonitionblock {{ .Class }}">
 <table>
 <tr>
 <td class="icon">
-{{ if .IconClass }}<i class="fa icon-{{ .IconClass }}" title="{{ .IconTitle }}"></i>{{ else }}<div class="title">{{ .IconTitle }}</div>{{ end }}
+{{ if .IconClass }}<i class="fa icon-{{ .IconClass }}" title="{{ .IconTitle }}"></i>{{ else if .IconImage }}<img src="{{ .IconImage }}" alt="{{ .IconTitle }}">{{ else }}<div class="title">{{ .IconTitle }}</div>{{ end }}
 </td>
 <td class="content">{{ if .Title }}
 <div class="title">{{ escape .Title }}</div>{{ end }}
@@ -50,13 +50,13 @@ func init() {
 			"escape":      EscapeString,
 		})
 
-	delimitedBlockParagraphTmpl = newTextTemplate("delimited block paragraph",
+	delimitedBlockParagraphTmpl = newTextTemplate("delimited_block_paragraph",
 		`{{ $ctx := .Context }}{{ with .Data }}<p>{{ .CheckStyle }}{{ renderLines $ctx .Lines | printf "%s" }}</p>{{ end }}`,
 		texttemplate.FuncMap{
 			"renderLines": renderLines,
 		})
 
-	sourceParagraphTmpl = newTextTemplate("source paragraph",
+	sourceParagraphTmpl = newTextTemplate("source_paragraph",
 		`{{ $ctx := .Context }}{{ with .Data }}<div class="listingblock">
 <div class="content">
 <pre class="highlight">{{ if .Language }}<code class="language-{{ .Language }}" data-lang="{{ .Language }}">{{ else }}<code>{{ end }}{{ renderLines $ctx .Lines | printf "%s" }}</code></pre>
@@ -67,7 +67,7 @@ func init() {
 			"escape":      EscapeString,
 		})
 
-	verseParagraphTmpl = newTextTemplate("verse paragraph", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="verseblock">{{ if .Title }}
+	verseParagraphTmpl = newTextTemplate("verse_paragraph", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="verseblock">{{ if .Title }}
 <div class="title">{{ escape .Title }}</div>{{ end }}
 <pre class="content">{{ renderLines $ctx .Lines plainText | printf "%s" }}</pre>{{ if .Attribution.First }}
 <div class="attribution">
@@ -80,7 +80,7 @@ func init() {
 			"plainText":   PlainText,
 			"escape":      EscapeString,
 		})
-	quoteParagraphTmpl = newTextTemplate("quote paragraph", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="quoteblock">{{ if .Title }}
+	quoteParagraphTmpl = newTextTemplate("quote_paragraph", `{{ $ctx := .Context }}{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="quoteblock">{{ if .Title }}
 <div class="title">{{ escape .Title }}</div>{{ end }}
 <blockquote>
 {{ renderLines $ctx .Lines | printf "%s" }}
@@ -116,7 +116,7 @@ func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 		return renderDelimitedBlockParagraph(ctx, p)
 	} else {
 		log.Debug("rendering a standalone paragraph")
-		err = paragraphTmpl.Execute(result, ContextualPipeline{
+		err = executeTemplate(ctx, paragraphTmpl, result, ContextualPipeline{
 			Context: ctx,
 			Data: struct {
 				ID         string
@@ -147,7 +147,7 @@ func renderAdmonitionParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte
 	if !ok {
 		return nil, errors.Errorf("failed to render admonition with unknown kind: %T", p.Attributes[types.AttrAdmonitionKind])
 	}
-	err := admonitionParagraphTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, admonitionParagraphTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID        string
@@ -155,13 +155,15 @@ func renderAdmonitionParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte
 			Class     string
 			IconTitle string
 			IconClass string
+			IconImage string
 			Lines     [][]interface{}
 		}{
 			ID:        renderElementID(p.Attributes),
 			Title:     renderTitle(p.Attributes),
 			Class:     renderClass(k),
-			IconTitle: renderIconTitle(k),
+			IconTitle: renderIconTitle(ctx, k),
 			IconClass: renderIconClass(ctx, k),
+			IconImage: renderIconImage(ctx, k),
 			Lines:     p.Lines,
 		},
 	})
@@ -171,7 +173,7 @@ func renderAdmonitionParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte
 func renderSourceParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 	log.Debug("rendering source paragraph...")
 	result := bytes.NewBuffer(nil)
-	err := sourceParagraphTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, sourceParagraphTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID       string
@@ -191,7 +193,7 @@ func renderSourceParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, er
 func renderVerseParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 	log.Debug("rendering verse paragraph...")
 	result := bytes.NewBuffer(nil)
-	err := verseParagraphTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, verseParagraphTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID          string
@@ -211,7 +213,7 @@ func renderVerseParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, err
 func renderQuoteParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 	log.Debug("rendering quote paragraph...")
 	result := bytes.NewBuffer(nil)
-	err := quoteParagraphTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, quoteParagraphTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID          string
@@ -231,7 +233,7 @@ func renderQuoteParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, err
 func renderDelimitedBlockParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
 	log.Debugf("rendering paragraph with %d line(s) within a delimited block or a list", len(p.Lines))
 	result := bytes.NewBuffer(nil)
-	err := delimitedBlockParagraphTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, delimitedBlockParagraphTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID         string
@@ -260,12 +262,19 @@ func renderCheckStyle(style interface{}) string {
 }
 
 func renderIconClass(ctx *renderer.Context, kind types.AdmonitionKind) string {
-	if icons, _ := ctx.Document.Attributes.GetAsString("icons"); icons == "font" {
+	if iconsMode(ctx) == "font" {
 		return renderClass(kind)
 	}
 	return ""
 }
 
+func renderIconImage(ctx *renderer.Context, kind types.AdmonitionKind) string {
+	if iconsMode(ctx) == "image" {
+		return iconImagePath(ctx, renderClass(kind))
+	}
+	return ""
+}
+
 func renderClass(kind types.AdmonitionKind) string {
 	switch kind {
 	case types.Tip:
@@ -284,7 +293,12 @@ func renderClass(kind types.AdmonitionKind) string {
 	}
 }
 
-func renderIconTitle(kind types.AdmonitionKind) string {
+// renderIconTitle returns the caption of the given kind of admonition, which can be overridden
+// with the `<kind>-caption` document attribute (eg: `note-caption`)
+func renderIconTitle(ctx *renderer.Context, kind types.AdmonitionKind) string {
+	if caption, found := ctx.Document.Attributes.GetAsString(renderClass(kind) + "-caption"); found {
+		return EscapeString(caption)
+	}
 	switch kind {
 	case types.Tip:
 		return "Tip"
@@ -18,11 +18,11 @@ var superscriptTextTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	boldTextTmpl = newTextTemplate("bold text", "<strong>{{ . }}</strong>")
-	italicTextTmpl = newTextTemplate("italic text", "<em>{{ . }}</em>")
-	monospaceTextTmpl = newTextTemplate("monospace text", "<code>{{ . }}</code>")
-	subscriptTextTmpl = newTextTemplate("subscript text", "<sub>{{ . }}</sub>")
-	superscriptTextTmpl = newTextTemplate("superscript text", "<sup>{{ . }}</sup>")
+	boldTextTmpl = newTextTemplate("bold_text", "<strong>{{ . }}</strong>")
+	italicTextTmpl = newTextTemplate("italic_text", "<em>{{ . }}</em>")
+	monospaceTextTmpl = newTextTemplate("monospace_text", "<code>{{ . }}</code>")
+	subscriptTextTmpl = newTextTemplate("subscript_text", "<sub>{{ . }}</sub>")
+	superscriptTextTmpl = newTextTemplate("superscript_text", "<sup>{{ . }}</sup>")
 }
 
 func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error) {
@@ -53,7 +53,7 @@ func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error)
 	default:
 		return nil, errors.Errorf("unsupported quoted text kind: '%v'", t.Kind)
 	}
-	err := tmpl.Execute(result, template.HTML(elementsBuffer.String())) //nolint: gosec
+	err := executeTemplate(ctx, tmpl, result, template.HTML(elementsBuffer.String())) //nolint: gosec
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to render monospaced quote")
 	}
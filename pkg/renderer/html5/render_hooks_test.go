@@ -0,0 +1,76 @@
+package html5_test
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("render hooks", func() {
+
+	It("should wrap the default rendering of a table", func() {
+		hook := renderer.DefineRenderHook(types.Table{}, func(ctx *renderer.Context, element interface{}, next renderer.RenderFunc) ([]byte, error) {
+			result, err := next(ctx, element)
+			if err != nil {
+				return nil, err
+			}
+			return []byte("<div class=\"scroll\">\n" + string(result) + "\n</div>"), nil
+		})
+		source := `|===
+| cell
+|===`
+		expected := `<div class="scroll">
+<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 100%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">cell</p></td>
+</tr>
+</tbody>
+</table>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, hook))
+	})
+
+	It("should replace the rendering of the images, including in the sections", func() {
+		hook := renderer.DefineRenderHook(types.ImageBlock{}, func(ctx *renderer.Context, element interface{}, next renderer.RenderFunc) ([]byte, error) {
+			img := element.(types.ImageBlock)
+			return []byte(fmt.Sprintf(`<picture><source srcset="%[1]s.webp" type="image/webp"><img src="%[1]s.png" alt="%[2]s"></picture>`,
+				img.Location.String(), img.Attributes.GetAsString(types.AttrImageAlt))), nil
+		})
+		source := `image::foo[bar]
+
+== A Section
+
+image::baz[]`
+		expected := `<picture><source srcset="foo.webp" type="image/webp"><img src="foo.png" alt="bar"></picture>
+<div class="sect1">
+<h2 id="_a_section">A Section</h2>
+<div class="sectionbody">
+<picture><source srcset="baz.webp" type="image/webp"><img src="baz.png" alt="baz"></picture>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, hook))
+	})
+
+	It("should replace the rendering of inline elements", func() {
+		hook := renderer.DefineRenderHook(types.QuotedText{}, func(ctx *renderer.Context, element interface{}, next renderer.RenderFunc) ([]byte, error) {
+			if element.(types.QuotedText).Kind == types.Bold {
+				return next(ctx, element.(types.QuotedText).Elements)
+			}
+			return next(ctx, element)
+		})
+		source := `some *bold* and _italic_ content`
+		expected := `<div class="paragraph">
+<p>some bold and <em>italic</em> content</p>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, hook))
+	})
+})
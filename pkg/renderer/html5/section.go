@@ -29,7 +29,7 @@ func init() {
 		texttemplate.FuncMap{
 			"renderElements": renderElements,
 		})
-	section1ContentTmpl = newTextTemplate("section 1",
+	section1ContentTmpl = newTextTemplate("section1_content",
 		`{{ $ctx := .Context }}{{ with .Data }}<div class="{{ .Class }}">
 {{ .SectionTitle }}
 <div class="sectionbody">{{ $elements := renderElements $ctx .Elements | printf "%s" }}{{ if $elements }}
@@ -39,7 +39,7 @@ func init() {
 		texttemplate.FuncMap{
 			"renderElements": renderElements,
 		})
-	otherSectionContentTmpl = newTextTemplate("other section",
+	otherSectionContentTmpl = newTextTemplate("other_section_content",
 		`{{ $ctx := .Context }}{{ with .Data }}<div class="{{ .Class }}">
 {{ .SectionTitle }}{{ $elements := renderElements $ctx .Elements | printf "%s" }}{{ if $elements }}
 {{ $elements }}{{ end }}
@@ -47,7 +47,7 @@ func init() {
 		texttemplate.FuncMap{
 			"renderElements": renderElements,
 		})
-	sectionHeaderTmpl = newTextTemplate("other sectionTitle",
+	sectionHeaderTmpl = newTextTemplate("section_header",
 		`<h{{ .Level }} id="{{ .ID }}">{{ .Content }}</h{{ .Level }}>`)
 }
 
@@ -60,7 +60,7 @@ func renderPreamble(ctx *renderer.Context, p types.Preamble) ([]byte, error) {
 	if _, ok := ctx.Document.Title(); ok {
 		wrapper = true
 	}
-	err := preambleTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, preambleTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			Wrapper  bool
@@ -91,7 +91,7 @@ func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
 	} else {
 		tmpl = otherSectionContentTmpl
 	}
-	err = tmpl.Execute(result, ContextualPipeline{
+	err = executeTemplate(ctx, tmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			Class        string
@@ -117,7 +117,7 @@ func renderSectionTitle(ctx *renderer.Context, s types.Section) (string, error)
 	}
 	renderedContentStr := strings.TrimSpace(string(renderedContent))
 	id := renderElementID(s.Attributes)
-	err = sectionHeaderTmpl.Execute(result, struct {
+	err = executeTemplate(ctx, sectionHeaderTmpl, result, struct {
 		Level   int
 		ID      string
 		Content string
@@ -0,0 +1,77 @@
+package html5
+
+import (
+	"bytes"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/mathml"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var stemBlockTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	stemBlockTmpl = newTextTemplate("stem_block", `{{ with .Data }}<div {{ if .ID }}id="{{ .ID }}" {{ end }}class="stemblock">
+{{ if .Title }}<div class="title">{{ escape .Title }}</div>
+{{ end }}<div class="content">
+{{ .Content }}
+</div>
+</div>{{ end }}`, texttemplate.FuncMap{
+		"escape": EscapeString,
+	})
+}
+
+func renderInlineStem(ctx *renderer.Context, s types.InlineStem) ([]byte, error) {
+	return []byte(renderStem(ctx, ctx.StemNotation(s.Notation), s.Content, mathml.Inline)), nil
+}
+
+func renderStemBlock(ctx *renderer.Context, b types.StemBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := executeTemplate(ctx, stemBlockTmpl, result, ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			ID      string
+			Title   string
+			Content string
+		}{
+			ID:      renderElementID(b.Attributes),
+			Title:   renderTitle(b.Attributes),
+			Content: renderStem(ctx, ctx.StemNotation(b.Notation), strings.Join(b.Lines, "\n"), mathml.Block),
+		}})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render stem block")
+	}
+	return result.Bytes(), nil
+}
+
+// renderStem renders the given STEM expression, converted into MathML if the renderer was configured to do so,
+// or surrounded by the MathJax delimiters otherwise (or if the conversion to MathML failed)
+func renderStem(ctx *renderer.Context, notation types.StemNotation, content string, display mathml.Display) string {
+	if ctx.StemRendering() == renderer.MathML {
+		var result string
+		var err error
+		switch notation {
+		case types.LaTeXMath:
+			result, err = mathml.FromLaTeX(content, display)
+		default:
+			result, err = mathml.FromAsciiMath(content, display)
+		}
+		if err == nil {
+			return result
+		}
+		log.Warnf("unable to convert '%s' into MathML, falling back to MathJax delimiters: %v", content, err)
+	}
+	switch {
+	case notation == types.LaTeXMath && display == mathml.Block:
+		return `\[` + EscapeString(content) + `\]`
+	case notation == types.LaTeXMath:
+		return `\(` + EscapeString(content) + `\)`
+	default:
+		return `\$` + EscapeString(content) + `\$`
+	}
+}
@@ -0,0 +1,186 @@
+package html5_test
+
+import (
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("stem", func() {
+
+	Context("mathjax rendering", func() {
+
+		It("inline stem with default notation", func() {
+			source := `:stem:
+
+the formula stem:[sqrt(4) = 2] is true`
+			expected := `<div class="paragraph">
+<p>the formula \$sqrt(4) = 2\$ is true</p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("inline stem with latexmath as the default notation", func() {
+			source := `:stem: latexmath
+
+stem:[x < \frac{1}{2}]`
+			expected := `<div class="paragraph">
+<p>\(x &lt; \frac{1}{2}\)</p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("inline latexmath and asciimath", func() {
+			source := `latexmath:[\alpha] and asciimath:[alpha]`
+			expected := `<div class="paragraph">
+<p>\(\alpha\) and \$alpha\$</p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("stem block", func() {
+			source := `[stem]
+++++
+sqrt(4) = 2
+++++`
+			expected := `<div class="stemblock">
+<div class="content">
+\$sqrt(4) = 2\$
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("latexmath block with id and title", func() {
+			source := `[[formula]]
+.The formula
+[latexmath]
+++++
+\sum_{i=1}^{n} i
+++++`
+			expected := `<div id="formula" class="stemblock">
+<div class="title">The formula</div>
+<div class="content">
+\[\sum_{i=1}^{n} i\]
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected))
+		})
+
+		It("mathjax script in full document", func() {
+			source := `:stem:
+
+stem:[x]`
+			expected := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title></title>
+</head>
+<body class="article">
+<div id="header">
+<h1></h1>
+</div>
+<div id="content">
+<div class="paragraph">
+<p>\$x\$</p>
+</div>
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+<script type="text/x-mathjax-config">
+MathJax.Hub.Config({
+  messageStyle: "none",
+  tex2jax: {
+    inlineMath: [["\\(", "\\)"]],
+    displayMath: [["\\[", "\\]"]],
+    ignoreClass: "nostem|nolatexmath"
+  },
+  asciimath2jax: {
+    delimiters: [["\\$", "\\$"]],
+    ignoreClass: "nostem|noasciimath"
+  },
+  TeX: { equationNumbers: { autoNumber: "none" } }
+})
+</script>
+<script src="https://cdnjs.cloudflare.com/ajax/libs/mathjax/2.7.4/MathJax.js?config=TeX-MML-AM_HTMLorMML"></script>
+</body>
+</html>`
+			Expect(source).To(RenderHTML5Body(expected, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now())))
+		})
+	})
+
+	Context("mathml rendering", func() {
+
+		It("inline asciimath", func() {
+			source := `asciimath:[sqrt(4) = 2]`
+			expected := `<div class="paragraph">
+<p><math xmlns="http://www.w3.org/1998/Math/MathML"><msqrt><mn>4</mn></msqrt><mo>=</mo><mn>2</mn></math></p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected, renderer.StemRendering(renderer.MathML)))
+		})
+
+		It("latexmath block", func() {
+			source := `[latexmath]
+++++
+\frac{a}{b}
+++++`
+			expected := `<div class="stemblock">
+<div class="content">
+<math xmlns="http://www.w3.org/1998/Math/MathML" display="block"><mfrac><mi>a</mi><mi>b</mi></mfrac></math>
+</div>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected, renderer.StemRendering(renderer.MathML)))
+		})
+
+		It("fallback to mathjax delimiters on unsupported latex", func() {
+			source := `latexmath:[\unknown{x}]`
+			expected := `<div class="paragraph">
+<p>\(\unknown{x}\)</p>
+</div>`
+			Expect(source).To(RenderHTML5Body(expected, renderer.StemRendering(renderer.MathML)))
+		})
+
+		It("no mathjax script in full document", func() {
+			source := `:stem:
+
+stem:[x]`
+			expected := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<!--[if IE]><meta http-equiv="X-UA-Compatible" content="IE=edge"><![endif]-->
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title></title>
+</head>
+<body class="article">
+<div id="header">
+<h1></h1>
+</div>
+<div id="content">
+<div class="paragraph">
+<p><math xmlns="http://www.w3.org/1998/Math/MathML"><mi>x</mi></math></p>
+</div>
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>`
+			Expect(source).To(RenderHTML5Body(expected, renderer.IncludeHeaderFooter(true), renderer.LastUpdated(time.Now()), renderer.StemRendering(renderer.MathML)))
+		})
+	})
+})
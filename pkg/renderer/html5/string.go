@@ -10,14 +10,14 @@ import (
 	"github.com/pkg/errors"
 )
 
-var stringTmpl = newTextTemplate("string element", "{{ escape . }}",
+var stringTmpl = newTextTemplate("string", "{{ escape . }}",
 	texttemplate.FuncMap{
 		"escape": EscapeString,
 	})
 
 func renderStringElement(ctx *renderer.Context, str types.StringElement) ([]byte, error) { //nolint: unparam
 	buf := bytes.NewBuffer(nil)
-	err := stringTmpl.Execute(buf, str.Content)
+	err := executeTemplate(ctx, stringTmpl, buf, str.Content)
 	if err != nil {
 		return []byte{}, errors.Wrapf(err, "unable to render string")
 	}
@@ -63,7 +63,7 @@ func renderTable(ctx *renderer.Context, t types.Table) ([]byte, error) {
 	if titleAttr, ok := t.Attributes[types.AttrTitle].(string); ok {
 		title = fmt.Sprintf("Table %d. %s", ctx.GetAndIncrementTableCounter(), EscapeString(titleAttr))
 	}
-	err := tableTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, tableTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			Title      string
@@ -17,11 +17,11 @@ var tableOfContentTmpl texttemplate.Template
 var tableOfContentSectionSetTmpl texttemplate.Template
 
 func init() {
-	tableOfContentTmpl = newTextTemplate("toc", `<div id="toc" class="toc">
+	tableOfContentTmpl = newTextTemplate("table_of_content", `<div id="toc" class="toc">
 <div id="toctitle">Table of Contents</div>
 {{ .Content }}
 </div>`)
-	tableOfContentSectionSetTmpl = newTextTemplate("toc section", `<ul class="sectlevel{{ .Level }}">
+	tableOfContentSectionSetTmpl = newTextTemplate("table_of_content_section_set", `<ul class="sectlevel{{ .Level }}">
 {{ range .Elements }}<li><a href="#{{ .Href }}">{{ .Title }}</a>{{ if .Elements }}
 {{ .Elements }}
 </li>{{else}}</li>{{end}}
@@ -58,7 +58,7 @@ func renderTableOfContents(ctx *renderer.Context, m types.TableOfContentsMacro)
 		return []byte{}, nil
 	}
 	result := bytes.NewBuffer(nil)
-	err = tableOfContentTmpl.Execute(result, TableOfContents{
+	err = executeTemplate(ctx, tableOfContentTmpl, result, TableOfContents{
 		Content: renderedSections,
 	})
 	if err != nil {
@@ -107,7 +107,7 @@ func renderTableOfContentsSections(ctx *renderer.Context, elements []interface{}
 		return template.HTML(""), nil
 	}
 	resultBuf := bytes.NewBuffer(nil)
-	err := tableOfContentSectionSetTmpl.Execute(resultBuf, TableOfContentsSectionGroup{
+	err := executeTemplate(ctx, tableOfContentSectionSetTmpl, resultBuf, TableOfContentsSectionGroup{
 		Level:    sections[0].Level,
 		Elements: sections,
 	})
@@ -1,19 +1,96 @@
 package html5
 
 import (
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
 	texttemplate "text/template"
 
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
 
+// the default templates, by kind of element (ie, by name)
+var defaultTemplates = map[string]texttemplate.Template{}
+
 func newTextTemplate(name, src string, funcs ...texttemplate.FuncMap) texttemplate.Template {
-	t := texttemplate.New(name)
-	for _, f := range funcs {
-		t.Funcs(f)
+	t := renderer.NewTextTemplate(name, src, funcs...)
+	if _, found := defaultTemplates[name]; found {
+		log.Fatalf("failed to initialize '%s' template: duplicate name", name)
+	}
+	defaultTemplates[name] = t
+	return t
+}
+
+// TemplateKinds returns the kinds of elements whose template can be overridden with a file in the directory given by
+// the `renderer.TemplateDir` option (eg: `paragraph` for a `paragraph.tmpl` file), in alphabetical order
+func TemplateKinds() []string {
+	result := make([]string, 0, len(defaultTemplates))
+	for kind := range defaultTemplates {
+		result = append(result, kind)
 	}
-	t, err := t.Parse(src)
+	sort.Strings(result)
+	return result
+}
+
+// executeTemplate applies the given template (or the template which overrides it) to the given data,
+// and writes the output in the given writer
+func executeTemplate(ctx *renderer.Context, t texttemplate.Template, wr io.Writer, data interface{}) error {
+	if ctx.TemplateDir() == "" {
+		return t.Execute(wr, data)
+	}
+	templates, err := loadOverrideTemplates(ctx)
 	if err != nil {
-		log.Fatalf("failed to initialize '%s' template: %s", name, err.Error())
+		return err
+	}
+	if override, found := templates[t.Name()]; found {
+		return override.Execute(wr, data)
+	}
+	return t.Execute(wr, data)
+}
+
+// the extension of the files of the override templates
+const templateExt = ".tmpl"
+
+// loadOverrideTemplates loads the templates in the directory given by the `renderer.TemplateDir` option,
+// or returns the templates which were already loaded in the given context.
+// Each template is parsed with the same funcs as the default template that it overrides.
+func loadOverrideTemplates(ctx *renderer.Context) (map[string]*texttemplate.Template, error) {
+	if templates, found := ctx.OverrideTemplates(); found {
+		return templates, nil
+	}
+	dir := ctx.TemplateDir()
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to load the templates in '%s'", dir)
+	}
+	templates := map[string]*texttemplate.Template{}
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != templateExt {
+			continue
+		}
+		kind := strings.TrimSuffix(f.Name(), templateExt)
+		t, found := defaultTemplates[kind]
+		if !found {
+			return nil, errors.Errorf("unable to load the template in '%s': unknown kind of template '%s'", filepath.Join(dir, f.Name()), kind)
+		}
+		src, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to load the template in '%s'", filepath.Join(dir, f.Name()))
+		}
+		override, err := t.Clone()
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to load the template in '%s'", filepath.Join(dir, f.Name()))
+		}
+		if override, err = override.Parse(string(src)); err != nil {
+			return nil, errors.Wrapf(err, "unable to load the template in '%s'", filepath.Join(dir, f.Name()))
+		}
+		log.Debugf("loaded the '%s' template from '%s'", kind, dir)
+		templates[kind] = override
 	}
-	return *t
+	ctx.SetOverrideTemplates(templates)
+	return templates, nil
 }
@@ -0,0 +1,101 @@
+package html5_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("template overrides", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "libasciidoc-templates")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	writeTemplate := func(name, content string) {
+		err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("should list the kinds of templates", func() {
+		Expect(html5.TemplateKinds()).To(ContainElement("document"))
+		Expect(html5.TemplateKinds()).To(ContainElement("paragraph"))
+		Expect(html5.TemplateKinds()).To(ContainElement("section_header"))
+		Expect(html5.TemplateKinds()).To(ContainElement("table"))
+	})
+
+	It("should override the paragraph template with the default funcs", func() {
+		writeTemplate("paragraph.tmpl", `{{ $ctx := .Context }}{{ with .Data }}<p class="custom">{{ renderLines $ctx .Lines .HardBreaks | printf "%s" }}</p>{{ end }}`)
+		source := `some *bold* content
+
+== A Section
+
+more content`
+		expected := `<p class="custom">some <strong>bold</strong> content</p>
+<div class="sect1">
+<h2 id="_a_section">A Section</h2>
+<div class="sectionbody">
+<p class="custom">more content</p>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, renderer.TemplateDir(dir)))
+	})
+
+	It("should override the section header template", func() {
+		writeTemplate("section_header.tmpl", `<h{{ .Level }} id="{{ .ID }}" class="title">{{ .Content }} <a class="anchor" href="#{{ .ID }}">#</a></h{{ .Level }}>`)
+		source := `== A Section`
+		expected := `<div class="sect1">
+<h2 id="_a_section" class="title">A Section <a class="anchor" href="#_a_section">#</a></h2>
+<div class="sectionbody">
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, renderer.TemplateDir(dir)))
+	})
+
+	It("should ignore the other files", func() {
+		writeTemplate("README.txt", `not a template`)
+		source := `some content`
+		expected := `<div class="paragraph">
+<p>some content</p>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected, renderer.TemplateDir(dir)))
+	})
+
+	It("should fail with an unknown kind of template", func() {
+		writeTemplate("unknown.tmpl", `{{ . }}`)
+		_, err := libasciidoc.ConvertToHTML(context.Background(), "test.adoc", strings.NewReader("some content"), bytes.NewBuffer(nil), renderer.TemplateDir(dir))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unknown kind of template 'unknown'"))
+	})
+
+	It("should fail with an invalid template", func() {
+		writeTemplate("paragraph.tmpl", `{{ if }}`)
+		_, err := libasciidoc.ConvertToHTML(context.Background(), "test.adoc", strings.NewReader("some content"), bytes.NewBuffer(nil), renderer.TemplateDir(dir))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unable to load the template in '" + filepath.Join(dir, "paragraph.tmpl") + "'"))
+	})
+
+	It("should fail with an unknown directory", func() {
+		_, err := libasciidoc.ConvertToHTML(context.Background(), "test.adoc", strings.NewReader("some content"), bytes.NewBuffer(nil), renderer.TemplateDir(filepath.Join(dir, "unknown")))
+		Expect(err).To(HaveOccurred())
+	})
+})
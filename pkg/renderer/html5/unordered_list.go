@@ -13,7 +13,7 @@ var unorderedListTmpl texttemplate.Template
 
 // initializes the templates
 func init() {
-	unorderedListTmpl = newTextTemplate("unordered list",
+	unorderedListTmpl = newTextTemplate("unordered_list",
 		`{{ $ctx := .Context }}{{ with .Data }}<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="ulist{{ if .Checklist }} checklist{{ end }}{{ if .Role }} {{ .Role }}{{ end}}">
 {{ if .Title }}<div class="title">{{ escape .Title }}</div>
 {{ end }}<ul{{ if .Checklist }} class="checklist"{{ end }}>
@@ -38,7 +38,7 @@ func renderUnorderedList(ctx *renderer.Context, l types.UnorderedList) ([]byte,
 	}
 	result := bytes.NewBuffer(nil)
 	// here we must preserve the HTML tags
-	err := unorderedListTmpl.Execute(result, ContextualPipeline{
+	err := executeTemplate(ctx, unorderedListTmpl, result, ContextualPipeline{
 		Context: ctx,
 		Data: struct {
 			ID        string
@@ -0,0 +1,137 @@
+package html5
+
+import (
+	"bytes"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+var videoBlockTmpl texttemplate.Template
+var embeddedVideoBlockTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	videoBlockTmpl = newTextTemplate("video_block", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="videoblock{{ if .Role }} {{ .Role }}{{ end }}">{{ if .Title }}
+<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="content">
+<video src="{{ .Path }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ if .Poster }} poster="{{ .Poster }}"{{ end }}{{ if .Autoplay }} autoplay{{ end }}{{ if .Loop }} loop{{ end }}{{ if .Controls }} controls{{ end }}>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+	embeddedVideoBlockTmpl = newTextTemplate("embedded_video_block", `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="videoblock{{ if .Role }} {{ .Role }}{{ end }}">{{ if .Title }}
+<div class="title">{{ escape .Title }}</div>{{ end }}
+<div class="content">
+<iframe{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }} src="{{ .Path }}" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>`,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+}
+
+func renderVideoBlock(ctx *renderer.Context, v types.VideoBlock) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	data := struct {
+		ID       string
+		Title    string
+		Role     string
+		Path     string
+		Width    string
+		Height   string
+		Poster   string
+		Autoplay bool
+		Loop     bool
+		Controls bool
+	}{
+		ID:       renderElementID(v.Attributes),
+		Title:    renderTitle(v.Attributes),
+		Role:     v.Attributes.GetAsString(types.AttrRole),
+		Width:    v.Attributes.GetAsString(types.AttrImageWidth),
+		Height:   v.Attributes.GetAsString(types.AttrImageHeight),
+		Autoplay: v.Attributes.HasOption("autoplay"),
+		Loop:     v.Attributes.HasOption("loop"),
+		Controls: !v.Attributes.HasOption("nocontrols"),
+	}
+	var err error
+	switch v.Provider() {
+	case types.YouTube:
+		data.Path = youtubeURL(v)
+		err = executeTemplate(ctx, embeddedVideoBlockTmpl, result, data)
+	case types.Vimeo:
+		data.Path = vimeoURL(v)
+		err = executeTemplate(ctx, embeddedVideoBlockTmpl, result, data)
+	default:
+		data.Path = v.Location.String() + mediaFragment(v.Attributes)
+		data.Poster = v.Attributes.GetAsString(types.AttrVideoPoster)
+		err = executeTemplate(ctx, videoBlockTmpl, result, data)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render video block")
+	}
+	return result.Bytes(), nil
+}
+
+// mediaFragment returns the temporal fragment (`#t=<start>,<end>`) of a video or an audio, based on the
+// `start` and `end` attributes, or an empty string if none was set
+func mediaFragment(attrs types.ElementAttributes) string {
+	start := attrs.GetAsString(types.AttrStart)
+	end := attrs.GetAsString(types.AttrEnd)
+	switch {
+	case start != "" && end != "":
+		return "#t=" + start + "," + end
+	case start != "":
+		return "#t=" + start
+	case end != "":
+		return "#t=," + end
+	default:
+		return ""
+	}
+}
+
+func youtubeURL(v types.VideoBlock) string {
+	id := v.Location.String()
+	params := []string{"rel=0"}
+	if start := v.Attributes.GetAsString(types.AttrStart); start != "" {
+		params = append(params, "start="+start)
+	}
+	if end := v.Attributes.GetAsString(types.AttrEnd); end != "" {
+		params = append(params, "end="+end)
+	}
+	if v.Attributes.HasOption("autoplay") {
+		params = append(params, "autoplay=1")
+	}
+	if v.Attributes.HasOption("loop") {
+		// the `playlist` parameter is required to loop on a single video
+		params = append(params, "loop=1", "playlist="+id)
+	}
+	if v.Attributes.HasOption("nocontrols") {
+		params = append(params, "controls=0")
+	}
+	return "https://www.youtube.com/embed/" + id + "?" + strings.Join(params, "&amp;")
+}
+
+func vimeoURL(v types.VideoBlock) string {
+	result := "https://player.vimeo.com/video/" + v.Location.String()
+	params := []string{}
+	if v.Attributes.HasOption("autoplay") {
+		params = append(params, "autoplay=1")
+	}
+	if v.Attributes.HasOption("loop") {
+		params = append(params, "loop=1")
+	}
+	if len(params) > 0 {
+		result = result + "?" + strings.Join(params, "&amp;")
+	}
+	if start := v.Attributes.GetAsString(types.AttrStart); start != "" {
+		result = result + "#at=" + start
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package html5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("videos", func() {
+
+	It("video block without attributes", func() {
+		source := "video::videos/foo.mp4[]"
+		expected := `<div class="videoblock">
+<div class="content">
+<video src="videos/foo.mp4" controls>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected))
+	})
+
+	It("video block with id, title, poster, dimensions, start, end and options", func() {
+		source := `[#demo]
+[.featured]
+.A demo
+video::videos/foo.mp4[foo.png, 640, 480, start=10, end=20, options="autoplay,loop,nocontrols"]`
+		expected := `<div id="demo" class="videoblock featured">
+<div class="title">A demo</div>
+<div class="content">
+<video src="videos/foo.mp4#t=10,20" width="640" height="480" poster="foo.png" autoplay loop>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected))
+	})
+
+	It("video block with quoted poster containing a comma", func() {
+		source := `video::videos/foo.mp4["foo, bar.png", 640]`
+		expected := `<div class="videoblock">
+<div class="content">
+<video src="videos/foo.mp4" width="640" poster="foo, bar.png" controls>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected))
+	})
+
+	It("video block with imagesdir", func() {
+		source := `:imagesdir: media
+
+video::foo.mp4[end=30]`
+		expected := `<div class="videoblock">
+<div class="content">
+<video src="media/foo.mp4#t=,30" controls>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected))
+	})
+
+	It("youtube video", func() {
+		source := `video::rPQoq7ThGAU[youtube, 640, 360, start=30, opts="autoplay,loop"]`
+		expected := `<div class="videoblock">
+<div class="content">
+<iframe width="640" height="360" src="https://www.youtube.com/embed/rPQoq7ThGAU?rel=0&amp;start=30&amp;autoplay=1&amp;loop=1&amp;playlist=rPQoq7ThGAU" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected))
+	})
+
+	It("vimeo video", func() {
+		source := `video::67480300[vimeo, start=60, options=autoplay]`
+		expected := `<div class="videoblock">
+<div class="content">
+<iframe src="https://player.vimeo.com/video/67480300?autoplay=1#at=60" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>`
+		Expect(source).To(RenderHTML5Body(expected))
+	})
+})
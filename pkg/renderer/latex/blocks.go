@@ -0,0 +1,335 @@
+package latex
+
+import (
+	"bytes"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// the sectioning commands of the `article` class, starting with the level 1 sections
+var articleSections = []string{`\section`, `\subsection`, `\subsubsection`, `\paragraph`, `\subparagraph`}
+
+// the sectioning commands of the `book` class, starting with the parts (ie, the level 0 sections)
+var bookSections = []string{`\part`, `\chapter`, `\section`, `\subsection`, `\subsubsection`, `\paragraph`, `\subparagraph`}
+
+func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
+	title, err := renderInlineElements(ctx, s.Title)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render section")
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(sectionCommand(ctx, s.Level) + "{" + strings.TrimSpace(string(title)) + "}")
+	if id := s.Attributes.GetAsString(types.AttrID); id != "" {
+		result.WriteString(`\label{` + id + `}`)
+	}
+	content, err := renderElements(ctx, s.Elements)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render section")
+	}
+	if len(content) > 0 {
+		result.WriteString("\n\n")
+		result.Write(content)
+	}
+	return result.Bytes(), nil
+}
+
+// sectionCommand returns the sectioning command for the given level, depending on the doctype of the document.
+// The deepest levels all use the last command of the class
+func sectionCommand(ctx *renderer.Context, level int) string {
+	commands, index := articleSections, level-1
+	if ctx.Document.Attributes.GetAsStringWithDefault(types.AttrDocType, "") == "book" {
+		commands, index = bookSections, level
+	}
+	if index < 0 {
+		index = 0
+	} else if index >= len(commands) {
+		index = len(commands) - 1
+	}
+	return commands[index]
+}
+
+func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		content, err := renderLines(ctx, p.Lines)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render admonition paragraph")
+		}
+		return renderAdmonition(ctx, p.Attributes, k, content), nil
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source:
+		lines := make([]string, len(p.Lines))
+		for i, line := range p.Lines {
+			lines[i] = renderer.PlainText(line)
+		}
+		return renderListing(ctx, p.Attributes, lines), nil
+	case types.Verse:
+		content, err := renderVerseLines(ctx, p.Lines)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render verse paragraph")
+		}
+		return renderQuote(ctx, p.Attributes, "verse", content), nil
+	case types.Quote:
+		content, err := renderLines(ctx, p.Lines)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render quote paragraph")
+		}
+		return renderQuote(ctx, p.Attributes, "quote", content), nil
+	}
+	log.Debug("rendering a standalone paragraph")
+	content, err := renderLines(ctx, p.Lines)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render paragraph")
+	}
+	return append([]byte(renderBlockTitle(p.Attributes)), content...), nil
+}
+
+// renderAdmonition renders the admonition in a `quote` environment, starting with its label (and title) in bold
+func renderAdmonition(ctx *renderer.Context, attrs types.ElementAttributes, kind types.AdmonitionKind, content []byte) []byte { //nolint:unparam
+	label := strings.Title(string(kind))
+	if title := renderTitle(attrs); title != "" {
+		label = label + ": " + EscapeString(title)
+	}
+	return []byte("\\begin{quote}\n\\textbf{" + label + "}\\par\n" + string(content) + "\n\\end{quote}")
+}
+
+// renderQuote renders the content in the given environment (`quote` or `verse`), followed by its attribution, if any
+func renderQuote(ctx *renderer.Context, attrs types.ElementAttributes, env string, content []byte) []byte { //nolint:unparam
+	attribution := []string{}
+	if author := attrs.GetAsString(types.AttrQuoteAuthor); author != "" {
+		attribution = append(attribution, EscapeString(author))
+	}
+	if title := attrs.GetAsString(types.AttrQuoteTitle); title != "" {
+		attribution = append(attribution, `\emph{`+EscapeString(title)+`}`)
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockTitle(attrs))
+	result.WriteString("\\begin{" + env + "}\n")
+	result.Write(content)
+	if len(attribution) > 0 {
+		result.WriteString("\n\n\\hfill--- " + strings.Join(attribution, ", "))
+	}
+	result.WriteString("\n\\end{" + env + "}")
+	return result.Bytes()
+}
+
+// renderVerseLines renders the given lines with a line break at the end of each line, and with
+// the empty lines as stanza separators
+func renderVerseLines(ctx *renderer.Context, lines [][]interface{}) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for i, line := range lines {
+		renderedLine, err := renderInlineElements(ctx, line)
+		if err != nil {
+			return nil, err
+		}
+		result.WriteString(strings.TrimLeft(string(renderedLine), " "))
+		if i < len(lines)-1 {
+			if len(renderedLine) > 0 && len(lines[i+1]) > 0 {
+				result.WriteString(` \\`)
+			}
+			result.WriteString("\n")
+		}
+	}
+	return result.Bytes(), nil
+}
+
+// the languages supported by the `listings` package, indexed by their usual name in the `source` blocks
+var listingsLanguages = map[string]string{
+	"bash":     "bash",
+	"c":        "C",
+	"c++":      "C++",
+	"cpp":      "C++",
+	"erlang":   "erlang",
+	"fortran":  "Fortran",
+	"haskell":  "Haskell",
+	"html":     "HTML",
+	"java":     "Java",
+	"lisp":     "Lisp",
+	"make":     "make",
+	"makefile": "make",
+	"matlab":   "Matlab",
+	"ocaml":    "Caml",
+	"pascal":   "Pascal",
+	"perl":     "Perl",
+	"php":      "PHP",
+	"py":       "Python",
+	"python":   "Python",
+	"r":        "R",
+	"rb":       "Ruby",
+	"ruby":     "Ruby",
+	"sh":       "sh",
+	"shell":    "sh",
+	"sql":      "SQL",
+	"tcl":      "tcl",
+	"tex":      "TeX",
+	"latex":    "TeX",
+	"xml":      "XML",
+	"xslt":     "XSLT",
+}
+
+// renderListing renders the given lines in a `lstlisting` environment, with the language of the source block
+// if it is supported by the `listings` package, and with the title of the block as its caption
+func renderListing(ctx *renderer.Context, attrs types.ElementAttributes, lines []string) []byte { //nolint:unparam
+	options := []string{}
+	if language := attrs.GetAsString(types.AttrLanguage); language != "" {
+		if l := listingsLanguages[strings.ToLower(language)]; l != "" {
+			options = append(options, "language="+l)
+		} else {
+			log.Debugf("the '%s' language is not supported by the listings package", language)
+		}
+	}
+	if title := renderTitle(attrs); title != "" {
+		options = append(options, "caption={"+EscapeString(title)+"}")
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(`\begin{lstlisting}`)
+	if len(options) > 0 {
+		result.WriteString("[" + strings.Join(options, ",") + "]")
+	}
+	result.WriteString("\n" + strings.Join(lines, "\n") + "\n" + `\end{lstlisting}`)
+	return result.Bytes()
+}
+
+// renderVerbatim renders the given lines as-is, in a `verbatim` environment
+func renderVerbatim(ctx *renderer.Context, attrs types.ElementAttributes, lines []string) []byte { //nolint:unparam
+	return []byte(renderBlockTitle(attrs) + "\\begin{verbatim}\n" + strings.Join(lines, "\n") + "\n\\end{verbatim}")
+}
+
+func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	elements := discardTrailingBlankLines(b.Elements)
+	switch b.Kind {
+	case types.Comment:
+		return []byte{}, nil
+	case types.Fenced, types.Listing, types.Source:
+		lines := []string{}
+		for _, element := range elements {
+			switch e := element.(type) {
+			case types.Paragraph:
+				for _, line := range e.Lines {
+					lines = append(lines, renderer.PlainText(line))
+				}
+			case types.BlankLine:
+				lines = append(lines, "")
+			}
+		}
+		return renderListing(ctx, b.Attributes, lines), nil
+	case types.Verse:
+		lines := [][]interface{}{}
+		for _, element := range elements {
+			switch e := element.(type) {
+			case types.Paragraph:
+				lines = append(lines, e.Lines...)
+			case types.BlankLine:
+				lines = append(lines, []interface{}{})
+			}
+		}
+		content, err := renderVerseLines(ctx, lines)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render verse block")
+		}
+		return renderQuote(ctx, b.Attributes, "verse", content), nil
+	}
+	content, err := renderElements(ctx, elements)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render delimited block")
+	}
+	switch b.Kind {
+	case types.Example:
+		if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+			return renderAdmonition(ctx, b.Attributes, k, content), nil
+		}
+		return append([]byte(renderBlockTitle(b.Attributes)), content...), nil
+	case types.Sidebar:
+		return []byte(renderBlockTitle(b.Attributes) + "\\fbox{\\parbox{\\dimexpr\\linewidth-2\\fboxsep-2\\fboxrule}{\n" + string(content) + "\n}}"), nil
+	case types.Quote:
+		return renderQuote(ctx, b.Attributes, "quote", content), nil
+	default:
+		return nil, errors.Errorf("unable to render delimited block of kind '%v'", b.Kind)
+	}
+}
+
+func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock) ([]byte, error) {
+	lines := b.Lines
+	if b.Attributes.GetAsString(types.AttrLiteralBlockType) == types.LiteralBlockWithSpacesOnFirstLine {
+		lines = renderer.TrimCommonIndentation(b.Lines)
+	}
+	return renderVerbatim(ctx, b.Attributes, lines), nil
+}
+
+func renderStemBlock(ctx *renderer.Context, b types.StemBlock) ([]byte, error) { //nolint:unparam
+	if ctx.StemNotation(b.Notation) != types.LaTeXMath {
+		// only the LaTeX notation can be rendered as a formula
+		return renderVerbatim(ctx, b.Attributes, b.Lines), nil
+	}
+	return []byte(renderBlockTitle(b.Attributes) + "\\[\n" + strings.Join(b.Lines, "\n") + "\n\\]"), nil
+}
+
+// renderImageBlock renders the image in a `figure` environment if it has a title (which is used as its caption),
+// or centered on its own line otherwise
+func renderImageBlock(ctx *renderer.Context, img types.ImageBlock) ([]byte, error) { //nolint:unparam
+	graphics := renderGraphics(img.Attributes, img.Location)
+	if title := renderTitle(img.Attributes); title != "" {
+		result := "\\begin{figure}[htbp]\n\\centering\n" + graphics + "\n\\caption{" + EscapeString(title) + "}"
+		if id := img.Attributes.GetAsString(types.AttrID); id != "" && img.Attributes.GetAsBool(types.AttrCustomID) {
+			result += `\label{` + id + `}`
+		}
+		return []byte(result + "\n\\end{figure}"), nil
+	}
+	return []byte(renderAnchor(img.Attributes) + "\\begin{center}\n" + graphics + "\n\\end{center}"), nil
+}
+
+func renderInlineImage(ctx *renderer.Context, img types.InlineImage) ([]byte, error) { //nolint:unparam
+	return []byte(renderGraphics(img.Attributes, img.Location)), nil
+}
+
+// renderGraphics renders the image with the `\includegraphics` command, wrapped in a link if the image has a `link` attribute.
+// Since LaTeX cannot include remote images, those are rendered as a link with their alternate text
+func renderGraphics(attrs types.ElementAttributes, location types.Location) string {
+	path := location.String()
+	alt := EscapeString(attrs.GetAsString(types.AttrImageAlt))
+	if u, err := url.Parse(path); err == nil && u.Scheme != "" && u.Scheme != "file" {
+		return `\href{` + escapeURL(path) + `}{` + alt + `}`
+	}
+	options := []string{}
+	if width := graphicsDimension(attrs.GetAsString(types.AttrImageWidth)); width != "" {
+		options = append(options, "width="+width)
+	}
+	if height := graphicsDimension(attrs.GetAsString(types.AttrImageHeight)); height != "" {
+		options = append(options, "height="+height)
+	}
+	result := `\includegraphics`
+	if len(options) > 0 {
+		result += "[" + strings.Join(options, ",") + "]"
+	}
+	result += "{" + path + "}"
+	if link := attrs.GetAsString(types.AttrInlineLink); link != "" {
+		result = `\href{` + escapeURL(link) + `}{` + result + `}`
+	}
+	return result
+}
+
+// graphicsDimension converts the given width or height of an image (in pixels or in percent) in a LaTeX dimension
+func graphicsDimension(value string) string {
+	if strings.HasSuffix(value, "%") {
+		if v, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64); err == nil {
+			return strconv.FormatFloat(v/100, 'f', -1, 64) + `\linewidth`
+		}
+		return ""
+	}
+	if v, err := strconv.ParseFloat(strings.TrimSuffix(value, "px"), 64); err == nil {
+		return strconv.FormatFloat(v, 'f', -1, 64) + "px"
+	}
+	return ""
+}
+
+// renderMediaBlock renders a link to the video or the audio, which cannot be embedded in the output
+func renderMediaBlock(ctx *renderer.Context, attrs types.ElementAttributes, location string, kind string) ([]byte, error) { //nolint:unparam
+	return []byte(renderAnchor(attrs) + renderBlockTitle(attrs) + `\url{` + escapeURL(location) + `} (` + kind + `)`), nil
+}
@@ -0,0 +1,83 @@
+package latex_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("blocks", func() {
+
+	It("source block with language and title", func() {
+		source := `.Hello
+[source,python]
+----
+fmt.Println("100% {done}")
+----`
+		expected := `\begin{lstlisting}[language=Python,caption={Hello}]
+fmt.Println("100% {done}")
+\end{lstlisting}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("listing block without language", func() {
+		source := `----
+some $content
+----`
+		expected := `\begin{lstlisting}
+some $content
+\end{lstlisting}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("quote block with author and title", func() {
+		source := `[quote, John Doe, Quote Title]
+____
+some *content*
+____`
+		expected := `\begin{quote}
+some \textbf{content}
+
+\hfill--- John Doe, \emph{Quote Title}
+\end{quote}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("image block with title", func() {
+		source := `[[img]]
+.A Dot
+image::images/dot.png[width=50%]`
+		expected := `\begin{figure}[htbp]
+\centering
+\includegraphics[width=0.5\linewidth]{images/dot.png}
+\caption{A Dot}\label{img}
+\end{figure}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("image block without title", func() {
+		source := `image::images/dot.png[dot,100,50]`
+		expected := `\begin{center}
+\includegraphics[width=100px,height=50px]{images/dot.png}
+\end{center}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+	It("video block", func() {
+		source := `video::videos/intro.mp4[]`
+		expected := `\url{videos/intro.mp4} (video)`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("youtube video block", func() {
+		source := `video::abc[youtube,start=10,end=20]`
+		expected := `\url{https://www.youtube.com/watch?v=abc} (video)`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("vimeo video block", func() {
+		source := `video::abc[vimeo,start=1m30s]`
+		expected := `\url{https://vimeo.com/abc} (video)`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+})
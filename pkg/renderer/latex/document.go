@@ -0,0 +1,151 @@
+package latex
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultPreamble the default template of the preamble, ie, the content before `\begin{document}`.
+// A custom template can be given with the `renderer.LaTeXPreamble` option. In both cases, the template
+// is executed with a `PreambleData` value, along with the following functions:
+// `escape` (to escape the special characters of a string) and `join`
+const DefaultPreamble = `\documentclass{ {{- .DocumentClass -}} }
+\usepackage[utf8]{inputenc}
+\usepackage[T1]{fontenc}
+\usepackage{lmodern}
+\usepackage{amsmath}
+\usepackage{amssymb}
+\usepackage{array}
+\usepackage{graphicx}
+\usepackage{enumitem}
+\usepackage{listings}
+\usepackage{hyperref}
+\lstset{basicstyle=\ttfamily\small,breaklines=true,columns=fullflexible}
+{{ if .Title }}\title{ {{- .Title -}} }
+{{ end }}{{ if .Authors }}\author{ {{- join .Authors " \\and " -}} }
+{{ end }}\date{ {{- .Date -}} }
+`
+
+// PreambleData the data with which the preamble template is executed
+type PreambleData struct {
+	// DocumentClass the class of the document: `book` for the documents with the `book` doctype, `article` otherwise
+	DocumentClass string
+	// Title the title of the document, in LaTeX
+	Title string
+	// Authors the (escaped) names of the authors of the document
+	Authors []string
+	// Date the (escaped) revision date of the document
+	Date string
+	// Lang the language of the document (from the `lang` attribute)
+	Lang string
+	// Attributes the document attributes
+	Attributes types.DocumentAttributes
+}
+
+var preambleFuncs = texttemplate.FuncMap{
+	"escape": EscapeString,
+	"join":   strings.Join,
+}
+
+var documentTmpl texttemplate.Template
+
+func init() {
+	documentTmpl = renderer.NewTextTemplate("document", `{{ .Preamble }}
+\begin{document}
+{{ if .Title }}
+\maketitle
+{{ end }}
+{{ .Content }}
+
+\end{document}
+`)
+}
+
+// renderDocument renders the whole document, including the preamble if needed
+func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	elements := ctx.Document.Elements
+	var title []byte
+	if header, found := ctx.Document.Header(); found {
+		var err error
+		if title, err = renderInlineElements(ctx, header.Title); err != nil {
+			return nil, errors.Wrap(err, "unable to render document")
+		}
+		title = bytes.TrimSpace(title)
+		// retain the elements of the header, followed by the other elements, if any
+		elements = append(append([]interface{}{}, header.Elements...), ctx.Document.Elements[1:]...)
+	}
+	renderedElements, err := renderElements(ctx, elements)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render document")
+	}
+	if ctx.IncludeHeaderFooter() {
+		log.Debugf("Rendering full document...")
+		preamble, err := renderPreamble(ctx, string(title))
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render document")
+		}
+		err = documentTmpl.Execute(output, struct {
+			Preamble string
+			Title    string
+			Content  string
+		}{
+			Preamble: strings.TrimRight(preamble, "\n"),
+			Title:    string(title),
+			Content:  string(renderedElements),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render document")
+		}
+	} else if _, err := output.Write(renderedElements); err != nil {
+		return nil, errors.Wrap(err, "unable to render document")
+	}
+	metadata := ctx.Document.Attributes
+	if header, found := ctx.Document.Header(); found {
+		metadata[types.AttrTitle] = strings.TrimSpace(renderer.PlainText(header.Title))
+	}
+	metadata["LastUpdated"] = ctx.LastUpdated()
+	return metadata, nil
+}
+
+// renderPreamble renders the preamble with the template given in the `renderer.LaTeXPreamble` option, if any,
+// or with the default template
+func renderPreamble(ctx *renderer.Context, title string) (string, error) {
+	src := ctx.LaTeXPreamble()
+	if src == "" {
+		src = DefaultPreamble
+	}
+	tmpl, err := texttemplate.New("preamble").Funcs(preambleFuncs).Parse(src)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid preamble template")
+	}
+	documentClass := "article"
+	if ctx.Document.Attributes.GetAsStringWithDefault(types.AttrDocType, "") == "book" {
+		documentClass = "book"
+	}
+	authors := []string{}
+	if docAuthors, found := ctx.Document.Authors(); found {
+		for _, a := range docAuthors {
+			authors = append(authors, EscapeString(strings.TrimSpace(a.FullName)))
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	err = tmpl.Execute(result, PreambleData{
+		DocumentClass: documentClass,
+		Title:         title,
+		Authors:       authors,
+		Date:          EscapeString(ctx.Document.Attributes.GetAsStringWithDefault("revdate", "")),
+		Lang:          ctx.Document.Attributes.GetAsStringWithDefault("lang", "en"),
+		Attributes:    ctx.Document.Attributes,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render preamble")
+	}
+	return result.String(), nil
+}
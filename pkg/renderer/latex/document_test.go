@@ -0,0 +1,160 @@
+package latex_test
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("documents", func() {
+
+	source := `= Document Title: 100% done
+John Doe <john@example.com>; Jane Doe
+v1.0, 2019-05-06
+
+some *content* footnote:[a note]
+
+== Section A
+
+content
+
+=== Section A.1
+
+more content`
+
+	It("full document with default preamble", func() {
+		expected := `\documentclass{article}
+\usepackage[utf8]{inputenc}
+\usepackage[T1]{fontenc}
+\usepackage{lmodern}
+\usepackage{amsmath}
+\usepackage{amssymb}
+\usepackage{array}
+\usepackage{graphicx}
+\usepackage{enumitem}
+\usepackage{listings}
+\usepackage{hyperref}
+\lstset{basicstyle=\ttfamily\small,breaklines=true,columns=fullflexible}
+\title{Document Title: 100\% done}
+\author{John Doe \and Jane Doe}
+\date{2019-05-06}
+\begin{document}
+
+\maketitle
+
+some \textbf{content} \footnote{a note}
+
+\section{Section A}\label{_section_a}
+
+content
+
+\subsection{Section A.1}\label{_section_a_1}
+
+more content
+
+\end{document}
+`
+		Expect(source).To(RenderLaTeXBody(expected, renderer.IncludeHeaderFooter(true)))
+	})
+
+	It("full document with custom preamble", func() {
+		preamble := `\documentclass[a4paper]{ {{- .DocumentClass -}} }
+\usepackage[{{ .Lang }}]{babel}
+\title{ {{- .Title -}} }
+\author{ {{- join .Authors ", " -}} }
+% {{ escape (index .Attributes "custom") }}`
+		expected := `\documentclass[a4paper]{article}
+\usepackage[french]{babel}
+\title{Document Title: 100\% done}
+\author{John Doe, Jane Doe}
+% 50\%
+\begin{document}
+
+\maketitle
+
+some \textbf{content} \footnote{a note}
+
+\section{Section A}\label{_section_a}
+
+content
+
+\subsection{Section A.1}\label{_section_a_1}
+
+more content
+
+\end{document}
+`
+		source := strings.Replace(source, "2019-05-06\n", "2019-05-06\n:lang: french\n:custom: 50%\n", 1)
+		Expect(source).To(RenderLaTeXBody(expected, renderer.IncludeHeaderFooter(true), renderer.LaTeXPreamble(preamble)))
+	})
+
+	It("invalid custom preamble", func() {
+		_, err := libasciidoc.Convert(context.Background(), "", strings.NewReader(source), ioutil.Discard, renderer.Backend("latex"), renderer.IncludeHeaderFooter(true), renderer.LaTeXPreamble(`{{ .Unknown }}`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("embedded document", func() {
+		expected := `some \textbf{content} \footnote{a note}
+
+\section{Section A}\label{_section_a}
+
+content
+
+\subsection{Section A.1}\label{_section_a_1}
+
+more content`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("book with parts, chapters and table of contents", func() {
+		source := `= Book Title
+:doctype: book
+:toc:
+
+= Part One
+
+== Chapter One
+
+=== Section
+
+content`
+		expected := `\documentclass{book}
+\usepackage[utf8]{inputenc}
+\usepackage[T1]{fontenc}
+\usepackage{lmodern}
+\usepackage{amsmath}
+\usepackage{amssymb}
+\usepackage{array}
+\usepackage{graphicx}
+\usepackage{enumitem}
+\usepackage{listings}
+\usepackage{hyperref}
+\lstset{basicstyle=\ttfamily\small,breaklines=true,columns=fullflexible}
+\title{Book Title}
+\date{}
+\begin{document}
+
+\maketitle
+
+\tableofcontents
+
+\part{Part One}\label{_part_one}
+
+\chapter{Chapter One}\label{_chapter_one}
+
+\section{Section}\label{_section}
+
+content
+
+\end{document}
+`
+		Expect(source).To(RenderLaTeXBody(expected, renderer.IncludeHeaderFooter(true)))
+	})
+})
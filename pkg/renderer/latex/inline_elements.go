@@ -0,0 +1,180 @@
+package latex
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderInlineElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	result := []byte{}
+	for i, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render line")
+		}
+		result = append(result, renderedElement...)
+		if _, ok := element.(types.StringElement); ok && i == len(elements)-1 {
+			// trim trailing spaces before returning the line
+			result = bytes.TrimRight(result, " ")
+		}
+	}
+	return result, nil
+}
+
+// renderLines renders all lines, one per line of output
+func renderLines(ctx *renderer.Context, lines [][]interface{}) ([]byte, error) {
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		renderedLine, err := renderInlineElements(ctx, line)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render lines")
+		}
+		l := strings.TrimLeft(string(renderedLine), " ")
+		if l == "" {
+			continue
+		}
+		result = append(result, l)
+	}
+	return []byte(strings.Join(result, "\n")), nil
+}
+
+var quotedTextCommands = map[types.QuotedTextKind]string{
+	types.Bold:        `\textbf`,
+	types.Italic:      `\emph`,
+	types.Monospace:   `\texttt`,
+	types.Subscript:   `\textsubscript`,
+	types.Superscript: `\textsuperscript`,
+}
+
+func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error) {
+	cmd, found := quotedTextCommands[t.Kind]
+	if !found {
+		return nil, errors.Errorf("unsupported quoted text kind: '%v'", t.Kind)
+	}
+	content, err := renderInlineElements(ctx, t.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quoted text")
+	}
+	return []byte(cmd + "{" + string(content) + "}"), nil
+}
+
+func renderPassthrough(ctx *renderer.Context, p types.Passthrough) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	for _, element := range p.Elements {
+		switch element := element.(type) {
+		case types.StringElement:
+			if p.Kind == types.SinglePlusPassthrough {
+				// content is escaped, but not substituted
+				buf.WriteString(EscapeString(element.Content))
+			} else {
+				// content is rendered as-is
+				buf.WriteString(element.Content)
+			}
+		default:
+			renderedElement, err := renderElement(ctx, element)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render passthrough")
+			}
+			buf.Write(renderedElement)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// renderLink renders the link with its text, or as a URL if it has no text
+func renderLink(ctx *renderer.Context, l types.InlineLink) ([]byte, error) {
+	location := l.Location.String()
+	if t, ok := l.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+		text, err := renderInlineElements(ctx, t)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render link")
+		}
+		return []byte(`\href{` + escapeURL(location) + `}{` + string(text) + `}`), nil
+	}
+	return []byte(`\url{` + escapeURL(location) + `}`), nil
+}
+
+// renderInternalCrossReference renders a link to the label of the target element, with the label of the cross reference
+// or the title of the target element as its text
+func renderInternalCrossReference(ctx *renderer.Context, xref types.InternalCrossReference) ([]byte, error) {
+	var label []byte
+	if xref.Label != "" {
+		label = []byte(EscapeString(xref.Label))
+	} else if target, ok := ctx.Document.ElementReferences[xref.ID].([]interface{}); ok {
+		var err error
+		if label, err = renderInlineElements(ctx, target); err != nil {
+			return nil, errors.Wrap(err, "unable to render cross reference")
+		}
+	} else {
+		label = []byte(EscapeString("[" + xref.ID + "]"))
+	}
+	return []byte(`\hyperref[` + xref.ID + `]{` + strings.TrimSpace(string(label)) + `}`), nil
+}
+
+func renderExternalCrossReference(ctx *renderer.Context, xref types.ExternalCrossReference) ([]byte, error) {
+	label, err := renderInlineElements(ctx, xref.Label)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render external cross reference")
+	}
+	return []byte(`\href{` + escapeURL(xref.Location.String()) + `}{` + string(label) + `}`), nil
+}
+
+// renderFootnote renders the footnote in place, since LaTeX takes care of the numbering and the placement
+// of the footnotes. A footnote with a reference has a label, so that it can be referred to later on
+func renderFootnote(ctx *renderer.Context, note types.Footnote) ([]byte, error) {
+	if len(note.Elements) > 0 {
+		content, err := renderInlineElements(ctx, note.Elements)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render footnote")
+		}
+		if note.Ref != "" {
+			return []byte(`\footnote{\label{` + footnoteLabel(note.Ref) + `}` + strings.TrimSpace(string(content)) + `}`), nil
+		}
+		return []byte(`\footnote{` + strings.TrimSpace(string(content)) + `}`), nil
+	}
+	if _, found := ctx.Document.FootnoteReferences[note.Ref]; found {
+		return []byte(`\footnotemark[\ref{` + footnoteLabel(note.Ref) + `}]`), nil
+	}
+	// invalid footnote
+	return []byte(EscapeString("[" + note.Ref + "]")), nil
+}
+
+func footnoteLabel(ref string) string {
+	return "_footnote_" + ref
+}
+
+func renderInlineStem(ctx *renderer.Context, s types.InlineStem) ([]byte, error) { //nolint:unparam
+	if ctx.StemNotation(s.Notation) != types.LaTeXMath {
+		// only the LaTeX notation can be rendered as a formula
+		return []byte(`\texttt{` + EscapeString(s.Content) + `}`), nil
+	}
+	return []byte(`\(` + s.Content + `\)`), nil
+}
+
+func renderUserMacro(ctx *renderer.Context, um types.UserMacro) ([]byte, error) {
+	buf := bytes.NewBuffer([]byte{})
+	macro, err := ctx.MacroTemplate(um.Name)
+	if err != nil {
+		if um.Kind == types.BlockMacro {
+			// fallback to paragraph
+			p, _ := types.NewParagraph([]interface{}{
+				[]interface{}{
+					types.StringElement{Content: um.RawText},
+				},
+			}, nil)
+			return renderParagraph(ctx, p)
+		}
+		// fallback to render raw text
+		_, err = buf.WriteString(EscapeString(um.RawText))
+	} else {
+		err = macro.Execute(buf, um)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
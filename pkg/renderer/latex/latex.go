@@ -0,0 +1,163 @@
+// Package latex renders the documents in LaTeX, with a preamble which can be customized
+// with the `renderer.LaTeXPreamble` option
+package latex
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Render renders the given document in LaTeX and writes the result in the given `writer`
+func Render(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	return renderDocument(ctx, output)
+}
+
+// renderElements renders the given blocks, separated by a blank line
+func renderElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	log.Debugf("rendering %d elements(s)...", len(elements))
+	buff := bytes.NewBuffer(nil)
+	for _, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render an element")
+		}
+		if len(renderedElement) == 0 {
+			continue
+		}
+		if buff.Len() > 0 {
+			buff.WriteString("\n\n")
+		}
+		if anchor := renderBlockAnchor(element); anchor != "" {
+			buff.WriteString(anchor + "\n")
+		}
+		buff.Write(renderedElement)
+	}
+	return buff.Bytes(), nil
+}
+
+// nolint: gocyclo
+func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
+	switch e := element.(type) {
+	case []interface{}:
+		return renderElements(ctx, e)
+	case types.TableOfContentsMacro:
+		return []byte(`\tableofcontents`), nil
+	case types.Section:
+		return renderSection(ctx, e)
+	case types.Preamble:
+		return renderElements(ctx, e.Elements)
+	case types.BlankLine:
+		return []byte{}, nil
+	case types.LabeledList:
+		return renderLabeledList(ctx, e)
+	case types.OrderedList:
+		return renderOrderedList(ctx, e)
+	case types.UnorderedList:
+		return renderUnorderedList(ctx, e)
+	case types.Paragraph:
+		return renderParagraph(ctx, e)
+	case types.InternalCrossReference:
+		return renderInternalCrossReference(ctx, e)
+	case types.ExternalCrossReference:
+		return renderExternalCrossReference(ctx, e)
+	case types.QuotedText:
+		return renderQuotedText(ctx, e)
+	case types.Passthrough:
+		return renderPassthrough(ctx, e)
+	case types.ImageBlock:
+		return renderImageBlock(ctx, e)
+	case types.InlineImage:
+		return renderInlineImage(ctx, e)
+	case types.VideoBlock:
+		return renderMediaBlock(ctx, e.Attributes, e.URL(), "video")
+	case types.AudioBlock:
+		return renderMediaBlock(ctx, e.Attributes, e.Location.String(), "audio")
+	case types.DelimitedBlock:
+		return renderDelimitedBlock(ctx, e)
+	case types.Table:
+		return renderTable(ctx, e)
+	case types.LiteralBlock:
+		return renderLiteralBlock(ctx, e)
+	case types.InlineLink:
+		return renderLink(ctx, e)
+	case types.StringElement:
+		return []byte(EscapeString(e.Content)), nil
+	case types.Footnote:
+		return renderFootnote(ctx, e)
+	case types.LineBreak:
+		return []byte(`\\`), nil
+	case types.UserMacro:
+		return renderUserMacro(ctx, e)
+	case types.InlineStem:
+		return renderInlineStem(ctx, e)
+	case types.InlineIcon:
+		return []byte(EscapeString("[" + e.Name + "]")), nil
+	case types.StemBlock:
+		return renderStemBlock(ctx, e)
+	default:
+		return nil, errors.Errorf("unsupported type of element: %T", element)
+	}
+}
+
+func renderTitle(attrs types.ElementAttributes) string {
+	return strings.TrimSpace(attrs.GetAsString(types.AttrTitle))
+}
+
+// renderBlockTitle renders the title of a block in bold, on its own line, or returns an empty string if the block has no title
+func renderBlockTitle(attrs types.ElementAttributes) string {
+	if title := renderTitle(attrs); title != "" {
+		return `\noindent\textbf{` + EscapeString(title) + "}\\par\n"
+	}
+	return ""
+}
+
+// renderBlockAnchor renders a label for the blocks which have a custom ID (ie, which may be the target of a cross reference),
+// or returns an empty string otherwise. Sections, tables and images have their own label.
+func renderBlockAnchor(element interface{}) string {
+	var attrs types.ElementAttributes
+	switch e := element.(type) {
+	case types.Paragraph:
+		attrs = e.Attributes
+	case types.DelimitedBlock:
+		attrs = e.Attributes
+	case types.LiteralBlock:
+		attrs = e.Attributes
+	case types.OrderedList:
+		attrs = e.Attributes
+	case types.UnorderedList:
+		attrs = e.Attributes
+	case types.LabeledList:
+		attrs = e.Attributes
+	default:
+		return ""
+	}
+	return renderAnchor(attrs)
+}
+
+// renderAnchor renders a label at the current position if the element has a custom ID, or returns an empty string otherwise
+func renderAnchor(attrs types.ElementAttributes) string {
+	if !attrs.GetAsBool(types.AttrCustomID) {
+		return ""
+	}
+	if id := attrs.GetAsString(types.AttrID); id != "" {
+		return `\phantomsection\label{` + id + `}`
+	}
+	return ""
+}
+
+func discardTrailingBlankLines(elements []interface{}) []interface{} {
+	result := elements
+	for len(result) > 0 {
+		if _, ok := result[len(result)-1].(types.BlankLine); !ok {
+			break
+		}
+		result = result[:len(result)-1]
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package latex
+
+import "strings"
+
+var latexReplacer = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`$`, `\$`,
+	`&`, `\&`,
+	`#`, `\#`,
+	`%`, `\%`,
+	`_`, `\_`,
+	`^`, `\textasciicircum{}`,
+	`~`, `\textasciitilde{}`,
+)
+
+// EscapeString escapes the characters which have a special meaning in LaTeX
+func EscapeString(s string) string {
+	return latexReplacer.Replace(s)
+}
+
+var urlReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	`#`, `\#`,
+	`%`, `\%`,
+	`{`, `\{`,
+	`}`, `\}`,
+)
+
+// escapeURL escapes the characters of the given URL which would break the argument of the `\href` and `\url` commands
+func escapeURL(s string) string {
+	return urlReplacer.Replace(s)
+}
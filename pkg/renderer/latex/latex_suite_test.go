@@ -0,0 +1,15 @@
+package latex_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/testsupport"
+)
+
+func TestLaTeX(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "LaTeX Suite")
+}
@@ -0,0 +1,116 @@
+package latex
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderUnorderedList(ctx *renderer.Context, l types.UnorderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockTitle(l.Attributes))
+	result.WriteString(`\begin{itemize}`)
+	for _, item := range l.Items {
+		result.WriteString("\n")
+		switch item.CheckStyle {
+		case types.Checked:
+			result.WriteString(`\item[$\boxtimes$] `)
+		case types.Unchecked:
+			result.WriteString(`\item[$\square$] `)
+		default:
+			result.WriteString(`\item `)
+		}
+		if err := renderListItemElements(ctx, result, item.Elements); err != nil {
+			return nil, errors.Wrap(err, "unable to render unordered list")
+		}
+	}
+	result.WriteString("\n" + `\end{itemize}`)
+	return result.Bytes(), nil
+}
+
+// the labels of the ordered list items, with the syntax of the `enumitem` package
+var numberingLabels = map[types.NumberingStyle]string{
+	types.Arabic:     `\arabic*.`,
+	types.LowerAlpha: `\alph*.`,
+	types.UpperAlpha: `\Alph*.`,
+	types.LowerRoman: `\roman*.`,
+	types.UpperRoman: `\Roman*.`,
+}
+
+func renderOrderedList(ctx *renderer.Context, l types.OrderedList) ([]byte, error) {
+	style := types.NumberingStyle(l.Attributes.GetAsString(types.AttrNumberingStyle))
+	if style == "" && len(l.Items) > 0 {
+		style = l.Items[0].NumberingStyle
+	}
+	options := []string{}
+	if label, found := numberingLabels[style]; found && style != types.Arabic {
+		options = append(options, "label="+label)
+	}
+	if s, err := strconv.Atoi(l.Attributes.GetAsString(types.AttrStart)); err == nil && s != 1 {
+		options = append(options, "start="+strconv.Itoa(s))
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockTitle(l.Attributes))
+	result.WriteString(`\begin{enumerate}`)
+	if len(options) > 0 {
+		result.WriteString("[" + strings.Join(options, ",") + "]")
+	}
+	for _, item := range l.Items {
+		result.WriteString("\n" + `\item `)
+		if err := renderListItemElements(ctx, result, item.Elements); err != nil {
+			return nil, errors.Wrap(err, "unable to render ordered list")
+		}
+	}
+	result.WriteString("\n" + `\end{enumerate}`)
+	return result.Bytes(), nil
+}
+
+func renderLabeledList(ctx *renderer.Context, l types.LabeledList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockTitle(l.Attributes))
+	result.WriteString(`\begin{description}`)
+	for _, item := range l.Items {
+		term, err := renderInlineElements(ctx, item.Term)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render labeled list")
+		}
+		// the term is wrapped in braces, in case it contains a closing bracket
+		result.WriteString("\n" + `\item[{` + string(bytes.TrimSpace(term)) + `}]`)
+		if len(item.Elements) > 0 {
+			result.WriteString(" ")
+			if err := renderListItemElements(ctx, result, item.Elements); err != nil {
+				return nil, errors.Wrap(err, "unable to render labeled list")
+			}
+		}
+	}
+	result.WriteString("\n" + `\end{description}`)
+	return result.Bytes(), nil
+}
+
+// renderListItemElements renders the elements of a list item. Nested lists directly follow the
+// previous element, whereas other elements are separated by a blank line
+func renderListItemElements(ctx *renderer.Context, result *bytes.Buffer, elements []interface{}) error {
+	for i, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return err
+		}
+		if len(renderedElement) == 0 {
+			continue
+		}
+		if i > 0 {
+			switch element.(type) {
+			case types.UnorderedList, types.OrderedList, types.LabeledList:
+				result.WriteString("\n")
+			default:
+				result.WriteString("\n\n")
+			}
+		}
+		result.Write(renderedElement)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package latex_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lists", func() {
+
+	It("unordered list with nested items", func() {
+		source := `.Items
+* item 1
+** item 1.1
+* item 2`
+		expected := `\noindent\textbf{Items}\par
+\begin{itemize}
+\item item 1
+\begin{itemize}
+\item item 1.1
+\end{itemize}
+\item item 2
+\end{itemize}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("checklist", func() {
+		source := `* [x] done
+* [ ] todo`
+		expected := `\begin{itemize}
+\item[$\boxtimes$] done
+\item[$\square$] todo
+\end{itemize}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("ordered list with custom numbering and start", func() {
+		source := `[loweralpha, start=3]
+. item 1
+. item 2`
+		expected := `\begin{enumerate}[label=\alph*.,start=3]
+\item item 1
+\item item 2
+\end{enumerate}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("labeled list", func() {
+		source := `term [1]:: description 1
+term 2:: description 2`
+		expected := `\begin{description}
+\item[{term [1]}] description 1
+\item[{term 2}] description 2
+\end{description}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+})
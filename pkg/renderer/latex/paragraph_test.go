@@ -0,0 +1,68 @@
+package latex_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("paragraphs", func() {
+
+	It("paragraph with special characters", func() {
+		source := `costs $10 & 50% off for item #1, {user}_name ^~ \ done`
+		expected := `costs \$10 \& 50\% off for item \#1, \{user\}\_name \textasciicircum{}\textasciitilde{} \textbackslash{} done`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("paragraph with quoted text", func() {
+		source := `some *bold*, _italic_, ` + "`mono`" + `, ~sub~ and ^sup^ content`
+		expected := `some \textbf{bold}, \emph{italic}, \texttt{mono}, \textsubscript{sub} and \textsuperscript{sup} content`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("paragraph with title and id", func() {
+		source := `[#intro]
+.A Title
+first line
+second line`
+		expected := `\phantomsection\label{intro}
+\noindent\textbf{A Title}\par
+first line
+second line`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("paragraph with links", func() {
+		source := `see https://example.com/a_b#c[the 100% site] or https://example.com`
+		expected := `see \href{https://example.com/a_b\#c}{the 100\% site} or \url{https://example.com}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("paragraph with cross references", func() {
+		source := `== Section A
+
+see <<_section_a>> or <<_section_a,that section>> or <<unknown>>`
+		expected := `\section{Section A}\label{_section_a}
+
+see \hyperref[_section_a]{Section A} or \hyperref[_section_a]{that section} or \hyperref[unknown]{[unknown]}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("paragraph with footnotes", func() {
+		source := `a footnote:[a *note*] and footnoteref:[ref, a reference] and footnoteref:[ref]`
+		expected := `a \footnote{a \textbf{note}} and \footnote{\label{_footnote_ref}a reference} and \footnotemark[\ref{_footnote_ref}]`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("admonition paragraph", func() {
+		source := `[NOTE]
+.Careful
+this is a note`
+		expected := `\begin{quote}
+\textbf{Note: Careful}\par
+this is a note
+\end{quote}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+})
@@ -0,0 +1,174 @@
+package latex
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// column the specification of a column of a table, from the `cols` attribute
+type column struct {
+	// the horizontal alignment: `l`, `c` or `r`
+	align string
+	// the relative width of the column, or 0 if the width is automatic
+	width float64
+	// the style of the cells: `m` (monospace), `s` (strong), `e` (emphasis), `h` (header), etc.
+	style string
+}
+
+// a column specification: an optional multiplier, horizontal (and vertical) alignments, width and style
+var columnSpecRegexp = regexp.MustCompile(`^(?:(\d+)\*)?([<^>])?(?:\.[<^>])?(\d+%?|~)?([adehlmsv])?$`)
+
+var columnAlignments = map[string]string{
+	"<": "l",
+	"^": "c",
+	">": "r",
+}
+
+// parseColumns parses the `cols` attribute of a table (eg: `1,2,>3m` or `3*`).
+// Returns `false` if the attribute is invalid
+func parseColumns(cols string) ([]column, bool) {
+	result := []column{}
+	for _, spec := range strings.FieldsFunc(cols, func(r rune) bool { return r == ',' || r == ';' }) {
+		m := columnSpecRegexp.FindStringSubmatch(strings.TrimSpace(spec))
+		if m == nil {
+			return nil, false
+		}
+		c := column{
+			align: "l",
+			style: m[4],
+		}
+		if a, found := columnAlignments[m[2]]; found {
+			c.align = a
+		}
+		if w, err := strconv.ParseFloat(strings.TrimSuffix(m[3], "%"), 64); err == nil {
+			c.width = w
+		}
+		n := 1
+		if m[1] != "" {
+			n, _ = strconv.Atoi(m[1])
+		}
+		for i := 0; i < n; i++ {
+			result = append(result, c)
+		}
+	}
+	return result, len(result) > 0
+}
+
+// columnSpec returns the column specification of the `tabular` environment. Columns have a fixed width
+// (relative to the width of the line) only if all of them have an explicit width
+func columnSpec(columns []column) string {
+	total := 0.0
+	for _, c := range columns {
+		if c.width == 0 {
+			total = 0
+			break
+		}
+		total += c.width
+	}
+	specs := make([]string, len(columns))
+	for i, c := range columns {
+		if total == 0 {
+			specs[i] = c.align
+			continue
+		}
+		width := strconv.FormatFloat(c.width/total, 'f', 4, 64) + `\linewidth`
+		switch c.align {
+		case "c":
+			specs[i] = `>{\centering\arraybackslash}p{` + width + `}`
+		case "r":
+			specs[i] = `>{\raggedleft\arraybackslash}p{` + width + `}`
+		default:
+			specs[i] = `>{\raggedright\arraybackslash}p{` + width + `}`
+		}
+	}
+	return "|" + strings.Join(specs, "|") + "|"
+}
+
+var cellStyleCommands = map[string]string{
+	"e": `\emph`,
+	"h": `\textbf`,
+	"m": `\texttt`,
+	"s": `\textbf`,
+}
+
+// renderTable renders the table in a `tabular` environment, within a `table` environment if it has a title
+// (which is used as its caption), or centered otherwise
+func renderTable(ctx *renderer.Context, t types.Table) ([]byte, error) {
+	header := t.Header
+	lines := t.Lines
+	if len(header.Cells) == 0 && t.Attributes.HasOption("header") && len(lines) > 0 {
+		header, lines = lines[0], lines[1:]
+	}
+	n := len(header.Cells)
+	if n == 0 && len(lines) > 0 {
+		n = len(lines[0].Cells)
+	}
+	columns, ok := parseColumns(t.Attributes.GetAsString(types.AttrCols))
+	if !ok || len(columns) != n {
+		if t.Attributes.Has(types.AttrCols) {
+			log.Warnf("ignoring the '%s' column specification of the table, which has %d column(s)", t.Attributes.GetAsString(types.AttrCols), n)
+		}
+		columns = make([]column, n)
+		for i := range columns {
+			columns[i] = column{align: "l"}
+		}
+	}
+	result := bytes.NewBuffer(nil)
+	if title := renderTitle(t.Attributes); title != "" {
+		result.WriteString("\\begin{table}[htbp]\n\\centering\n\\caption{" + EscapeString(title) + "}")
+		if id := t.Attributes.GetAsString(types.AttrID); id != "" && t.Attributes.GetAsBool(types.AttrCustomID) {
+			result.WriteString(`\label{` + id + `}`)
+		}
+		result.WriteString("\n")
+	} else {
+		result.WriteString(renderAnchor(t.Attributes) + "\\begin{center}\n")
+	}
+	result.WriteString("\\begin{tabular}{" + columnSpec(columns) + "}\n\\hline\n")
+	if len(header.Cells) > 0 {
+		if err := renderTableLine(ctx, result, header, columns, true); err != nil {
+			return nil, errors.Wrap(err, "unable to render table")
+		}
+	}
+	for _, line := range lines {
+		if err := renderTableLine(ctx, result, line, columns, false); err != nil {
+			return nil, errors.Wrap(err, "unable to render table")
+		}
+	}
+	result.WriteString("\\end{tabular}\n")
+	if renderTitle(t.Attributes) != "" {
+		result.WriteString(`\end{table}`)
+	} else {
+		result.WriteString(`\end{center}`)
+	}
+	return result.Bytes(), nil
+}
+
+func renderTableLine(ctx *renderer.Context, result *bytes.Buffer, line types.TableLine, columns []column, header bool) error {
+	for i, cell := range line.Cells {
+		content, err := renderInlineElements(ctx, cell)
+		if err != nil {
+			return err
+		}
+		c := strings.TrimSpace(string(content))
+		if header {
+			c = `\textbf{` + c + `}`
+		} else if i < len(columns) && c != "" {
+			if cmd, found := cellStyleCommands[columns[i].style]; found {
+				c = cmd + "{" + c + "}"
+			}
+		}
+		if i > 0 {
+			result.WriteString(" & ")
+		}
+		result.WriteString(c)
+	}
+	result.WriteString(" \\\\\n\\hline\n")
+	return nil
+}
@@ -0,0 +1,96 @@
+package latex_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tables", func() {
+
+	It("table with header", func() {
+		source := `|===
+| Column 1 | Column 2
+
+| A & B | 100%
+|===`
+		expected := `\begin{center}
+\begin{tabular}{|l|l|}
+\hline
+\textbf{Column 1} & \textbf{Column 2} \\
+\hline
+A \& B & 100\% \\
+\hline
+\end{tabular}
+\end{center}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("table with alignments and styles", func() {
+		source := `[cols="<,^m,>e"]
+|===
+| a | b | c
+|===`
+		expected := `\begin{center}
+\begin{tabular}{|l|c|r|}
+\hline
+a & \texttt{b} & \emph{c} \\
+\hline
+\end{tabular}
+\end{center}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("table with widths, header option and title", func() {
+		source := `[[tbl]]
+[cols="1,^3",options="header"]
+.A Table
+|===
+| Name | Value
+| a | b
+|===`
+		expected := `\begin{table}[htbp]
+\centering
+\caption{A Table}\label{tbl}
+\begin{tabular}{|>{\raggedright\arraybackslash}p{0.2500\linewidth}|>{\centering\arraybackslash}p{0.7500\linewidth}|}
+\hline
+\textbf{Name} & \textbf{Value} \\
+\hline
+a & b \\
+\hline
+\end{tabular}
+\end{table}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("table with multiplied columns", func() {
+		source := `[cols="2*s"]
+|===
+| a | b
+|===`
+		expected := `\begin{center}
+\begin{tabular}{|l|l|}
+\hline
+\textbf{a} & \textbf{b} \\
+\hline
+\end{tabular}
+\end{center}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+
+	It("table with invalid column specification", func() {
+		source := `[cols="1,2,3"]
+|===
+| a | b
+|===`
+		expected := `\begin{center}
+\begin{tabular}{|l|l|}
+\hline
+a & b \\
+\hline
+\end{tabular}
+\end{center}`
+		Expect(source).To(RenderLaTeXBody(expected))
+	})
+})
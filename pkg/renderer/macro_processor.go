@@ -0,0 +1,42 @@
+package renderer
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// MacroProcessor a function which processes the given user macro (eg: `issue:1234[]`) and which returns the elements
+// to render instead of the macro: inline elements (eg: a `types.InlineLink`) for an inline macro, or blocks
+// (eg: a `types.Paragraph`) for a block macro
+type MacroProcessor func(ctx *Context, macro types.UserMacro) ([]interface{}, error)
+
+type macroProcessorKey struct {
+	kind types.MacroKind
+	name string
+}
+
+// DefineInlineMacroProcessor defines the given processor for the inline macros with the given name
+// (eg: `issue` for the `issue:1234[]` macros)
+func DefineInlineMacroProcessor(name string, p MacroProcessor) Option {
+	return func(ctx *Context) {
+		ctx.macroProcessors[macroProcessorKey{kind: types.InlineMacro, name: name}] = p
+	}
+}
+
+// DefineBlockMacroProcessor defines the given processor for the block macros with the given name
+// (eg: `gist` for the `gist::1234[]` macros)
+func DefineBlockMacroProcessor(name string, p MacroProcessor) Option {
+	return func(ctx *Context) {
+		ctx.macroProcessors[macroProcessorKey{kind: types.BlockMacro, name: name}] = p
+	}
+}
+
+// MacroProcessor finds and returns the macro processor defined for the kind and the name of the given macro
+func (ctx *Context) MacroProcessor(macro types.UserMacro) (MacroProcessor, bool) {
+	p, found := ctx.macroProcessors[macroProcessorKey{kind: macro.Kind, name: macro.Name}]
+	return p, found
+}
+
+// HasMacroProcessors returns `true` if at least one macro processor was defined
+func (ctx *Context) HasMacroProcessors() bool {
+	return len(ctx.macroProcessors) > 0
+}
@@ -0,0 +1,99 @@
+package renderer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Manpage the details of a document with the `manpage` doctype
+type Manpage struct {
+	Title   string   // the name of the manual page, from the document title (eg: `git-foo`)
+	VolNum  string   // the section of the manual, from the document title (eg: `1`)
+	Name    string   // the name of the command, from the `NAME` section
+	Names   []string // all the names of the command, from the `NAME` section
+	Purpose string   // the purpose of the command, from the `NAME` section
+	// the `NAME` section, which is usually rendered in the header of the document
+	NameSection types.Section
+}
+
+var manpageTitleRegexp = regexp.MustCompile(`^(.+)\((.+)\)$`)
+var manpageNamePurposeRegexp = regexp.MustCompile(`^(.+?)\s+-\s+(.+)$`)
+
+// NewManpage returns the details of the given document, or an error if its structure does not conform to the `manpage` doctype:
+// the document title must be in the `name(volnum)` form, the first section must be the `NAME` section, with a `name - purpose`
+// paragraph, and the document must have a `SYNOPSIS` section
+func NewManpage(doc types.Document) (Manpage, error) {
+	header, found := doc.Header()
+	if !found {
+		return Manpage{}, errors.New("manpage document must have a title")
+	}
+	title := strings.TrimSpace(PlainText(header.Title))
+	match := manpageTitleRegexp.FindStringSubmatch(title)
+	if match == nil {
+		return Manpage{}, errors.Errorf("manpage document title must be in the 'name(volnum)' form (actual: '%s')", title)
+	}
+	result := Manpage{
+		Title:  strings.TrimSpace(match[1]),
+		VolNum: strings.TrimSpace(match[2]),
+	}
+	sections := []types.Section{}
+	for _, element := range header.Elements {
+		if s, ok := element.(types.Section); ok {
+			sections = append(sections, s)
+		}
+	}
+	if len(sections) == 0 || !strings.EqualFold(strings.TrimSpace(PlainText(sections[0].Title)), "NAME") {
+		return Manpage{}, errors.New("manpage document must start with a 'NAME' section")
+	}
+	result.NameSection = sections[0]
+	var namePurpose string
+	for _, element := range sections[0].Elements {
+		if p, ok := element.(types.Paragraph); ok {
+			lines := make([]string, len(p.Lines))
+			for i, line := range p.Lines {
+				lines[i] = strings.TrimSpace(PlainText(line))
+			}
+			namePurpose = strings.Join(lines, " ")
+			break
+		}
+	}
+	match = manpageNamePurposeRegexp.FindStringSubmatch(namePurpose)
+	if match == nil {
+		return Manpage{}, errors.Errorf("manpage 'NAME' section must contain a 'name - purpose' paragraph (actual: '%s')", namePurpose)
+	}
+	// the paragraph may contain a comma-separated list of names, the first one being the name of the command
+	for _, name := range strings.Split(match[1], ",") {
+		result.Names = append(result.Names, strings.TrimSpace(name))
+	}
+	result.Name = result.Names[0]
+	result.Purpose = match[2]
+	for _, s := range sections[1:] {
+		if strings.EqualFold(strings.TrimSpace(PlainText(s.Title)), "SYNOPSIS") {
+			return result, nil
+		}
+	}
+	return Manpage{}, errors.New("manpage document must have a 'SYNOPSIS' section")
+}
+
+// ProcessManpageHeader validates the structure of the document if it has the `manpage` doctype, and includes
+// its details in the document attributes. Documents which do not conform to the `manpage` doctype are rendered
+// as articles.
+func ProcessManpageHeader(ctx *Context) {
+	if doctype, _ := ctx.Document.Attributes.GetAsString(types.AttrDocType); doctype != types.DocTypeManpage {
+		return
+	}
+	manpage, err := NewManpage(ctx.Document)
+	if err != nil {
+		log.Warnf("invalid manpage document, rendering it as an article instead: %v", err)
+		ctx.Document.Attributes[types.AttrDocType] = "article"
+		return
+	}
+	ctx.Document.Attributes.AddNonEmpty(types.AttrManTitle, manpage.Title)
+	ctx.Document.Attributes.AddNonEmpty(types.AttrManVolNum, manpage.VolNum)
+	ctx.Document.Attributes.AddNonEmpty(types.AttrManName, manpage.Name)
+	ctx.Document.Attributes.AddNonEmpty(types.AttrManPurpose, manpage.Purpose)
+}
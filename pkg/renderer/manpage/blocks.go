@@ -0,0 +1,292 @@
+package manpage
+
+import (
+	"bytes"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var admonitionTmpl texttemplate.Template
+var listingTmpl texttemplate.Template
+var quoteTmpl texttemplate.Template
+var exampleTmpl texttemplate.Template
+
+// initializes the templates
+func init() {
+	admonitionTmpl = renderer.NewTextTemplate("admonition", `{{ with .Data }}.if n .sp
+.RS 4
+.B {{ .Label }}{{ if .Title }}: {{ escape .Title }}{{ end }}
+.br
+{{ .Content }}
+.RE{{ end }}`,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+	listingTmpl = renderer.NewTextTemplate("listing", `{{ with .Data }}.sp
+{{ .Title }}.if n .RS 4
+.nf
+.fam C
+{{ .Content }}
+.fam
+.fi
+.if n .RE{{ end }}`)
+	quoteTmpl = renderer.NewTextTemplate("quote", `{{ with .Data }}.sp
+{{ .Title }}.RS 3
+.ll -.6i{{ if .Verse }}
+.nf{{ end }}
+{{ .Content }}{{ if .Verse }}
+.fi{{ end }}
+.br
+.RE
+.ll{{ if .Attribution }}
+.RS 5
+.ll -.10i
+\(em {{ escape .Attribution }}
+.RE
+.ll{{ end }}{{ end }}`,
+		texttemplate.FuncMap{
+			"escape": EscapeString,
+		})
+	exampleTmpl = renderer.NewTextTemplate("example", `{{ with .Data }}.sp
+{{ .Title }}.RS 4
+{{ .Content }}
+.RE{{ end }}`)
+}
+
+func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
+	title, err := renderInlineElements(ctx, s.Title)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render section")
+	}
+	result := bytes.NewBuffer(nil)
+	switch s.Level {
+	case 1:
+		result.WriteString(`.SH "` + strings.ToUpper(strings.Replace(strings.TrimSpace(string(title)), `"`, `\(dq`, -1)) + `"`)
+	default:
+		result.WriteString(`.SS "` + strings.Replace(strings.TrimSpace(string(title)), `"`, `\(dq`, -1) + `"`)
+	}
+	content, err := renderElements(ctx, s.Elements)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render section")
+	}
+	if len(content) > 0 {
+		result.WriteString("\n")
+		result.Write(content)
+	}
+	return result.Bytes(), nil
+}
+
+func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		content, err := renderLines(ctx, p.Lines)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render admonition paragraph")
+		}
+		return renderAdmonition(ctx, p.Attributes, k, string(content))
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source:
+		lines := make([]string, len(p.Lines))
+		for i, line := range p.Lines {
+			lines[i] = renderer.PlainText(line)
+		}
+		return renderListing(ctx, p.Attributes, lines)
+	case types.Verse, types.Quote:
+		content, err := renderLines(ctx, p.Lines)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render quote paragraph")
+		}
+		return renderQuote(ctx, p.Attributes, string(content), p.Attributes[types.AttrKind] == types.Verse)
+	}
+	log.Debug("rendering a standalone paragraph")
+	content, err := renderLines(ctx, p.Lines)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render paragraph")
+	}
+	return []byte(".sp\n" + renderBlockTitle(p.Attributes) + string(content)), nil
+}
+
+// renderListItemParagraph renders the given paragraph without vertical spacing, since it follows the bullet or the number
+// of a list item
+func renderListItemParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	content, err := renderLines(ctx, p.Lines)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render paragraph")
+	}
+	switch p.Attributes[types.AttrCheckStyle] {
+	case types.Checked:
+		return append([]byte(`[x] `), content...), nil
+	case types.Unchecked:
+		return append([]byte(`[ ] `), content...), nil
+	default:
+		return content, nil
+	}
+}
+
+func renderAdmonition(ctx *renderer.Context, attrs types.ElementAttributes, kind types.AdmonitionKind, content string) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := admonitionTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			Label   string
+			Title   string
+			Content string
+		}{
+			Label:   strings.Title(string(kind)),
+			Title:   renderTitle(attrs),
+			Content: content,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render admonition")
+	}
+	return result.Bytes(), nil
+}
+
+// renderListing renders the given lines as-is (ie, without filling), in a monospaced font
+func renderListing(ctx *renderer.Context, attrs types.ElementAttributes, lines []string) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := listingTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			Title   string
+			Content string
+		}{
+			Title:   renderBlockTitle(attrs),
+			Content: escapeControlLines(EscapeString(strings.Join(lines, "\n"))),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render listing")
+	}
+	return result.Bytes(), nil
+}
+
+func renderQuote(ctx *renderer.Context, attrs types.ElementAttributes, content string, verse bool) ([]byte, error) {
+	attribution := []string{}
+	if author := attrs.GetAsString(types.AttrQuoteAuthor); author != "" {
+		attribution = append(attribution, author)
+	}
+	if title := attrs.GetAsString(types.AttrQuoteTitle); title != "" {
+		attribution = append(attribution, title)
+	}
+	result := bytes.NewBuffer(nil)
+	err := quoteTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			Title       string
+			Verse       bool
+			Content     string
+			Attribution string
+		}{
+			Title:       renderBlockTitle(attrs),
+			Verse:       verse,
+			Content:     content,
+			Attribution: strings.Join(attribution, ", "),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render quote")
+	}
+	return result.Bytes(), nil
+}
+
+func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	switch b.Kind {
+	case types.Fenced, types.Listing, types.Source:
+		lines := []string{}
+		for _, element := range discardTrailingBlankLines(b.Elements) {
+			switch e := element.(type) {
+			case types.Paragraph:
+				for _, line := range e.Lines {
+					lines = append(lines, renderer.PlainText(line))
+				}
+			case types.BlankLine:
+				lines = append(lines, "")
+			}
+		}
+		return renderListing(ctx, b.Attributes, lines)
+	case types.Verse:
+		lines := []string{}
+		for _, element := range discardTrailingBlankLines(b.Elements) {
+			switch e := element.(type) {
+			case types.Paragraph:
+				content, err := renderLines(ctx, e.Lines)
+				if err != nil {
+					return nil, errors.Wrap(err, "unable to render verse block")
+				}
+				lines = append(lines, string(content))
+			case types.BlankLine:
+				lines = append(lines, "")
+			}
+		}
+		return renderQuote(ctx, b.Attributes, strings.Join(lines, "\n"), true)
+	}
+	content, err := renderElements(ctx, discardTrailingBlankLines(b.Elements))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render delimited block")
+	}
+	// the blocks start with a vertical spacing, which is not needed at the beginning of a delimited block
+	content = bytes.TrimPrefix(content, []byte(".sp\n"))
+	switch b.Kind {
+	case types.Example:
+		if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+			return renderAdmonition(ctx, b.Attributes, k, string(content))
+		}
+		return renderExample(ctx, b.Attributes, string(content))
+	case types.Sidebar:
+		return renderExample(ctx, b.Attributes, string(content))
+	case types.Quote:
+		return renderQuote(ctx, b.Attributes, string(content), false)
+	default:
+		return nil, errors.Errorf("unable to render delimited block of kind '%v'", b.Kind)
+	}
+}
+
+func renderExample(ctx *renderer.Context, attrs types.ElementAttributes, content string) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	err := exampleTmpl.Execute(result, renderer.ContextualPipeline{
+		Context: ctx,
+		Data: struct {
+			Title   string
+			Content string
+		}{
+			Title:   renderBlockTitle(attrs),
+			Content: content,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render example")
+	}
+	return result.Bytes(), nil
+}
+
+func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock) ([]byte, error) {
+	lines := b.Lines
+	if b.Attributes.GetAsString(types.AttrLiteralBlockType) == types.LiteralBlockWithSpacesOnFirstLine {
+		lines = renderer.TrimCommonIndentation(b.Lines)
+	}
+	return renderListing(ctx, b.Attributes, lines)
+}
+
+// renderMediaBlock renders the given text (eg: the alt text of an image) in place of an image, a video or an audio
+func renderMediaBlock(ctx *renderer.Context, attrs types.ElementAttributes, text string) ([]byte, error) { //nolint:unparam
+	return []byte(".sp\n" + renderBlockTitle(attrs) + EscapeString(text)), nil
+}
+
+func discardTrailingBlankLines(elements []interface{}) []interface{} {
+	result := elements
+	for len(result) > 0 {
+		if _, ok := result[len(result)-1].(types.BlankLine); !ok {
+			break
+		}
+		result = result[:len(result)-1]
+	}
+	return result
+}
@@ -0,0 +1,164 @@
+package manpage
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var documentTmpl texttemplate.Template
+var bodyTmpl texttemplate.Template
+
+func init() {
+	documentTmpl = renderer.NewTextTemplate("root document", `'\" t
+.\"     Title: {{ .Title }}{{ if .Author }}
+.\"    Author: {{ .Author }}{{ end }}
+.\" Generator: libasciidoc
+.\"      Date: {{ .Date }}{{ if .Manual }}
+.\"    Manual: {{ .Manual }}{{ end }}{{ if .Source }}
+.\"    Source: {{ .Source }}{{ end }}
+.\"
+.TH "{{ arg (upper .Title) }}" "{{ arg .VolNum }}" "{{ arg .Date }}" "{{ if .Source }}{{ arg .Source }}{{ else }}\ \&{{ end }}" "{{ if .Manual }}{{ arg .Manual }}{{ else }}\ \&{{ end }}"
+.ie \n(.g .ds Aq \(aq
+.el       .ds Aq '
+.ss \n[.ss] 0
+.nh
+.ad l
+{{ .Content }}`,
+		texttemplate.FuncMap{
+			"arg":   escapeQuotedArg,
+			"upper": strings.ToUpper,
+		})
+	bodyTmpl = renderer.NewTextTemplate("body", `.SH "NAME"
+{{ escape .Names }} \- {{ escape .Purpose }}{{ if .Content }}
+{{ .Content }}{{ end }}{{ if .Footnotes }}
+.SH "NOTES"{{ range $index, $footnote := .Footnotes }}
+.IP [{{ inc $index }}]
+{{ $footnote }}{{ end }}{{ end }}{{ if .Authors }}
+.SH "AUTHOR(S)"{{ range .Authors }}
+.sp
+\fB{{ escape (trim .FullName) }}\fP{{ if .Email }} <{{ escape .Email }}>{{ end }}
+.RS 4
+Author.
+.RE{{ end }}{{ end }}`,
+		texttemplate.FuncMap{
+			"arg":    escapeQuotedArg,
+			"escape": EscapeString,
+			"inc":    func(i int) int { return i + 1 },
+			"trim":   strings.TrimSpace,
+		})
+}
+
+// renderDocument renders the whole document, including the title header (`.TH`) if needed
+func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	manpage, err := renderer.NewManpage(ctx.Document)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render manpage")
+	}
+	renderedBody, err := renderBody(ctx, manpage)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render manpage")
+	}
+	if ctx.IncludeHeaderFooter() {
+		log.Debugf("Rendering full document...")
+		authors := []string{}
+		if docAuthors, found := ctx.Document.Authors(); found {
+			for _, a := range docAuthors {
+				authors = append(authors, strings.TrimSpace(a.FullName))
+			}
+		}
+		err = documentTmpl.Execute(output, struct {
+			Title   string
+			VolNum  string
+			Author  string
+			Date    string
+			Manual  string
+			Source  string
+			Content string
+		}{
+			Title:   manpage.Title,
+			VolNum:  manpage.VolNum,
+			Author:  strings.Join(authors, ", "),
+			Date:    renderDate(ctx),
+			Manual:  ctx.Document.Attributes.GetAsStringWithDefault(types.AttrManManual, ""),
+			Source:  ctx.Document.Attributes.GetAsStringWithDefault(types.AttrManSource, ""),
+			Content: string(renderedBody),
+		})
+	} else {
+		_, err = output.Write(renderedBody)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render manpage")
+	}
+	metadata := ctx.Document.Attributes
+	metadata[types.AttrTitle] = manpage.Title + "(" + manpage.VolNum + ")"
+	metadata["LastUpdated"] = ctx.LastUpdated()
+	return metadata, nil
+}
+
+// renderBody renders the `NAME` section, followed by the other sections, the footnotes and the authors
+func renderBody(ctx *renderer.Context, manpage renderer.Manpage) ([]byte, error) {
+	header, _ := ctx.Document.Header()
+	elements := []interface{}{}
+	nameSectionFound := false
+	for _, element := range header.Elements {
+		if _, ok := element.(types.Section); ok && !nameSectionFound {
+			// the `NAME` section is rendered from the manpage details
+			nameSectionFound = true
+			continue
+		}
+		elements = append(elements, element)
+	}
+	// also include the elements which follow the header, if any
+	elements = append(elements, ctx.Document.Elements[1:]...)
+	renderedElements, err := renderElements(ctx, elements)
+	if err != nil {
+		return nil, err
+	}
+	footnotes := make([]string, len(ctx.Document.Footnotes))
+	for i, note := range ctx.Document.Footnotes {
+		renderedNote, err := renderInlineElements(ctx, note.Elements)
+		if err != nil {
+			return nil, err
+		}
+		footnotes[i] = strings.TrimSpace(string(renderedNote))
+	}
+	authors, _ := ctx.Document.Authors()
+	result := bytes.NewBuffer(nil)
+	err = bodyTmpl.Execute(result, struct {
+		Names     string
+		Purpose   string
+		Content   string
+		Footnotes []string
+		Authors   []types.DocumentAuthor
+	}{
+		Names:     strings.Join(manpage.Names, ", "),
+		Purpose:   manpage.Purpose,
+		Content:   string(renderedElements),
+		Footnotes: footnotes,
+		Authors:   authors,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Bytes(), nil
+}
+
+// renderDate returns the revision date of the document, or the date on which it was last updated
+func renderDate(ctx *renderer.Context) string {
+	if revdate, found := ctx.Document.Attributes.GetAsString("revdate"); found {
+		return revdate
+	}
+	return strings.Split(ctx.LastUpdated(), " ")[0]
+}
+
+func footnoteNumber(id int) string {
+	return "[" + strconv.Itoa(id+1) + "]"
+}
@@ -0,0 +1,181 @@
+package manpage_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("documents", func() {
+
+	It("document with header, sections and authors", func() {
+		source := `= git-foo(1)
+John Doe <john@example.com>
+:doctype: manpage
+:revdate: 2020-01-01
+:manmanual: Git Manual
+:mansource: Git 1.0
+
+== NAME
+
+git-foo, git-bar - does foo things
+
+== SYNOPSIS
+
+*git foo* [_options_]
+
+== OPTIONS
+
+=== General options
+
+content`
+		expected := `'\" t
+.\"     Title: git-foo
+.\"    Author: John Doe
+.\" Generator: libasciidoc
+.\"      Date: 2020-01-01
+.\"    Manual: Git Manual
+.\"    Source: Git 1.0
+.\"
+.TH "GIT\-FOO" "1" "2020\-01\-01" "Git 1.0" "Git Manual"
+.ie \n(.g .ds Aq \(aq
+.el       .ds Aq '
+.ss \n[.ss] 0
+.nh
+.ad l
+.SH "NAME"
+git\-foo, git\-bar \- does foo things
+.SH "SYNOPSIS"
+.sp
+\fBgit foo\fP [\fIoptions\fP]
+.SH "OPTIONS"
+.SS "General options"
+.sp
+content
+.SH "AUTHOR(S)"
+.sp
+\fBJohn Doe\fP <john@example.com>
+.RS 4
+Author.
+.RE`
+		Expect(source).To(RenderManpageBody(expected, renderer.IncludeHeaderFooter(true)))
+	})
+
+	It("document without manual and source", func() {
+		source := `= git-foo(1)
+:doctype: manpage
+:revdate: 2020-01-01
+
+== NAME
+
+git-foo - does foo things
+
+== SYNOPSIS
+
+*git foo*`
+		expected := `'\" t
+.\"     Title: git-foo
+.\" Generator: libasciidoc
+.\"      Date: 2020-01-01
+.\"
+.TH "GIT\-FOO" "1" "2020\-01\-01" "\ \&" "\ \&"
+.ie \n(.g .ds Aq \(aq
+.el       .ds Aq '
+.ss \n[.ss] 0
+.nh
+.ad l
+.SH "NAME"
+git\-foo \- does foo things
+.SH "SYNOPSIS"
+.sp
+\fBgit foo\fP`
+		Expect(source).To(RenderManpageBody(expected, renderer.IncludeHeaderFooter(true)))
+	})
+
+	It("document with footnotes", func() {
+		source := `= git-foo(1)
+
+== NAME
+
+git-foo - does foo things
+
+== SYNOPSIS
+
+some content footnote:[a *note*] and more footnote:[another note]`
+		expected := `.SH "NAME"
+git\-foo \- does foo things
+.SH "SYNOPSIS"
+.sp
+some content [1] and more [2]
+.SH "NOTES"
+.IP [1]
+a \fBnote\fP
+.IP [2]
+another note`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	Context("invalid documents", func() {
+
+		render := func(source string) error {
+			_, err := libasciidoc.Convert(context.Background(), "test.adoc", strings.NewReader(source), bytes.NewBuffer(nil), renderer.Backend("manpage"))
+			return err
+		}
+
+		It("document without title", func() {
+			source := `== NAME
+
+git-foo - does foo things`
+			Expect(render(source)).To(MatchError(ContainSubstring("manpage document must have a title")))
+		})
+
+		It("document with invalid title", func() {
+			source := `= git-foo
+
+== NAME
+
+git-foo - does foo things
+
+== SYNOPSIS`
+			Expect(render(source)).To(MatchError(ContainSubstring("manpage document title must be in the 'name(volnum)' form (actual: 'git-foo')")))
+		})
+
+		It("document without NAME section", func() {
+			source := `= git-foo(1)
+
+== SYNOPSIS
+
+*git foo*`
+			Expect(render(source)).To(MatchError(ContainSubstring("manpage document must start with a 'NAME' section")))
+		})
+
+		It("document with invalid NAME section", func() {
+			source := `= git-foo(1)
+
+== NAME
+
+git-foo does foo things
+
+== SYNOPSIS
+
+*git foo*`
+			Expect(render(source)).To(MatchError(ContainSubstring("manpage 'NAME' section must contain a 'name - purpose' paragraph (actual: 'git-foo does foo things')")))
+		})
+
+		It("document without SYNOPSIS section", func() {
+			source := `= git-foo(1)
+
+== NAME
+
+git-foo - does foo things`
+			Expect(render(source)).To(MatchError(ContainSubstring("manpage document must have a 'SYNOPSIS' section")))
+		})
+	})
+})
@@ -0,0 +1,151 @@
+package manpage
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderInlineElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	for i, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render line")
+		}
+		if _, ok := element.(types.StringElement); ok && i == len(elements)-1 {
+			// trim trailing spaces before returning the line
+			renderedElement = bytes.TrimRight(renderedElement, " ")
+		}
+		buf.Write(renderedElement)
+	}
+	return buf.Bytes(), nil
+}
+
+// renderLines renders all lines, one per line of output. Leading spaces are discarded,
+// since they are significant in roff
+func renderLines(ctx *renderer.Context, lines [][]interface{}) ([]byte, error) {
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		renderedLine, err := renderInlineElements(ctx, line)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render lines")
+		}
+		l := strings.TrimLeft(string(renderedLine), " ")
+		if l == "" {
+			continue
+		}
+		if strings.HasPrefix(l, ".") {
+			// prevent the line from being interpreted as a request
+			l = `\&` + l
+		}
+		result = append(result, l)
+	}
+	return []byte(strings.Join(result, "\n")), nil
+}
+
+func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error) {
+	content, err := renderInlineElements(ctx, t.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quoted text")
+	}
+	switch t.Kind {
+	case types.Bold:
+		return []byte(`\fB` + string(content) + `\fP`), nil
+	case types.Italic:
+		return []byte(`\fI` + string(content) + `\fP`), nil
+	case types.Monospace:
+		return []byte(`\f(CR` + string(content) + `\fP`), nil
+	default:
+		// no subscript or superscript in roff
+		return content, nil
+	}
+}
+
+func renderPassthrough(ctx *renderer.Context, p types.Passthrough) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	for _, element := range p.Elements {
+		switch element := element.(type) {
+		case types.StringElement:
+			if p.Kind == types.SinglePlusPassthrough {
+				// content is escaped, but not substituted
+				buf.WriteString(EscapeString(element.Content))
+			} else {
+				// content is rendered as-is
+				buf.WriteString(element.Content)
+			}
+		default:
+			renderedElement, err := renderElement(ctx, element)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render passthrough")
+			}
+			buf.Write(renderedElement)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// renderLink renders the text of the link followed by its URL, or the URL alone if the link has no text
+func renderLink(ctx *renderer.Context, l types.InlineLink) ([]byte, error) {
+	location := EscapeString(l.Location.String())
+	if t, ok := l.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+		text, err := renderInlineElements(ctx, t)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render link")
+		}
+		return []byte(string(text) + ` \(la` + location + `\(ra`), nil
+	}
+	return []byte(location), nil
+}
+
+// renderInternalCrossReference renders the label of the cross reference, or the title of the target element
+func renderInternalCrossReference(ctx *renderer.Context, xref types.InternalCrossReference) ([]byte, error) {
+	if xref.Label != "" {
+		return []byte(EscapeString(xref.Label)), nil
+	}
+	if target, ok := ctx.Document.ElementReferences[xref.ID].([]interface{}); ok {
+		return renderInlineElements(ctx, target)
+	}
+	return []byte(EscapeString("[" + xref.ID + "]")), nil
+}
+
+// renderFootnote renders the number of the footnote, whose content is rendered in the `NOTES` section
+func renderFootnote(ctx *renderer.Context, note types.Footnote) ([]byte, error) { //nolint:unparam
+	if id, found := ctx.Document.Footnotes.IndexOf(note); found {
+		return []byte(footnoteNumber(id)), nil
+	}
+	if noteRef, found := ctx.Document.FootnoteReferences[note.Ref]; found {
+		if id, found := ctx.Document.Footnotes.IndexOf(noteRef); found {
+			return []byte(footnoteNumber(id)), nil
+		}
+	}
+	// invalid footnote
+	return []byte(EscapeString("[" + note.Ref + "]")), nil
+}
+
+func renderUserMacro(ctx *renderer.Context, um types.UserMacro) ([]byte, error) {
+	buf := bytes.NewBuffer([]byte{})
+	macro, err := ctx.MacroTemplate(um.Name)
+	if err != nil {
+		if um.Kind == types.BlockMacro {
+			// fallback to paragraph
+			p, _ := types.NewParagraph([]interface{}{
+				[]interface{}{
+					types.StringElement{Content: um.RawText},
+				},
+			}, nil)
+			return renderParagraph(ctx, p)
+		}
+		// fallback to render raw text
+		_, err = buf.WriteString(EscapeString(um.RawText))
+	} else {
+		err = macro.Execute(buf, um)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,137 @@
+package manpage
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// the bullet of the unordered list items, with an indentation for the nroff and the troff outputs
+const bulletItemStart = `.sp
+.RS 4
+.ie n \{\
+\h'-04'\(bu\h'+03'\c
+.\}
+.el \{\
+.  sp -1
+.  IP \(bu 2.3
+.\}
+`
+
+// the number of the ordered list items, with an indentation for the nroff and the troff outputs
+const numberedItemStart = `.sp
+.RS 4
+.ie n \{\
+\h'-04'%[1]s\h'+01'\c
+.\}
+.el \{\
+.  sp -1
+.  IP "%[1]s" 4.2
+.\}
+`
+
+func renderUnorderedList(ctx *renderer.Context, l types.UnorderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderListTitle(l.Attributes))
+	for i, item := range l.Items {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		result.WriteString(bulletItemStart)
+		if err := renderListItemElements(ctx, result, item.Elements); err != nil {
+			return nil, errors.Wrap(err, "unable to render unordered list")
+		}
+		result.WriteString("\n.RE")
+	}
+	return result.Bytes(), nil
+}
+
+func renderOrderedList(ctx *renderer.Context, l types.OrderedList) ([]byte, error) {
+	start := 1
+	if s, err := strconv.Atoi(l.Attributes.GetAsString(types.AttrStart)); err == nil {
+		start = s
+	}
+	style := types.NumberingStyle(l.Attributes.GetAsString(types.AttrNumberingStyle))
+	if style == "" && len(l.Items) > 0 {
+		style = l.Items[0].NumberingStyle
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderListTitle(l.Attributes))
+	for i, item := range l.Items {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		result.WriteString(fmt.Sprintf(numberedItemStart, fmt.Sprintf("%2s.", style.ItemNumber(start+i))))
+		if err := renderListItemElements(ctx, result, item.Elements); err != nil {
+			return nil, errors.Wrap(err, "unable to render ordered list")
+		}
+		result.WriteString("\n.RE")
+	}
+	return result.Bytes(), nil
+}
+
+func renderLabeledList(ctx *renderer.Context, l types.LabeledList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderListTitle(l.Attributes))
+	qanda := l.Attributes.Has(types.AttrQandA)
+	for i, item := range l.Items {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		term, err := renderInlineElements(ctx, item.Term)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render labeled list")
+		}
+		if qanda {
+			// questions are rendered in italic
+			term = []byte(`\fI` + string(term) + `\fP`)
+		}
+		result.WriteString(".sp\n")
+		result.Write(term)
+		if len(item.Elements) > 0 {
+			result.WriteString("\n.RS 4\n")
+			if err := renderListItemElements(ctx, result, item.Elements); err != nil {
+				return nil, errors.Wrap(err, "unable to render labeled list")
+			}
+			result.WriteString("\n.RE")
+		}
+	}
+	return result.Bytes(), nil
+}
+
+// renderListItemElements renders the elements of a list item. The first paragraph of the item
+// is rendered without vertical spacing, since it follows the bullet, the number or the term of the item
+func renderListItemElements(ctx *renderer.Context, result *bytes.Buffer, elements []interface{}) error {
+	if len(elements) == 0 {
+		return nil
+	}
+	if p, ok := elements[0].(types.Paragraph); ok && !p.Attributes.Has(types.AttrKind) && !p.Attributes.Has(types.AttrAdmonitionKind) {
+		content, err := renderListItemParagraph(ctx, p)
+		if err != nil {
+			return err
+		}
+		result.Write(content)
+		elements = elements[1:]
+		if len(elements) > 0 {
+			result.WriteString("\n")
+		}
+	}
+	content, err := renderElements(ctx, elements)
+	if err != nil {
+		return err
+	}
+	result.Write(content)
+	return nil
+}
+
+// renderListTitle renders the title of the list, or returns an empty string if the list has no title
+func renderListTitle(attrs types.ElementAttributes) string {
+	if title := renderBlockTitle(attrs); title != "" {
+		return ".sp\n" + title
+	}
+	return ""
+}
@@ -0,0 +1,142 @@
+package manpage_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lists", func() {
+
+	It("unordered list with nested list and checklist", func() {
+		source := header + `* item 1
+** nested item
+* [x] item 2`
+		expected := renderedHeader + `.sp
+.RS 4
+.ie n \{\
+\h'-04'\(bu\h'+03'\c
+.\}
+.el \{\
+.  sp -1
+.  IP \(bu 2.3
+.\}
+item 1
+.sp
+.RS 4
+.ie n \{\
+\h'-04'\(bu\h'+03'\c
+.\}
+.el \{\
+.  sp -1
+.  IP \(bu 2.3
+.\}
+nested item
+.RE
+.RE
+.sp
+.RS 4
+.ie n \{\
+\h'-04'\(bu\h'+03'\c
+.\}
+.el \{\
+.  sp -1
+.  IP \(bu 2.3
+.\}
+[x] item 2
+.RE`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("ordered list with title", func() {
+		source := header + `.Steps
+. step 1
+. step 2
++
+more content`
+		expected := renderedHeader + `.sp
+.B Steps
+.br
+.sp
+.RS 4
+.ie n \{\
+\h'-04' 1.\h'+01'\c
+.\}
+.el \{\
+.  sp -1
+.  IP " 1." 4.2
+.\}
+step 1
+.RE
+.sp
+.RS 4
+.ie n \{\
+\h'-04' 2.\h'+01'\c
+.\}
+.el \{\
+.  sp -1
+.  IP " 2." 4.2
+.\}
+step 2
+.sp
+more content
+.RE`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("ordered list with numbering style and start", func() {
+		source := header + `[lowerroman,start=3]
+. item 1
+. item 2`
+		expected := renderedHeader + `.sp
+.RS 4
+.ie n \{\
+\h'-04'iii.\h'+01'\c
+.\}
+.el \{\
+.  sp -1
+.  IP "iii." 4.2
+.\}
+item 1
+.RE
+.sp
+.RS 4
+.ie n \{\
+\h'-04'iv.\h'+01'\c
+.\}
+.el \{\
+.  sp -1
+.  IP "iv." 4.2
+.\}
+item 2
+.RE`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("labeled list", func() {
+		source := header + `-v, --verbose::
+  be verbose
+-q::`
+		expected := renderedHeader + `.sp
+\-v, \-\-verbose
+.RS 4
+be verbose
+.RE
+.sp
+\-q`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("q and a list", func() {
+		source := header + `[qanda]
+What?::
+  That.`
+		expected := renderedHeader + `.sp
+\fIWhat?\fP
+.RS 4
+That.
+.RE`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+})
@@ -0,0 +1,114 @@
+// Package manpage renders the documents with the `manpage` doctype in the roff format, used by the `man` command
+package manpage
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Render renders the given document in roff and writes the result in the given `writer`.
+// Returns an error if the document does not conform to the `manpage` doctype
+func Render(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	return renderDocument(ctx, output)
+}
+
+// renderElements renders the given blocks, one after the other. Since each block starts with
+// a request (eg: `.sp`), there is no need for extra spacing between them
+func renderElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	log.Debugf("rendering %d elements(s)...", len(elements))
+	buff := bytes.NewBuffer(nil)
+	for _, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render an element")
+		}
+		if buff.Len() > 0 && len(renderedElement) > 0 {
+			buff.WriteString("\n")
+		}
+		buff.Write(renderedElement)
+	}
+	return buff.Bytes(), nil
+}
+
+// nolint: gocyclo
+func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
+	switch e := element.(type) {
+	case []interface{}:
+		return renderElements(ctx, e)
+	case types.TableOfContentsMacro:
+		// no table of contents in a manual page
+		return []byte{}, nil
+	case types.Section:
+		return renderSection(ctx, e)
+	case types.Preamble:
+		return renderElements(ctx, e.Elements)
+	case types.BlankLine:
+		return []byte{}, nil
+	case types.LabeledList:
+		return renderLabeledList(ctx, e)
+	case types.OrderedList:
+		return renderOrderedList(ctx, e)
+	case types.UnorderedList:
+		return renderUnorderedList(ctx, e)
+	case types.Paragraph:
+		return renderParagraph(ctx, e)
+	case types.InternalCrossReference:
+		return renderInternalCrossReference(ctx, e)
+	case types.ExternalCrossReference:
+		return renderInlineElements(ctx, e.Label)
+	case types.QuotedText:
+		return renderQuotedText(ctx, e)
+	case types.Passthrough:
+		return renderPassthrough(ctx, e)
+	case types.ImageBlock:
+		return renderMediaBlock(ctx, e.Attributes, "["+e.Attributes.GetAsString(types.AttrImageAlt)+"]")
+	case types.InlineImage:
+		return []byte(EscapeString("[" + e.Attributes.GetAsString(types.AttrImageAlt) + "]")), nil
+	case types.VideoBlock:
+		return renderMediaBlock(ctx, e.Attributes, "<"+e.URL()+"> (video)")
+	case types.AudioBlock:
+		return renderMediaBlock(ctx, e.Attributes, "<"+e.Location.String()+"> (audio)")
+	case types.DelimitedBlock:
+		return renderDelimitedBlock(ctx, e)
+	case types.Table:
+		return renderTable(ctx, e)
+	case types.LiteralBlock:
+		return renderLiteralBlock(ctx, e)
+	case types.InlineLink:
+		return renderLink(ctx, e)
+	case types.StringElement:
+		return []byte(EscapeString(e.Content)), nil
+	case types.Footnote:
+		return renderFootnote(ctx, e)
+	case types.LineBreak:
+		return []byte("\n.br"), nil
+	case types.UserMacro:
+		return renderUserMacro(ctx, e)
+	case types.InlineStem:
+		return []byte(EscapeString(e.Content)), nil
+	case types.InlineIcon:
+		return []byte(EscapeString("[" + e.Name + "]")), nil
+	case types.StemBlock:
+		return renderListing(ctx, e.Attributes, e.Lines)
+	default:
+		return nil, errors.Errorf("unsupported type of element: %T", element)
+	}
+}
+
+func renderTitle(attrs types.ElementAttributes) string {
+	return strings.TrimSpace(attrs.GetAsString(types.AttrTitle))
+}
+
+// renderBlockTitle renders the title of a block in bold, on its own line, or returns an empty string if the block has no title
+func renderBlockTitle(attrs types.ElementAttributes) string {
+	if title := renderTitle(attrs); title != "" {
+		return ".B " + EscapeString(title) + "\n.br\n"
+	}
+	return ""
+}
@@ -0,0 +1,189 @@
+package manpage_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// the header of all the manpage documents in the tests below
+const header = `= git-foo(1)
+
+== NAME
+
+git-foo - does foo things
+
+== SYNOPSIS
+
+`
+
+// the output of the header above
+const renderedHeader = `.SH "NAME"
+git\-foo \- does foo things
+.SH "SYNOPSIS"
+`
+
+var _ = Describe("paragraphs", func() {
+
+	It("paragraph with inline formatting", func() {
+		source := header + "*bold* _italic_ `mono` and https://example.com[a link] or https://example.com"
+		expected := renderedHeader + `.sp
+\fBbold\fP \fIitalic\fP \f(CRmono\fP and a link \(lahttps://example.com\(ra or https://example.com`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("paragraph with special characters", func() {
+		source := header + `a back\slash, a -dash and an 'apostrophe'
+.a line starting with a period`
+		expected := renderedHeader + `.sp
+a back\(rsslash, a \-dash and an \(aqapostrophe\(aq
+\&.a line starting with a period`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("paragraph with title and line break", func() {
+		source := header + `.a title
+a line +
+another line`
+		expected := renderedHeader + `.sp
+.B a title
+.br
+a line
+.br
+another line`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("paragraph with cross reference", func() {
+		source := header + `see <<_synopsis>>`
+		expected := renderedHeader + `.sp
+see SYNOPSIS`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("admonition paragraph", func() {
+		source := header + `NOTE: be careful`
+		expected := renderedHeader + `.if n .sp
+.RS 4
+.B Note
+.br
+be careful
+.RE`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+})
+
+var _ = Describe("delimited blocks", func() {
+
+	It("listing block", func() {
+		source := header + "```" + `
+.hidden
+  code \ here
+` + "```"
+		expected := renderedHeader + `.sp
+.if n .RS 4
+.nf
+.fam C
+\&.hidden
+  code \(rs here
+.fam
+.fi
+.if n .RE`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("source block with title", func() {
+		source := header + `[source,go]
+.Example
+----
+package foo
+----`
+		expected := renderedHeader + `.sp
+.B Example
+.br
+.if n .RS 4
+.nf
+.fam C
+package foo
+.fam
+.fi
+.if n .RE`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("literal block", func() {
+		source := header + `  some
+    literal content`
+		expected := renderedHeader + `.sp
+.if n .RS 4
+.nf
+.fam C
+some
+  literal content
+.fam
+.fi
+.if n .RE`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("example block", func() {
+		source := header + `.Example
+====
+some content
+====`
+		expected := renderedHeader + `.sp
+.B Example
+.br
+.RS 4
+some content
+.RE`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("quote block", func() {
+		source := header + `[quote, John Doe]
+____
+some *wise* words
+____`
+		expected := renderedHeader + `.sp
+.RS 3
+.ll -.6i
+some \fBwise\fP words
+.br
+.RE
+.ll
+.RS 5
+.ll -.10i
+\(em John Doe
+.RE
+.ll`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("verse block", func() {
+		source := header + `[verse]
+____
+line 1
+line 2
+____`
+		expected := renderedHeader + `.sp
+.RS 3
+.ll -.6i
+.nf
+line 1
+line 2
+.fi
+.br
+.RE
+.ll`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("youtube video block", func() {
+		source := header + `video::abc[youtube]`
+		expected := renderedHeader + `.sp
+<https://www.youtube.com/watch?v=abc> (video)`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+})
@@ -0,0 +1,33 @@
+package manpage
+
+import "strings"
+
+var roffReplacer = strings.NewReplacer(
+	`\`, `\(rs`,
+	`-`, `\-`,
+	`'`, `\(aq`,
+)
+
+// EscapeString escapes the characters which have a special meaning in roff
+func EscapeString(s string) string {
+	return roffReplacer.Replace(s)
+}
+
+var quotedArgReplacer = strings.NewReplacer(`"`, `\(dq`)
+
+// escapeQuotedArg escapes the given string to be used as a quoted argument of a request (eg: `.SH "NAME"`)
+func escapeQuotedArg(s string) string {
+	return quotedArgReplacer.Replace(EscapeString(s))
+}
+
+// escapeControlLines prevents the lines of the given text which start with a period
+// from being interpreted as requests
+func escapeControlLines(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, ".") {
+			lines[i] = `\&` + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,56 @@
+package manpage
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// renderTable renders the table with the `tbl` preprocessor, with each cell in a text block (`T{` and `T}`)
+// so that its content can span multiple lines
+func renderTable(ctx *renderer.Context, t types.Table) ([]byte, error) {
+	// the number of columns is given by the header or by the first line
+	var columns int
+	if len(t.Header.Cells) > 0 {
+		columns = len(t.Header.Cells)
+	} else if len(t.Lines) > 0 {
+		columns = len(t.Lines[0].Cells)
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(".sp\n" + renderBlockTitle(t.Attributes) + ".TS\nallbox tab(:);\n")
+	if len(t.Header.Cells) > 0 {
+		result.WriteString(strings.TrimSpace(strings.Repeat("ltB ", columns)) + ".\n")
+		if err := renderTableLine(ctx, result, t.Header); err != nil {
+			return nil, errors.Wrap(err, "unable to render table")
+		}
+		result.WriteString(".T&\n")
+	}
+	result.WriteString(strings.TrimSpace(strings.Repeat("lt ", columns)) + ".\n")
+	for _, line := range t.Lines {
+		if err := renderTableLine(ctx, result, line); err != nil {
+			return nil, errors.Wrap(err, "unable to render table")
+		}
+	}
+	result.WriteString(".TE")
+	return result.Bytes(), nil
+}
+
+func renderTableLine(ctx *renderer.Context, result *bytes.Buffer, line types.TableLine) error {
+	for i, cell := range line.Cells {
+		if i > 0 {
+			result.WriteString(":")
+		}
+		content, err := renderLines(ctx, [][]interface{}{cell})
+		if err != nil {
+			return err
+		}
+		result.WriteString("T{\n")
+		result.Write(content)
+		result.WriteString("\nT}")
+	}
+	result.WriteString("\n")
+	return nil
+}
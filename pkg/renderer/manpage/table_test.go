@@ -0,0 +1,63 @@
+package manpage_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tables", func() {
+
+	It("table with title and header", func() {
+		source := header + `.A table
+|===
+| Option | Description
+
+| -v | be *verbose*
+| -q | be quiet
+|===`
+		expected := renderedHeader + `.sp
+.B A table
+.br
+.TS
+allbox tab(:);
+ltB ltB.
+T{
+Option
+T}:T{
+Description
+T}
+.T&
+lt lt.
+T{
+\-v
+T}:T{
+be \fBverbose\fP
+T}
+T{
+\-q
+T}:T{
+be quiet
+T}
+.TE`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+
+	It("table without header", func() {
+		source := header + `|===
+| a | b
+|===`
+		expected := renderedHeader + `.sp
+.TS
+allbox tab(:);
+lt lt.
+T{
+a
+T}:T{
+b
+T}
+.TE`
+		Expect(source).To(RenderManpageBody(expected))
+	})
+})
@@ -0,0 +1,66 @@
+package renderer_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("manpages", func() {
+
+	parse := func(source string) types.Document {
+		doc, err := parser.ParseDocument("test.adoc", strings.NewReader(source))
+		Expect(err).ToNot(HaveOccurred())
+		return doc
+	}
+
+	It("valid manpage", func() {
+		doc := parse(`= git-foo(1)
+:doctype: manpage
+
+== NAME
+
+git-foo, git-bar - does *foo* things
+
+== SYNOPSIS
+
+*git foo*`)
+		manpage, err := renderer.NewManpage(doc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manpage.Title).To(Equal("git-foo"))
+		Expect(manpage.VolNum).To(Equal("1"))
+		Expect(manpage.Name).To(Equal("git-foo"))
+		Expect(manpage.Names).To(Equal([]string{"git-foo", "git-bar"}))
+		Expect(manpage.Purpose).To(Equal("does foo things"))
+		// also verify the document attributes
+		ctx := renderer.Wrap(context.Background(), doc)
+		renderer.ProcessManpageHeader(ctx)
+		Expect(ctx.Document.Attributes).To(HaveKeyWithValue(types.AttrDocType, types.DocTypeManpage))
+		Expect(ctx.Document.Attributes).To(HaveKeyWithValue(types.AttrManTitle, "git-foo"))
+		Expect(ctx.Document.Attributes).To(HaveKeyWithValue(types.AttrManVolNum, "1"))
+		Expect(ctx.Document.Attributes).To(HaveKeyWithValue(types.AttrManName, "git-foo"))
+		Expect(ctx.Document.Attributes).To(HaveKeyWithValue(types.AttrManPurpose, "does foo things"))
+	})
+
+	It("invalid manpage", func() {
+		doc := parse(`= git-foo(1)
+:doctype: manpage
+
+== NAME
+
+git-foo - does foo things`)
+		_, err := renderer.NewManpage(doc)
+		Expect(err).To(MatchError("manpage document must have a 'SYNOPSIS' section"))
+		// also verify that the document is processed as an article
+		ctx := renderer.Wrap(context.Background(), doc)
+		renderer.ProcessManpageHeader(ctx)
+		Expect(ctx.Document.Attributes).To(HaveKeyWithValue(types.AttrDocType, "article"))
+		Expect(ctx.Document.Attributes).ToNot(HaveKey(types.AttrManTitle))
+	})
+})
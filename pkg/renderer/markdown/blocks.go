@@ -0,0 +1,225 @@
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+func renderSection(ctx *renderer.Context, s types.Section) ([]byte, error) {
+	title, err := renderInlineElements(ctx, s.Title)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render section")
+	}
+	// Markdown headings are limited to 6 levels, the first one being used by the document title
+	level := s.Level + 1
+	if level > 6 {
+		level = 6
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(strings.Repeat("#", level) + " ")
+	if anchor := renderAnchor(s.Attributes); anchor != "" {
+		result.WriteString(anchor)
+	}
+	result.WriteString(strings.TrimSpace(string(title)))
+	content, err := renderElements(ctx, s.Elements)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render section")
+	}
+	if len(content) > 0 {
+		result.WriteString("\n\n")
+		result.Write(content)
+	}
+	return result.Bytes(), nil
+}
+
+func renderParagraph(ctx *renderer.Context, p types.Paragraph) ([]byte, error) {
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		content, err := renderLines(ctx, p.Lines)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render admonition paragraph")
+		}
+		return renderAdmonition(ctx, p.Attributes, k, content), nil
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source:
+		lines := make([]string, len(p.Lines))
+		for i, line := range p.Lines {
+			lines[i] = renderer.PlainText(line)
+		}
+		return renderFencedBlock(ctx, p.Attributes, p.Attributes.GetAsString(types.AttrLanguage), lines)
+	case types.Verse:
+		content, err := renderVerseLines(ctx, p.Lines)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render verse paragraph")
+		}
+		return renderQuote(ctx, p.Attributes, content), nil
+	case types.Quote:
+		content, err := renderLines(ctx, p.Lines)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render quote paragraph")
+		}
+		return renderQuote(ctx, p.Attributes, content), nil
+	}
+	log.Debug("rendering a standalone paragraph")
+	content, err := renderLines(ctx, p.Lines)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render paragraph")
+	}
+	return append([]byte(renderBlockTitle(p.Attributes)), content...), nil
+}
+
+// renderAdmonition renders the admonition as a blockquote, starting with its label (and title) in bold
+func renderAdmonition(ctx *renderer.Context, attrs types.ElementAttributes, kind types.AdmonitionKind, content []byte) []byte { //nolint:unparam
+	label := strings.Title(string(kind))
+	if title := renderTitle(attrs); title != "" {
+		label = label + ": " + EscapeString(title)
+	}
+	return blockquote([]byte("**" + label + "**\n\n" + string(content)))
+}
+
+// renderQuote renders the quote as a blockquote, followed by its attribution, if any
+func renderQuote(ctx *renderer.Context, attrs types.ElementAttributes, content []byte) []byte { //nolint:unparam
+	attribution := []string{}
+	if author := attrs.GetAsString(types.AttrQuoteAuthor); author != "" {
+		attribution = append(attribution, EscapeString(author))
+	}
+	if title := attrs.GetAsString(types.AttrQuoteTitle); title != "" {
+		attribution = append(attribution, "*"+EscapeString(title)+"*")
+	}
+	if len(attribution) > 0 {
+		content = append(content, []byte("\n\n— "+strings.Join(attribution, ", "))...)
+	}
+	return append([]byte(renderBlockTitle(attrs)), blockquote(content)...)
+}
+
+// renderVerseLines renders the given lines with a hard line break at the end of each line, and with
+// the empty lines as paragraph separators
+func renderVerseLines(ctx *renderer.Context, lines [][]interface{}) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for i, line := range lines {
+		renderedLine, err := renderInlineElements(ctx, line)
+		if err != nil {
+			return nil, err
+		}
+		result.WriteString(escapeBlockStart(strings.TrimLeft(string(renderedLine), " ")))
+		if i < len(lines)-1 {
+			if len(renderedLine) > 0 && len(lines[i+1]) > 0 {
+				result.WriteString(`\`)
+			}
+			result.WriteString("\n")
+		}
+	}
+	return result.Bytes(), nil
+}
+
+// blockquote prefixes all lines of the given content with the `>` marker
+func blockquote(content []byte) []byte {
+	lines := strings.Split(string(content), "\n")
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = ">"
+		} else {
+			lines[i] = "> " + line
+		}
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+var fenceRegexp = regexp.MustCompile("^\\s*(`{3,})")
+
+// renderFencedBlock renders the given lines in a fenced code block, with the given language, if any.
+// The fence is longer than any fence within the lines
+func renderFencedBlock(ctx *renderer.Context, attrs types.ElementAttributes, language string, lines []string) ([]byte, error) { //nolint:unparam
+	fence := "```"
+	for _, line := range lines {
+		if m := fenceRegexp.FindStringSubmatch(line); m != nil && len(m[1]) >= len(fence) {
+			fence = strings.Repeat("`", len(m[1])+1)
+		}
+	}
+	return []byte(renderBlockTitle(attrs) + fence + language + "\n" + strings.Join(lines, "\n") + "\n" + fence), nil
+}
+
+func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock) ([]byte, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	elements := discardTrailingBlankLines(b.Elements)
+	switch b.Kind {
+	case types.Comment:
+		return []byte{}, nil
+	case types.Fenced, types.Listing, types.Source:
+		lines := []string{}
+		for _, element := range elements {
+			switch e := element.(type) {
+			case types.Paragraph:
+				for _, line := range e.Lines {
+					lines = append(lines, renderer.PlainText(line))
+				}
+			case types.BlankLine:
+				lines = append(lines, "")
+			}
+		}
+		return renderFencedBlock(ctx, b.Attributes, b.Attributes.GetAsString(types.AttrLanguage), lines)
+	case types.Verse:
+		lines := [][]interface{}{}
+		for _, element := range elements {
+			switch e := element.(type) {
+			case types.Paragraph:
+				lines = append(lines, e.Lines...)
+			case types.BlankLine:
+				lines = append(lines, []interface{}{})
+			}
+		}
+		content, err := renderVerseLines(ctx, lines)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render verse block")
+		}
+		return renderQuote(ctx, b.Attributes, content), nil
+	}
+	content, err := renderElements(ctx, elements)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render delimited block")
+	}
+	switch b.Kind {
+	case types.Example:
+		if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+			return renderAdmonition(ctx, b.Attributes, k, content), nil
+		}
+		return append([]byte(renderBlockTitle(b.Attributes)), content...), nil
+	case types.Sidebar:
+		return append([]byte(renderBlockTitle(b.Attributes)), content...), nil
+	case types.Quote:
+		return renderQuote(ctx, b.Attributes, content), nil
+	default:
+		return nil, errors.Errorf("unable to render delimited block of kind '%v'", b.Kind)
+	}
+}
+
+func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock) ([]byte, error) {
+	lines := b.Lines
+	if b.Attributes.GetAsString(types.AttrLiteralBlockType) == types.LiteralBlockWithSpacesOnFirstLine {
+		lines = renderer.TrimCommonIndentation(b.Lines)
+	}
+	return renderFencedBlock(ctx, b.Attributes, "", lines)
+}
+
+func renderImageBlock(ctx *renderer.Context, img types.ImageBlock) ([]byte, error) { //nolint:unparam
+	return []byte(renderBlockTitle(img.Attributes) + renderImage(img.Attributes, img.Location)), nil
+}
+
+func renderInlineImage(ctx *renderer.Context, img types.InlineImage) ([]byte, error) { //nolint:unparam
+	return []byte(renderImage(img.Attributes, img.Location)), nil
+}
+
+// renderImage renders the image, wrapped in a link if the image has a `link` attribute
+func renderImage(attrs types.ElementAttributes, location types.Location) string {
+	result := "![" + EscapeString(attrs.GetAsString(types.AttrImageAlt)) + "](" + renderLinkDestination(location.String()) + ")"
+	if link := attrs.GetAsString(types.AttrInlineLink); link != "" {
+		result = "[" + result + "](" + renderLinkDestination(link) + ")"
+	}
+	return result
+}
@@ -0,0 +1,112 @@
+package markdown_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("delimited blocks", func() {
+
+	It("source block with language and title", func() {
+		source := `[source,go]
+.Example
+----
+func main() {}
+----`
+		expected := "**Example**\n\n```go\nfunc main() {}\n```"
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("listing block with fence", func() {
+		source := "----\n```\ncode\n```\n----"
+		expected := "````\n```\ncode\n```\n````"
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("literal block", func() {
+		source := `  some
+    literal content`
+		expected := "```\nsome\n  literal content\n```"
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("stem block", func() {
+		source := `[stem]
+++++
+sqrt(4) = 2
+++++`
+		expected := "```math\nsqrt(4) = 2\n```"
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("admonition block with title", func() {
+		source := `[WARNING]
+.Title
+====
+some *content*
+
+more content
+====`
+		expected := `> **Warning: Title**
+>
+> some **content**
+>
+> more content`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("quote block with attribution", func() {
+		source := `[quote, John Doe, Book]
+____
+some *wise* words
+____`
+		expected := `> some **wise** words
+>
+> — John Doe, *Book*`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("verse block", func() {
+		source := `[verse]
+____
+line 1
+line 2
+
+line 3
+____`
+		expected := `> line 1\
+> line 2
+>
+> line 3`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("example block with title", func() {
+		source := `.Example
+====
+some content
+====`
+		expected := `**Example**
+
+some content`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("image block with link", func() {
+		source := `image::foo.png[Foo, link=https://example.com]`
+		expected := `[![Foo](foo.png)](https://example.com)`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("video block in HTML", func() {
+		source := `video::abc[youtube]`
+		expected := `<div class="videoblock">
+<div class="content">
+<iframe src="https://www.youtube.com/embed/abc?rel=0" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+})
@@ -0,0 +1,70 @@
+package markdown
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// renderDocument renders the whole document, including its title if needed, followed by the footnotes
+func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	result := bytes.NewBuffer(nil)
+	elements := ctx.Document.Elements
+	var title string
+	if header, found := ctx.Document.Header(); found {
+		title = strings.TrimSpace(renderer.PlainText(header.Title))
+		if ctx.IncludeHeaderFooter() {
+			log.Debugf("Rendering full document...")
+			renderedTitle, err := renderInlineElements(ctx, header.Title)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render document")
+			}
+			result.WriteString("# " + strings.TrimSpace(string(renderedTitle)))
+		}
+		// retain the elements of the header, followed by the other elements, if any
+		elements = append(append([]interface{}{}, header.Elements...), ctx.Document.Elements[1:]...)
+	}
+	renderedElements, err := renderElements(ctx, elements)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render document")
+	}
+	if result.Len() > 0 && len(renderedElements) > 0 {
+		result.WriteString("\n\n")
+	}
+	result.Write(renderedElements)
+	renderedFootnotes, err := renderFootnotes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render document")
+	}
+	if result.Len() > 0 && len(renderedFootnotes) > 0 {
+		result.WriteString("\n\n")
+	}
+	result.Write(renderedFootnotes)
+	if _, err := output.Write(result.Bytes()); err != nil {
+		return nil, errors.Wrap(err, "unable to render document")
+	}
+	metadata := ctx.Document.Attributes
+	if title != "" {
+		metadata[types.AttrTitle] = title
+	}
+	metadata["LastUpdated"] = ctx.LastUpdated()
+	return metadata, nil
+}
+
+// renderFootnotes renders the footnotes of the document, one per line
+func renderFootnotes(ctx *renderer.Context) ([]byte, error) {
+	footnotes := make([]string, len(ctx.Document.Footnotes))
+	for i, note := range ctx.Document.Footnotes {
+		renderedNote, err := renderInlineElements(ctx, note.Elements)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render footnotes")
+		}
+		footnotes[i] = footnoteReference(i) + ": " + strings.TrimSpace(string(renderedNote))
+	}
+	return []byte(strings.Join(footnotes, "\n")), nil
+}
@@ -0,0 +1,100 @@
+package markdown_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("documents", func() {
+
+	source := `= Document Title
+
+some *content*
+
+== Section A
+
+content
+
+=== Section A.1
+
+more content footnote:[a *note*]
+
+====== Section A.1.1.1.1
+
+even more content`
+
+	It("full document with header and sections", func() {
+		expected := `# Document Title
+
+some **content**
+
+## Section A
+
+content
+
+### Section A.1
+
+more content [^1]
+
+###### Section A.1.1.1.1
+
+even more content
+
+[^1]: a **note**`
+		Expect(source).To(RenderMarkdownBody(expected, renderer.IncludeHeaderFooter(true)))
+	})
+
+	It("embedded document with header and sections", func() {
+		expected := `some **content**
+
+## Section A
+
+content
+
+### Section A.1
+
+more content [^1]
+
+###### Section A.1.1.1.1
+
+even more content
+
+[^1]: a **note**`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("document without header", func() {
+		source := `== Section A
+
+content footnote:[a note] and footnoteref:[ref, another note] and footnoteref:[ref]`
+		expected := `## Section A
+
+content [^1] and [^2] and [^2]
+
+[^1]: a note
+[^2]: another note`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("cross references to sections", func() {
+		source := `== Section A
+
+see <<_section_b_again>>, <<custom>> or <<custom,here>>
+
+== Section B, again!
+
+[[custom]]
+== Section C`
+		expected := `## Section A
+
+see [Section B, again!](#section-b-again), [Section C](#custom) or [here](#custom)
+
+## Section B, again!
+
+## <a id="custom"></a>Section C`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+})
@@ -0,0 +1,237 @@
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderInlineElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	result := []byte{}
+	for i, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render line")
+		}
+		if _, ok := element.(types.LineBreak); ok {
+			// the backslash must immediately precede the end of the line
+			result = bytes.TrimRight(result, " ")
+		}
+		result = append(result, renderedElement...)
+		if _, ok := element.(types.StringElement); ok && i == len(elements)-1 {
+			// trim trailing spaces before returning the line
+			result = bytes.TrimRight(result, " ")
+		}
+	}
+	return result, nil
+}
+
+// renderLines renders all lines, one per line of output. Leading spaces are discarded,
+// since they may turn the line into an indented code block
+func renderLines(ctx *renderer.Context, lines [][]interface{}) ([]byte, error) {
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		renderedLine, err := renderInlineElements(ctx, line)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render lines")
+		}
+		l := strings.TrimLeft(string(renderedLine), " ")
+		if l == "" {
+			continue
+		}
+		result = append(result, escapeBlockStart(l))
+	}
+	return []byte(strings.Join(result, "\n")), nil
+}
+
+func renderQuotedText(ctx *renderer.Context, t types.QuotedText) ([]byte, error) {
+	if t.Kind == types.Monospace {
+		// no formatting within a code span
+		return []byte(renderCodeSpan(renderer.PlainText(t.Elements))), nil
+	}
+	content, err := renderInlineElements(ctx, t.Elements)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to render quoted text")
+	}
+	switch t.Kind {
+	case types.Bold:
+		return []byte("**" + string(content) + "**"), nil
+	case types.Italic:
+		return []byte("*" + string(content) + "*"), nil
+	case types.Subscript:
+		return []byte("<sub>" + string(content) + "</sub>"), nil
+	case types.Superscript:
+		return []byte("<sup>" + string(content) + "</sup>"), nil
+	default:
+		return nil, errors.Errorf("unsupported quoted text kind: '%v'", t.Kind)
+	}
+}
+
+var backticksRegexp = regexp.MustCompile("`+")
+
+// renderCodeSpan wraps the given content with enough backticks to include the backticks of the content, if any
+func renderCodeSpan(content string) string {
+	delimiter := "`"
+	for _, backticks := range backticksRegexp.FindAllString(content, -1) {
+		if len(backticks) >= len(delimiter) {
+			delimiter = strings.Repeat("`", len(backticks)+1)
+		}
+	}
+	if strings.HasPrefix(content, "`") || strings.HasSuffix(content, "`") {
+		content = " " + content + " "
+	}
+	return delimiter + content + delimiter
+}
+
+func renderPassthrough(ctx *renderer.Context, p types.Passthrough) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	for _, element := range p.Elements {
+		switch element := element.(type) {
+		case types.StringElement:
+			if p.Kind == types.SinglePlusPassthrough {
+				// content is escaped, but not substituted
+				buf.WriteString(EscapeString(element.Content))
+			} else {
+				// content is rendered as-is
+				buf.WriteString(element.Content)
+			}
+		default:
+			renderedElement, err := renderElement(ctx, element)
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to render passthrough")
+			}
+			buf.Write(renderedElement)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// renderLink renders the link with its text, or as an autolink if it has no text
+func renderLink(ctx *renderer.Context, l types.InlineLink) ([]byte, error) {
+	location := l.Location.String()
+	if t, ok := l.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+		text, err := renderInlineElements(ctx, t)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render link")
+		}
+		return []byte("[" + string(text) + "](" + renderLinkDestination(location) + ")"), nil
+	}
+	return []byte("<" + location + ">"), nil
+}
+
+// renderLinkDestination wraps the given destination with angle brackets if it contains spaces or parentheses
+func renderLinkDestination(location string) string {
+	if strings.ContainsAny(location, " ()") {
+		return "<" + strings.Replace(location, ">", "%3E", -1) + ">"
+	}
+	return location
+}
+
+// renderInternalCrossReference renders a link to the target element, with the label of the cross reference
+// or the title of the target element as its text
+func renderInternalCrossReference(ctx *renderer.Context, xref types.InternalCrossReference) ([]byte, error) {
+	var label []byte
+	if xref.Label != "" {
+		label = []byte(EscapeString(xref.Label))
+	} else if target, ok := ctx.Document.ElementReferences[xref.ID].([]interface{}); ok {
+		var err error
+		if label, err = renderInlineElements(ctx, target); err != nil {
+			return nil, errors.Wrap(err, "unable to render cross reference")
+		}
+	} else {
+		label = []byte(EscapeString("[" + xref.ID + "]"))
+	}
+	return []byte("[" + strings.TrimSpace(string(label)) + "](#" + crossReferenceAnchor(ctx, xref.ID) + ")"), nil
+}
+
+// crossReferenceAnchor returns the anchor of the element with the given ID. Sections with a generated ID are
+// referenced by the anchor which is generated by the Markdown viewers (eg: `#section-a` for `== Section A`)
+func crossReferenceAnchor(ctx *renderer.Context, id string) string {
+	if s, found := findSection(ctx.Document.Elements, id); found && !s.Attributes.GetAsBool(types.AttrCustomID) {
+		return headingAnchor(renderer.PlainText(s.Title))
+	}
+	return id
+}
+
+func findSection(elements []interface{}, id string) (types.Section, bool) {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.Section:
+			if e.Attributes.GetAsString(types.AttrID) == id {
+				return e, true
+			}
+			if s, found := findSection(e.Elements, id); found {
+				return s, true
+			}
+		case types.Preamble:
+			if s, found := findSection(e.Elements, id); found {
+				return s, true
+			}
+		}
+	}
+	return types.Section{}, false
+}
+
+var headingAnchorRegexp = regexp.MustCompile(`[^\p{L}\p{N}\s_-]`)
+
+// headingAnchor returns the anchor of the heading with the given title, as generated by GitHub:
+// in lower case, without punctuation and with dashes instead of spaces
+func headingAnchor(title string) string {
+	anchor := headingAnchorRegexp.ReplaceAllString(strings.ToLower(strings.TrimSpace(title)), "")
+	return strings.Replace(anchor, " ", "-", -1)
+}
+
+func renderExternalCrossReference(ctx *renderer.Context, xref types.ExternalCrossReference) ([]byte, error) {
+	label, err := renderInlineElements(ctx, xref.Label)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render external cross reference")
+	}
+	return []byte("[" + string(label) + "](" + renderLinkDestination(xref.Location.String()) + ")"), nil
+}
+
+// renderFootnote renders a reference to the footnote, whose content is rendered at the end of the document
+func renderFootnote(ctx *renderer.Context, note types.Footnote) ([]byte, error) { //nolint:unparam
+	if id, found := ctx.Document.Footnotes.IndexOf(note); found {
+		return []byte(footnoteReference(id)), nil
+	}
+	if noteRef, found := ctx.Document.FootnoteReferences[note.Ref]; found {
+		if id, found := ctx.Document.Footnotes.IndexOf(noteRef); found {
+			return []byte(footnoteReference(id)), nil
+		}
+	}
+	// invalid footnote
+	return []byte(EscapeString("[" + note.Ref + "]")), nil
+}
+
+func footnoteReference(id int) string {
+	return "[^" + strconv.Itoa(id+1) + "]"
+}
+
+func renderUserMacro(ctx *renderer.Context, um types.UserMacro) ([]byte, error) {
+	buf := bytes.NewBuffer([]byte{})
+	macro, err := ctx.MacroTemplate(um.Name)
+	if err != nil {
+		if um.Kind == types.BlockMacro {
+			// fallback to paragraph
+			p, _ := types.NewParagraph([]interface{}{
+				[]interface{}{
+					types.StringElement{Content: um.RawText},
+				},
+			}, nil)
+			return renderParagraph(ctx, p)
+		}
+		// fallback to render raw text
+		_, err = buf.WriteString(EscapeString(um.RawText))
+	} else {
+		err = macro.Execute(buf, um)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,102 @@
+package markdown
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderUnorderedList(ctx *renderer.Context, l types.UnorderedList) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockTitle(l.Attributes))
+	for i, item := range l.Items {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		marker := "- "
+		switch item.CheckStyle {
+		case types.Checked:
+			marker += "[x] "
+		case types.Unchecked:
+			marker += "[ ] "
+		}
+		content, err := renderListItemElements(ctx, item.Elements)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render unordered list")
+		}
+		result.Write(listItem(marker, 2, content))
+	}
+	return result.Bytes(), nil
+}
+
+func renderOrderedList(ctx *renderer.Context, l types.OrderedList) ([]byte, error) {
+	style := types.NumberingStyle(l.Attributes.GetAsString(types.AttrNumberingStyle))
+	if style == "" && len(l.Items) > 0 {
+		style = l.Items[0].NumberingStyle
+	}
+	if style != "" && style != types.Arabic {
+		// only arabic numbers in Markdown
+		return renderHTML(ctx, l)
+	}
+	start := 1
+	if s, err := strconv.Atoi(l.Attributes.GetAsString(types.AttrStart)); err == nil {
+		start = s
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockTitle(l.Attributes))
+	for i, item := range l.Items {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		content, err := renderListItemElements(ctx, item.Elements)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render ordered list")
+		}
+		marker := strconv.Itoa(start+i) + ". "
+		result.Write(listItem(marker, len(marker), content))
+	}
+	return result.Bytes(), nil
+}
+
+// renderListItemElements renders the elements of a list item. Nested lists directly follow the
+// previous element, whereas other elements are separated by a blank line
+func renderListItemElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	result := bytes.NewBuffer(nil)
+	for _, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, err
+		}
+		if len(renderedElement) == 0 {
+			continue
+		}
+		if result.Len() > 0 {
+			switch element.(type) {
+			case types.UnorderedList, types.OrderedList:
+				result.WriteString("\n")
+			default:
+				result.WriteString("\n\n")
+			}
+		}
+		result.Write(renderedElement)
+	}
+	return result.Bytes(), nil
+}
+
+// listItem renders the list item with the given marker on its first line, and the following lines indented
+// with the given number of spaces, so that they belong to the list item
+func listItem(marker string, indent int, content []byte) []byte {
+	lines := strings.Split(string(content), "\n")
+	for i, line := range lines {
+		if i == 0 {
+			lines[i] = marker + line
+		} else if line != "" {
+			lines[i] = strings.Repeat(" ", indent) + line
+		}
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,72 @@
+package markdown_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lists", func() {
+
+	It("unordered list with nested list and check list", func() {
+		source := `* item 1
+** nested item
+* [x] item 2
+* [ ] item 3`
+		expected := `- item 1
+  - nested item
+- [x] item 2
+- [ ] item 3`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("ordered list with start and continuation", func() {
+		source := `[start=9]
+. item 9
+. item 10
++
+----
+code
+----`
+		expected := "9. item 9\n10. item 10\n\n    ```\n    code\n    ```"
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("ordered list with title", func() {
+		source := `.Steps
+. step 1
+. step 2`
+		expected := `**Steps**
+
+1. step 1
+2. step 2`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("ordered list with roman numbers in HTML", func() {
+		source := `[upperroman]
+. item`
+		expected := `<div class="olist upperroman">
+<ol class="upperroman" type="I">
+<li>
+<p>item</p>
+</li>
+</ol>
+</div>`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("labeled list in HTML", func() {
+		source := `term:: description`
+		expected := `<div class="dlist">
+<dl>
+<dt class="hdlist1">term</dt>
+<dd>
+<p>description</p>
+</dd>
+</dl>
+</div>`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+})
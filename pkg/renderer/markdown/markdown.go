@@ -0,0 +1,189 @@
+// Package markdown renders the documents in the GitHub Flavored Markdown format (a superset of CommonMark).
+// The elements which have no equivalent in Markdown are rendered in HTML.
+package markdown
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	htmlrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Render renders the given document in Markdown and writes the result in the given `writer`
+func Render(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	return renderDocument(ctx, output)
+}
+
+// renderElements renders the given blocks, separated by a blank line
+func renderElements(ctx *renderer.Context, elements []interface{}) ([]byte, error) {
+	log.Debugf("rendering %d elements(s)...", len(elements))
+	buff := bytes.NewBuffer(nil)
+	for _, element := range elements {
+		renderedElement, err := renderElement(ctx, element)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to render an element")
+		}
+		if len(renderedElement) == 0 {
+			continue
+		}
+		if buff.Len() > 0 {
+			buff.WriteString("\n\n")
+		}
+		if anchor := renderBlockAnchor(element); anchor != "" {
+			buff.WriteString(anchor + "\n")
+		}
+		buff.Write(renderedElement)
+	}
+	return buff.Bytes(), nil
+}
+
+// nolint: gocyclo
+func renderElement(ctx *renderer.Context, element interface{}) ([]byte, error) {
+	switch e := element.(type) {
+	case []interface{}:
+		return renderElements(ctx, e)
+	case types.TableOfContentsMacro:
+		// the table of contents is generated by the Markdown viewers, if needed
+		return []byte{}, nil
+	case types.Section:
+		return renderSection(ctx, e)
+	case types.Preamble:
+		return renderElements(ctx, e.Elements)
+	case types.BlankLine:
+		return []byte{}, nil
+	case types.LabeledList:
+		// no definition list in Markdown
+		return renderHTML(ctx, e)
+	case types.OrderedList:
+		return renderOrderedList(ctx, e)
+	case types.UnorderedList:
+		return renderUnorderedList(ctx, e)
+	case types.Paragraph:
+		return renderParagraph(ctx, e)
+	case types.InternalCrossReference:
+		return renderInternalCrossReference(ctx, e)
+	case types.ExternalCrossReference:
+		return renderExternalCrossReference(ctx, e)
+	case types.QuotedText:
+		return renderQuotedText(ctx, e)
+	case types.Passthrough:
+		return renderPassthrough(ctx, e)
+	case types.ImageBlock:
+		return renderImageBlock(ctx, e)
+	case types.InlineImage:
+		return renderInlineImage(ctx, e)
+	case types.VideoBlock, types.AudioBlock:
+		// no video or audio in Markdown
+		return renderHTML(ctx, e)
+	case types.DelimitedBlock:
+		return renderDelimitedBlock(ctx, e)
+	case types.Table:
+		return renderTable(ctx, e)
+	case types.LiteralBlock:
+		return renderLiteralBlock(ctx, e)
+	case types.InlineLink:
+		return renderLink(ctx, e)
+	case types.StringElement:
+		return []byte(EscapeString(e.Content)), nil
+	case types.Footnote:
+		return renderFootnote(ctx, e)
+	case types.LineBreak:
+		return []byte(`\`), nil
+	case types.UserMacro:
+		return renderUserMacro(ctx, e)
+	case types.InlineStem:
+		return []byte("$`" + e.Content + "`$"), nil
+	case types.InlineIcon:
+		return []byte(EscapeString("[" + e.Name + "]")), nil
+	case types.StemBlock:
+		return renderFencedBlock(ctx, e.Attributes, "math", e.Lines)
+	default:
+		return nil, errors.Errorf("unsupported type of element: %T", element)
+	}
+}
+
+// renderHTML renders the given element with the `html5` backend, for the elements which cannot be expressed in Markdown
+func renderHTML(ctx *renderer.Context, element interface{}) ([]byte, error) {
+	attributes := types.DocumentAttributes{}
+	for k, v := range ctx.Document.Attributes {
+		if k != types.AttrDocType {
+			attributes[k] = v
+		}
+	}
+	htmlCtx := ctx.WithDocument(types.Document{
+		Attributes:         attributes,
+		ElementReferences:  ctx.Document.ElementReferences,
+		Footnotes:          types.Footnotes{},
+		FootnoteReferences: types.FootnoteReferences{},
+		Elements:           []interface{}{element},
+	}, renderer.IncludeHeaderFooter(false))
+	result := bytes.NewBuffer(nil)
+	if _, err := htmlrenderer.Render(htmlCtx, result); err != nil {
+		return nil, errors.Wrapf(err, "unable to render element in HTML")
+	}
+	return result.Bytes(), nil
+}
+
+func renderTitle(attrs types.ElementAttributes) string {
+	return strings.TrimSpace(attrs.GetAsString(types.AttrTitle))
+}
+
+// renderBlockTitle renders the title of a block in bold, followed by an empty line, or returns an empty string if the block has no title
+func renderBlockTitle(attrs types.ElementAttributes) string {
+	if title := renderTitle(attrs); title != "" {
+		return "**" + EscapeString(title) + "**\n\n"
+	}
+	return ""
+}
+
+// renderBlockAnchor renders an HTML anchor for the blocks which have a custom ID (ie, which may be the target of a cross reference),
+// or returns an empty string otherwise. Sections have their own anchor, in their heading.
+func renderBlockAnchor(element interface{}) string {
+	var attrs types.ElementAttributes
+	switch e := element.(type) {
+	case types.Paragraph:
+		attrs = e.Attributes
+	case types.DelimitedBlock:
+		attrs = e.Attributes
+	case types.LiteralBlock:
+		attrs = e.Attributes
+	case types.Table:
+		attrs = e.Attributes
+	case types.ImageBlock:
+		attrs = e.Attributes
+	case types.OrderedList:
+		attrs = e.Attributes
+	case types.UnorderedList:
+		attrs = e.Attributes
+	default:
+		return ""
+	}
+	return renderAnchor(attrs)
+}
+
+// renderAnchor renders an HTML anchor if the element has a custom ID, or returns an empty string otherwise
+func renderAnchor(attrs types.ElementAttributes) string {
+	if !attrs.GetAsBool(types.AttrCustomID) {
+		return ""
+	}
+	if id := attrs.GetAsString(types.AttrID); id != "" {
+		return `<a id="` + htmlrenderer.EscapeString(id) + `"></a>`
+	}
+	return ""
+}
+
+func discardTrailingBlankLines(elements []interface{}) []interface{} {
+	result := elements
+	for len(result) > 0 {
+		if _, ok := result[len(result)-1].(types.BlankLine); !ok {
+			break
+		}
+		result = result[:len(result)-1]
+	}
+	return result
+}
@@ -0,0 +1,37 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+)
+
+var markdownReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+)
+
+// EscapeString escapes the characters which have a special meaning in inline Markdown content
+func EscapeString(s string) string {
+	return markdownReplacer.Replace(s)
+}
+
+// matches the beginning of the lines which would be interpreted as a heading, a list item, a thematic break
+// or a setext heading underline
+var blockStartRegexp = regexp.MustCompile(`^(#{1,6}|[-+]+|=+|\d{1,9}[.)])(\s|$)`)
+
+// escapeBlockStart escapes the beginning of the given line if it would be interpreted as the start of a block
+func escapeBlockStart(line string) string {
+	if strings.HasPrefix(line, ">") {
+		return `\` + line
+	}
+	if m := blockStartRegexp.FindStringSubmatchIndex(line); m != nil {
+		// escape the last character of the marker (eg: `#`, `-` or the `.` after the number)
+		return line[:m[3]-1] + `\` + line[m[3]-1:]
+	}
+	return line
+}
@@ -0,0 +1,66 @@
+package markdown_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("paragraphs", func() {
+
+	It("paragraph with inline formatting", func() {
+		source := "*bold* _italic_ `mono` H~2~O E=mc^2^ and a `` `backtick` ``"
+		expected := "**bold** *italic* `mono` H<sub>2</sub>O E=mc<sup>2</sup> and a `` `backtick` ``"
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("paragraph with links and images", func() {
+		source := `a https://example.com[link], https://example.com and image:foo.png[Foo]`
+		expected := `a [link](https://example.com), <https://example.com> and ![Foo](foo.png)`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("paragraph with special characters", func() {
+		source := `# not a heading, with some_underscores*
+1. not a list
+- not a list either`
+		expected := `\# not a heading, with some\_underscores\*
+1\. not a list
+\- not a list either`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("paragraph with title and line break", func() {
+		source := `.a title
+a line +
+another line`
+		expected := `**a title**
+
+a line\
+another line`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("paragraph with custom ID", func() {
+		source := `[[custom]]
+a paragraph`
+		expected := `<a id="custom"></a>
+a paragraph`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("admonition paragraph", func() {
+		source := `NOTE: be careful`
+		expected := `> **Note**
+>
+> be careful`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("inline stem", func() {
+		source := `stem:[sqrt(4) = 2]`
+		expected := "$`sqrt(4) = 2`$"
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+})
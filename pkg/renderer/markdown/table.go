@@ -0,0 +1,58 @@
+package markdown
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// renderTable renders the table in the GitHub Flavored Markdown format, which requires a header line.
+// Tables without header are rendered in HTML
+func renderTable(ctx *renderer.Context, t types.Table) ([]byte, error) {
+	if len(t.Header.Cells) == 0 {
+		return renderHTML(ctx, t)
+	}
+	result := bytes.NewBuffer(nil)
+	result.WriteString(renderBlockTitle(t.Attributes))
+	if err := renderTableLine(ctx, result, t.Header); err != nil {
+		return nil, errors.Wrap(err, "unable to render table")
+	}
+	result.WriteString("\n|" + strings.Repeat(" --- |", len(t.Header.Cells)))
+	for _, line := range t.Lines {
+		result.WriteString("\n")
+		if err := renderTableLine(ctx, result, line); err != nil {
+			return nil, errors.Wrap(err, "unable to render table")
+		}
+	}
+	return result.Bytes(), nil
+}
+
+func renderTableLine(ctx *renderer.Context, result *bytes.Buffer, line types.TableLine) error {
+	result.WriteString("|")
+	for _, cell := range line.Cells {
+		content, err := renderInlineElements(ctx, unescapePipes(cell))
+		if err != nil {
+			return err
+		}
+		// the pipes in the content would be interpreted as cell delimiters
+		result.WriteString(" " + strings.Replace(strings.TrimSpace(string(content)), "|", `\|`, -1) + " |")
+	}
+	return nil
+}
+
+// unescapePipes replaces the escaped pipes (`\|`) in the strings of the given cell with plain pipes,
+// which are escaped again (once) when the cell is rendered
+func unescapePipes(cell []interface{}) []interface{} {
+	result := make([]interface{}, len(cell))
+	for i, element := range cell {
+		if s, ok := element.(types.StringElement); ok {
+			s.Content = strings.Replace(s.Content, `\|`, "|", -1)
+			element = s
+		}
+		result[i] = element
+	}
+	return result
+}
@@ -0,0 +1,59 @@
+package markdown_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tables", func() {
+
+	It("table with title and header", func() {
+		source := `.A table
+|===
+| Option | Description
+
+| -v | be *verbose*
+| -q | be quiet
+|===`
+		expected := `**A table**
+
+| Option | Description |
+| --- | --- |
+| -v | be **verbose** |
+| -q | be quiet |`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("table with escaped pipes", func() {
+		source := `|===
+| Operator | Description
+
+| a \| b | a or b
+|===`
+		expected := `| Operator | Description |
+| --- | --- |
+| a \| b | a or b |`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+
+	It("table without header in HTML", func() {
+		source := `|===
+| a | b
+|===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;">
+<col style="width: 50%;">
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">a</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">b</p></td>
+</tr>
+</tbody>
+</table>`
+		Expect(source).To(RenderMarkdownBody(expected))
+	})
+})
@@ -1,9 +1,12 @@
 package renderer
 
 import (
+	"path/filepath"
+	"reflect"
 	"time"
 
 	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/vfs"
 )
 
 //Option the options when rendering a document
@@ -17,6 +20,30 @@ const (
 	keyIncludeHeaderFooter string = "IncludeHeaderFooter"
 	// keyEntrypoint a bool value to indicate if the entrypoint to start with when parsing the document
 	keyEntrypoint string = "Entrypoint"
+	// keyStemRendering the way STEM expressions should be rendered
+	keyStemRendering string = "StemRendering"
+	// keyBackend the backend used to render the document (eg: `html5` or `docbook5`)
+	keyBackend string = "Backend"
+	// keyFilename the name of the file being rendered, used to resolve the relative paths of the resources to embed
+	keyFilename string = "Filename"
+	// keyTextWidth the maximum number of characters per line when rendering a document in plain text
+	keyTextWidth string = "TextWidth"
+	// keyLaTeXPreamble the template of the preamble when rendering a document in LaTeX
+	keyLaTeXPreamble string = "LaTeXPreamble"
+	// keyTemplateDir the directory of the templates which override the default templates of the `html5` backend
+	keyTemplateDir string = "TemplateDir"
+	// keyFileSystem the file system from which the files to embed in the output are read (eg: the images)
+	keyFileSystem string = "FileSystem"
+	// keyURIFileSystem the file system from which the content at the URIs to include is read
+	keyURIFileSystem string = "URIFileSystem"
+	// keySafeMode the safe mode which restricts the access to the files
+	keySafeMode string = "SafeMode"
+	// keyBaseDir the directory which contains the files that can be read in the `safe` and `server` safe modes
+	keyBaseDir string = "BaseDir"
+	// keyAttributes the document attributes set by the caller, which take precedence over the attributes of the document
+	keyAttributes string = "Attributes"
+	// DefaultTextWidth the default maximum number of characters per line when rendering a document in plain text
+	DefaultTextWidth int = 80
 	// LastUpdatedFormat the time format for the `last updated` document attribute
 	LastUpdatedFormat string = "2006-01-02 15:04:05 -0700"
 )
@@ -42,6 +69,106 @@ func Entrypoint(entrypoint string) Option {
 	}
 }
 
+// StemRenderingMode the way STEM expressions are rendered
+type StemRenderingMode string
+
+const (
+	// MathJax STEM expressions are rendered within their MathJax delimiters, to be processed in the browser
+	MathJax StemRenderingMode = "mathjax"
+	// MathML STEM expressions are converted into MathML elements
+	MathML StemRenderingMode = "mathml"
+)
+
+// StemRendering function to set the `stem rendering` option in the renderer context (default is `MathJax`)
+func StemRendering(mode StemRenderingMode) Option {
+	return func(ctx *Context) {
+		ctx.options[keyStemRendering] = mode
+	}
+}
+
+// Backend function to set the `backend` option in the renderer context (default is the value of the `backend`
+// document attribute, or `html5`)
+func Backend(backend string) Option {
+	return func(ctx *Context) {
+		ctx.options[keyBackend] = backend
+	}
+}
+
+// TextWidth function to set the maximum number of characters per line in the renderer context, when rendering
+// a document in plain text (default is `DefaultTextWidth`)
+func TextWidth(width int) Option {
+	return func(ctx *Context) {
+		ctx.options[keyTextWidth] = width
+	}
+}
+
+// LaTeXPreamble function to set the template of the preamble in the renderer context, when rendering a document
+// in LaTeX (ie, the content before `\begin{document}`)
+func LaTeXPreamble(tmpl string) Option {
+	return func(ctx *Context) {
+		ctx.options[keyLaTeXPreamble] = tmpl
+	}
+}
+
+// TemplateDir function to set the directory of the templates which override the default templates of the
+// `html5` backend, one file per kind of element (eg: `paragraph.tmpl` or `table.tmpl`)
+func TemplateDir(dir string) Option {
+	return func(ctx *Context) {
+		ctx.options[keyTemplateDir] = dir
+	}
+}
+
+// FileSystem function to set the file system from which the files to embed in the output are read, such as the images
+// with the `data-uri` document attribute (default is the local filesystem)
+func FileSystem(fs vfs.FS) Option {
+	return func(ctx *Context) {
+		ctx.options[keyFileSystem] = fs
+	}
+}
+
+// URIFileSystem function to set the file system from which the content at the URIs to include is read, when the
+// `allow-uri-read` document attribute is set (eg: a `vfs.HTTPFS` with a custom HTTP client)
+func URIFileSystem(fs vfs.FS) Option {
+	return func(ctx *Context) {
+		ctx.options[keyURIFileSystem] = fs
+	}
+}
+
+// SafeMode function to set the safe mode which restricts the access to the files (default is `types.SafeModeUnsafe`)
+func SafeMode(mode types.SafeMode) Option {
+	return func(ctx *Context) {
+		ctx.options[keySafeMode] = mode
+	}
+}
+
+// BaseDir function to set the directory which contains the files that can be included or embedded in the output
+// in the `safe` and `server` safe modes (default is the directory of the document)
+func BaseDir(dir string) Option {
+	return func(ctx *Context) {
+		ctx.options[keyBaseDir] = dir
+	}
+}
+
+// Attributes function to set document attributes in the renderer context, which take precedence over the attributes
+// declared in the document (eg: the `allow-uri-read` attribute, which cannot be set by the document itself).
+// The attributes are added to the ones given in the previous `Attributes` options, if any
+func Attributes(attrs types.DocumentAttributes) Option {
+	return func(ctx *Context) {
+		result := ctx.Attributes()
+		for k, v := range attrs {
+			result[k] = v
+		}
+		ctx.options[keyAttributes] = result
+	}
+}
+
+// Filename function to set the name of the file being rendered in the renderer context
+func Filename(filename string) Option {
+	return func(ctx *Context) {
+		ctx.options[keyFilename] = filename
+	}
+}
+
 // DefineMacro defines the given template to a user macro with the given name
 func DefineMacro(name string, t MacroTemplate) Option {
 	return func(ctx *Context) {
@@ -49,6 +176,21 @@ func DefineMacro(name string, t MacroTemplate) Option {
 	}
 }
 
+// RenderFunc a function which renders the given element
+type RenderFunc func(ctx *Context, element interface{}) ([]byte, error)
+
+// RenderHook a function which renders the given element instead of the backend. The `next` function
+// renders the element with the backend, so that the hook can wrap or alter its default rendering
+type RenderHook func(ctx *Context, element interface{}, next RenderFunc) ([]byte, error)
+
+// DefineRenderHook defines the given hook to render the elements of the same type as the given element
+// (eg: `types.ImageBlock{}`). The hooks are supported by the `html5` backend.
+func DefineRenderHook(element interface{}, hook RenderHook) Option {
+	return func(ctx *Context) {
+		ctx.hooks[reflect.TypeOf(element)] = hook
+	}
+}
+
 // LastUpdated returns the value of the 'LastUpdated' Option if it was present,
 // otherwise it returns the current time using the `2006/01/02 15:04:05 MST` format
 func (ctx *Context) LastUpdated() string {
@@ -70,3 +212,124 @@ func (ctx *Context) IncludeHeaderFooter() bool {
 	}
 	return false
 }
+
+// StemRendering returns the value of the 'StemRendering' Option if it was present,
+// otherwise it returns `MathJax`
+func (ctx *Context) StemRendering() StemRenderingMode {
+	if mode, found := ctx.options[keyStemRendering]; found {
+		if mode, typeMatch := mode.(StemRenderingMode); typeMatch {
+			return mode
+		}
+	}
+	return MathJax
+}
+
+// Backend returns the value of the 'Backend' Option if it was present,
+// otherwise the value of the `backend` document attribute if it was set, or `html5`
+func (ctx *Context) Backend() string {
+	if backend, found := ctx.options[keyBackend]; found {
+		if backend, typeMatch := backend.(string); typeMatch {
+			return backend
+		}
+	}
+	if backend, found := ctx.Document.Attributes.GetAsString(types.AttrBackend); found && backend != "" {
+		return backend
+	}
+	return "html5"
+}
+
+// Filename returns the value of the 'Filename' Option if it was present,
+// otherwise it returns an empty string
+func (ctx *Context) Filename() string {
+	if filename, found := ctx.options[keyFilename]; found {
+		if filename, typeMatch := filename.(string); typeMatch {
+			return filename
+		}
+	}
+	return ""
+}
+
+// TextWidth returns the value of the 'TextWidth' Option if it was present and positive,
+// otherwise it returns `DefaultTextWidth`
+func (ctx *Context) TextWidth() int {
+	if width, found := ctx.options[keyTextWidth]; found {
+		if width, typeMatch := width.(int); typeMatch && width > 0 {
+			return width
+		}
+	}
+	return DefaultTextWidth
+}
+
+// LaTeXPreamble returns the value of the 'LaTeXPreamble' Option if it was present,
+// otherwise it returns an empty string
+func (ctx *Context) LaTeXPreamble() string {
+	if tmpl, found := ctx.options[keyLaTeXPreamble]; found {
+		if tmpl, typeMatch := tmpl.(string); typeMatch {
+			return tmpl
+		}
+	}
+	return ""
+}
+
+// TemplateDir returns the value of the 'TemplateDir' Option if it was present,
+// otherwise it returns an empty string
+func (ctx *Context) TemplateDir() string {
+	if dir, found := ctx.options[keyTemplateDir]; found {
+		if dir, typeMatch := dir.(string); typeMatch {
+			return dir
+		}
+	}
+	return ""
+}
+
+// FileSystem returns the value of the 'FileSystem' Option if it was present,
+// otherwise it returns the local filesystem
+func (ctx *Context) FileSystem() vfs.FS {
+	if fs, found := ctx.options[keyFileSystem]; found {
+		if fs, typeMatch := fs.(vfs.FS); typeMatch {
+			return fs
+		}
+	}
+	return vfs.OS
+}
+
+// URIFileSystem returns the value of the 'URIFileSystem' Option if it was present,
+// otherwise it returns `false`
+func (ctx *Context) URIFileSystem() (vfs.FS, bool) {
+	fs, found := ctx.options[keyURIFileSystem].(vfs.FS)
+	return fs, found
+}
+
+// SafeMode returns the value of the 'SafeMode' Option if it was present,
+// otherwise it returns `types.SafeModeUnsafe`
+func (ctx *Context) SafeMode() types.SafeMode {
+	if mode, found := ctx.options[keySafeMode]; found {
+		if mode, typeMatch := mode.(types.SafeMode); typeMatch {
+			return mode
+		}
+	}
+	return types.SafeModeUnsafe
+}
+
+// Attributes returns a copy of the document attributes set with the 'Attributes' Option,
+// or an empty set of attributes if there was none
+func (ctx *Context) Attributes() types.DocumentAttributes {
+	result := types.DocumentAttributes{}
+	if attrs, found := ctx.options[keyAttributes].(types.DocumentAttributes); found {
+		for k, v := range attrs {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// BaseDir returns the value of the 'BaseDir' Option if it was present,
+// otherwise it returns the directory of the document
+func (ctx *Context) BaseDir() string {
+	if dir, found := ctx.options[keyBaseDir]; found {
+		if dir, typeMatch := dir.(string); typeMatch {
+			return dir
+		}
+	}
+	return filepath.Dir(ctx.Filename())
+}
@@ -0,0 +1,45 @@
+package renderer
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// PlainText returns the content of the given inline elements (eg: the title of a section), without any formatting
+// nor escaping. The links are replaced with their text, or with their location if they have no text.
+func PlainText(elements []interface{}) string {
+	result := strings.Builder{}
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.StringElement:
+			result.WriteString(e.Content)
+		case types.QuotedText:
+			result.WriteString(PlainText(e.Elements))
+		case types.Passthrough:
+			result.WriteString(PlainText(e.Elements))
+		case types.InlineLink:
+			if text, ok := e.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+				result.WriteString(PlainText(text))
+			} else {
+				result.WriteString(e.Location.String())
+			}
+		}
+	}
+	return result.String()
+}
+
+// TrimCommonIndentation removes the leading spaces shared by all the given lines (eg: the lines of a literal block)
+func TrimCommonIndentation(lines []string) []string {
+	indent := -1
+	for _, line := range lines {
+		if n := len(line) - len(strings.TrimLeft(line, " ")); indent == -1 || n < indent {
+			indent = n
+		}
+	}
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		result[i] = line[indent:]
+	}
+	return result
+}
@@ -0,0 +1,54 @@
+package renderer_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("plain text", func() {
+
+	It("should return the content of the inline elements without formatting", func() {
+		elements := []interface{}{
+			types.StringElement{Content: "a "},
+			types.QuotedText{
+				Kind: types.Bold,
+				Elements: []interface{}{
+					types.StringElement{Content: "bold"},
+				},
+			},
+			types.StringElement{Content: " and "},
+			types.InlineLink{
+				Location: types.Location{
+					Elements: []interface{}{
+						types.StringElement{Content: "https://example.com"},
+					},
+				},
+				Attributes: types.ElementAttributes{
+					types.AttrInlineLinkText: []interface{}{
+						types.StringElement{Content: "a link"},
+					},
+				},
+			},
+			types.StringElement{Content: " to "},
+			types.InlineLink{
+				Location: types.Location{
+					Elements: []interface{}{
+						types.StringElement{Content: "https://example.com"},
+					},
+				},
+				Attributes: types.ElementAttributes{},
+			},
+			types.StringElement{Content: " "},
+		}
+		Expect(renderer.PlainText(elements)).To(Equal("a bold and a link to https://example.com "))
+	})
+
+	It("should remove the common indentation of the lines", func() {
+		Expect(renderer.TrimCommonIndentation([]string{"    foo", "  bar", "     baz"})).To(Equal([]string{"  foo", "bar", "   baz"}))
+		Expect(renderer.TrimCommonIndentation([]string{"foo", "  bar"})).To(Equal([]string{"foo", "  bar"}))
+		Expect(renderer.TrimCommonIndentation([]string{})).To(BeEmpty())
+	})
+})
@@ -9,10 +9,12 @@ import (
 // - wraps elements in a preamble
 // - generates the ToC
 // - processes the document headers (added in the document attributes)
+// - processes the manpage header (if the document has the `manpage` doctype)
 func Prerender(ctx *Context) error {
 	IncludePreamble(ctx)
 	IncludeTableOfContents(ctx)
 	ProcessDocumentHeader(ctx)
+	ProcessManpageHeader(ctx)
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debug("pre-rendered document:")
 		spew.Dump(ctx.Document)
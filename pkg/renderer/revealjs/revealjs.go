@@ -0,0 +1,134 @@
+// Package revealjs renders the documents in a standalone HTML slide deck, presented with Reveal.js
+// (https://revealjs.com).
+//
+// The document header is rendered in a title slide, along with the preamble. Each level 1 section is rendered
+// in a horizontal slide, and each of its level 2 sections is rendered in a vertical slide below it. The content of
+// the slides is rendered with the `html5` backend, except for the following blocks:
+//
+// - the blocks with the `notes` role (eg: `[.notes]`) are rendered in the speaker notes of their slide
+// - the blocks with the `step` option (eg: `[%step]`) are rendered as fragments, ie, they are displayed one
+// after the other. In the case of ordered and unordered lists, each item is a fragment.
+//
+// The `background-*` attributes of a section (eg: `[background-color="yellow"]`) are applied to its slide, and
+// the `title-slide-background-*` document attributes are applied to the title slide.
+//
+// The location of Reveal.js, the theme and the transition between the slides can be set with the `revealjsdir`,
+// `revealjs_theme` and `revealjs_transition` document attributes (the `revealjs-theme` and `revealjs-transition`
+// aliases are also supported).
+package revealjs
+
+import (
+	"bytes"
+	"html"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// AttrRevealJSDir the document attribute to set the location of Reveal.js
+	AttrRevealJSDir = "revealjsdir"
+	// AttrRevealJSTheme the document attribute to set the theme of the slides
+	AttrRevealJSTheme = "revealjs_theme"
+	// AttrRevealJSTransition the document attribute to set the transition between the slides
+	AttrRevealJSTransition = "revealjs_transition"
+	// DefaultRevealJSDir the default location of Reveal.js
+	DefaultRevealJSDir = "https://cdn.jsdelivr.net/npm/reveal.js@4.1.0"
+	// DefaultRevealJSTheme the default theme of the slides
+	DefaultRevealJSTheme = "black"
+	// DefaultRevealJSTransition the default transition between the slides
+	DefaultRevealJSTransition = "slide"
+)
+
+var deckTmpl texttemplate.Template
+
+func init() {
+	deckTmpl = *texttemplate.Must(texttemplate.New("deck").Funcs(texttemplate.FuncMap{
+		"escape": html.EscapeString,
+	}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta name="viewport" content="width=device-width, initial-scale=1.0, maximum-scale=1.0, user-scalable=no">
+<meta name="generator" content="libasciidoc">
+<title>{{ escape .Title }}</title>
+<link rel="stylesheet" href="{{ escape .RevealJSDir }}/dist/reset.css">
+<link rel="stylesheet" href="{{ escape .RevealJSDir }}/dist/reveal.css">
+<link rel="stylesheet" href="{{ escape .RevealJSDir }}/dist/theme/{{ escape .Theme }}.css" id="theme">
+</head>
+<body>
+<div class="reveal">
+<div class="slides">
+{{ .Slides }}
+</div>
+</div>
+<script src="{{ escape .RevealJSDir }}/dist/reveal.js"></script>
+<script src="{{ escape .RevealJSDir }}/plugin/notes/notes.js"></script>
+<script>
+Reveal.initialize({
+  hash: true,
+  transition: '{{ escape .Transition }}',
+  plugins: [ RevealNotes ]
+});
+</script>
+</body>
+</html>
+`))
+}
+
+// Render renders the given document in a Reveal.js slide deck and writes the result in the given `writer`
+func Render(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	log.Debug("rendering document in a Reveal.js slide deck")
+	title := ""
+	if documentTitle, hasTitle := ctx.Document.Title(); hasTitle {
+		title = strings.TrimSpace(renderer.PlainText(documentTitle))
+	}
+	slides, err := renderSlides(ctx, splitSlides(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render slide deck")
+	}
+	attrs := ctx.Document.Attributes
+	result := bytes.NewBuffer(nil)
+	err = deckTmpl.Execute(result, struct {
+		Title       string
+		RevealJSDir string
+		Theme       string
+		Transition  string
+		Slides      string
+	}{
+		Title:       title,
+		RevealJSDir: attribute(attrs, AttrRevealJSDir, DefaultRevealJSDir),
+		Theme:       attribute(attrs, AttrRevealJSTheme, DefaultRevealJSTheme),
+		Transition:  attribute(attrs, AttrRevealJSTransition, DefaultRevealJSTransition),
+		Slides:      slides,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render slide deck")
+	}
+	if _, err := output.Write(result.Bytes()); err != nil {
+		return nil, errors.Wrap(err, "unable to render slide deck")
+	}
+	metadata := ctx.Document.Attributes
+	if title != "" {
+		metadata[types.AttrTitle] = title
+	}
+	metadata["LastUpdated"] = ctx.LastUpdated()
+	return metadata, nil
+}
+
+// attribute returns the value of the given document attribute, or of its alias with hyphens instead of
+// underscores (eg: `revealjs-theme` for `revealjs_theme`), or the given default value if none was set
+func attribute(attrs types.DocumentAttributes, name, defaultValue string) string {
+	if value, found := attrs.GetAsString(name); found {
+		return value
+	}
+	if value, found := attrs.GetAsString(strings.Replace(name, "_", "-", -1)); found {
+		return value
+	}
+	return defaultValue
+}
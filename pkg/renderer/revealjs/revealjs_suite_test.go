@@ -0,0 +1,15 @@
+package revealjs_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+
+	_ "github.com/bytesparadise/libasciidoc/testsupport"
+)
+
+func TestRevealJS(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Reveal.js Suite")
+}
@@ -0,0 +1,366 @@
+package revealjs_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reveal.js slide decks", func() {
+
+	It("deck with default settings", func() {
+		source := `= My Deck
+
+== First Slide
+
+content`
+		expected := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta name="viewport" content="width=device-width, initial-scale=1.0, maximum-scale=1.0, user-scalable=no">
+<meta name="generator" content="libasciidoc">
+<title>My Deck</title>
+<link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/reveal.js@4.1.0/dist/reset.css">
+<link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/reveal.js@4.1.0/dist/reveal.css">
+<link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/reveal.js@4.1.0/dist/theme/black.css" id="theme">
+</head>
+<body>
+<div class="reveal">
+<div class="slides">
+<section class="title" data-state="title">
+<h1>My Deck</h1>
+</section>
+<section id="_first_slide">
+<h2>First Slide</h2>
+<div class="slide-content">
+<div class="paragraph">
+<p>content</p>
+</div>
+</div>
+</section>
+</div>
+</div>
+<script src="https://cdn.jsdelivr.net/npm/reveal.js@4.1.0/dist/reveal.js"></script>
+<script src="https://cdn.jsdelivr.net/npm/reveal.js@4.1.0/plugin/notes/notes.js"></script>
+<script>
+Reveal.initialize({
+  hash: true,
+  transition: 'slide',
+  plugins: [ RevealNotes ]
+});
+</script>
+</body>
+</html>
+`
+		Expect(renderDeck(source)).To(Equal(expected))
+	})
+
+	It("deck with custom settings", func() {
+		source := `= My Deck
+:revealjsdir: reveal.js
+:revealjs-theme: white
+:revealjs-transition: fade
+
+== First Slide`
+		result := renderDeck(source)
+		Expect(result).To(ContainSubstring(`<link rel="stylesheet" href="reveal.js/dist/reveal.css">`))
+		Expect(result).To(ContainSubstring(`<link rel="stylesheet" href="reveal.js/dist/theme/white.css" id="theme">`))
+		Expect(result).To(ContainSubstring(`<script src="reveal.js/dist/reveal.js"></script>`))
+		Expect(result).To(ContainSubstring(`transition: 'fade',`))
+	})
+
+	It("deck with custom settings with underscores", func() {
+		source := `= My Deck
+:revealjs_theme: white
+:revealjs_transition: fade
+
+== First Slide`
+		result := renderDeck(source)
+		Expect(result).To(ContainSubstring(`<link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/reveal.js@4.1.0/dist/theme/white.css" id="theme">`))
+		Expect(result).To(ContainSubstring(`transition: 'fade',`))
+	})
+
+	It("title slide with authors, preamble and background", func() {
+		source := `= My _Deck_
+John Doe <john@example.com>; Jane Doe
+:title-slide-background-color: navy
+
+An introduction.
+
+== First Slide`
+		expected := `<section class="title" data-state="title" data-background-color="navy">
+<h1>My <em>Deck</em></h1>
+<p class="author"><small>John Doe, Jane Doe</small></p>
+<div class="slide-content">
+<div class="paragraph">
+<p>An introduction.</p>
+</div>
+</div>
+</section>
+<section id="_first_slide">
+<h2>First Slide</h2>
+</section>`
+		result := renderDeck(source)
+		Expect(slides(result)).To(Equal(expected))
+		Expect(result).To(ContainSubstring("<title>My Deck</title>"))
+	})
+
+	It("untitled first slide without header", func() {
+		source := `An introduction.
+
+== First Slide`
+		expected := `<section>
+<div class="slide-content">
+<div class="paragraph">
+<p>An introduction.</p>
+</div>
+</div>
+</section>
+<section id="_first_slide">
+<h2>First Slide</h2>
+</section>`
+		Expect(slides(renderDeck(source))).To(Equal(expected))
+	})
+
+	It("vertical slides", func() {
+		source := `= My Deck
+
+== First Slide
+
+content
+
+=== Vertical One
+
+below
+
+==== Subsection
+
+deeper
+
+=== Vertical Two
+
+further below
+
+== Second Slide`
+		expected := `<section class="title" data-state="title">
+<h1>My Deck</h1>
+</section>
+<section>
+<section id="_first_slide">
+<h2>First Slide</h2>
+<div class="slide-content">
+<div class="paragraph">
+<p>content</p>
+</div>
+</div>
+</section>
+<section id="_vertical_one">
+<h2>Vertical One</h2>
+<div class="slide-content">
+<div class="paragraph">
+<p>below</p>
+</div>
+<div class="sect3">
+<h4 id="_subsection">Subsection</h4>
+<div class="paragraph">
+<p>deeper</p>
+</div>
+</div>
+</div>
+</section>
+<section id="_vertical_two">
+<h2>Vertical Two</h2>
+<div class="slide-content">
+<div class="paragraph">
+<p>further below</p>
+</div>
+</div>
+</section>
+</section>
+<section id="_second_slide">
+<h2>Second Slide</h2>
+</section>`
+		Expect(slides(renderDeck(source))).To(Equal(expected))
+	})
+
+	It("speaker notes", func() {
+		source := `== First Slide
+
+content
+
+[.notes]
+****
+* a note
+* another note
+****
+
+== Second Slide
+
+[.notes]
+a single note`
+		expected := `<section id="_first_slide">
+<h2>First Slide</h2>
+<div class="slide-content">
+<div class="paragraph">
+<p>content</p>
+</div>
+</div>
+<aside class="notes">
+<div class="ulist">
+<ul>
+<li>
+<p>a note</p>
+</li>
+<li>
+<p>another note</p>
+</li>
+</ul>
+</div>
+</aside>
+</section>
+<section id="_second_slide">
+<h2>Second Slide</h2>
+<aside class="notes">
+<div class="paragraph">
+<p>a single note</p>
+</div>
+</aside>
+</section>`
+		Expect(slides(renderDeck(source))).To(Equal(expected))
+	})
+
+	It("fragments", func() {
+		source := `== First Slide
+
+[%step]
+* one
+* two
+
+[options=step]
+. three
+
+[%step]
+a paragraph`
+		expected := `<section id="_first_slide">
+<h2>First Slide</h2>
+<div class="slide-content">
+<div class="ulist">
+<ul>
+<li class="fragment">
+<p>one</p>
+</li>
+<li class="fragment">
+<p>two</p>
+</li>
+</ul>
+</div>
+<div class="olist arabic">
+<ol class="arabic">
+<li class="fragment">
+<p>three</p>
+</li>
+</ol>
+</div>
+<div class="fragment">
+<div class="paragraph">
+<p>a paragraph</p>
+</div>
+</div>
+</div>
+</section>`
+		Expect(slides(renderDeck(source))).To(Equal(expected))
+	})
+
+	It("slide backgrounds and roles", func() {
+		source := `:imagesdir: images
+
+[.intro]
+[background-color=yellow]
+== First Slide
+
+[background-image=bg.png,background-size=cover]
+== Second Slide
+
+[background-image=https://example.com/bg.png]
+== Third Slide`
+		expected := `<section id="_first_slide" class="intro" data-background-color="yellow">
+<h2>First Slide</h2>
+</section>
+<section id="_second_slide" data-background-image="images/bg.png" data-background-size="cover">
+<h2>Second Slide</h2>
+</section>
+<section id="_third_slide" data-background-image="https://example.com/bg.png">
+<h2>Third Slide</h2>
+</section>`
+		Expect(slides(renderDeck(source))).To(Equal(expected))
+	})
+
+	It("footnotes in slides", func() {
+		source := `== First Slide
+
+content footnote:[a note]
+
+== Second Slide
+
+more content footnote:[another note]`
+		expected := `<section id="_first_slide">
+<h2>First Slide</h2>
+<div class="slide-content">
+<div class="paragraph">
+<p>content <sup class="footnote">[<a id="_footnoteref_1" class="footnote" href="#_footnotedef_1" title="View footnote.">1</a>]</sup></p>
+</div>
+<div class="footnotes">
+<hr>
+<div class="footnote" id="_footnotedef_1">
+<a href="#_footnoteref_1">1</a>. a note
+</div>
+</div>
+</div>
+</section>
+<section id="_second_slide">
+<h2>Second Slide</h2>
+<div class="slide-content">
+<div class="paragraph">
+<p>more content <sup class="footnote">[<a id="_footnoteref_2" class="footnote" href="#_footnotedef_2" title="View footnote.">2</a>]</sup></p>
+</div>
+<div class="footnotes">
+<hr>
+<div class="footnote" id="_footnotedef_2">
+<a href="#_footnoteref_2">2</a>. another note
+</div>
+</div>
+</div>
+</section>`
+		Expect(slides(renderDeck(source))).To(Equal(expected))
+	})
+
+	It("metadata", func() {
+		source := `= My Deck
+
+== First Slide`
+		metadata, err := libasciidoc.Convert(context.Background(), "", strings.NewReader(source), bytes.NewBuffer(nil), renderer.Backend("revealjs"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metadata["title"]).To(Equal("My Deck"))
+	})
+})
+
+func renderDeck(source string) string {
+	result := bytes.NewBuffer(nil)
+	_, err := libasciidoc.Convert(context.Background(), "", strings.NewReader(source), result, renderer.Backend("revealjs"))
+	Expect(err).NotTo(HaveOccurred())
+	return result.String()
+}
+
+// slides returns the slides of the given deck
+func slides(deck string) string {
+	start := strings.Index(deck, "<div class=\"slides\">\n") + len("<div class=\"slides\">\n")
+	end := strings.Index(deck, "\n</div>\n</div>\n<script")
+	return deck[start:end]
+}
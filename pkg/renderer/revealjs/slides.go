@@ -0,0 +1,407 @@
+package revealjs
+
+import (
+	"bytes"
+	"html"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	htmlrenderer "github.com/bytesparadise/libasciidoc/pkg/renderer/html5"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var slideTmpl texttemplate.Template
+
+func init() {
+	slideTmpl = *texttemplate.Must(texttemplate.New("slide").Parse(`<section{{ .Attributes }}>{{ if .Heading }}
+{{ .Heading }}{{ end }}{{ if .Content }}
+<div class="slide-content">
+{{ .Content }}
+</div>{{ end }}{{ if .Notes }}
+<aside class="notes">
+{{ .Notes }}
+</aside>{{ end }}
+</section>`))
+}
+
+// slide a slide of the deck
+type slide struct {
+	// whether this slide is the title slide, with the document header
+	TitleSlide bool
+	// the section from which this slide was built (empty for the title slide)
+	Section types.Section
+	// the elements of the slide, excluding the sections rendered in the vertical slides
+	Elements []interface{}
+	// the slides displayed below this one
+	Vertical []*slide
+}
+
+// splitSlides splits the document in slides: a title slide with the document header and preamble, a horizontal slide
+// per level 1 section, and a vertical slide per level 2 section, below the slide of its parent section
+func splitSlides(ctx *renderer.Context) []*slide {
+	elements := ctx.Document.Elements
+	if header, found := ctx.Document.Header(); found {
+		elements = append(append([]interface{}{}, header.Elements...), ctx.Document.Elements[1:]...)
+	}
+	result := []*slide{}
+	front := []interface{}{}
+	for _, element := range elements {
+		switch e := element.(type) {
+		case types.TableOfContentsMacro:
+			// not relevant in a slide deck
+			continue
+		case types.Preamble:
+			front = append(front, e.Elements...)
+		case types.Section:
+			s := &slide{
+				Section: e,
+			}
+			for _, se := range e.Elements {
+				if ss, ok := se.(types.Section); ok && ss.Level == e.Level+1 {
+					s.Vertical = append(s.Vertical, newSlide(ss))
+					continue
+				}
+				s.Elements = append(s.Elements, se)
+			}
+			result = append(result, s)
+		default:
+			if len(result) == 0 {
+				front = append(front, e)
+			} else {
+				log.Warnf("skipping element of type '%T' after the first slide of the deck", e)
+			}
+		}
+	}
+	if _, hasTitle := ctx.Document.Title(); hasTitle || len(front) > 0 {
+		result = append([]*slide{
+			{
+				TitleSlide: hasTitle,
+				Elements:   front,
+			},
+		}, result...)
+	}
+	return result
+}
+
+// newSlide returns a (vertical) slide with all the elements of the given section
+func newSlide(s types.Section) *slide {
+	return &slide{
+		Section:  s,
+		Elements: s.Elements,
+	}
+}
+
+// renderSlides renders the given slides, with their vertical slides grouped in a parent `section`
+func renderSlides(ctx *renderer.Context, slides []*slide) (string, error) {
+	result := []string{}
+	for _, s := range slides {
+		rendered, err := renderSlide(ctx, s)
+		if err != nil {
+			return "", err
+		}
+		if len(s.Vertical) == 0 {
+			result = append(result, rendered)
+			continue
+		}
+		vertical := []string{"<section>", rendered}
+		for _, v := range s.Vertical {
+			renderedVertical, err := renderSlide(ctx, v)
+			if err != nil {
+				return "", err
+			}
+			vertical = append(vertical, renderedVertical)
+		}
+		result = append(result, strings.Join(append(vertical, "</section>"), "\n"))
+	}
+	return strings.Join(result, "\n"), nil
+}
+
+func renderSlide(ctx *renderer.Context, s *slide) (string, error) {
+	content, notes := splitNotes(s.Elements)
+	attrs := types.DocumentAttributes{}
+	for k, v := range ctx.Document.Attributes {
+		attrs[k] = v
+	}
+	// the slides have no header, so they must not be processed as a manpage or with a table of contents
+	delete(attrs, types.AttrDocType)
+	delete(attrs, types.AttrTableOfContents)
+	slideCtx := ctx.WithDocument(types.Document{
+		Attributes:         attrs,
+		Elements:           content,
+		ElementReferences:  ctx.Document.ElementReferences,
+		Footnotes:          ctx.Document.Footnotes,
+		FootnoteReferences: ctx.Document.FootnoteReferences,
+	}, renderer.IncludeHeaderFooter(false))
+	heading, err := renderSlideHeading(ctx, s)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render slide")
+	}
+	renderedContent := []string{}
+	for _, element := range content {
+		r, err := renderSlideElement(slideCtx, element)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render slide")
+		}
+		if r != "" {
+			renderedContent = append(renderedContent, r)
+		}
+	}
+	renderedFootnotes, err := renderSlideFootnotes(slideCtx, renderer.FootnotesIn(ctx.Document.Footnotes, content))
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render slide")
+	}
+	if renderedFootnotes != "" {
+		renderedContent = append(renderedContent, renderedFootnotes)
+	}
+	renderedNotes, err := htmlrenderer.RenderElements(slideCtx, notes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render slide")
+	}
+	result := bytes.NewBuffer(nil)
+	err = slideTmpl.Execute(result, struct {
+		Attributes string
+		Heading    string
+		Content    string
+		Notes      string
+	}{
+		Attributes: renderSlideAttributes(ctx, s),
+		Heading:    heading,
+		Content:    strings.Join(renderedContent, "\n"),
+		Notes:      strings.TrimSpace(string(renderedNotes)),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render slide")
+	}
+	return result.String(), nil
+}
+
+// renderSlideFootnotes renders the footnotes of a slide at the bottom of the slide. The footnotes keep their number
+// in the whole document, so that the IDs of their anchors are unique in the deck
+func renderSlideFootnotes(ctx *renderer.Context, notes types.Footnotes) (string, error) {
+	if len(notes) == 0 {
+		return "", nil
+	}
+	result := strings.Builder{}
+	result.WriteString("<div class=\"footnotes\">\n<hr>")
+	for _, note := range notes {
+		index, _ := ctx.Document.Footnotes.IndexOf(note)
+		content, err := htmlrenderer.RenderInlineElements(ctx, note.Elements)
+		if err != nil {
+			return "", err
+		}
+		number := strconv.Itoa(index + 1)
+		result.WriteString("\n<div class=\"footnote\" id=\"_footnotedef_" + number + "\">\n")
+		result.WriteString("<a href=\"#_footnoteref_" + number + "\">" + number + "</a>. " + strings.TrimSpace(string(content)) + "\n</div>")
+	}
+	result.WriteString("\n</div>")
+	return result.String(), nil
+}
+
+// renderSlideHeading renders the title of the slide, along with the authors in the case of the title slide.
+// The given context is the one of the whole document, since the slides have no header
+func renderSlideHeading(ctx *renderer.Context, s *slide) (string, error) {
+	if s.TitleSlide {
+		documentTitle, _ := ctx.Document.Title()
+		title, err := htmlrenderer.RenderInlineElements(ctx, documentTitle)
+		if err != nil {
+			return "", err
+		}
+		result := "<h1>" + string(title) + "</h1>"
+		if authors := documentAuthors(ctx); len(authors) > 0 {
+			result += "\n<p class=\"author\"><small>" + html.EscapeString(strings.Join(authors, ", ")) + "</small></p>"
+		}
+		return result, nil
+	}
+	if len(s.Section.Title) == 0 {
+		return "", nil
+	}
+	title, err := htmlrenderer.RenderInlineElements(ctx, s.Section.Title)
+	if err != nil {
+		return "", err
+	}
+	return "<h2>" + string(bytes.TrimSpace(title)) + "</h2>", nil
+}
+
+func documentAuthors(ctx *renderer.Context) []string {
+	result := []string{}
+	authors, _ := ctx.Document.Authors()
+	for _, author := range authors {
+		if name := strings.TrimSpace(author.FullName); name != "" {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// renderSlideAttributes renders the attributes of the `section` element of the given slide: its ID and its roles,
+// or the `title` class for the title slide, along with its background settings
+func renderSlideAttributes(ctx *renderer.Context, s *slide) string {
+	result := strings.Builder{}
+	backgrounds := map[string]string{}
+	if s.TitleSlide {
+		result.WriteString(` class="title" data-state="title"`)
+		for k := range ctx.Document.Attributes {
+			if strings.HasPrefix(k, "title-slide-background-") {
+				backgrounds[strings.TrimPrefix(k, "title-slide-")], _ = ctx.Document.Attributes.GetAsString(k)
+			}
+		}
+	} else {
+		if id := s.Section.Attributes.GetAsString(types.AttrID); id != "" {
+			result.WriteString(` id="` + html.EscapeString(id) + `"`)
+		}
+		if role := s.Section.Attributes.GetAsString(types.AttrRole); role != "" {
+			result.WriteString(` class="` + html.EscapeString(role) + `"`)
+		}
+		for k := range s.Section.Attributes {
+			if strings.HasPrefix(k, "background-") {
+				backgrounds[k] = s.Section.Attributes.GetAsString(k)
+			}
+		}
+	}
+	keys := make([]string, 0, len(backgrounds))
+	for k := range backgrounds {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		value := backgrounds[k]
+		if k == "background-image" {
+			value = imagePath(ctx, value)
+		}
+		result.WriteString(` data-` + k + `="` + html.EscapeString(value) + `"`)
+	}
+	return result.String()
+}
+
+// imagePath returns the location of the given image, relative to the `imagesdir` directory (if set)
+// unless it is an absolute path or a URL
+func imagePath(ctx *renderer.Context, location string) string {
+	imagesdir, found := ctx.Document.Attributes.GetAsString(types.AttrImagesDir)
+	if !found || imagesdir == "" || path.IsAbs(location) || strings.Contains(location, "://") {
+		return location
+	}
+	return path.Join(imagesdir, location)
+}
+
+// splitNotes separates the elements with the `notes` role (ie, the speaker notes) from the actual content of the slide.
+// The content of the delimited blocks is retained in the notes, while the other elements are retained without their role.
+func splitNotes(elements []interface{}) ([]interface{}, []interface{}) {
+	content := []interface{}{}
+	notes := []interface{}{}
+	for _, element := range elements {
+		if !hasRole(element, "notes") {
+			content = append(content, element)
+			continue
+		}
+		switch e := element.(type) {
+		case types.DelimitedBlock:
+			notes = append(notes, e.Elements...)
+		case types.Paragraph:
+			e.Attributes = withoutRole(e.Attributes, "notes")
+			notes = append(notes, e)
+		case types.UnorderedList:
+			e.Attributes = withoutRole(e.Attributes, "notes")
+			notes = append(notes, e)
+		case types.OrderedList:
+			e.Attributes = withoutRole(e.Attributes, "notes")
+			notes = append(notes, e)
+		default:
+			notes = append(notes, e)
+		}
+	}
+	return content, notes
+}
+
+// renderSlideElement renders the given element with the `html5` backend, as a fragment if it has the `step` option
+func renderSlideElement(ctx *renderer.Context, element interface{}) (string, error) {
+	r, err := htmlrenderer.RenderElements(ctx, []interface{}{element})
+	if err != nil {
+		return "", err
+	}
+	result := strings.TrimSpace(string(r))
+	if result == "" || !hasOption(element, "step") {
+		return result, nil
+	}
+	switch element.(type) {
+	case types.OrderedList, types.UnorderedList:
+		// each item is displayed in turn
+		return listItemRegexp.ReplaceAllString(result, `<li class="fragment">`), nil
+	default:
+		return "<div class=\"fragment\">\n" + result + "\n</div>", nil
+	}
+}
+
+var listItemRegexp = regexp.MustCompile(`<li>`)
+
+// attributes returns the attributes of the given block element, or `nil` if the element has no attributes
+func attributes(element interface{}) types.ElementAttributes {
+	switch e := element.(type) {
+	case types.Paragraph:
+		return e.Attributes
+	case types.DelimitedBlock:
+		return e.Attributes
+	case types.UnorderedList:
+		return e.Attributes
+	case types.OrderedList:
+		return e.Attributes
+	case types.LabeledList:
+		return e.Attributes
+	case types.ImageBlock:
+		return e.Attributes
+	case types.Table:
+		return e.Attributes
+	case types.LiteralBlock:
+		return e.Attributes
+	case types.VideoBlock:
+		return e.Attributes
+	case types.AudioBlock:
+		return e.Attributes
+	case types.Section:
+		return e.Attributes
+	default:
+		return nil
+	}
+}
+
+func hasRole(element interface{}, role string) bool {
+	for _, r := range strings.Fields(attributes(element).GetAsString(types.AttrRole)) {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// hasOption returns `true` if the given element has the given option, set in the `options` attribute
+// or with the shorthand syntax (eg: `[%step]`)
+func hasOption(element interface{}, option string) bool {
+	attrs := attributes(element)
+	return attrs.Has("%"+option) || attrs.HasOption(option)
+}
+
+// withoutRole returns a copy of the given attributes, without the given role
+func withoutRole(attrs types.ElementAttributes, role string) types.ElementAttributes {
+	result := types.ElementAttributes{}
+	for k, v := range attrs {
+		result[k] = v
+	}
+	roles := []string{}
+	for _, r := range strings.Fields(attrs.GetAsString(types.AttrRole)) {
+		if r != role {
+			roles = append(roles, r)
+		}
+	}
+	if len(roles) > 0 {
+		result[types.AttrRole] = strings.Join(roles, " ")
+	} else {
+		delete(result, types.AttrRole)
+	}
+	return result
+}
@@ -0,0 +1,29 @@
+package renderer
+
+import (
+	texttemplate "text/template"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// NewTextTemplate parses the given source in a new template with the given name and functions,
+// and exits if the source is not a valid template
+func NewTextTemplate(name, src string, funcs ...texttemplate.FuncMap) texttemplate.Template {
+	t := texttemplate.New(name)
+	for _, f := range funcs {
+		t.Funcs(f)
+	}
+	t, err := t.Parse(src)
+	if err != nil {
+		log.Fatalf("failed to initialize '%s' template: %s", name, err.Error())
+	}
+	return *t
+}
+
+// ContextualPipeline as structure that carries the renderer context along with
+// the pipeline data to process in a template or in a nested template
+type ContextualPipeline struct {
+	Context *Context
+	// The actual pipeline
+	Data interface{}
+}
@@ -0,0 +1,174 @@
+package text
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// the characters used to underline the document title and the section titles, by level
+var headingUnderlines = []string{"=", "-", "~", "^", "+"}
+
+// renderHeading renders the given title, underlined according to its level
+func renderHeading(title string, level int, width int) string {
+	title = wrap(title, width)
+	if level >= len(headingUnderlines) {
+		return title
+	}
+	lines := strings.Split(title, "\n")
+	longest := 0
+	for _, line := range lines {
+		if w := textWidth(line); w > longest {
+			longest = w
+		}
+	}
+	return title + "\n" + strings.Repeat(headingUnderlines[level], longest)
+}
+
+func renderSection(ctx *renderer.Context, s types.Section, width int) (string, error) {
+	title, err := renderInlineElements(ctx, s.Title)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render section")
+	}
+	result := renderHeading(strings.TrimSpace(title), s.Level, width)
+	content, err := renderElements(ctx, s.Elements, width)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render section")
+	}
+	if content != "" {
+		result += "\n\n" + content
+	}
+	return result, nil
+}
+
+func renderParagraph(ctx *renderer.Context, p types.Paragraph, width int) (string, error) {
+	if k, ok := p.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+		content, err := renderLines(ctx, p.Lines)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render admonition paragraph")
+		}
+		return renderBlockTitle(p.Attributes, width) + hangingIndent(admonitionLabel(k)+": ", content, width), nil
+	}
+	switch p.Attributes[types.AttrKind] {
+	case types.Source:
+		lines := make([]string, len(p.Lines))
+		for i, line := range p.Lines {
+			l, err := renderInlineElements(ctx, line)
+			if err != nil {
+				return "", errors.Wrap(err, "unable to render source paragraph")
+			}
+			lines[i] = l
+		}
+		return renderListing(p.Attributes, lines, width), nil
+	case types.Verse:
+		lines := make([]string, len(p.Lines))
+		for i, line := range p.Lines {
+			l, err := renderInlineElements(ctx, line)
+			if err != nil {
+				return "", errors.Wrap(err, "unable to render verse paragraph")
+			}
+			lines[i] = strings.TrimSpace(l)
+		}
+		return renderQuote(p.Attributes, strings.Join(lines, "\n"), width), nil
+	case types.Quote:
+		content, err := renderLines(ctx, p.Lines)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render quote paragraph")
+		}
+		return renderQuote(p.Attributes, wrap(content, width-4), width), nil
+	}
+	log.Debug("rendering a standalone paragraph")
+	content, err := renderLines(ctx, p.Lines)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render paragraph")
+	}
+	return renderBlockTitle(p.Attributes, width) + wrap(content, width), nil
+}
+
+func admonitionLabel(kind types.AdmonitionKind) string {
+	return strings.ToUpper(string(kind))
+}
+
+// renderQuote renders the given (wrapped) content indented, followed by its attribution, if any
+func renderQuote(attrs types.ElementAttributes, content string, width int) string {
+	attribution := []string{}
+	if author := attrs.GetAsString(types.AttrQuoteAuthor); author != "" {
+		attribution = append(attribution, author)
+	}
+	if title := attrs.GetAsString(types.AttrQuoteTitle); title != "" {
+		attribution = append(attribution, title)
+	}
+	result := renderBlockTitle(attrs, width) + indent(content, 4)
+	if len(attribution) > 0 {
+		result += "\n\n" + indent(hangingIndent("— ", strings.Join(attribution, ", "), width-4), 4)
+	}
+	return result
+}
+
+// renderListing renders the given lines as-is (ie, without wrapping), indented
+func renderListing(attrs types.ElementAttributes, lines []string, width int) string {
+	return renderBlockTitle(attrs, width) + indent(strings.Join(lines, "\n"), 4)
+}
+
+func renderDelimitedBlock(ctx *renderer.Context, b types.DelimitedBlock, width int) (string, error) {
+	log.Debugf("rendering delimited block of kind '%v'", b.Kind)
+	elements := discardTrailingBlankLines(b.Elements)
+	switch b.Kind {
+	case types.Comment:
+		return "", nil
+	case types.Fenced, types.Listing, types.Source, types.Verse:
+		lines := []string{}
+		for _, element := range elements {
+			switch e := element.(type) {
+			case types.Paragraph:
+				for _, line := range e.Lines {
+					l, err := renderInlineElements(ctx, line)
+					if err != nil {
+						return "", errors.Wrap(err, "unable to render delimited block")
+					}
+					lines = append(lines, l)
+				}
+			case types.BlankLine:
+				lines = append(lines, "")
+			}
+		}
+		if b.Kind == types.Verse {
+			return renderQuote(b.Attributes, strings.Join(lines, "\n"), width), nil
+		}
+		return renderListing(b.Attributes, lines, width), nil
+	case types.Example:
+		if k, ok := b.Attributes[types.AttrAdmonitionKind].(types.AdmonitionKind); ok {
+			content, err := renderElements(ctx, elements, width-4)
+			if err != nil {
+				return "", errors.Wrap(err, "unable to render admonition block")
+			}
+			label := admonitionLabel(k) + ":"
+			if title := renderTitle(b.Attributes); title != "" {
+				label += " " + title
+			}
+			return wrap(label, width) + "\n" + indent(content, 4), nil
+		}
+	case types.Quote:
+		content, err := renderElements(ctx, elements, width-4)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render quote block")
+		}
+		return renderQuote(b.Attributes, content, width), nil
+	}
+	content, err := renderElements(ctx, elements, width)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render delimited block")
+	}
+	return renderBlockTitle(b.Attributes, width) + content, nil
+}
+
+func renderLiteralBlock(ctx *renderer.Context, b types.LiteralBlock, width int) (string, error) { //nolint:unparam
+	lines := b.Lines
+	if b.Attributes.GetAsString(types.AttrLiteralBlockType) == types.LiteralBlockWithSpacesOnFirstLine {
+		lines = renderer.TrimCommonIndentation(b.Lines)
+	}
+	return renderListing(b.Attributes, lines, width), nil
+}
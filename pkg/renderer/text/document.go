@@ -0,0 +1,76 @@
+package text
+
+import (
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// renderDocument renders the whole document, including its title and authors if needed, followed by the footnotes
+func renderDocument(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	width := ctx.TextWidth()
+	result := strings.Builder{}
+	elements := ctx.Document.Elements
+	var title string
+	if header, found := ctx.Document.Header(); found {
+		renderedTitle, err := renderInlineElements(ctx, header.Title)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to render document")
+		}
+		title = strings.TrimSpace(renderedTitle)
+		if ctx.IncludeHeaderFooter() {
+			log.Debugf("Rendering full document...")
+			result.WriteString(renderHeading(title, 0, width))
+			if authors, found := ctx.Document.Authors(); found {
+				for _, author := range authors {
+					result.WriteString("\n" + strings.TrimSpace(author.FullName))
+					if author.Email != "" {
+						result.WriteString(" <" + author.Email + ">")
+					}
+				}
+			}
+		}
+		// retain the elements of the header, followed by the other elements, if any
+		elements = append(append([]interface{}{}, header.Elements...), ctx.Document.Elements[1:]...)
+	}
+	renderedElements, err := renderElements(ctx, elements, width)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render document")
+	}
+	for _, block := range []string{renderedElements, renderFootnotes(ctx, width)} {
+		if block == "" {
+			continue
+		}
+		if result.Len() > 0 {
+			result.WriteString("\n\n")
+		}
+		result.WriteString(block)
+	}
+	if _, err := io.WriteString(output, result.String()); err != nil {
+		return nil, errors.Wrap(err, "unable to render document")
+	}
+	metadata := ctx.Document.Attributes
+	if title != "" {
+		metadata[types.AttrTitle] = title
+	}
+	metadata["LastUpdated"] = ctx.LastUpdated()
+	return metadata, nil
+}
+
+// renderFootnotes renders the footnotes of the document, one per paragraph, or returns an empty string
+// if the document has no footnote
+func renderFootnotes(ctx *renderer.Context, width int) string {
+	footnotes := make([]string, len(ctx.Document.Footnotes))
+	for i, note := range ctx.Document.Footnotes {
+		content, err := renderInlineElements(ctx, note.Elements)
+		if err != nil {
+			log.Warnf("unable to render footnote: %v", err)
+		}
+		footnotes[i] = hangingIndent(footnoteNumber(i)+" ", strings.TrimSpace(content), width)
+	}
+	return strings.Join(footnotes, "\n")
+}
@@ -0,0 +1,64 @@
+package text_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("documents", func() {
+
+	source := `= Document Title
+John Doe <john@example.com>
+
+some *content* footnote:[a note which is long enough to be wrapped]
+
+== Section A
+
+content
+
+=== Section A.1
+
+more content`
+
+	It("full document with header, sections and footnotes", func() {
+		expected := `Document Title
+==============
+John Doe <john@example.com>
+
+some content [1]
+
+Section A
+---------
+
+content
+
+Section A.1
+~~~~~~~~~~~
+
+more content
+
+[1] a note which is long enough to be
+    wrapped`
+		Expect(source).To(RenderTextBody(expected, renderer.IncludeHeaderFooter(true), renderer.TextWidth(40)))
+	})
+
+	It("embedded document", func() {
+		expected := `some content [1]
+
+Section A
+---------
+
+content
+
+Section A.1
+~~~~~~~~~~~
+
+more content
+
+[1] a note which is long enough to be wrapped`
+		Expect(source).To(RenderTextBody(expected))
+	})
+})
@@ -0,0 +1,159 @@
+package text
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderInlineElements(ctx *renderer.Context, elements []interface{}) (string, error) {
+	result := strings.Builder{}
+	for _, element := range elements {
+		renderedElement, err := renderInlineElement(ctx, element)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to render line")
+		}
+		result.WriteString(renderedElement)
+	}
+	return result.String(), nil
+}
+
+// nolint: gocyclo
+func renderInlineElement(ctx *renderer.Context, element interface{}) (string, error) {
+	switch e := element.(type) {
+	case []interface{}:
+		return renderInlineElements(ctx, e)
+	case types.StringElement:
+		return e.Content, nil
+	case types.QuotedText:
+		return renderInlineElements(ctx, e.Elements)
+	case types.Passthrough:
+		return renderInlineElements(ctx, e.Elements)
+	case types.InlineLink:
+		return renderLink(ctx, e)
+	case types.InternalCrossReference:
+		return renderInternalCrossReference(ctx, e)
+	case types.ExternalCrossReference:
+		label, err := renderInlineElements(ctx, e.Label)
+		if err != nil {
+			return "", err
+		}
+		return label + " <" + e.Location.String() + ">", nil
+	case types.Footnote:
+		return renderFootnote(ctx, e), nil
+	case types.InlineImage:
+		return renderImage(e.Attributes), nil
+	case types.InlineIcon:
+		return "[" + e.Name + "]", nil
+	case types.InlineStem:
+		return e.Content, nil
+	case types.LineBreak:
+		return "\n", nil
+	case types.UserMacro:
+		return renderUserMacro(ctx, e, renderer.DefaultTextWidth)
+	default:
+		return "", errors.Errorf("unsupported type of element: %T", element)
+	}
+}
+
+// renderLines renders the given lines as a single line, except for the hard line breaks
+func renderLines(ctx *renderer.Context, lines [][]interface{}) (string, error) {
+	result := strings.Builder{}
+	for _, line := range lines {
+		renderedLine, err := renderInlineElements(ctx, line)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render lines")
+		}
+		renderedLine = strings.TrimSpace(renderedLine)
+		if renderedLine == "" {
+			continue
+		}
+		if result.Len() > 0 && !strings.HasSuffix(result.String(), "\n") {
+			result.WriteString(" ")
+		}
+		result.WriteString(renderedLine)
+		if len(line) > 0 {
+			if _, ok := line[len(line)-1].(types.LineBreak); ok {
+				result.WriteString("\n")
+			}
+		}
+	}
+	return strings.TrimSuffix(result.String(), "\n"), nil
+}
+
+// renderLink renders the text of the link followed by its URL, or the URL alone if the link has no text
+func renderLink(ctx *renderer.Context, l types.InlineLink) (string, error) {
+	location := l.Location.String()
+	if t, ok := l.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
+		text, err := renderInlineElements(ctx, t)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to render link")
+		}
+		if text = strings.TrimSpace(text); text != "" && text != location {
+			return text + " <" + location + ">", nil
+		}
+	}
+	return location, nil
+}
+
+// renderInternalCrossReference renders the label of the cross reference, or the title of the target element
+func renderInternalCrossReference(ctx *renderer.Context, xref types.InternalCrossReference) (string, error) {
+	if xref.Label != "" {
+		return xref.Label, nil
+	}
+	if target, ok := ctx.Document.ElementReferences[xref.ID].([]interface{}); ok {
+		title, err := renderInlineElements(ctx, target)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render cross reference")
+		}
+		return strings.TrimSpace(title), nil
+	}
+	return "[" + xref.ID + "]", nil
+}
+
+// renderFootnote renders the number of the footnote, whose content is rendered at the end of the document
+func renderFootnote(ctx *renderer.Context, note types.Footnote) string {
+	if id, found := ctx.Document.Footnotes.IndexOf(note); found {
+		return footnoteNumber(id)
+	}
+	if noteRef, found := ctx.Document.FootnoteReferences[note.Ref]; found {
+		if id, found := ctx.Document.Footnotes.IndexOf(noteRef); found {
+			return footnoteNumber(id)
+		}
+	}
+	// invalid footnote
+	return "[" + note.Ref + "]"
+}
+
+func footnoteNumber(id int) string {
+	return "[" + strconv.Itoa(id+1) + "]"
+}
+
+func renderUserMacro(ctx *renderer.Context, um types.UserMacro, width int) (string, error) {
+	buf := bytes.NewBuffer([]byte{})
+	macro, err := ctx.MacroTemplate(um.Name)
+	if err != nil {
+		if um.Kind == types.BlockMacro {
+			// fallback to paragraph
+			return wrap(um.RawText, width), nil
+		}
+		// fallback to render raw text
+		return um.RawText, nil
+	}
+	if err := macro.Execute(buf, um); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func renderImage(attrs types.ElementAttributes) string {
+	return "[" + attrs.GetAsString(types.AttrImageAlt) + "]"
+}
+
+func renderVideo(v types.VideoBlock) string {
+	return "[video: " + v.URL() + "]"
+}
@@ -0,0 +1,108 @@
+package text
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func renderUnorderedList(ctx *renderer.Context, l types.UnorderedList, width int) (string, error) {
+	items := make([]string, len(l.Items))
+	for i, item := range l.Items {
+		marker := "* "
+		switch item.CheckStyle {
+		case types.Checked:
+			marker += "[x] "
+		case types.Unchecked:
+			marker += "[ ] "
+		}
+		content, err := renderListItemElements(ctx, item.Elements, width-textWidth(marker))
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render unordered list")
+		}
+		items[i] = listItem(marker, content)
+	}
+	return renderBlockTitle(l.Attributes, width) + strings.Join(items, "\n"), nil
+}
+
+func renderOrderedList(ctx *renderer.Context, l types.OrderedList, width int) (string, error) {
+	start := 1
+	if s, err := strconv.Atoi(l.Attributes.GetAsString(types.AttrStart)); err == nil {
+		start = s
+	}
+	style := types.NumberingStyle(l.Attributes.GetAsString(types.AttrNumberingStyle))
+	if style == "" && len(l.Items) > 0 {
+		style = l.Items[0].NumberingStyle
+	}
+	items := make([]string, len(l.Items))
+	for i, item := range l.Items {
+		marker := style.ItemNumber(start+i) + ". "
+		content, err := renderListItemElements(ctx, item.Elements, width-textWidth(marker))
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render ordered list")
+		}
+		items[i] = listItem(marker, content)
+	}
+	return renderBlockTitle(l.Attributes, width) + strings.Join(items, "\n"), nil
+}
+
+func renderLabeledList(ctx *renderer.Context, l types.LabeledList, width int) (string, error) {
+	items := make([]string, len(l.Items))
+	for i, item := range l.Items {
+		term, err := renderInlineElements(ctx, item.Term)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render labeled list")
+		}
+		items[i] = wrap(strings.TrimSpace(term), width)
+		content, err := renderListItemElements(ctx, item.Elements, width-4)
+		if err != nil {
+			return "", errors.Wrap(err, "unable to render labeled list")
+		}
+		if content != "" {
+			items[i] += "\n" + indent(content, 4)
+		}
+	}
+	return renderBlockTitle(l.Attributes, width) + strings.Join(items, "\n"), nil
+}
+
+// renderListItemElements renders the elements of a list item within the given width. Nested lists
+// directly follow the previous element, whereas other elements are separated by a blank line
+func renderListItemElements(ctx *renderer.Context, elements []interface{}, width int) (string, error) {
+	result := strings.Builder{}
+	for _, element := range elements {
+		renderedElement, err := renderElement(ctx, element, width)
+		if err != nil {
+			return "", err
+		}
+		if renderedElement == "" {
+			continue
+		}
+		if result.Len() > 0 {
+			switch element.(type) {
+			case types.UnorderedList, types.OrderedList, types.LabeledList:
+				result.WriteString("\n")
+			default:
+				result.WriteString("\n\n")
+			}
+		}
+		result.WriteString(renderedElement)
+	}
+	return result.String(), nil
+}
+
+// listItem renders the list item with the given marker on its first line, and the following lines indented
+// to the width of the marker
+func listItem(marker string, content string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if i == 0 {
+			lines[i] = marker + line
+		} else if line != "" {
+			lines[i] = strings.Repeat(" ", textWidth(marker)) + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,47 @@
+package text_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lists", func() {
+
+	It("unordered list with nested list and check list", func() {
+		source := `* item 1 is long enough to be wrapped
+** nested item
+* [x] item 2`
+		expected := `* item 1 is long enough
+  to be wrapped
+  * nested item
+* [x] item 2`
+		Expect(source).To(RenderTextBody(expected, renderer.TextWidth(24)))
+	})
+
+	It("ordered list with numbering style and start", func() {
+		source := `[upperroman,start=3]
+. item 3
+. item 4
++
+more content`
+		expected := `III. item 3
+IV. item 4
+
+    more content`
+		Expect(source).To(RenderTextBody(expected))
+	})
+
+	It("labeled list", func() {
+		source := `.Options
+-v:: be verbose
+-q::`
+		expected := `Options
+-v
+    be verbose
+-q`
+		Expect(source).To(RenderTextBody(expected))
+	})
+})
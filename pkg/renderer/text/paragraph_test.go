@@ -0,0 +1,117 @@
+package text_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("paragraphs", func() {
+
+	It("paragraph wrapped with the default width", func() {
+		source := `Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt
+ut labore et dolore magna aliqua.
+Ut enim ad minim veniam.`
+		expected := `Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor
+incididunt ut labore et dolore magna aliqua. Ut enim ad minim veniam.`
+		Expect(source).To(RenderTextBody(expected))
+	})
+
+	It("paragraph with title, line break and a custom width", func() {
+		source := `.a title
+some *bold* and _italic_ content +
+on two lines`
+		expected := `a title
+some bold and
+italic content
+on two lines`
+		Expect(source).To(RenderTextBody(expected, renderer.TextWidth(15)))
+	})
+
+	It("paragraph with links, images and cross references", func() {
+		source := `== Section A
+
+a https://example.com[link], https://example.com, image:foo.png[Foo] and <<_section_a>>`
+		expected := `Section A
+---------
+
+a link <https://example.com>, https://example.com, [Foo] and Section A`
+		Expect(source).To(RenderTextBody(expected))
+	})
+
+	It("admonition paragraph", func() {
+		source := `NOTE: some content which is long enough to be wrapped`
+		expected := `NOTE: some content which is long
+      enough to be wrapped`
+		Expect(source).To(RenderTextBody(expected, renderer.TextWidth(32)))
+	})
+})
+
+var _ = Describe("delimited blocks", func() {
+
+	It("listing block", func() {
+		source := `.Example
+----
+some code which is not wrapped
+  with its indentation
+----`
+		expected := `Example
+    some code which is not wrapped
+      with its indentation`
+		Expect(source).To(RenderTextBody(expected, renderer.TextWidth(15)))
+	})
+
+	It("literal block", func() {
+		source := `  some
+    literal content`
+		expected := `    some
+      literal content`
+		Expect(source).To(RenderTextBody(expected))
+	})
+
+	It("admonition block", func() {
+		source := `[WARNING]
+.Title
+====
+some content
+
+more content
+====`
+		expected := `WARNING: Title
+    some content
+
+    more content`
+		Expect(source).To(RenderTextBody(expected))
+	})
+
+	It("quote block", func() {
+		source := `[quote, John Doe, Book]
+____
+some wise words which are long enough to be wrapped
+____`
+		expected := `    some wise words which are
+    long enough to be wrapped
+
+    — John Doe, Book`
+		Expect(source).To(RenderTextBody(expected, renderer.TextWidth(30)))
+	})
+
+	It("verse block", func() {
+		source := `[verse]
+____
+line 1
+line 2
+____`
+		expected := `    line 1
+    line 2`
+		Expect(source).To(RenderTextBody(expected))
+	})
+
+	It("video block", func() {
+		source := `video::abc[youtube]`
+		expected := `[video: https://www.youtube.com/watch?v=abc]`
+		Expect(source).To(RenderTextBody(expected))
+	})
+})
@@ -0,0 +1,133 @@
+package text
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// the separator between the columns of a table
+const columnSeparator = " | "
+
+// renderTable renders the table in columns, with the header line (if any) underlined. The cells are wrapped
+// if the table is wider than the given width
+func renderTable(ctx *renderer.Context, t types.Table, width int) (string, error) {
+	lines := t.Lines
+	if len(t.Header.Cells) > 0 {
+		lines = append([]types.TableLine{t.Header}, t.Lines...)
+	}
+	rows := make([][]string, len(lines))
+	for i, line := range lines {
+		rows[i] = make([]string, len(line.Cells))
+		for j, cell := range line.Cells {
+			content, err := renderInlineElements(ctx, cell)
+			if err != nil {
+				return "", errors.Wrap(err, "unable to render table")
+			}
+			rows[i][j] = strings.TrimSpace(content)
+		}
+	}
+	if len(rows) == 0 {
+		return renderBlockTitle(t.Attributes, width), nil
+	}
+	widths := columnWidths(rows, width)
+	result := []string{}
+	for i, row := range rows {
+		result = append(result, renderTableRow(row, widths)...)
+		if i == 0 && len(t.Header.Cells) > 0 {
+			separators := make([]string, len(widths))
+			for j, w := range widths {
+				separators[j] = strings.Repeat("-", w)
+			}
+			result = append(result, strings.Join(separators, "-+-"))
+		}
+	}
+	return renderBlockTitle(t.Attributes, width) + strings.Join(result, "\n"), nil
+}
+
+// columnWidths returns the width of each column: columns which are narrower than an equal share of the available width
+// retain their natural width, and the other columns share the remaining width
+func columnWidths(rows [][]string, width int) []int {
+	columns := 0
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+	natural := make([]int, columns)
+	for _, row := range rows {
+		for i, cell := range row {
+			for _, line := range strings.Split(cell, "\n") {
+				if w := textWidth(line); w > natural[i] {
+					natural[i] = w
+				}
+			}
+		}
+	}
+	result := make([]int, columns)
+	available := width - textWidth(columnSeparator)*(columns-1)
+	remaining := columns
+	for remaining > 0 {
+		share := available / remaining
+		fixed := false
+		for i := range natural {
+			if result[i] == 0 && natural[i] <= share {
+				result[i] = natural[i]
+				available -= natural[i]
+				remaining--
+				fixed = true
+			}
+		}
+		if !fixed {
+			// all the other columns are wider than their share
+			for i := range result {
+				if result[i] == 0 {
+					result[i] = share
+				}
+			}
+			break
+		}
+	}
+	for i := range result {
+		if result[i] < 1 {
+			result[i] = 1
+		}
+	}
+	return result
+}
+
+// renderTableRow renders the cells of the row in their column, on as many lines as needed
+func renderTableRow(row []string, widths []int) []string {
+	cells := make([][]string, len(widths))
+	height := 1
+	for i := range widths {
+		if i < len(row) {
+			cells[i] = strings.Split(wrap(row[i], widths[i]), "\n")
+		}
+		if len(cells[i]) > height {
+			height = len(cells[i])
+		}
+	}
+	lines := make([]string, height)
+	for l := range lines {
+		parts := make([]string, len(widths))
+		for i, w := range widths {
+			var content string
+			if l < len(cells[i]) {
+				content = cells[i][l]
+			}
+			parts[i] = content + strings.Repeat(" ", max(w-textWidth(content), 0))
+		}
+		lines[l] = strings.TrimRight(strings.Join(parts, columnSeparator), " ")
+	}
+	return lines
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,38 @@
+package text_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tables", func() {
+
+	It("table with title and header", func() {
+		source := `.A table
+|===
+| Option | Description
+
+| -v | be *verbose*
+| -q | be quiet
+|===`
+		expected := `A table
+Option | Description
+-------+------------
+-v     | be verbose
+-q     | be quiet`
+		Expect(source).To(RenderTextBody(expected))
+	})
+
+	It("table with wrapped cells", func() {
+		source := `|===
+| -v | be verbose, ie, display all messages
+|===`
+		expected := `-v | be verbose, ie,
+   | display all
+   | messages`
+		Expect(source).To(RenderTextBody(expected, renderer.TextWidth(20)))
+	})
+})
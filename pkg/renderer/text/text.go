@@ -0,0 +1,103 @@
+// Package text renders the documents in plain text, wrapped to a maximum number of characters per line
+// (see `renderer.TextWidth`). This format is suitable for emails or search indexes, for example.
+package text
+
+import (
+	"io"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Render renders the given document in plain text and writes the result in the given `writer`
+func Render(ctx *renderer.Context, output io.Writer) (map[string]interface{}, error) {
+	return renderDocument(ctx, output)
+}
+
+// renderElements renders the given blocks within the given width, separated by a blank line
+func renderElements(ctx *renderer.Context, elements []interface{}, width int) (string, error) {
+	log.Debugf("rendering %d elements(s)...", len(elements))
+	result := strings.Builder{}
+	for _, element := range elements {
+		renderedElement, err := renderElement(ctx, element, width)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to render an element")
+		}
+		if renderedElement == "" {
+			continue
+		}
+		if result.Len() > 0 {
+			result.WriteString("\n\n")
+		}
+		result.WriteString(renderedElement)
+	}
+	return result.String(), nil
+}
+
+// renderElement renders the given block within the given width
+// nolint: gocyclo
+func renderElement(ctx *renderer.Context, element interface{}, width int) (string, error) {
+	switch e := element.(type) {
+	case []interface{}:
+		return renderElements(ctx, e, width)
+	case types.TableOfContentsMacro, types.BlankLine:
+		return "", nil
+	case types.Section:
+		return renderSection(ctx, e, width)
+	case types.Preamble:
+		return renderElements(ctx, e.Elements, width)
+	case types.LabeledList:
+		return renderLabeledList(ctx, e, width)
+	case types.OrderedList:
+		return renderOrderedList(ctx, e, width)
+	case types.UnorderedList:
+		return renderUnorderedList(ctx, e, width)
+	case types.Paragraph:
+		return renderParagraph(ctx, e, width)
+	case types.ImageBlock:
+		return renderBlockTitle(e.Attributes, width) + renderImage(e.Attributes), nil
+	case types.VideoBlock:
+		return renderBlockTitle(e.Attributes, width) + renderVideo(e), nil
+	case types.AudioBlock:
+		return renderBlockTitle(e.Attributes, width) + "[audio: " + e.Location.String() + "]", nil
+	case types.DelimitedBlock:
+		return renderDelimitedBlock(ctx, e, width)
+	case types.Table:
+		return renderTable(ctx, e, width)
+	case types.LiteralBlock:
+		return renderLiteralBlock(ctx, e, width)
+	case types.StemBlock:
+		return renderListing(e.Attributes, e.Lines, width), nil
+	case types.UserMacro:
+		return renderUserMacro(ctx, e, width)
+	default:
+		// inline element
+		return renderInlineElement(ctx, element)
+	}
+}
+
+func renderTitle(attrs types.ElementAttributes) string {
+	return strings.TrimSpace(attrs.GetAsString(types.AttrTitle))
+}
+
+// renderBlockTitle renders the title of the block on its own line(s), or returns an empty string if the block has no title
+func renderBlockTitle(attrs types.ElementAttributes, width int) string {
+	if title := renderTitle(attrs); title != "" {
+		return wrap(title, width) + "\n"
+	}
+	return ""
+}
+
+func discardTrailingBlankLines(elements []interface{}) []interface{} {
+	result := elements
+	for len(result) > 0 {
+		if _, ok := result[len(result)-1].(types.BlankLine); !ok {
+			break
+		}
+		result = result[:len(result)-1]
+	}
+	return result
+}
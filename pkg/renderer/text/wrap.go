@@ -0,0 +1,65 @@
+package text
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// wrap wraps the given text so that its lines do not exceed the given width, unless a single word
+// is longer than the width. The existing line breaks are retained.
+func wrap(text string, width int) string {
+	lines := strings.Split(text, "\n")
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		result = append(result, wrapLine(line, width)...)
+	}
+	return strings.Join(result, "\n")
+}
+
+func wrapLine(line string, width int) []string {
+	words := strings.Fields(line)
+	if len(words) == 0 {
+		return []string{""}
+	}
+	result := []string{}
+	current := words[0]
+	for _, word := range words[1:] {
+		if textWidth(current)+1+textWidth(word) > width {
+			result = append(result, current)
+			current = word
+			continue
+		}
+		current = current + " " + word
+	}
+	return append(result, current)
+}
+
+// hangingIndent renders the given prefix on the first line of the wrapped text, and indents the other lines
+// to the width of the prefix
+func hangingIndent(prefix, text string, width int) string {
+	lines := strings.Split(wrap(text, width-textWidth(prefix)), "\n")
+	for i, line := range lines {
+		if i == 0 {
+			lines[i] = prefix + line
+		} else if line != "" {
+			lines[i] = strings.Repeat(" ", textWidth(prefix)) + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// indent indents all non-empty lines of the given text with the given number of spaces
+func indent(text string, n int) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = strings.Repeat(" ", n) + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// textWidth returns the number of characters in the given text
+func textWidth(text string) int {
+	return utf8.RuneCountInString(text)
+}
@@ -0,0 +1,37 @@
+package renderer
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// TreeProcessor a function which processes the given document after it was parsed and before it is pre-rendered,
+// and which returns the document to render instead (eg: with generated sections, or with some elements replaced).
+// The document attributes are available in `doc.Attributes`, and can be modified as well.
+type TreeProcessor func(ctx *Context, doc types.Document) (types.Document, error)
+
+// DefineTreeProcessor defines the given tree processor. The tree processors are applied on the document
+// in the order in which they were defined, each processor receiving the document returned by the previous one.
+func DefineTreeProcessor(p TreeProcessor) Option {
+	return func(ctx *Context) {
+		ctx.treeProcessors = append(ctx.treeProcessors, p)
+	}
+}
+
+// ProcessTree applies the tree processors defined in the given context on its document,
+// and replaces the document with the result
+func ProcessTree(ctx *Context) error {
+	for i, p := range ctx.treeProcessors {
+		log.Debugf("applying tree processor #%d", i+1)
+		doc, err := p(ctx, ctx.Document)
+		if err != nil {
+			return errors.Wrapf(err, "error while processing the document tree")
+		}
+		if doc.Attributes == nil {
+			doc.Attributes = types.DocumentAttributes{}
+		}
+		ctx.Document = doc
+	}
+	return nil
+}
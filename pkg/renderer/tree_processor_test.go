@@ -0,0 +1,103 @@
+package renderer_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("tree processors", func() {
+
+	paragraph := func(content string) types.Paragraph {
+		return types.Paragraph{
+			Attributes: types.ElementAttributes{},
+			Lines: [][]interface{}{
+				{
+					types.StringElement{Content: content},
+				},
+			},
+		}
+	}
+
+	// a processor which appends a paragraph with the given content to the document
+	appendParagraph := func(content string) renderer.TreeProcessor {
+		return func(ctx *renderer.Context, doc types.Document) (types.Document, error) {
+			doc.Elements = append(doc.Elements, paragraph(content))
+			return doc, nil
+		}
+	}
+
+	It("should apply the processors in the order in which they were defined", func() {
+		doc := types.Document{
+			Attributes: types.DocumentAttributes{},
+			Elements: []interface{}{
+				paragraph("foo"),
+			},
+		}
+		ctx := renderer.Wrap(context.Background(), doc,
+			renderer.DefineTreeProcessor(appendParagraph("bar")),
+			renderer.DefineTreeProcessor(appendParagraph("baz")),
+		)
+		err := renderer.ProcessTree(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ctx.Document.Elements).To(Equal([]interface{}{
+			paragraph("foo"),
+			paragraph("bar"),
+			paragraph("baz"),
+		}))
+	})
+
+	It("should give access to the document attributes", func() {
+		doc := types.Document{
+			Attributes: types.DocumentAttributes{
+				"product": "libasciidoc",
+			},
+			Elements: []interface{}{},
+		}
+		ctx := renderer.Wrap(context.Background(), doc,
+			renderer.DefineTreeProcessor(func(ctx *renderer.Context, doc types.Document) (types.Document, error) {
+				doc.Elements = append(doc.Elements, paragraph(doc.Attributes.GetAsStringWithDefault("product", "")))
+				doc.Attributes["processed"] = "true"
+				return doc, nil
+			}),
+		)
+		err := renderer.ProcessTree(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ctx.Document.Elements).To(Equal([]interface{}{
+			paragraph("libasciidoc"),
+		}))
+		Expect(ctx.Document.Attributes).To(HaveKeyWithValue("processed", "true"))
+	})
+
+	It("should keep the processors in the sub-contexts", func() {
+		ctx := renderer.Wrap(context.Background(), types.Document{},
+			renderer.DefineTreeProcessor(appendParagraph("foo")),
+		)
+		subCtx := ctx.WithDocument(types.Document{
+			Attributes: types.DocumentAttributes{},
+			Elements:   []interface{}{},
+		})
+		err := renderer.ProcessTree(subCtx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(subCtx.Document.Elements).To(Equal([]interface{}{
+			paragraph("foo"),
+		}))
+	})
+
+	It("should fail when a processor fails", func() {
+		ctx := renderer.Wrap(context.Background(), types.Document{},
+			renderer.DefineTreeProcessor(func(ctx *renderer.Context, doc types.Document) (types.Document, error) {
+				return doc, fmt.Errorf("mock error")
+			}),
+			renderer.DefineTreeProcessor(appendParagraph("foo")),
+		)
+		err := renderer.ProcessTree(ctx)
+		Expect(err).To(MatchError("error while processing the document tree: mock error"))
+		Expect(ctx.Document.Elements).To(BeEmpty())
+	})
+})
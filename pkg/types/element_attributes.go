@@ -46,6 +46,10 @@ const (
 	AttrSource string = "source"
 	// AttrLanguage the associated `language` attribute for a source block or a source paragraph
 	AttrLanguage string = "language"
+	// AttrStem the `stem` document attribute, which enables the STEM support and defines the default notation
+	AttrStem string = "stem"
+	// AttrStemNotation the notation (`stem`, `asciimath` or `latexmath`) of a STEM block
+	AttrStemNotation string = "stemNotation"
 	// AttrCheckStyle the attribute to mark the first element of an unordered list itemd as a checked or not
 	AttrCheckStyle string = "checkstyle"
 	// AttrStart the `start` attribute in an ordered list
@@ -60,6 +64,8 @@ const (
 	AttrLineRanges string = "lines"
 	// AttrTagRanges the `tag`/`tags` attribute used in file inclusions
 	AttrTagRanges string = "tags"
+	// AttrIncludeDepth the `depth` attribute used in file inclusions, to limit the depth of the inclusions in the file to include
+	AttrIncludeDepth string = "depth"
 	// AttrLastUpdated the "last updated" data in the document, i.e., the output/generation time
 	AttrLastUpdated string = "LastUpdated"
 	// AttrImageAlt the image `alt` attribute
@@ -70,6 +76,67 @@ const (
 	AttrImageHeight string = "height"
 	// AttrImageTitle the image `title` attribute
 	AttrImageTitle string = "title"
+	// AttrImagesDir the `imagesdir` attribute, which can be set at the document level or on an image
+	AttrImagesDir string = "imagesdir"
+	// AttrImageAlign the image block `align` attribute (`left`, `center` or `right`)
+	AttrImageAlign string = "align"
+	// AttrImageFloat the image `float` attribute (`left` or `right`)
+	AttrImageFloat string = "float"
+	// AttrImageFormat the image `format` attribute (eg: `svg`), when it cannot be determined from the image path
+	AttrImageFormat string = "format"
+	// AttrDataURI the `data-uri` document attribute, to embed the images in the output document
+	AttrDataURI string = "data-uri"
+	// AttrAllowURIRead the `allow-uri-read` attribute, to include the content at a URI (eg: `include::https://example.com/README.adoc[]`).
+	// It cannot be declared in the document, and must be set with the options instead
+	AttrAllowURIRead string = "allow-uri-read"
+	// AttrMaxIncludeDepth the `max-include-depth` document attribute, to limit the depth of the nested file inclusions
+	AttrMaxIncludeDepth string = "max-include-depth"
+	// AttrIcons the `icons` document attribute, to render the icons with a font or with images
+	AttrIcons string = "icons"
+	// AttrImageScaledWidth the image `scaledwidth` attribute
+	AttrImageScaledWidth string = "scaledwidth"
+	// AttrImageFit the image `fit` attribute (`contain`, `cover`, `fill`, `none` or `scale-down`)
+	AttrImageFit string = "fit"
+	// AttrLinkWindow the `window` attribute, ie, the target window of a link
+	AttrLinkWindow string = "window"
+	// AttrCaption the `caption` attribute, which overrides the caption (eg: `Figure 1. `) of a block
+	AttrCaption string = "caption"
+	// AttrFigureCaption the `figure-caption` document attribute, ie, the label of the image block captions
+	AttrFigureCaption string = "figure-caption"
+	// AttrIconSize the icon `size` attribute
+	AttrIconSize string = "size"
+	// AttrVideoPoster the video `poster` attribute (or the provider, such as `youtube` or `vimeo`)
+	AttrVideoPoster string = "poster"
+	// AttrEnd the `end` attribute of a video or audio block
+	AttrEnd string = "end"
+	// AttrOptions the `options` attribute, ie, a comma-separated list of options
+	AttrOptions string = "options"
+	// AttrOpts the `opts` attribute (alias of the `options` attribute)
+	AttrOpts string = "opts"
+	// AttrCols the `cols` attribute of a table, ie, the specification of its columns (eg: `1,2,>3m`)
+	AttrCols string = "cols"
+	// AttrDocType the `doctype` document attribute (eg: `article` or `manpage`)
+	AttrDocType string = "doctype"
+	// DocTypeManpage the `manpage` doctype
+	DocTypeManpage string = "manpage"
+	// AttrManTitle the `mantitle` document attribute, ie, the name of the manual page (eg: `git-foo`)
+	AttrManTitle string = "mantitle"
+	// AttrManVolNum the `manvolnum` document attribute, ie, the section of the manual (eg: `1`)
+	AttrManVolNum string = "manvolnum"
+	// AttrManName the `manname` document attribute, ie, the name of the command (from the `NAME` section)
+	AttrManName string = "manname"
+	// AttrManPurpose the `manpurpose` document attribute, ie, the purpose of the command (from the `NAME` section)
+	AttrManPurpose string = "manpurpose"
+	// AttrManManual the `manmanual` document attribute, ie, the name of the manual (eg: `Git Manual`)
+	AttrManManual string = "manmanual"
+	// AttrManSource the `mansource` document attribute, ie, the source of the manual (eg: `Git 2.25`)
+	AttrManSource string = "mansource"
+	// AttrBackend the `backend` document attribute, ie, the backend used to render the document (eg: `html5`)
+	AttrBackend string = "backend"
+	// AttrBaseBackend the `basebackend` document attribute, ie, the family of the backend (eg: `html`)
+	AttrBaseBackend string = "basebackend"
+	// AttrOutFileSuffix the `outfilesuffix` document attribute, ie, the extension of the output file (eg: `.html`)
+	AttrOutFileSuffix string = "outfilesuffix"
 )
 
 // ElementWithAttributes an element on which attributes can be added/set
@@ -197,6 +264,14 @@ func NewSourceAttributes(language interface{}) (ElementAttributes, error) {
 	return result, nil
 }
 
+// NewStemAttributes initializes a new attribute map with two entries, one for the kind of element ("stem") and another one for the notation
+func NewStemAttributes(notation string) (ElementAttributes, error) {
+	return ElementAttributes{
+		AttrKind:         Stem,
+		AttrStemNotation: notation,
+	}, nil
+}
+
 // ElementAttributes is a map[string]interface{} with some utility methods
 type ElementAttributes map[string]interface{}
 
@@ -232,6 +307,19 @@ func (a ElementAttributes) GetAsBool(key string) bool {
 	return false
 }
 
+// HasOption returns `true` if the given option is part of the comma-separated values
+// of the `options` (or `opts`) attribute
+func (a ElementAttributes) HasOption(option string) bool {
+	for _, key := range []string{AttrOptions, AttrOpts} {
+		for _, o := range strings.Split(a.GetAsString(key), ",") {
+			if strings.TrimSpace(o) == option {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // AddAll adds all the given attributes to the current ones
 func (a ElementAttributes) AddAll(attributes ElementAttributes) {
 	if attributes == nil {
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SafeMode the level of restriction of the access to the files and the URIs while processing a document,
+// with the same semantics as in Asciidoctor
+type SafeMode int
+
+const (
+	// SafeModeUnsafe no restriction
+	SafeModeUnsafe SafeMode = 0
+	// SafeModeSafe the files to include and the images to embed must be in the base directory
+	SafeModeSafe SafeMode = 1
+	// SafeModeServer same as `SafeModeSafe`, and the document cannot set the backend with the `backend` attribute
+	SafeModeServer SafeMode = 10
+	// SafeModeSecure same as `SafeModeServer`, and the files are not read at all: the file inclusions are rendered
+	// as links, the content at the URIs is not included, and the document cannot set the `icons` and `data-uri` attributes
+	SafeModeSecure SafeMode = 20
+)
+
+var safeModes = map[string]SafeMode{
+	"unsafe": SafeModeUnsafe,
+	"safe":   SafeModeSafe,
+	"server": SafeModeServer,
+	"secure": SafeModeSecure,
+}
+
+// ParseSafeMode returns the safe mode with the given name (`unsafe`, `safe`, `server` or `secure`)
+func ParseSafeMode(name string) (SafeMode, error) {
+	if mode, found := safeModes[strings.ToLower(name)]; found {
+		return mode, nil
+	}
+	return SafeModeUnsafe, errors.Errorf("unknown safe mode: '%s'", name)
+}
+
+// String returns the name of the safe mode
+func (m SafeMode) String() string {
+	for name, mode := range safeModes {
+		if mode == m {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// LockedAttributes returns the document attributes that the document cannot set in the safe mode.
+// As in Asciidoctor, the `allow-uri-read` attribute can never be set by the document itself, whatever the safe mode.
+func (m SafeMode) LockedAttributes() []string {
+	switch {
+	case m >= SafeModeSecure:
+		return []string{AttrAllowURIRead, AttrBackend, AttrDataURI, AttrIcons}
+	case m >= SafeModeServer:
+		return []string{AttrAllowURIRead, AttrBackend}
+	default:
+		return []string{AttrAllowURIRead}
+	}
+}
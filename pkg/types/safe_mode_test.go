@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("parse safe modes",
+	func(name string, expected types.SafeMode) {
+		mode, err := types.ParseSafeMode(name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mode).To(Equal(expected))
+		Expect(mode.String()).To(Equal(name))
+	},
+	Entry("unsafe", "unsafe", types.SafeModeUnsafe),
+	Entry("safe", "safe", types.SafeModeSafe),
+	Entry("server", "server", types.SafeModeServer),
+	Entry("secure", "secure", types.SafeModeSecure),
+)
+
+var _ = Describe("safe modes", func() {
+
+	It("should not parse an unknown safe mode", func() {
+		_, err := types.ParseSafeMode("paranoid")
+		Expect(err).To(MatchError("unknown safe mode: 'paranoid'"))
+	})
+
+	It("should lock the attributes in the server and secure modes", func() {
+		Expect(types.SafeModeServer.LockedAttributes()).To(ConsistOf(types.AttrAllowURIRead, types.AttrBackend))
+		Expect(types.SafeModeSecure.LockedAttributes()).To(ConsistOf(types.AttrAllowURIRead, types.AttrBackend, types.AttrDataURI, types.AttrIcons))
+	})
+
+	It("should always lock the allow-uri-read attribute", func() {
+		Expect(types.SafeModeUnsafe.LockedAttributes()).To(ConsistOf(types.AttrAllowURIRead))
+		Expect(types.SafeModeSafe.LockedAttributes()).To(ConsistOf(types.AttrAllowURIRead))
+	})
+})
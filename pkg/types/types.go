@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"sort"
+	"strconv"
 	"strings"
 
 	yaml "gopkg.in/yaml.v2"
@@ -283,6 +284,8 @@ const (
 	Literal BlockKind = "literal"
 	// Source a source block
 	Source BlockKind = "source"
+	// Stem a STEM (Science, Technology, Engineering and Math) block
+	Stem BlockKind = "stem"
 )
 
 // ------------------------------------------
@@ -514,6 +517,42 @@ const (
 	UpperGreek NumberingStyle = "uppergreek"
 )
 
+// ItemNumber returns the number of the n-th item of an ordered list, in this numbering style
+// (eg: `c` or `iii` for the third item)
+func (s NumberingStyle) ItemNumber(n int) string {
+	switch s {
+	case LowerAlpha:
+		return string(rune('a' + (n-1)%26))
+	case UpperAlpha:
+		return string(rune('A' + (n-1)%26))
+	case LowerRoman:
+		return strings.ToLower(romanNumber(n))
+	case UpperRoman:
+		return romanNumber(n)
+	default:
+		return strconv.Itoa(n)
+	}
+}
+
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"}, {100, "C"}, {90, "XC"},
+	{50, "L"}, {40, "XL"}, {10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+func romanNumber(n int) string {
+	result := strings.Builder{}
+	for _, r := range romanNumerals {
+		for n >= r.value {
+			result.WriteString(r.symbol)
+			n -= r.value
+		}
+	}
+	return result.String()
+}
+
 // NewOrderedList initializes a new ordered list with the given item
 func NewOrderedList(item *OrderedListItem) *OrderedList {
 	attrs := rearrangeListAttributes(item.Attributes)
@@ -1042,13 +1081,23 @@ func NewImageBlock(path Location, inlineAttributes ElementAttributes, attributes
 // ResolveLocation resolves the image path using the given document attributes
 // also, updates the `alt` attribute based on the resolved path of the image
 func (b ImageBlock) ResolveLocation(attrs DocumentAttributes) ImageBlock {
-	b.Location = b.Location.Resolve(attrs)
+	b.Location = b.Location.Resolve(withImagesDir(attrs, b.Attributes))
 	if _, found := b.Attributes[AttrImageAlt]; !found {
 		b.Attributes[AttrImageAlt] = resolveAlt(b.Location)
 	}
 	return b
 }
 
+// ResolveCaption disables the caption of the image block if it has a title
+// but no custom `caption` while the `figure-caption` document attribute was unset
+func (b ImageBlock) ResolveCaption(attrs DocumentAttributes) ImageBlock {
+	if !b.Attributes.Has(AttrTitle) || b.Attributes.Has(AttrCaption) || attrs.Has(AttrFigureCaption) {
+		return b
+	}
+	b.Attributes[AttrCaption] = ""
+	return b
+}
+
 // InlineImage the structure for the inline image macros
 type InlineImage struct {
 	Location   Location
@@ -1067,13 +1116,28 @@ func NewInlineImage(path Location, attributes ElementAttributes) (InlineImage, e
 // ResolveLocation resolves the image path using the given document attributes
 // also, updates the `alt` attribute based on the resolved path of the image
 func (i InlineImage) ResolveLocation(attrs DocumentAttributes) InlineImage {
-	i.Location = i.Location.Resolve(attrs)
+	i.Location = i.Location.Resolve(withImagesDir(attrs, i.Attributes))
 	if _, found := i.Attributes[AttrImageAlt]; !found {
 		i.Attributes[AttrImageAlt] = resolveAlt(i.Location)
 	}
 	return i
 }
 
+// withImagesDir returns the given document attributes, or a copy of them in which the `imagesdir`
+// is overridden by the value of the element attribute with the same name
+func withImagesDir(attrs DocumentAttributes, elementAttrs ElementAttributes) DocumentAttributes {
+	dir, ok := elementAttrs[AttrImagesDir].(string)
+	if !ok {
+		return attrs
+	}
+	result := DocumentAttributes{}
+	for k, v := range attrs {
+		result[k] = v
+	}
+	result[AttrImagesDir] = dir
+	return result
+}
+
 // NewImageAttributes returns a map of image attributes, some of which have implicit keys (`alt`, `width` and `height`)
 func NewImageAttributes(alt, width, height interface{}, otherattrs []interface{}) (ElementAttributes, error) {
 	result := ElementAttributes{}
@@ -1107,6 +1171,145 @@ func NewImageAttributes(alt, width, height interface{}, otherattrs []interface{}
 	return result, nil
 }
 
+// ------------------------------------------
+// Icons
+// ------------------------------------------
+
+// InlineIcon the structure for the inline icon macros
+type InlineIcon struct {
+	Name       string
+	Attributes ElementAttributes
+}
+
+// NewInlineIcon initializes a new `InlineIcon`
+func NewInlineIcon(name string, attributes ElementAttributes) (InlineIcon, error) {
+	return InlineIcon{
+		Name:       name,
+		Attributes: attributes,
+	}, nil
+}
+
+// NewIconAttributes returns a map of icon attributes, some of which have implicit keys (`size`)
+func NewIconAttributes(size interface{}, otherattrs []interface{}) (ElementAttributes, error) {
+	result := ElementAttributes{}
+	if size, ok := size.(string); ok {
+		result.NilSafeSet(AttrIconSize, Apply(size, strings.TrimSpace))
+	}
+	for _, otherAttr := range otherattrs {
+		if otherAttr, ok := otherAttr.(ElementAttributes); ok {
+			result.AddAll(otherAttr)
+		}
+	}
+	return result, nil
+}
+
+// ------------------------------------------
+// Videos and Audios
+// ------------------------------------------
+
+const (
+	// YouTube the YouTube video provider
+	YouTube string = "youtube"
+	// Vimeo the Vimeo video provider
+	Vimeo string = "vimeo"
+)
+
+// VideoBlock the structure for the block videos
+type VideoBlock struct {
+	Location   Location
+	Attributes ElementAttributes
+}
+
+// NewVideoBlock initializes a new `VideoBlock`
+func NewVideoBlock(path Location, inlineAttributes ElementAttributes, attributes interface{}) (VideoBlock, error) {
+	attrs := ElementAttributes{}
+	if attributes, ok := attributes.(ElementAttributes); ok {
+		attrs.AddAll(attributes)
+	}
+	attrs.AddAll(inlineAttributes)
+	return VideoBlock{
+		Location:   path,
+		Attributes: attrs,
+	}, nil
+}
+
+// Provider returns the video provider (`youtube` or `vimeo`), or an empty string if the video is not hosted by a provider
+func (b VideoBlock) Provider() string {
+	switch p := b.Attributes.GetAsString(AttrVideoPoster); p {
+	case YouTube, Vimeo:
+		return p
+	default:
+		return ""
+	}
+}
+
+// URL returns the URL of the page of the video on its provider (eg: `https://www.youtube.com/watch?v=ID`),
+// or the location of the video if it is not hosted by a provider
+func (b VideoBlock) URL() string {
+	switch b.Provider() {
+	case YouTube:
+		return "https://www.youtube.com/watch?v=" + b.Location.String()
+	case Vimeo:
+		return "https://vimeo.com/" + b.Location.String()
+	default:
+		return b.Location.String()
+	}
+}
+
+// ResolveLocation resolves the video path using the given document attributes
+// (unless the video is hosted by a provider, in which case the location is the ID of the video)
+func (b VideoBlock) ResolveLocation(attrs DocumentAttributes) VideoBlock {
+	if b.Provider() == "" {
+		b.Location = b.Location.Resolve(attrs)
+	}
+	return b
+}
+
+// NewVideoAttributes returns a map of video attributes, some of which have implicit keys (`poster`, `width` and `height`)
+func NewVideoAttributes(poster, width, height interface{}, otherattrs []interface{}) (ElementAttributes, error) {
+	result := ElementAttributes{}
+	for k, v := range map[string]interface{}{
+		AttrVideoPoster: poster,
+		AttrImageWidth:  width,
+		AttrImageHeight: height,
+	} {
+		if v, ok := v.(string); ok {
+			result.NilSafeSet(k, Apply(v, strings.TrimSpace))
+		}
+	}
+	for _, otherAttr := range otherattrs {
+		if otherAttr, ok := otherAttr.(ElementAttributes); ok {
+			result.AddAll(otherAttr)
+		}
+	}
+	return result, nil
+}
+
+// AudioBlock the structure for the block audios
+type AudioBlock struct {
+	Location   Location
+	Attributes ElementAttributes
+}
+
+// NewAudioBlock initializes a new `AudioBlock`
+func NewAudioBlock(path Location, inlineAttributes ElementAttributes, attributes interface{}) (AudioBlock, error) {
+	attrs := ElementAttributes{}
+	if attributes, ok := attributes.(ElementAttributes); ok {
+		attrs.AddAll(attributes)
+	}
+	attrs.AddAll(inlineAttributes)
+	return AudioBlock{
+		Location:   path,
+		Attributes: attrs,
+	}, nil
+}
+
+// ResolveLocation resolves the audio path using the given document attributes
+func (b AudioBlock) ResolveLocation(attrs DocumentAttributes) AudioBlock {
+	b.Location = b.Location.Resolve(attrs)
+	return b
+}
+
 // ------------------------------------------
 // Footnotes
 // ------------------------------------------
@@ -1319,6 +1522,65 @@ func NewLiteralBlock(origin string, lines []interface{}, attributes interface{})
 	}, nil
 }
 
+// ------------------------------------------
+// STEM (Science, Technology, Engineering and Math)
+// ------------------------------------------
+
+// StemNotation the notation of a STEM expression
+type StemNotation string
+
+const (
+	// StemDefault the notation defined by the `stem` document attribute (AsciiMath if the attribute is empty)
+	StemDefault StemNotation = "stem"
+	// AsciiMath the AsciiMath notation
+	AsciiMath StemNotation = "asciimath"
+	// LaTeXMath the LaTeX math notation
+	LaTeXMath StemNotation = "latexmath"
+)
+
+// InlineStem the structure for the `stem:[]`, `asciimath:[]` and `latexmath:[]` inline macros
+type InlineStem struct {
+	Notation StemNotation
+	Content  string
+}
+
+// NewInlineStem initializes a new `InlineStem` with the given notation and content
+func NewInlineStem(notation string, content string) (InlineStem, error) {
+	return InlineStem{
+		Notation: StemNotation(notation),
+		Content:  strings.Replace(content, `\]`, "]", -1),
+	}, nil
+}
+
+// StemBlock the structure for the STEM blocks (delimited with `++++` or as a paragraph)
+type StemBlock struct {
+	Attributes ElementAttributes
+	Notation   StemNotation
+	Lines      []string
+}
+
+// NewStemBlock initializes a new `StemBlock` with the given lines. The notation is retrieved from the attributes.
+func NewStemBlock(lines []interface{}, attributes interface{}) (StemBlock, error) {
+	l, err := toString(lines)
+	if err != nil {
+		return StemBlock{}, errors.Wrapf(err, "unable to initialize a new StemBlock")
+	}
+	attrs := ElementAttributes{}
+	if attributes, ok := attributes.(ElementAttributes); ok {
+		attrs.AddAll(attributes)
+	}
+	notation := StemDefault
+	if n := attrs.GetAsString(AttrStemNotation); n != "" {
+		notation = StemNotation(n)
+	}
+	delete(attrs, AttrStemNotation)
+	return StemBlock{
+		Attributes: attrs,
+		Notation:   notation,
+		Lines:      l,
+	}, nil
+}
+
 // ------------------------------------------
 // BlankLine
 // ------------------------------------------
@@ -1824,8 +2086,6 @@ func (l Location) String() string { // (attrs map[string]string) string {
 	return result.String()
 }
 
-const imagesdir = "imagesdir"
-
 // Resolve resolves the Location by replacing all document attribute substitutions
 // with their associated values, or their corresponding raw text if
 // no attribute matched
@@ -1850,7 +2110,7 @@ func (l *Location) Resolve(attrs DocumentAttributes) Location {
 	if !strings.HasPrefix(location, "/") {
 		if u, err := url.Parse(location); err == nil {
 			if !u.IsAbs() {
-				if imagesdir, ok := attrs.GetAsString(imagesdir); ok {
+				if imagesdir, ok := attrs.GetAsString(AttrImagesDir); ok {
 					location = imagesdir + "/" + location
 				}
 			}
@@ -660,6 +660,47 @@ var _ = DescribeTable("draft document attributes",
 	),
 )
 
+var _ = Describe("ordered list item numbers", func() {
+
+	DescribeTable("item number",
+		func(style types.NumberingStyle, n int, expected string) {
+			Expect(style.ItemNumber(n)).To(Equal(expected))
+		},
+		Entry("arabic", types.Arabic, 12, "12"),
+		Entry("lower alpha", types.LowerAlpha, 3, "c"),
+		Entry("upper alpha", types.UpperAlpha, 27, "A"),
+		Entry("lower roman", types.LowerRoman, 14, "xiv"),
+		Entry("upper roman", types.UpperRoman, 1994, "MCMXCIV"),
+	)
+})
+
+var _ = Describe("video URL", func() {
+
+	DescribeTable("video URL",
+		func(location string, attributes types.ElementAttributes, expected string) {
+			video := types.VideoBlock{
+				Location: types.Location{
+					Elements: []interface{}{
+						types.StringElement{
+							Content: location,
+						},
+					},
+				},
+				Attributes: attributes,
+			}
+			Expect(video.URL()).To(Equal(expected))
+		},
+		Entry("local video", "videos/intro.mp4", types.ElementAttributes{}, "videos/intro.mp4"),
+		Entry("youtube video", "abc", types.ElementAttributes{
+			types.AttrVideoPoster: "youtube",
+			types.AttrStart:       "10",
+		}, "https://www.youtube.com/watch?v=abc"),
+		Entry("vimeo video", "abc", types.ElementAttributes{
+			types.AttrVideoPoster: "vimeo",
+		}, "https://vimeo.com/abc"),
+	)
+})
+
 var _ = Describe("element id resolution", func() {
 
 	Context("sections", func() {
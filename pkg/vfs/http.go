@@ -0,0 +1,109 @@
+package vfs
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultHTTPTimeout the default timeout of the requests to read the content at a URI
+	DefaultHTTPTimeout time.Duration = 30 * time.Second
+	// DefaultMaxSize the default maximum size (in bytes) of the content read at a URI
+	DefaultMaxSize int64 = 10 << 20
+)
+
+// HTTPFS an FS which reads the content at the given URIs (eg: `https://example.com/README.adoc`) with an HTTP client.
+// The responses are cached, so that the content at a given URI is only read once.
+type HTTPFS struct {
+	client  *http.Client
+	maxSize int64
+	mutex   sync.Mutex
+	cache   map[string]*httpEntry
+}
+
+// httpEntry the cached content at a URI. Its mutex is locked while the content is read,
+// so that the concurrent reads of the same URI wait for the first one, without blocking the reads of the other URIs
+type httpEntry struct {
+	mutex   sync.Mutex
+	read    bool
+	content []byte
+}
+
+// NewHTTPFS returns a new FS which reads the content at the given URIs with the given client
+// (or with a client whose timeout is `DefaultHTTPTimeout` if `client` is nil), and which fails
+// if the content is larger than `maxSize` bytes (or `DefaultMaxSize` if `maxSize` is not positive)
+func NewHTTPFS(client *http.Client, maxSize int64) *HTTPFS {
+	if client == nil {
+		client = &http.Client{
+			Timeout: DefaultHTTPTimeout,
+		}
+	}
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	return &HTTPFS{
+		client:  client,
+		maxSize: maxSize,
+		cache:   map[string]*httpEntry{},
+	}
+}
+
+// Open reads the content at the given URI, or returns the content which was already read
+func (fs *HTTPFS) Open(uri string) (io.ReadCloser, error) {
+	content, err := fs.read(uri)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read '%s'", uri)
+	}
+	return ioutil.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (fs *HTTPFS) read(uri string) ([]byte, error) {
+	fs.mutex.Lock()
+	entry, found := fs.cache[uri]
+	if !found {
+		entry = &httpEntry{}
+		fs.cache[uri] = entry
+	}
+	fs.mutex.Unlock()
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+	if entry.read {
+		log.Debugf("reading '%s' from the cache", uri)
+		return entry.content, nil
+	}
+	content, err := fs.get(uri)
+	if err != nil {
+		return nil, err
+	}
+	entry.read = true
+	entry.content = content
+	return content, nil
+}
+
+func (fs *HTTPFS) get(uri string) ([]byte, error) {
+	log.Debugf("reading '%s'", uri)
+	resp, err := fs.client.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, errors.Errorf("unexpected response status: %s", resp.Status)
+	}
+	// read one more byte than the limit, to detect the content which is too large
+	content, err := ioutil.ReadAll(io.LimitReader(resp.Body, fs.maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > fs.maxSize {
+		return nil, errors.Errorf("content is larger than %d bytes", fs.maxSize)
+	}
+	return content, nil
+}
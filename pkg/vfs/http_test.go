@@ -0,0 +1,97 @@
+package vfs_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/vfs"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HTTP file system", func() {
+
+	var server *httptest.Server
+	var hits int32
+
+	BeforeEach(func() {
+		hits = 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&hits, 1)
+			switch r.URL.Path {
+			case "/doc.adoc":
+				fmt.Fprint(w, "some content")
+			case "/slow.adoc":
+				time.Sleep(200 * time.Millisecond)
+				fmt.Fprint(w, "some content")
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should read the content at a URI only once", func() {
+		fs := vfs.NewHTTPFS(nil, 0)
+		Expect(vfs.ReadFile(fs, server.URL+"/doc.adoc")).To(Equal([]byte("some content")))
+		Expect(vfs.ReadFile(fs, server.URL+"/doc.adoc")).To(Equal([]byte("some content")))
+		Expect(atomic.LoadInt32(&hits)).To(Equal(int32(1)))
+	})
+
+	It("should read the content at a URI while another URI is being read", func() {
+		fs := vfs.NewHTTPFS(nil, 0)
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			Expect(vfs.ReadFile(fs, server.URL+"/slow.adoc")).To(Equal([]byte("some content")))
+		}()
+		// wait until the slow request is received by the server
+		Eventually(func() int32 { return atomic.LoadInt32(&hits) }).Should(Equal(int32(1)))
+		start := time.Now()
+		Expect(vfs.ReadFile(fs, server.URL+"/doc.adoc")).To(Equal([]byte("some content")))
+		Expect(time.Since(start)).To(BeNumerically("<", 150*time.Millisecond))
+		Eventually(done).Should(BeClosed())
+	})
+
+	It("should read the content at a URI only once when it is read concurrently", func() {
+		fs := vfs.NewHTTPFS(nil, 0)
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			Expect(vfs.ReadFile(fs, server.URL+"/slow.adoc")).To(Equal([]byte("some content")))
+		}()
+		Eventually(func() int32 { return atomic.LoadInt32(&hits) }).Should(Equal(int32(1)))
+		Expect(vfs.ReadFile(fs, server.URL+"/slow.adoc")).To(Equal([]byte("some content")))
+		Eventually(done).Should(BeClosed())
+		Expect(atomic.LoadInt32(&hits)).To(Equal(int32(1)))
+	})
+
+	It("should not read the content at a missing URI", func() {
+		_, err := vfs.ReadFile(vfs.NewHTTPFS(nil, 0), server.URL+"/missing.adoc")
+		Expect(err).To(MatchError(fmt.Sprintf("unable to read '%s/missing.adoc': unexpected response status: 404 Not Found", server.URL)))
+	})
+
+	It("should not read the content which is too large", func() {
+		_, err := vfs.ReadFile(vfs.NewHTTPFS(nil, 5), server.URL+"/doc.adoc")
+		Expect(err).To(MatchError(fmt.Sprintf("unable to read '%s/doc.adoc': content is larger than 5 bytes", server.URL)))
+	})
+
+	It("should not read the content after the timeout of the client", func() {
+		client := &http.Client{
+			Timeout: 50 * time.Millisecond,
+		}
+		_, err := vfs.ReadFile(vfs.NewHTTPFS(client, 0), server.URL+"/slow.adoc")
+		Expect(err).To(HaveOccurred())
+		Expect(strings.Contains(err.Error(), "Client.Timeout exceeded")).To(BeTrue())
+	})
+})
@@ -0,0 +1,92 @@
+// Package vfs provides the access to the files which are included in the documents or embedded in the output
+// (eg: the images with the `data-uri` document attribute), so that the documents can be read from another source
+// than the local filesystem, such as a database or a git tree.
+package vfs
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// FS the access to the files, given their path
+type FS interface {
+	// Open opens the file at the given path for reading
+	Open(name string) (io.ReadCloser, error)
+}
+
+// ReadFile reads the whole content of the file at the given path in the given FS
+func ReadFile(fs FS, name string) ([]byte, error) {
+	f, err := fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+// OS the local filesystem
+var OS FS = osFS{}
+
+type osFS struct{}
+
+func (osFS) Open(name string) (io.ReadCloser, error) {
+	return os.Open(name)
+}
+
+// MemFS an in-memory FS, with the content of the files by path (eg: `chapters/chapter-1.adoc`).
+// The paths are cleaned and use forward slashes as separators, regardless of the OS
+type MemFS map[string][]byte
+
+// Open opens the file at the given path for reading
+func (fs MemFS) Open(name string) (io.ReadCloser, error) {
+	content, found := fs[path.Clean(filepath.ToSlash(name))]
+	if !found {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	return ioutil.NopCloser(bytes.NewReader(content)), nil
+}
+
+// Within returns true if the given path is the given base directory or a path within it.
+// Both paths are made absolute with the current working directory if they are relative, and the symbolic links
+// that they contain are resolved, so that a link within the base directory cannot point to a file outside of it
+func Within(base, path string) bool {
+	absBase, err := resolve(base)
+	if err != nil {
+		return false
+	}
+	absPath, err := resolve(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(absBase, absPath)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// resolve returns the absolute path of the given path, in which the symbolic links are resolved.
+// If the path does not exist, the links are resolved in its longest existing parent directory.
+func resolve(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	dir, rest := abs, ""
+	for {
+		if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+			return filepath.Join(resolved, rest), nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return abs, nil
+		}
+		rest = filepath.Join(filepath.Base(dir), rest)
+		dir = parent
+	}
+}
@@ -0,0 +1,13 @@
+package vfs_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestVFS(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "VFS Suite")
+}
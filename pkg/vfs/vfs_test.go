@@ -0,0 +1,67 @@
+package vfs_test
+
+import (
+	"os"
+
+	"github.com/bytesparadise/libasciidoc/pkg/vfs"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("file systems", func() {
+
+	Context("in-memory file system", func() {
+
+		fs := vfs.MemFS{
+			"docs/index.adoc": []byte("= Index"),
+		}
+
+		It("should read a file", func() {
+			Expect(vfs.ReadFile(fs, "docs/index.adoc")).To(Equal([]byte("= Index")))
+		})
+
+		It("should read a file given a path which is not clean", func() {
+			Expect(vfs.ReadFile(fs, "docs/../docs/./index.adoc")).To(Equal([]byte("= Index")))
+		})
+
+		It("should not read a missing file", func() {
+			_, err := vfs.ReadFile(fs, "docs/missing.adoc")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	Context("local filesystem", func() {
+
+		It("should read a file", func() {
+			content, err := vfs.ReadFile(vfs.OS, "../../test/includes/chapter-a.adoc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(HavePrefix("= Chapter A"))
+		})
+	})
+
+	Context("base directories", func() {
+
+		It("should accept the paths within the base directory", func() {
+			Expect(vfs.Within("docs", "docs")).To(BeTrue())
+			Expect(vfs.Within("docs", "docs/chapters/../index.adoc")).To(BeTrue())
+			Expect(vfs.Within("docs", "docs/..foo.adoc")).To(BeTrue())
+		})
+
+		It("should reject the paths outside of the base directory", func() {
+			Expect(vfs.Within("docs", "docs/../secrets.adoc")).To(BeFalse())
+			Expect(vfs.Within("docs", "/etc/passwd")).To(BeFalse())
+			Expect(vfs.Within("docs", "..")).To(BeFalse())
+		})
+
+		It("should accept the symbolic links to the files within the base directory", func() {
+			Expect(vfs.Within("../../test/includes/symlinks", "../../test/includes/symlinks/inside.adoc")).To(BeTrue())
+		})
+
+		It("should reject the symbolic links to the files outside of the base directory", func() {
+			Expect(vfs.Within("../../test/includes/symlinks", "../../test/includes/symlinks/outside.adoc")).To(BeFalse())
+			Expect(vfs.Within("../../test/includes/symlinks", "../../test/includes/symlinks/parent/chapter-a.adoc")).To(BeFalse())
+			Expect(vfs.Within("../../test/includes/symlinks", "../../test/includes/symlinks/parent/missing.adoc")).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,108 @@
+package test_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/formatter"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/asciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("asciidoc round-trip", func() {
+
+	// verifies that all files in the `supported` subfolder can be rendered in AsciiDoc and parsed again,
+	// with no difference in the resulting (draft) documents
+	DescribeTable("draft documents", roundTripDraft, entries("fixtures/supported/*.adoc")...)
+	DescribeTable("documents", roundTrip, entries("fixtures/supported/*.adoc")...)
+})
+
+var _ = Describe("formatter round-trip", func() {
+
+	// verifies that all files in the `supported` subfolder are rendered in the same HTML once formatted
+	DescribeTable("documents", func(file string) {
+		content, err := ioutil.ReadFile(file)
+		Expect(err).ShouldNot(HaveOccurred())
+		formatRoundTrip(file, string(content))
+	}, entries("fixtures/supported/*.adoc")...)
+
+	DescribeTable("sources", func(source string) {
+		formatRoundTrip("test.adoc", source)
+	},
+		Entry("escaped quoted texts", `\*not bold* and \**not bold** and \_not italic_ and \`+"`not monospace`"+`
+*bold \*text*`),
+		Entry("style and ID in an attribute group", `[NOTE , id=x]
+a paragraph`),
+		Entry("source block with spaces around the language", `[source , go]
+----
+func main() {}
+----`),
+		Entry("table with spaces around the attributes", `[cols="1,1" , options="header"]
+|===
+| a | b
+| c | d
+|===`),
+		Entry("attribute substitutions", `:foo: bar
+
+a {foo} paragraph
+
+----
+a {foo} listing
+----`),
+	)
+})
+
+// formatRoundTrip verifies that the given source and its formatted version are rendered in the same HTML
+func formatRoundTrip(file, source string) {
+	formatted := bytes.NewBuffer(nil)
+	err := formatter.Format(file, bytes.NewReader([]byte(source)), formatted)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(toHTML(file, source)).To(Equal(toHTML(file, formatted.String())))
+}
+
+func toHTML(file, source string) string {
+	types.ResetFootnoteSequence()
+	output := bytes.NewBuffer(nil)
+	_, err := libasciidoc.ConvertToHTML(context.Background(), file, bytes.NewReader([]byte(source)), output, renderer.LastUpdated(time.Time{}))
+	Expect(err).ShouldNot(HaveOccurred())
+	return output.String()
+}
+
+func roundTripDraft(file string) {
+	content, err := ioutil.ReadFile(file)
+	Expect(err).ShouldNot(HaveOccurred())
+	types.ResetFootnoteSequence()
+	expected, err := parser.ParseDraftDocument(file, bytes.NewReader(content))
+	Expect(err).ShouldNot(HaveOccurred())
+	output := bytes.NewBuffer(nil)
+	err = asciidoc.RenderDraft(expected, output)
+	Expect(err).ShouldNot(HaveOccurred())
+	types.ResetFootnoteSequence()
+	actual, err := parser.ParseDraftDocument(file, output)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(actual).To(Equal(expected))
+}
+
+func roundTrip(file string) {
+	content, err := ioutil.ReadFile(file)
+	Expect(err).ShouldNot(HaveOccurred())
+	types.ResetFootnoteSequence()
+	expected, err := parser.ParseDocument(file, bytes.NewReader(content))
+	Expect(err).ShouldNot(HaveOccurred())
+	output := bytes.NewBuffer(nil)
+	_, err = asciidoc.Render(renderer.Wrap(context.Background(), expected), output)
+	Expect(err).ShouldNot(HaveOccurred())
+	types.ResetFootnoteSequence()
+	actual, err := parser.ParseDocument(file, output)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(actual).To(Equal(expected))
+}
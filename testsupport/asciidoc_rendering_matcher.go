@@ -0,0 +1,93 @@
+package testsupport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/asciidoc"
+
+	gomegatypes "github.com/onsi/gomega/types"
+	"github.com/pkg/errors"
+)
+
+// ----------------------
+// Render AsciiDoc
+// ----------------------
+
+// RenderAsciiDoc a custom matcher to verify that a content renders as the expectation once parsed in a document
+// and serialized back in AsciiDoc
+func RenderAsciiDoc(expected string, options ...FilenameOption) gomegatypes.GomegaMatcher {
+	return newAsciiDocMatcher("RenderAsciiDoc", false, expected, options...)
+}
+
+// RenderDraftAsciiDoc a custom matcher to verify that a content renders as the expectation once parsed in a draft
+// document and serialized back in AsciiDoc
+func RenderDraftAsciiDoc(expected string, options ...FilenameOption) gomegatypes.GomegaMatcher {
+	return newAsciiDocMatcher("RenderDraftAsciiDoc", true, expected, options...)
+}
+
+func newAsciiDocMatcher(name string, draft bool, expected string, options ...FilenameOption) *asciidocMatcher {
+	m := &asciidocMatcher{
+		name:     name,
+		draft:    draft,
+		expected: expected,
+		filename: "test.adoc",
+	}
+	for _, configure := range options {
+		configure(m)
+	}
+	return m
+}
+
+func (m *asciidocMatcher) setFilename(f string) {
+	m.filename = f
+}
+
+type asciidocMatcher struct {
+	name       string
+	draft      bool
+	filename   string
+	expected   string
+	actual     string
+	comparison comparison
+}
+
+func (m *asciidocMatcher) Match(actual interface{}) (success bool, err error) {
+	content, ok := actual.(string)
+	if !ok {
+		return false, errors.Errorf("%s matcher expects a string (actual: %T)", m.name, actual)
+	}
+	resultWriter := bytes.NewBuffer(nil)
+	if m.draft {
+		doc, err := parser.ParseDraftDocument(m.filename, strings.NewReader(content))
+		if err != nil {
+			return false, err
+		}
+		if err := asciidoc.RenderDraft(doc, resultWriter); err != nil {
+			return false, err
+		}
+	} else {
+		doc, err := parser.ParseDocument(m.filename, strings.NewReader(content))
+		if err != nil {
+			return false, err
+		}
+		if _, err := asciidoc.Render(renderer.Wrap(context.Background(), doc), resultWriter); err != nil {
+			return false, err
+		}
+	}
+	m.actual = resultWriter.String()
+	m.comparison = compare(m.actual, m.expected)
+	return m.comparison.diffs == "", nil
+}
+
+func (m *asciidocMatcher) FailureMessage(_ interface{}) (message string) {
+	return fmt.Sprintf("expected AsciiDoc contents to match:\n\texpected: '%v'\n\tactual:   '%v'", m.expected, m.actual)
+}
+
+func (m *asciidocMatcher) NegatedFailureMessage(_ interface{}) (message string) {
+	return fmt.Sprintf("expected AsciiDoc contents not to match:\n\texpected: '%v'\n\tactual:   '%v'", m.expected, m.actual)
+}
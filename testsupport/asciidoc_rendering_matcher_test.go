@@ -0,0 +1,62 @@
+package testsupport_test
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("asciidoc rendering assertions", func() {
+
+	It("should match document", func() {
+		// given
+		matcher := testsupport.RenderAsciiDoc("hello, *world*!\n\nhello again\n")
+		actual := "hello, *world*!\n\n\n// a comment\nhello again"
+		// when
+		result, err := matcher.Match(actual)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeTrue())
+	})
+
+	It("should match draft document", func() {
+		// given
+		matcher := testsupport.RenderDraftAsciiDoc("hello, *world*!\n\n\n// a comment\nhello again\n")
+		actual := "hello, *world*!\n\n\n// a comment\nhello again"
+		// when
+		result, err := matcher.Match(actual)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeTrue())
+	})
+
+	It("should not match", func() {
+		// given
+		expected := "hello, world!\n"
+		matcher := testsupport.RenderAsciiDoc(expected)
+		actual := "foo"
+		// when
+		result, err := matcher.Match(actual)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeFalse())
+		// also verify messages
+		obtained := "foo\n"
+		Expect(matcher.FailureMessage(actual)).To(Equal(fmt.Sprintf("expected AsciiDoc contents to match:\n\texpected: '%v'\n\tactual:   '%v'", expected, obtained)))
+		Expect(matcher.NegatedFailureMessage(actual)).To(Equal(fmt.Sprintf("expected AsciiDoc contents not to match:\n\texpected: '%v'\n\tactual:   '%v'", expected, obtained)))
+	})
+
+	It("should return error when invalid type is input", func() {
+		// given
+		matcher := testsupport.RenderDraftAsciiDoc("")
+		// when
+		result, err := matcher.Match(1) // not a string
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("RenderDraftAsciiDoc matcher expects a string (actual: int)"))
+		Expect(result).To(BeFalse())
+	})
+})
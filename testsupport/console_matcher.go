@@ -63,9 +63,22 @@ func ContainMessageWithLevel(level log.Level, msg string) types.GomegaMatcher {
 	}
 }
 
+// ContainMessageWithLevelAndError a custom Matcher to verify that a message with at a given level was logged
+// along with the given error
+func ContainMessageWithLevelAndError(level log.Level, msg, err string) types.GomegaMatcher {
+	return &containMessageMatcher{
+		level:     level,
+		msg:       msg,
+		withError: true,
+		err:       err,
+	}
+}
+
 type containMessageMatcher struct {
-	level log.Level
-	msg   string
+	level     log.Level
+	msg       string
+	withError bool
+	err       string
 }
 
 func (m *containMessageMatcher) Match(actual interface{}) (success bool, err error) {
@@ -86,6 +99,9 @@ func (m *containMessageMatcher) Match(actual interface{}) (success bool, err err
 		if msg, ok := out["msg"].(string); !ok || msg != m.msg {
 			continue
 		}
+		if e, ok := out["error"].(string); m.withError && (!ok || e != m.err) {
+			continue
+		}
 		// match found
 		return true, nil
 	}
@@ -94,10 +110,16 @@ func (m *containMessageMatcher) Match(actual interface{}) (success bool, err err
 }
 
 func (m *containMessageMatcher) FailureMessage(_ interface{}) (message string) {
+	if m.withError {
+		return fmt.Sprintf("expected console to contain message '%s' with level '%v' and error '%s'", m.msg, m.level, m.err)
+	}
 	return fmt.Sprintf("expected console to contain message '%s' with level '%v'", m.msg, m.level)
 }
 
 func (m *containMessageMatcher) NegatedFailureMessage(_ interface{}) (message string) {
+	if m.withError {
+		return fmt.Sprintf("expected console not to contain message '%s' with level '%v' and error '%s'", m.msg, m.level, m.err)
+	}
 	return fmt.Sprintf("expected console not to contain message '%s' with level '%v'", m.msg, m.level)
 }
 
@@ -68,6 +68,32 @@ var _ = Describe("console assertions", func() {
 		})
 	})
 
+	Context("with message, level and error", func() {
+
+		It("should find expected level/message/error", func() {
+			// given
+			matcher := testsupport.ContainMessageWithLevelAndError(log.ErrorLevel, "failed to include '../../test/includes/unknown.adoc'", "open unknown.adoc: no such file or directory")
+			// when
+			result, err := matcher.Match(strings.NewReader(console))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeTrue())
+		})
+
+		It("should not find expected level/message/error with wrong error", func() {
+			// given
+			matcher := testsupport.ContainMessageWithLevelAndError(log.ErrorLevel, "failed to include '../../test/includes/unknown.adoc'", "foo") // unknown error
+			// when
+			result, err := matcher.Match(strings.NewReader(console))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeFalse())
+			// also verify the messages
+			Expect(matcher.FailureMessage(strings.NewReader(console))).To(Equal(fmt.Sprintf("expected console to contain message '%s' with level '%v' and error '%s'", "failed to include '../../test/includes/unknown.adoc'", log.ErrorLevel, "foo")))
+			Expect(matcher.NegatedFailureMessage(strings.NewReader(console))).To(Equal(fmt.Sprintf("expected console not to contain message '%s' with level '%v' and error '%s'", "failed to include '../../test/includes/unknown.adoc'", log.ErrorLevel, "foo")))
+		})
+	})
+
 	Context("with level only", func() {
 
 		It("should find with single given level", func() {
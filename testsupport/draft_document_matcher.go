@@ -47,7 +47,7 @@ func (m *draftDocumentMatcher) Match(actual interface{}) (success bool, err erro
 	}
 	r := strings.NewReader(content)
 	if !m.preprocessing {
-		m.actual, err = parser.ParseReader(m.filename, r, parser.Entrypoint("AsciidocDocument"))
+		m.actual, err = parser.ParseRawDocument(m.filename, r)
 	} else {
 		m.actual, err = parser.ParseDraftDocument(m.filename, r)
 	}
@@ -0,0 +1,105 @@
+package testsupport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	gomegatypes "github.com/onsi/gomega/types"
+	"github.com/pkg/errors"
+)
+
+// --------------------
+// Render Body
+// --------------------
+
+// RenderBody a custom matcher to verify that a block renders as the expectation with the given backend
+// (eg: `markdown` or `latex`)
+func RenderBody(backend, expected string, options ...interface{}) gomegatypes.GomegaMatcher {
+	m := &bodyMatcher{
+		backend:  backend,
+		expected: expected,
+		filename: "test.adoc",
+		opts:     []renderer.Option{},
+	}
+	for _, o := range options {
+		if configure, ok := o.(FilenameOption); ok {
+			configure(m)
+		} else if opt, ok := o.(renderer.Option); ok {
+			m.opts = append(m.opts, opt)
+		}
+	}
+	return m
+}
+
+// RenderDocBook5Body a custom matcher to verify that a block renders as the expectation with the DocBook 5 backend
+func RenderDocBook5Body(expected string, options ...interface{}) gomegatypes.GomegaMatcher {
+	return RenderBody("docbook5", expected, options...)
+}
+
+// RenderLaTeXBody a custom matcher to verify that a block renders as the expectation with the LaTeX backend
+func RenderLaTeXBody(expected string, options ...interface{}) gomegatypes.GomegaMatcher {
+	return RenderBody("latex", expected, options...)
+}
+
+// RenderManpageBody a custom matcher to verify that a block renders as the expectation with the manpage backend
+func RenderManpageBody(expected string, options ...interface{}) gomegatypes.GomegaMatcher {
+	return RenderBody("manpage", expected, options...)
+}
+
+// RenderMarkdownBody a custom matcher to verify that a block renders as the expectation with the Markdown backend
+func RenderMarkdownBody(expected string, options ...interface{}) gomegatypes.GomegaMatcher {
+	return RenderBody("markdown", expected, options...)
+}
+
+// RenderTextBody a custom matcher to verify that a block renders as the expectation with the plain text backend
+func RenderTextBody(expected string, options ...interface{}) gomegatypes.GomegaMatcher {
+	return RenderBody("text", expected, options...)
+}
+
+func (m *bodyMatcher) setFilename(f string) {
+	m.filename = f
+}
+
+type bodyMatcher struct {
+	backend    string
+	opts       []renderer.Option
+	filename   string
+	expected   string
+	actual     string
+	comparison comparison
+}
+
+func (m *bodyMatcher) Match(actual interface{}) (success bool, err error) {
+	content, ok := actual.(string)
+	if !ok {
+		return false, errors.Errorf("RenderBody matcher expects a string (actual: %T)", actual)
+	}
+	contentReader := strings.NewReader(content)
+	resultWriter := bytes.NewBuffer(nil)
+	metadata, err := libasciidoc.Convert(context.Background(), m.filename, contentReader, resultWriter, append(m.opts, renderer.Backend(m.backend))...)
+	if err != nil {
+		return false, err
+	}
+	if strings.Contains(m.expected, "{{.LastUpdated}}") {
+		if lastUpdated, ok := metadata[types.AttrLastUpdated].(string); ok {
+			m.expected = strings.Replace(m.expected, "{{.LastUpdated}}", lastUpdated, 1)
+		}
+	}
+	m.actual = resultWriter.String()
+	m.comparison = compare(m.actual, m.expected)
+	return m.comparison.diffs == "", nil
+}
+
+func (m *bodyMatcher) FailureMessage(_ interface{}) (message string) {
+	return fmt.Sprintf("expected %s bodies to match:\n\texpected: '%v'\n\tactual:   '%v'", m.backend, m.expected, m.actual)
+}
+
+func (m *bodyMatcher) NegatedFailureMessage(_ interface{}) (message string) {
+	return fmt.Sprintf("expected %s bodies not to match:\n\texpected: '%v'\n\tactual:   '%v'", m.backend, m.expected, m.actual)
+}
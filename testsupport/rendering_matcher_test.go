@@ -0,0 +1,83 @@
+package testsupport_test
+
+import (
+	"fmt"
+
+	"github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+)
+
+var _ = Describe("rendering assertions", func() {
+
+	expected := `hello, *world*!`
+
+	It("should match", func() {
+		// given
+		matcher := testsupport.RenderBody("markdown", expected)
+		actual := "hello, _world_!"
+		// when
+		result, err := matcher.Match(actual)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeTrue())
+	})
+
+	It("should not match", func() {
+		// given
+		matcher := testsupport.RenderBody("markdown", expected)
+		actual := "foo"
+		// when
+		result, err := matcher.Match(actual)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeFalse())
+		// also verify messages
+		obtained := `foo`
+		Expect(matcher.FailureMessage(actual)).To(Equal(fmt.Sprintf("expected markdown bodies to match:\n\texpected: '%v'\n\tactual:   '%v'", expected, obtained)))
+		Expect(matcher.NegatedFailureMessage(actual)).To(Equal(fmt.Sprintf("expected markdown bodies not to match:\n\texpected: '%v'\n\tactual:   '%v'", expected, obtained)))
+	})
+
+	It("should return error when invalid type is input", func() {
+		// given
+		matcher := testsupport.RenderBody("markdown", "")
+		// when
+		result, err := matcher.Match(1) // not a string
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("RenderBody matcher expects a string (actual: int)"))
+		Expect(result).To(BeFalse())
+	})
+
+	It("should fail with an unknown backend", func() {
+		// given
+		matcher := testsupport.RenderBody("unknown", "")
+		// when
+		result, err := matcher.Match("hello, world!")
+		// then
+		Expect(err).To(MatchError("unsupported backend: 'unknown'"))
+		Expect(result).To(BeFalse())
+	})
+
+	DescribeTable("should match with the backends",
+		func(matcher func(string, ...interface{}) gomegatypes.GomegaMatcher, source, expected string) {
+			// when
+			result, err := matcher(expected).Match(source)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeTrue())
+		},
+		Entry("docbook5", testsupport.RenderDocBook5Body, "hello, world!", `<simpara>hello, world!</simpara>`),
+		Entry("latex", testsupport.RenderLaTeXBody, "hello, *world*!", `hello, \textbf{world}!`),
+		Entry("manpage", testsupport.RenderManpageBody, "= foo(1)\n\n== NAME\n\nfoo - does things\n\n== SYNOPSIS\n\nhello, world!", `.SH "NAME"
+foo \- does things
+.SH "SYNOPSIS"
+.sp
+hello, world!`),
+		Entry("markdown", testsupport.RenderMarkdownBody, "hello, _world_!", `hello, *world*!`),
+		Entry("text", testsupport.RenderTextBody, "hello, *world*!", `hello, world!`),
+	)
+})